@@ -0,0 +1,66 @@
+package ints
+
+import (
+	"math/big"
+	"math/bits"
+)
+
+// wordsFromLimbs stores the limbs, given in big-endian order, into z as little-endian [big.Word]s, and returns z.
+// len(z) must be len(limbs)*64/bits.UintSize.
+func wordsFromLimbs(z []big.Word, limbs []uint64) []big.Word {
+	if bits.UintSize == 64 {
+		for i, v := range limbs {
+			z[len(limbs)-1-i] = big.Word(v)
+		}
+		return z
+	}
+
+	// 32-bit platforms
+	for i, v := range limbs {
+		j := 2 * (len(limbs) - 1 - i)
+		z[j] = big.Word(v)
+		z[j+1] = big.Word(v >> 32)
+	}
+	return z
+}
+
+// magnitudeFromBigInt stores |x| modulo 2**(64*len(z)) into z in big-endian order.
+// It reports whether |x| fits in z.
+func magnitudeFromBigInt(z []uint64, x *big.Int) bool {
+	clear(z)
+	fit := true
+	for i, w := range x.Bits() {
+		j := i * bits.UintSize / 64
+		if j >= len(z) {
+			fit = fit && w == 0
+			continue
+		}
+		z[len(z)-1-j] |= uint64(w) << (i * bits.UintSize % 64)
+	}
+	return fit
+}
+
+// uintFromBigInt stores x modulo 2**(64*len(z)) into z in big-endian order.
+// It reports whether x is representable as a bitSize-bit unsigned integer.
+func uintFromBigInt(z []uint64, bitSize int, x *big.Int) bool {
+	fit := magnitudeFromBigInt(z, x)
+
+	// mask of the valid bits in the most significant limb.
+	top := ^uint64(0) >> (64*len(z) - bitSize)
+	ok := fit && z[0] <= top && x.Sign() >= 0
+	if x.Sign() < 0 {
+		negLimbs(z)
+	}
+	return ok
+}
+
+// intFromBigInt stores x modulo 2**(64*len(z)) into z in two's complement big-endian order.
+// It reports whether x is representable as a bitSize-bit signed integer.
+func intFromBigInt(z []uint64, bitSize int, x *big.Int) bool {
+	fit := magnitudeFromBigInt(z, x)
+	ok := fit && fitsInt(z, bitSize, x.Sign() < 0)
+	if x.Sign() < 0 {
+		negLimbs(z)
+	}
+	return ok
+}
@@ -0,0 +1,56 @@
+package ints
+
+import "math/big"
+
+func bigIntFromString(s string) *big.Int {
+	b, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		panic("invalid number: " + s)
+	}
+	return b
+}
+
+// saturateBigInt clamps x to the range of a bitSize-bit integer.
+func saturateBigInt(x *big.Int, bitSize int, signed bool) *big.Int {
+	var lo, hi big.Int
+	if signed {
+		hi.Lsh(big.NewInt(1), uint(bitSize-1))
+		lo.Neg(&hi)
+		hi.Sub(&hi, big.NewInt(1))
+	} else {
+		hi.Lsh(big.NewInt(1), uint(bitSize))
+		hi.Sub(&hi, big.NewInt(1))
+	}
+	switch {
+	case x.Cmp(&lo) < 0:
+		return &lo
+	case x.Cmp(&hi) > 0:
+		return &hi
+	}
+	return x
+}
+
+// rshRoundBigInt returns x/2**i rounded to the nearest integer, with ties rounded to even.
+func rshRoundBigInt(x *big.Int, i uint) *big.Int {
+	// q = floor(x / 2**i), r = x - q * 2**i
+	q := new(big.Int).Rsh(x, i)
+	r := new(big.Int).Sub(x, new(big.Int).Lsh(q, i))
+
+	// compare 2*r with 2**i
+	switch new(big.Int).Lsh(r, 1).Cmp(new(big.Int).Lsh(big.NewInt(1), i)) {
+	case 1:
+		q.Add(q, big.NewInt(1))
+	case 0:
+		if q.Bit(0) == 1 {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+	return q
+}
+
+// rshCeilBigInt returns x/2**i rounded toward positive infinity.
+func rshCeilBigInt(x *big.Int, i uint) *big.Int {
+	q := new(big.Int).Neg(x)
+	q.Rsh(q, i)
+	return q.Neg(q)
+}
@@ -1,5 +1,7 @@
 package ints
 
+import "math"
+
 // Int8 returns a itself.
 func (a Int8) Int8() Int8 {
 	return a
@@ -74,6 +76,360 @@ func (a Int8) Int1024() Int1024 {
 	}
 }
 
+// Uint8 converts a to a Uint8, reinterpreting its two's complement representation.
+// If a is negative, it is sign-extended and the result is a+2**8.
+func (a Int8) Uint8() Uint8 {
+	return Uint8(a)
+}
+
+// Uint16 converts a to a Uint16, reinterpreting its two's complement representation.
+// If a is negative, it is sign-extended and the result is a+2**16.
+func (a Int8) Uint16() Uint16 {
+	return Uint16(a)
+}
+
+// Uint32 converts a to a Uint32, reinterpreting its two's complement representation.
+// If a is negative, it is sign-extended and the result is a+2**32.
+func (a Int8) Uint32() Uint32 {
+	return Uint32(a)
+}
+
+// Uint64 converts a to a Uint64, reinterpreting its two's complement representation.
+// If a is negative, it is sign-extended and the result is a+2**64.
+func (a Int8) Uint64() Uint64 {
+	return Uint64(a)
+}
+
+// Uint128 converts a to a Uint128, reinterpreting its two's complement representation.
+// If a is negative, it is sign-extended and the result is a+2**128.
+func (a Int8) Uint128() Uint128 {
+	return Uint128{uint64(a >> 7), uint64(a)}
+}
+
+// Uint256 converts a to a Uint256, reinterpreting its two's complement representation.
+// If a is negative, it is sign-extended and the result is a+2**256.
+func (a Int8) Uint256() Uint256 {
+	sign := uint64(a >> 7)
+	return Uint256{
+		sign,
+		sign,
+		sign,
+		uint64(a),
+	}
+}
+
+// Uint512 converts a to a Uint512, reinterpreting its two's complement representation.
+// If a is negative, it is sign-extended and the result is a+2**512.
+func (a Int8) Uint512() Uint512 {
+	sign := uint64(a >> 7)
+	return Uint512{
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		uint64(a),
+	}
+}
+
+// Uint1024 converts a to a Uint1024, reinterpreting its two's complement representation.
+// If a is negative, it is sign-extended and the result is a+2**1024.
+func (a Int8) Uint1024() Uint1024 {
+	sign := uint64(a >> 7)
+	return Uint1024{
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		uint64(a),
+	}
+}
+
+// TryUint8 converts a to a Uint8.
+// It reports whether the value of a is representable as a Uint8;
+// if not, the result is the same as [Int8.Uint8].
+func (a Int8) TryUint8() (Uint8, bool) {
+	b := a.Uint8()
+	return b, a.Sign() >= 0 && b.Int8() == a
+}
+
+// TryUint16 converts a to a Uint16.
+// It reports whether the value of a is representable as a Uint16;
+// if not, the result is the same as [Int8.Uint16].
+func (a Int8) TryUint16() (Uint16, bool) {
+	b := a.Uint16()
+	return b, a.Sign() >= 0 && b.Int8() == a
+}
+
+// TryUint32 converts a to a Uint32.
+// It reports whether the value of a is representable as a Uint32;
+// if not, the result is the same as [Int8.Uint32].
+func (a Int8) TryUint32() (Uint32, bool) {
+	b := a.Uint32()
+	return b, a.Sign() >= 0 && b.Int8() == a
+}
+
+// TryUint64 converts a to a Uint64.
+// It reports whether the value of a is representable as a Uint64;
+// if not, the result is the same as [Int8.Uint64].
+func (a Int8) TryUint64() (Uint64, bool) {
+	b := a.Uint64()
+	return b, a.Sign() >= 0 && b.Int8() == a
+}
+
+// TryUint128 converts a to a Uint128.
+// It reports whether the value of a is representable as a Uint128;
+// if not, the result is the same as [Int8.Uint128].
+func (a Int8) TryUint128() (Uint128, bool) {
+	b := a.Uint128()
+	return b, a.Sign() >= 0 && b.Int8() == a
+}
+
+// TryUint256 converts a to a Uint256.
+// It reports whether the value of a is representable as a Uint256;
+// if not, the result is the same as [Int8.Uint256].
+func (a Int8) TryUint256() (Uint256, bool) {
+	b := a.Uint256()
+	return b, a.Sign() >= 0 && b.Int8() == a
+}
+
+// TryUint512 converts a to a Uint512.
+// It reports whether the value of a is representable as a Uint512;
+// if not, the result is the same as [Int8.Uint512].
+func (a Int8) TryUint512() (Uint512, bool) {
+	b := a.Uint512()
+	return b, a.Sign() >= 0 && b.Int8() == a
+}
+
+// TryUint1024 converts a to a Uint1024.
+// It reports whether the value of a is representable as a Uint1024;
+// if not, the result is the same as [Int8.Uint1024].
+func (a Int8) TryUint1024() (Uint1024, bool) {
+	b := a.Uint1024()
+	return b, a.Sign() >= 0 && b.Int8() == a
+}
+
+// TryInt8 converts a to an Int8.
+// It reports whether the value of a is representable as an Int8;
+// if not, the result is the same as [Int8.Int8].
+func (a Int8) TryInt8() (Int8, bool) {
+	return a, true
+}
+
+// TryInt16 converts a to an Int16.
+// It reports whether the value of a is representable as an Int16;
+// if not, the result is the same as [Int8.Int16].
+func (a Int8) TryInt16() (Int16, bool) {
+	return a.Int16(), true
+}
+
+// TryInt32 converts a to an Int32.
+// It reports whether the value of a is representable as an Int32;
+// if not, the result is the same as [Int8.Int32].
+func (a Int8) TryInt32() (Int32, bool) {
+	return a.Int32(), true
+}
+
+// TryInt64 converts a to an Int64.
+// It reports whether the value of a is representable as an Int64;
+// if not, the result is the same as [Int8.Int64].
+func (a Int8) TryInt64() (Int64, bool) {
+	return a.Int64(), true
+}
+
+// TryInt128 converts a to an Int128.
+// It reports whether the value of a is representable as an Int128;
+// if not, the result is the same as [Int8.Int128].
+func (a Int8) TryInt128() (Int128, bool) {
+	return a.Int128(), true
+}
+
+// TryInt256 converts a to an Int256.
+// It reports whether the value of a is representable as an Int256;
+// if not, the result is the same as [Int8.Int256].
+func (a Int8) TryInt256() (Int256, bool) {
+	return a.Int256(), true
+}
+
+// TryInt512 converts a to an Int512.
+// It reports whether the value of a is representable as an Int512;
+// if not, the result is the same as [Int8.Int512].
+func (a Int8) TryInt512() (Int512, bool) {
+	return a.Int512(), true
+}
+
+// TryInt1024 converts a to an Int1024.
+// It reports whether the value of a is representable as an Int1024;
+// if not, the result is the same as [Int8.Int1024].
+func (a Int8) TryInt1024() (Int1024, bool) {
+	return a.Int1024(), true
+}
+
+// SaturatingInt8 converts a to an Int8.
+// If the value of a is out of the range of Int8, the result is clamped to the range.
+func (a Int8) SaturatingInt8() Int8 {
+	return a.Int8()
+}
+
+// SaturatingInt16 converts a to an Int16.
+// If the value of a is out of the range of Int16, the result is clamped to the range.
+func (a Int8) SaturatingInt16() Int16 {
+	return a.Int16()
+}
+
+// SaturatingInt32 converts a to an Int32.
+// If the value of a is out of the range of Int32, the result is clamped to the range.
+func (a Int8) SaturatingInt32() Int32 {
+	return a.Int32()
+}
+
+// SaturatingInt64 converts a to an Int64.
+// If the value of a is out of the range of Int64, the result is clamped to the range.
+func (a Int8) SaturatingInt64() Int64 {
+	return a.Int64()
+}
+
+// SaturatingInt128 converts a to an Int128.
+// If the value of a is out of the range of Int128, the result is clamped to the range.
+func (a Int8) SaturatingInt128() Int128 {
+	return a.Int128()
+}
+
+// SaturatingInt256 converts a to an Int256.
+// If the value of a is out of the range of Int256, the result is clamped to the range.
+func (a Int8) SaturatingInt256() Int256 {
+	return a.Int256()
+}
+
+// SaturatingInt512 converts a to an Int512.
+// If the value of a is out of the range of Int512, the result is clamped to the range.
+func (a Int8) SaturatingInt512() Int512 {
+	return a.Int512()
+}
+
+// SaturatingInt1024 converts a to an Int1024.
+// If the value of a is out of the range of Int1024, the result is clamped to the range.
+func (a Int8) SaturatingInt1024() Int1024 {
+	return a.Int1024()
+}
+
+// SaturatingUint8 converts a to a Uint8.
+// If the value of a is out of the range of Uint8, the result is clamped to the range.
+func (a Int8) SaturatingUint8() Uint8 {
+	b, ok := a.TryUint8()
+	if !ok {
+		if a.Sign() < 0 {
+			return 0
+		}
+		return MaxUint8
+	}
+	return b
+}
+
+// SaturatingUint16 converts a to a Uint16.
+// If the value of a is out of the range of Uint16, the result is clamped to the range.
+func (a Int8) SaturatingUint16() Uint16 {
+	b, ok := a.TryUint16()
+	if !ok {
+		if a.Sign() < 0 {
+			return 0
+		}
+		return MaxUint16
+	}
+	return b
+}
+
+// SaturatingUint32 converts a to a Uint32.
+// If the value of a is out of the range of Uint32, the result is clamped to the range.
+func (a Int8) SaturatingUint32() Uint32 {
+	b, ok := a.TryUint32()
+	if !ok {
+		if a.Sign() < 0 {
+			return 0
+		}
+		return MaxUint32
+	}
+	return b
+}
+
+// SaturatingUint64 converts a to a Uint64.
+// If the value of a is out of the range of Uint64, the result is clamped to the range.
+func (a Int8) SaturatingUint64() Uint64 {
+	b, ok := a.TryUint64()
+	if !ok {
+		if a.Sign() < 0 {
+			return 0
+		}
+		return MaxUint64
+	}
+	return b
+}
+
+// SaturatingUint128 converts a to a Uint128.
+// If the value of a is out of the range of Uint128, the result is clamped to the range.
+func (a Int8) SaturatingUint128() Uint128 {
+	b, ok := a.TryUint128()
+	if !ok {
+		if a.Sign() < 0 {
+			return Uint128{}
+		}
+		return Uint128{math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingUint256 converts a to a Uint256.
+// If the value of a is out of the range of Uint256, the result is clamped to the range.
+func (a Int8) SaturatingUint256() Uint256 {
+	b, ok := a.TryUint256()
+	if !ok {
+		if a.Sign() < 0 {
+			return Uint256{}
+		}
+		return Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingUint512 converts a to a Uint512.
+// If the value of a is out of the range of Uint512, the result is clamped to the range.
+func (a Int8) SaturatingUint512() Uint512 {
+	b, ok := a.TryUint512()
+	if !ok {
+		if a.Sign() < 0 {
+			return Uint512{}
+		}
+		return Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingUint1024 converts a to a Uint1024.
+// If the value of a is out of the range of Uint1024, the result is clamped to the range.
+func (a Int8) SaturatingUint1024() Uint1024 {
+	b, ok := a.TryUint1024()
+	if !ok {
+		if a.Sign() < 0 {
+			return Uint1024{}
+		}
+		return Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
 // Int8 converts a to an Int8.
 func (a Int16) Int8() Int8 {
 	return Int8(a)
@@ -148,35 +504,41 @@ func (a Int16) Int1024() Int1024 {
 	}
 }
 
-// Int8 converts a to an Int8.
-func (a Int32) Int8() Int8 {
-	return Int8(a)
+// Uint8 converts a to a Uint8, reinterpreting its two's complement representation.
+// a is truncated to its low 8 bits, so the result is a mod 2**8.
+func (a Int16) Uint8() Uint8 {
+	return Uint8(a)
 }
 
-// Int16 converts a to an Int16.
-func (a Int32) Int16() Int16 {
-	return Int16(a)
+// Uint16 converts a to a Uint16, reinterpreting its two's complement representation.
+// If a is negative, it is sign-extended and the result is a+2**16.
+func (a Int16) Uint16() Uint16 {
+	return Uint16(a)
 }
 
-// Int32 returns a itself.
-func (a Int32) Int32() Int32 {
-	return a
+// Uint32 converts a to a Uint32, reinterpreting its two's complement representation.
+// If a is negative, it is sign-extended and the result is a+2**32.
+func (a Int16) Uint32() Uint32 {
+	return Uint32(a)
 }
 
-// Int64 converts a to an Int64.
-func (a Int32) Int64() Int64 {
-	return Int64(a)
+// Uint64 converts a to a Uint64, reinterpreting its two's complement representation.
+// If a is negative, it is sign-extended and the result is a+2**64.
+func (a Int16) Uint64() Uint64 {
+	return Uint64(a)
 }
 
-// Int128 converts a to an Int128.
-func (a Int32) Int128() Int128 {
-	return Int128{uint64(a >> 31), uint64(a)}
+// Uint128 converts a to a Uint128, reinterpreting its two's complement representation.
+// If a is negative, it is sign-extended and the result is a+2**128.
+func (a Int16) Uint128() Uint128 {
+	return Uint128{uint64(a >> 15), uint64(a)}
 }
 
-// Int256 converts a to an Int256.
-func (a Int32) Int256() Int256 {
-	sign := uint64(a >> 31)
-	return Int256{
+// Uint256 converts a to a Uint256, reinterpreting its two's complement representation.
+// If a is negative, it is sign-extended and the result is a+2**256.
+func (a Int16) Uint256() Uint256 {
+	sign := uint64(a >> 15)
+	return Uint256{
 		sign,
 		sign,
 		sign,
@@ -184,10 +546,11 @@ func (a Int32) Int256() Int256 {
 	}
 }
 
-// Int512 converts a to an Int512.
-func (a Int32) Int512() Int512 {
-	sign := uint64(a >> 31)
-	return Int512{
+// Uint512 converts a to a Uint512, reinterpreting its two's complement representation.
+// If a is negative, it is sign-extended and the result is a+2**512.
+func (a Int16) Uint512() Uint512 {
+	sign := uint64(a >> 15)
+	return Uint512{
 		sign,
 		sign,
 		sign,
@@ -199,10 +562,11 @@ func (a Int32) Int512() Int512 {
 	}
 }
 
-// Int1024 converts a to an Int1024.
-func (a Int32) Int1024() Int1024 {
-	sign := uint64(a >> 31)
-	return Int1024{
+// Uint1024 converts a to a Uint1024, reinterpreting its two's complement representation.
+// If a is negative, it is sign-extended and the result is a+2**1024.
+func (a Int16) Uint1024() Uint1024 {
+	sign := uint64(a >> 15)
+	return Uint1024{
 		sign,
 		sign,
 		sign,
@@ -222,34 +586,314 @@ func (a Int32) Int1024() Int1024 {
 	}
 }
 
+// TryUint8 converts a to a Uint8.
+// It reports whether the value of a is representable as a Uint8;
+// if not, the result is the same as [Int16.Uint8].
+func (a Int16) TryUint8() (Uint8, bool) {
+	b := a.Uint8()
+	return b, a.Sign() >= 0 && b.Int16() == a
+}
+
+// TryUint16 converts a to a Uint16.
+// It reports whether the value of a is representable as a Uint16;
+// if not, the result is the same as [Int16.Uint16].
+func (a Int16) TryUint16() (Uint16, bool) {
+	b := a.Uint16()
+	return b, a.Sign() >= 0 && b.Int16() == a
+}
+
+// TryUint32 converts a to a Uint32.
+// It reports whether the value of a is representable as a Uint32;
+// if not, the result is the same as [Int16.Uint32].
+func (a Int16) TryUint32() (Uint32, bool) {
+	b := a.Uint32()
+	return b, a.Sign() >= 0 && b.Int16() == a
+}
+
+// TryUint64 converts a to a Uint64.
+// It reports whether the value of a is representable as a Uint64;
+// if not, the result is the same as [Int16.Uint64].
+func (a Int16) TryUint64() (Uint64, bool) {
+	b := a.Uint64()
+	return b, a.Sign() >= 0 && b.Int16() == a
+}
+
+// TryUint128 converts a to a Uint128.
+// It reports whether the value of a is representable as a Uint128;
+// if not, the result is the same as [Int16.Uint128].
+func (a Int16) TryUint128() (Uint128, bool) {
+	b := a.Uint128()
+	return b, a.Sign() >= 0 && b.Int16() == a
+}
+
+// TryUint256 converts a to a Uint256.
+// It reports whether the value of a is representable as a Uint256;
+// if not, the result is the same as [Int16.Uint256].
+func (a Int16) TryUint256() (Uint256, bool) {
+	b := a.Uint256()
+	return b, a.Sign() >= 0 && b.Int16() == a
+}
+
+// TryUint512 converts a to a Uint512.
+// It reports whether the value of a is representable as a Uint512;
+// if not, the result is the same as [Int16.Uint512].
+func (a Int16) TryUint512() (Uint512, bool) {
+	b := a.Uint512()
+	return b, a.Sign() >= 0 && b.Int16() == a
+}
+
+// TryUint1024 converts a to a Uint1024.
+// It reports whether the value of a is representable as a Uint1024;
+// if not, the result is the same as [Int16.Uint1024].
+func (a Int16) TryUint1024() (Uint1024, bool) {
+	b := a.Uint1024()
+	return b, a.Sign() >= 0 && b.Int16() == a
+}
+
+// TryInt8 converts a to an Int8.
+// It reports whether the value of a is representable as an Int8;
+// if not, the result is the same as [Int16.Int8].
+func (a Int16) TryInt8() (Int8, bool) {
+	b := a.Int8()
+	return b, b.Int16() == a
+}
+
+// TryInt16 converts a to an Int16.
+// It reports whether the value of a is representable as an Int16;
+// if not, the result is the same as [Int16.Int16].
+func (a Int16) TryInt16() (Int16, bool) {
+	return a, true
+}
+
+// TryInt32 converts a to an Int32.
+// It reports whether the value of a is representable as an Int32;
+// if not, the result is the same as [Int16.Int32].
+func (a Int16) TryInt32() (Int32, bool) {
+	return a.Int32(), true
+}
+
+// TryInt64 converts a to an Int64.
+// It reports whether the value of a is representable as an Int64;
+// if not, the result is the same as [Int16.Int64].
+func (a Int16) TryInt64() (Int64, bool) {
+	return a.Int64(), true
+}
+
+// TryInt128 converts a to an Int128.
+// It reports whether the value of a is representable as an Int128;
+// if not, the result is the same as [Int16.Int128].
+func (a Int16) TryInt128() (Int128, bool) {
+	return a.Int128(), true
+}
+
+// TryInt256 converts a to an Int256.
+// It reports whether the value of a is representable as an Int256;
+// if not, the result is the same as [Int16.Int256].
+func (a Int16) TryInt256() (Int256, bool) {
+	return a.Int256(), true
+}
+
+// TryInt512 converts a to an Int512.
+// It reports whether the value of a is representable as an Int512;
+// if not, the result is the same as [Int16.Int512].
+func (a Int16) TryInt512() (Int512, bool) {
+	return a.Int512(), true
+}
+
+// TryInt1024 converts a to an Int1024.
+// It reports whether the value of a is representable as an Int1024;
+// if not, the result is the same as [Int16.Int1024].
+func (a Int16) TryInt1024() (Int1024, bool) {
+	return a.Int1024(), true
+}
+
+// SaturatingInt8 converts a to an Int8.
+// If the value of a is out of the range of Int8, the result is clamped to the range.
+func (a Int16) SaturatingInt8() Int8 {
+	b, ok := a.TryInt8()
+	if !ok {
+		if a.Sign() < 0 {
+			return MinInt8
+		}
+		return MaxInt8
+	}
+	return b
+}
+
+// SaturatingInt16 converts a to an Int16.
+// If the value of a is out of the range of Int16, the result is clamped to the range.
+func (a Int16) SaturatingInt16() Int16 {
+	return a.Int16()
+}
+
+// SaturatingInt32 converts a to an Int32.
+// If the value of a is out of the range of Int32, the result is clamped to the range.
+func (a Int16) SaturatingInt32() Int32 {
+	return a.Int32()
+}
+
+// SaturatingInt64 converts a to an Int64.
+// If the value of a is out of the range of Int64, the result is clamped to the range.
+func (a Int16) SaturatingInt64() Int64 {
+	return a.Int64()
+}
+
+// SaturatingInt128 converts a to an Int128.
+// If the value of a is out of the range of Int128, the result is clamped to the range.
+func (a Int16) SaturatingInt128() Int128 {
+	return a.Int128()
+}
+
+// SaturatingInt256 converts a to an Int256.
+// If the value of a is out of the range of Int256, the result is clamped to the range.
+func (a Int16) SaturatingInt256() Int256 {
+	return a.Int256()
+}
+
+// SaturatingInt512 converts a to an Int512.
+// If the value of a is out of the range of Int512, the result is clamped to the range.
+func (a Int16) SaturatingInt512() Int512 {
+	return a.Int512()
+}
+
+// SaturatingInt1024 converts a to an Int1024.
+// If the value of a is out of the range of Int1024, the result is clamped to the range.
+func (a Int16) SaturatingInt1024() Int1024 {
+	return a.Int1024()
+}
+
+// SaturatingUint8 converts a to a Uint8.
+// If the value of a is out of the range of Uint8, the result is clamped to the range.
+func (a Int16) SaturatingUint8() Uint8 {
+	b, ok := a.TryUint8()
+	if !ok {
+		if a.Sign() < 0 {
+			return 0
+		}
+		return MaxUint8
+	}
+	return b
+}
+
+// SaturatingUint16 converts a to a Uint16.
+// If the value of a is out of the range of Uint16, the result is clamped to the range.
+func (a Int16) SaturatingUint16() Uint16 {
+	b, ok := a.TryUint16()
+	if !ok {
+		if a.Sign() < 0 {
+			return 0
+		}
+		return MaxUint16
+	}
+	return b
+}
+
+// SaturatingUint32 converts a to a Uint32.
+// If the value of a is out of the range of Uint32, the result is clamped to the range.
+func (a Int16) SaturatingUint32() Uint32 {
+	b, ok := a.TryUint32()
+	if !ok {
+		if a.Sign() < 0 {
+			return 0
+		}
+		return MaxUint32
+	}
+	return b
+}
+
+// SaturatingUint64 converts a to a Uint64.
+// If the value of a is out of the range of Uint64, the result is clamped to the range.
+func (a Int16) SaturatingUint64() Uint64 {
+	b, ok := a.TryUint64()
+	if !ok {
+		if a.Sign() < 0 {
+			return 0
+		}
+		return MaxUint64
+	}
+	return b
+}
+
+// SaturatingUint128 converts a to a Uint128.
+// If the value of a is out of the range of Uint128, the result is clamped to the range.
+func (a Int16) SaturatingUint128() Uint128 {
+	b, ok := a.TryUint128()
+	if !ok {
+		if a.Sign() < 0 {
+			return Uint128{}
+		}
+		return Uint128{math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingUint256 converts a to a Uint256.
+// If the value of a is out of the range of Uint256, the result is clamped to the range.
+func (a Int16) SaturatingUint256() Uint256 {
+	b, ok := a.TryUint256()
+	if !ok {
+		if a.Sign() < 0 {
+			return Uint256{}
+		}
+		return Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingUint512 converts a to a Uint512.
+// If the value of a is out of the range of Uint512, the result is clamped to the range.
+func (a Int16) SaturatingUint512() Uint512 {
+	b, ok := a.TryUint512()
+	if !ok {
+		if a.Sign() < 0 {
+			return Uint512{}
+		}
+		return Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingUint1024 converts a to a Uint1024.
+// If the value of a is out of the range of Uint1024, the result is clamped to the range.
+func (a Int16) SaturatingUint1024() Uint1024 {
+	b, ok := a.TryUint1024()
+	if !ok {
+		if a.Sign() < 0 {
+			return Uint1024{}
+		}
+		return Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
 // Int8 converts a to an Int8.
-func (a Int64) Int8() Int8 {
+func (a Int32) Int8() Int8 {
 	return Int8(a)
 }
 
 // Int16 converts a to an Int16.
-func (a Int64) Int16() Int16 {
+func (a Int32) Int16() Int16 {
 	return Int16(a)
 }
 
-// Int32 converts a to an Int32.
-func (a Int64) Int32() Int32 {
-	return Int32(a)
+// Int32 returns a itself.
+func (a Int32) Int32() Int32 {
+	return a
 }
 
-// Int64 returns a itself.
-func (a Int64) Int64() Int64 {
-	return a
+// Int64 converts a to an Int64.
+func (a Int32) Int64() Int64 {
+	return Int64(a)
 }
 
 // Int128 converts a to an Int128.
-func (a Int64) Int128() Int128 {
-	return Int128{uint64(a >> 63), uint64(a)}
+func (a Int32) Int128() Int128 {
+	return Int128{uint64(a >> 31), uint64(a)}
 }
 
 // Int256 converts a to an Int256.
-func (a Int64) Int256() Int256 {
-	sign := uint64(a >> 63)
+func (a Int32) Int256() Int256 {
+	sign := uint64(a >> 31)
 	return Int256{
 		sign,
 		sign,
@@ -259,8 +903,8 @@ func (a Int64) Int256() Int256 {
 }
 
 // Int512 converts a to an Int512.
-func (a Int64) Int512() Int512 {
-	sign := uint64(a >> 63)
+func (a Int32) Int512() Int512 {
+	sign := uint64(a >> 31)
 	return Int512{
 		sign,
 		sign,
@@ -274,8 +918,8 @@ func (a Int64) Int512() Int512 {
 }
 
 // Int1024 converts a to an Int1024.
-func (a Int64) Int1024() Int1024 {
-	sign := uint64(a >> 63)
+func (a Int32) Int1024() Int1024 {
+	sign := uint64(a >> 31)
 	return Int1024{
 		sign,
 		sign,
@@ -296,61 +940,69 @@ func (a Int64) Int1024() Int1024 {
 	}
 }
 
-// Int8 converts a to an Int8.
-func (a Int128) Int8() Int8 {
-	return Int8(a[1])
+// Uint8 converts a to a Uint8, reinterpreting its two's complement representation.
+// a is truncated to its low 8 bits, so the result is a mod 2**8.
+func (a Int32) Uint8() Uint8 {
+	return Uint8(a)
 }
 
-// Int16 converts a to an Int16.
-func (a Int128) Int16() Int16 {
-	return Int16(a[1])
+// Uint16 converts a to a Uint16, reinterpreting its two's complement representation.
+// a is truncated to its low 16 bits, so the result is a mod 2**16.
+func (a Int32) Uint16() Uint16 {
+	return Uint16(a)
 }
 
-// Int32 converts a to an Int32.
-func (a Int128) Int32() Int32 {
-	return Int32(a[1])
+// Uint32 converts a to a Uint32, reinterpreting its two's complement representation.
+// If a is negative, it is sign-extended and the result is a+2**32.
+func (a Int32) Uint32() Uint32 {
+	return Uint32(a)
 }
 
-// Int64 converts a to an Int64.
-func (a Int128) Int64() Int64 {
-	return Int64(a[1])
+// Uint64 converts a to a Uint64, reinterpreting its two's complement representation.
+// If a is negative, it is sign-extended and the result is a+2**64.
+func (a Int32) Uint64() Uint64 {
+	return Uint64(a)
 }
 
-// Int128 returns a itself.
-func (a Int128) Int128() Int128 {
-	return a
+// Uint128 converts a to a Uint128, reinterpreting its two's complement representation.
+// If a is negative, it is sign-extended and the result is a+2**128.
+func (a Int32) Uint128() Uint128 {
+	return Uint128{uint64(a >> 31), uint64(a)}
 }
 
-// Int256 converts a to an Int256.
-func (a Int128) Int256() Int256 {
-	sign := uint64(int64(a[0]) >> 63)
-	return Int256{
+// Uint256 converts a to a Uint256, reinterpreting its two's complement representation.
+// If a is negative, it is sign-extended and the result is a+2**256.
+func (a Int32) Uint256() Uint256 {
+	sign := uint64(a >> 31)
+	return Uint256{
 		sign,
 		sign,
-		a[0],
-		a[1],
+		sign,
+		uint64(a),
 	}
 }
 
-// Int512 converts a to an Int512.
-func (a Int128) Int512() Int512 {
-	sign := uint64(int64(a[0]) >> 63)
-	return Int512{
+// Uint512 converts a to a Uint512, reinterpreting its two's complement representation.
+// If a is negative, it is sign-extended and the result is a+2**512.
+func (a Int32) Uint512() Uint512 {
+	sign := uint64(a >> 31)
+	return Uint512{
 		sign,
 		sign,
 		sign,
 		sign,
 		sign,
 		sign,
-		a[0],
-		a[1],
+		sign,
+		uint64(a),
 	}
 }
 
-// Int1024 converts a to an Int1024.
-func (a Int128) Int1024() Int1024 {
-	sign := uint64(int64(a[0]) >> 63)
-	return Int1024{
+// Uint1024 converts a to a Uint1024, reinterpreting its two's complement representation.
+// If a is negative, it is sign-extended and the result is a+2**1024.
+func (a Int32) Uint1024() Uint1024 {
+	sign := uint64(a >> 31)
+	return Uint1024{
 		sign,
 		sign,
 		sign,
@@ -365,62 +1017,353 @@ func (a Int128) Int1024() Int1024 {
 		sign,
 		sign,
 		sign,
-		a[0],
-		a[1],
+		sign,
+		uint64(a),
+	}
+}
+
+// TryUint8 converts a to a Uint8.
+// It reports whether the value of a is representable as a Uint8;
+// if not, the result is the same as [Int32.Uint8].
+func (a Int32) TryUint8() (Uint8, bool) {
+	b := a.Uint8()
+	return b, a.Sign() >= 0 && b.Int32() == a
+}
+
+// TryUint16 converts a to a Uint16.
+// It reports whether the value of a is representable as a Uint16;
+// if not, the result is the same as [Int32.Uint16].
+func (a Int32) TryUint16() (Uint16, bool) {
+	b := a.Uint16()
+	return b, a.Sign() >= 0 && b.Int32() == a
+}
+
+// TryUint32 converts a to a Uint32.
+// It reports whether the value of a is representable as a Uint32;
+// if not, the result is the same as [Int32.Uint32].
+func (a Int32) TryUint32() (Uint32, bool) {
+	b := a.Uint32()
+	return b, a.Sign() >= 0 && b.Int32() == a
+}
+
+// TryUint64 converts a to a Uint64.
+// It reports whether the value of a is representable as a Uint64;
+// if not, the result is the same as [Int32.Uint64].
+func (a Int32) TryUint64() (Uint64, bool) {
+	b := a.Uint64()
+	return b, a.Sign() >= 0 && b.Int32() == a
+}
+
+// TryUint128 converts a to a Uint128.
+// It reports whether the value of a is representable as a Uint128;
+// if not, the result is the same as [Int32.Uint128].
+func (a Int32) TryUint128() (Uint128, bool) {
+	b := a.Uint128()
+	return b, a.Sign() >= 0 && b.Int32() == a
+}
+
+// TryUint256 converts a to a Uint256.
+// It reports whether the value of a is representable as a Uint256;
+// if not, the result is the same as [Int32.Uint256].
+func (a Int32) TryUint256() (Uint256, bool) {
+	b := a.Uint256()
+	return b, a.Sign() >= 0 && b.Int32() == a
+}
+
+// TryUint512 converts a to a Uint512.
+// It reports whether the value of a is representable as a Uint512;
+// if not, the result is the same as [Int32.Uint512].
+func (a Int32) TryUint512() (Uint512, bool) {
+	b := a.Uint512()
+	return b, a.Sign() >= 0 && b.Int32() == a
+}
+
+// TryUint1024 converts a to a Uint1024.
+// It reports whether the value of a is representable as a Uint1024;
+// if not, the result is the same as [Int32.Uint1024].
+func (a Int32) TryUint1024() (Uint1024, bool) {
+	b := a.Uint1024()
+	return b, a.Sign() >= 0 && b.Int32() == a
+}
+
+// TryInt8 converts a to an Int8.
+// It reports whether the value of a is representable as an Int8;
+// if not, the result is the same as [Int32.Int8].
+func (a Int32) TryInt8() (Int8, bool) {
+	b := a.Int8()
+	return b, b.Int32() == a
+}
+
+// TryInt16 converts a to an Int16.
+// It reports whether the value of a is representable as an Int16;
+// if not, the result is the same as [Int32.Int16].
+func (a Int32) TryInt16() (Int16, bool) {
+	b := a.Int16()
+	return b, b.Int32() == a
+}
+
+// TryInt32 converts a to an Int32.
+// It reports whether the value of a is representable as an Int32;
+// if not, the result is the same as [Int32.Int32].
+func (a Int32) TryInt32() (Int32, bool) {
+	return a, true
+}
+
+// TryInt64 converts a to an Int64.
+// It reports whether the value of a is representable as an Int64;
+// if not, the result is the same as [Int32.Int64].
+func (a Int32) TryInt64() (Int64, bool) {
+	return a.Int64(), true
+}
+
+// TryInt128 converts a to an Int128.
+// It reports whether the value of a is representable as an Int128;
+// if not, the result is the same as [Int32.Int128].
+func (a Int32) TryInt128() (Int128, bool) {
+	return a.Int128(), true
+}
+
+// TryInt256 converts a to an Int256.
+// It reports whether the value of a is representable as an Int256;
+// if not, the result is the same as [Int32.Int256].
+func (a Int32) TryInt256() (Int256, bool) {
+	return a.Int256(), true
+}
+
+// TryInt512 converts a to an Int512.
+// It reports whether the value of a is representable as an Int512;
+// if not, the result is the same as [Int32.Int512].
+func (a Int32) TryInt512() (Int512, bool) {
+	return a.Int512(), true
+}
+
+// TryInt1024 converts a to an Int1024.
+// It reports whether the value of a is representable as an Int1024;
+// if not, the result is the same as [Int32.Int1024].
+func (a Int32) TryInt1024() (Int1024, bool) {
+	return a.Int1024(), true
+}
+
+// SaturatingInt8 converts a to an Int8.
+// If the value of a is out of the range of Int8, the result is clamped to the range.
+func (a Int32) SaturatingInt8() Int8 {
+	b, ok := a.TryInt8()
+	if !ok {
+		if a.Sign() < 0 {
+			return MinInt8
+		}
+		return MaxInt8
+	}
+	return b
+}
+
+// SaturatingInt16 converts a to an Int16.
+// If the value of a is out of the range of Int16, the result is clamped to the range.
+func (a Int32) SaturatingInt16() Int16 {
+	b, ok := a.TryInt16()
+	if !ok {
+		if a.Sign() < 0 {
+			return MinInt16
+		}
+		return MaxInt16
+	}
+	return b
+}
+
+// SaturatingInt32 converts a to an Int32.
+// If the value of a is out of the range of Int32, the result is clamped to the range.
+func (a Int32) SaturatingInt32() Int32 {
+	return a.Int32()
+}
+
+// SaturatingInt64 converts a to an Int64.
+// If the value of a is out of the range of Int64, the result is clamped to the range.
+func (a Int32) SaturatingInt64() Int64 {
+	return a.Int64()
+}
+
+// SaturatingInt128 converts a to an Int128.
+// If the value of a is out of the range of Int128, the result is clamped to the range.
+func (a Int32) SaturatingInt128() Int128 {
+	return a.Int128()
+}
+
+// SaturatingInt256 converts a to an Int256.
+// If the value of a is out of the range of Int256, the result is clamped to the range.
+func (a Int32) SaturatingInt256() Int256 {
+	return a.Int256()
+}
+
+// SaturatingInt512 converts a to an Int512.
+// If the value of a is out of the range of Int512, the result is clamped to the range.
+func (a Int32) SaturatingInt512() Int512 {
+	return a.Int512()
+}
+
+// SaturatingInt1024 converts a to an Int1024.
+// If the value of a is out of the range of Int1024, the result is clamped to the range.
+func (a Int32) SaturatingInt1024() Int1024 {
+	return a.Int1024()
+}
+
+// SaturatingUint8 converts a to a Uint8.
+// If the value of a is out of the range of Uint8, the result is clamped to the range.
+func (a Int32) SaturatingUint8() Uint8 {
+	b, ok := a.TryUint8()
+	if !ok {
+		if a.Sign() < 0 {
+			return 0
+		}
+		return MaxUint8
 	}
+	return b
+}
+
+// SaturatingUint16 converts a to a Uint16.
+// If the value of a is out of the range of Uint16, the result is clamped to the range.
+func (a Int32) SaturatingUint16() Uint16 {
+	b, ok := a.TryUint16()
+	if !ok {
+		if a.Sign() < 0 {
+			return 0
+		}
+		return MaxUint16
+	}
+	return b
+}
+
+// SaturatingUint32 converts a to a Uint32.
+// If the value of a is out of the range of Uint32, the result is clamped to the range.
+func (a Int32) SaturatingUint32() Uint32 {
+	b, ok := a.TryUint32()
+	if !ok {
+		if a.Sign() < 0 {
+			return 0
+		}
+		return MaxUint32
+	}
+	return b
+}
+
+// SaturatingUint64 converts a to a Uint64.
+// If the value of a is out of the range of Uint64, the result is clamped to the range.
+func (a Int32) SaturatingUint64() Uint64 {
+	b, ok := a.TryUint64()
+	if !ok {
+		if a.Sign() < 0 {
+			return 0
+		}
+		return MaxUint64
+	}
+	return b
+}
+
+// SaturatingUint128 converts a to a Uint128.
+// If the value of a is out of the range of Uint128, the result is clamped to the range.
+func (a Int32) SaturatingUint128() Uint128 {
+	b, ok := a.TryUint128()
+	if !ok {
+		if a.Sign() < 0 {
+			return Uint128{}
+		}
+		return Uint128{math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingUint256 converts a to a Uint256.
+// If the value of a is out of the range of Uint256, the result is clamped to the range.
+func (a Int32) SaturatingUint256() Uint256 {
+	b, ok := a.TryUint256()
+	if !ok {
+		if a.Sign() < 0 {
+			return Uint256{}
+		}
+		return Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingUint512 converts a to a Uint512.
+// If the value of a is out of the range of Uint512, the result is clamped to the range.
+func (a Int32) SaturatingUint512() Uint512 {
+	b, ok := a.TryUint512()
+	if !ok {
+		if a.Sign() < 0 {
+			return Uint512{}
+		}
+		return Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingUint1024 converts a to a Uint1024.
+// If the value of a is out of the range of Uint1024, the result is clamped to the range.
+func (a Int32) SaturatingUint1024() Uint1024 {
+	b, ok := a.TryUint1024()
+	if !ok {
+		if a.Sign() < 0 {
+			return Uint1024{}
+		}
+		return Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return b
 }
 
 // Int8 converts a to an Int8.
-func (a Int256) Int8() Int8 {
-	return Int8(a[3])
+func (a Int64) Int8() Int8 {
+	return Int8(a)
 }
 
 // Int16 converts a to an Int16.
-func (a Int256) Int16() Int16 {
-	return Int16(a[3])
+func (a Int64) Int16() Int16 {
+	return Int16(a)
 }
 
 // Int32 converts a to an Int32.
-func (a Int256) Int32() Int32 {
-	return Int32(a[3])
+func (a Int64) Int32() Int32 {
+	return Int32(a)
 }
 
-// Int64 converts a to an Int64.
-func (a Int256) Int64() Int64 {
-	return Int64(a[3])
+// Int64 returns a itself.
+func (a Int64) Int64() Int64 {
+	return a
 }
 
 // Int128 converts a to an Int128.
-func (a Int256) Int128() Int128 {
-	return Int128{
-		a[2],
-		a[3],
-	}
+func (a Int64) Int128() Int128 {
+	return Int128{uint64(a >> 63), uint64(a)}
 }
 
-// Int256 returns a itself.
-func (a Int256) Int256() Int256 {
-	return a
+// Int256 converts a to an Int256.
+func (a Int64) Int256() Int256 {
+	sign := uint64(a >> 63)
+	return Int256{
+		sign,
+		sign,
+		sign,
+		uint64(a),
+	}
 }
 
 // Int512 converts a to an Int512.
-func (a Int256) Int512() Int512 {
-	sign := uint64(int64(a[0]) >> 63)
+func (a Int64) Int512() Int512 {
+	sign := uint64(a >> 63)
 	return Int512{
 		sign,
 		sign,
 		sign,
 		sign,
-		a[0],
-		a[1],
-		a[2],
-		a[3],
+		sign,
+		sign,
+		sign,
+		uint64(a),
 	}
 }
 
 // Int1024 converts a to an Int1024.
-func (a Int256) Int1024() Int1024 {
-	sign := uint64(int64(a[0]) >> 63)
+func (a Int64) Int1024() Int1024 {
+	sign := uint64(a >> 63)
 	return Int1024{
 		sign,
 		sign,
@@ -434,60 +1377,76 @@ func (a Int256) Int1024() Int1024 {
 		sign,
 		sign,
 		sign,
-		a[0],
-		a[1],
-		a[2],
-		a[3],
+		sign,
+		sign,
+		sign,
+		uint64(a),
 	}
 }
 
-// Int8 converts a to an Int8.
-func (a Int512) Int8() Int8 {
-	return Int8(a[7])
+// Uint8 converts a to a Uint8, reinterpreting its two's complement representation.
+// a is truncated to its low 8 bits, so the result is a mod 2**8.
+func (a Int64) Uint8() Uint8 {
+	return Uint8(a)
 }
 
-// Int16 converts a to an Int16.
-func (a Int512) Int16() Int16 {
-	return Int16(a[7])
+// Uint16 converts a to a Uint16, reinterpreting its two's complement representation.
+// a is truncated to its low 16 bits, so the result is a mod 2**16.
+func (a Int64) Uint16() Uint16 {
+	return Uint16(a)
 }
 
-// Int32 converts a to an Int32.
-func (a Int512) Int32() Int32 {
-	return Int32(a[7])
+// Uint32 converts a to a Uint32, reinterpreting its two's complement representation.
+// a is truncated to its low 32 bits, so the result is a mod 2**32.
+func (a Int64) Uint32() Uint32 {
+	return Uint32(a)
 }
 
-// Int64 converts a to an Int64.
-func (a Int512) Int64() Int64 {
-	return Int64(a[7])
+// Uint64 converts a to a Uint64, reinterpreting its two's complement representation.
+// If a is negative, it is sign-extended and the result is a+2**64.
+func (a Int64) Uint64() Uint64 {
+	return Uint64(a)
 }
 
-// Int128 converts a to an Int128.
-func (a Int512) Int128() Int128 {
-	return Int128{
-		a[6],
-		a[7],
-	}
+// Uint128 converts a to a Uint128, reinterpreting its two's complement representation.
+// If a is negative, it is sign-extended and the result is a+2**128.
+func (a Int64) Uint128() Uint128 {
+	return Uint128{uint64(a >> 63), uint64(a)}
 }
 
-// Int256 converts a to an Int256.
-func (a Int512) Int256() Int256 {
-	return Int256{
-		a[4],
-		a[5],
-		a[6],
-		a[7],
+// Uint256 converts a to a Uint256, reinterpreting its two's complement representation.
+// If a is negative, it is sign-extended and the result is a+2**256.
+func (a Int64) Uint256() Uint256 {
+	sign := uint64(a >> 63)
+	return Uint256{
+		sign,
+		sign,
+		sign,
+		uint64(a),
 	}
 }
 
-// Int512 returns a itself.
-func (a Int512) Int512() Int512 {
-	return a
+// Uint512 converts a to a Uint512, reinterpreting its two's complement representation.
+// If a is negative, it is sign-extended and the result is a+2**512.
+func (a Int64) Uint512() Uint512 {
+	sign := uint64(a >> 63)
+	return Uint512{
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		uint64(a),
+	}
 }
 
-// Int1024 converts a to an Int1024.
-func (a Int512) Int1024() Int1024 {
-	sign := uint64(int64(a[0]) >> 63)
-	return Int1024{
+// Uint1024 converts a to a Uint1024, reinterpreting its two's complement representation.
+// If a is negative, it is sign-extended and the result is a+2**1024.
+func (a Int64) Uint1024() Uint1024 {
+	sign := uint64(a >> 63)
+	return Uint1024{
 		sign,
 		sign,
 		sign,
@@ -496,58 +1455,1800 @@ func (a Int512) Int1024() Int1024 {
 		sign,
 		sign,
 		sign,
-		a[0],
-		a[1],
-		a[2],
-		a[3],
-		a[4],
-		a[5],
-		a[6],
-		a[7],
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		uint64(a),
 	}
 }
 
-// Int8 converts a to an Int8.
-func (a Int1024) Int8() Int8 {
-	return Int8(a[15])
+// TryUint8 converts a to a Uint8.
+// It reports whether the value of a is representable as a Uint8;
+// if not, the result is the same as [Int64.Uint8].
+func (a Int64) TryUint8() (Uint8, bool) {
+	b := a.Uint8()
+	return b, a.Sign() >= 0 && b.Int64() == a
 }
 
-// Int16 converts a to an Int16.
-func (a Int1024) Int16() Int16 {
-	return Int16(a[15])
+// TryUint16 converts a to a Uint16.
+// It reports whether the value of a is representable as a Uint16;
+// if not, the result is the same as [Int64.Uint16].
+func (a Int64) TryUint16() (Uint16, bool) {
+	b := a.Uint16()
+	return b, a.Sign() >= 0 && b.Int64() == a
 }
 
-// Int32 converts a to an Int32.
-func (a Int1024) Int32() Int32 {
-	return Int32(a[15])
+// TryUint32 converts a to a Uint32.
+// It reports whether the value of a is representable as a Uint32;
+// if not, the result is the same as [Int64.Uint32].
+func (a Int64) TryUint32() (Uint32, bool) {
+	b := a.Uint32()
+	return b, a.Sign() >= 0 && b.Int64() == a
 }
 
-// Int64 converts a to an Int64.
-func (a Int1024) Int64() Int64 {
-	return Int64(a[15])
+// TryUint64 converts a to a Uint64.
+// It reports whether the value of a is representable as a Uint64;
+// if not, the result is the same as [Int64.Uint64].
+func (a Int64) TryUint64() (Uint64, bool) {
+	b := a.Uint64()
+	return b, a.Sign() >= 0 && b.Int64() == a
 }
 
-// Int128 converts a to an Int128.
-func (a Int1024) Int128() Int128 {
-	return Int128{
-		a[14],
-		a[15],
-	}
+// TryUint128 converts a to a Uint128.
+// It reports whether the value of a is representable as a Uint128;
+// if not, the result is the same as [Int64.Uint128].
+func (a Int64) TryUint128() (Uint128, bool) {
+	b := a.Uint128()
+	return b, a.Sign() >= 0 && b.Int64() == a
 }
 
-// Int256 converts a to an Int256.
-func (a Int1024) Int256() Int256 {
-	return Int256{
-		a[12],
-		a[13],
-		a[14],
-		a[15],
-	}
+// TryUint256 converts a to a Uint256.
+// It reports whether the value of a is representable as a Uint256;
+// if not, the result is the same as [Int64.Uint256].
+func (a Int64) TryUint256() (Uint256, bool) {
+	b := a.Uint256()
+	return b, a.Sign() >= 0 && b.Int64() == a
 }
 
-// Int512 converts a to an Int512.
-func (a Int1024) Int512() Int512 {
-	return Int512{
+// TryUint512 converts a to a Uint512.
+// It reports whether the value of a is representable as a Uint512;
+// if not, the result is the same as [Int64.Uint512].
+func (a Int64) TryUint512() (Uint512, bool) {
+	b := a.Uint512()
+	return b, a.Sign() >= 0 && b.Int64() == a
+}
+
+// TryUint1024 converts a to a Uint1024.
+// It reports whether the value of a is representable as a Uint1024;
+// if not, the result is the same as [Int64.Uint1024].
+func (a Int64) TryUint1024() (Uint1024, bool) {
+	b := a.Uint1024()
+	return b, a.Sign() >= 0 && b.Int64() == a
+}
+
+// TryInt8 converts a to an Int8.
+// It reports whether the value of a is representable as an Int8;
+// if not, the result is the same as [Int64.Int8].
+func (a Int64) TryInt8() (Int8, bool) {
+	b := a.Int8()
+	return b, b.Int64() == a
+}
+
+// TryInt16 converts a to an Int16.
+// It reports whether the value of a is representable as an Int16;
+// if not, the result is the same as [Int64.Int16].
+func (a Int64) TryInt16() (Int16, bool) {
+	b := a.Int16()
+	return b, b.Int64() == a
+}
+
+// TryInt32 converts a to an Int32.
+// It reports whether the value of a is representable as an Int32;
+// if not, the result is the same as [Int64.Int32].
+func (a Int64) TryInt32() (Int32, bool) {
+	b := a.Int32()
+	return b, b.Int64() == a
+}
+
+// TryInt64 converts a to an Int64.
+// It reports whether the value of a is representable as an Int64;
+// if not, the result is the same as [Int64.Int64].
+func (a Int64) TryInt64() (Int64, bool) {
+	return a, true
+}
+
+// TryInt128 converts a to an Int128.
+// It reports whether the value of a is representable as an Int128;
+// if not, the result is the same as [Int64.Int128].
+func (a Int64) TryInt128() (Int128, bool) {
+	return a.Int128(), true
+}
+
+// TryInt256 converts a to an Int256.
+// It reports whether the value of a is representable as an Int256;
+// if not, the result is the same as [Int64.Int256].
+func (a Int64) TryInt256() (Int256, bool) {
+	return a.Int256(), true
+}
+
+// TryInt512 converts a to an Int512.
+// It reports whether the value of a is representable as an Int512;
+// if not, the result is the same as [Int64.Int512].
+func (a Int64) TryInt512() (Int512, bool) {
+	return a.Int512(), true
+}
+
+// TryInt1024 converts a to an Int1024.
+// It reports whether the value of a is representable as an Int1024;
+// if not, the result is the same as [Int64.Int1024].
+func (a Int64) TryInt1024() (Int1024, bool) {
+	return a.Int1024(), true
+}
+
+// SaturatingInt8 converts a to an Int8.
+// If the value of a is out of the range of Int8, the result is clamped to the range.
+func (a Int64) SaturatingInt8() Int8 {
+	b, ok := a.TryInt8()
+	if !ok {
+		if a.Sign() < 0 {
+			return MinInt8
+		}
+		return MaxInt8
+	}
+	return b
+}
+
+// SaturatingInt16 converts a to an Int16.
+// If the value of a is out of the range of Int16, the result is clamped to the range.
+func (a Int64) SaturatingInt16() Int16 {
+	b, ok := a.TryInt16()
+	if !ok {
+		if a.Sign() < 0 {
+			return MinInt16
+		}
+		return MaxInt16
+	}
+	return b
+}
+
+// SaturatingInt32 converts a to an Int32.
+// If the value of a is out of the range of Int32, the result is clamped to the range.
+func (a Int64) SaturatingInt32() Int32 {
+	b, ok := a.TryInt32()
+	if !ok {
+		if a.Sign() < 0 {
+			return MinInt32
+		}
+		return MaxInt32
+	}
+	return b
+}
+
+// SaturatingInt64 converts a to an Int64.
+// If the value of a is out of the range of Int64, the result is clamped to the range.
+func (a Int64) SaturatingInt64() Int64 {
+	return a.Int64()
+}
+
+// SaturatingInt128 converts a to an Int128.
+// If the value of a is out of the range of Int128, the result is clamped to the range.
+func (a Int64) SaturatingInt128() Int128 {
+	return a.Int128()
+}
+
+// SaturatingInt256 converts a to an Int256.
+// If the value of a is out of the range of Int256, the result is clamped to the range.
+func (a Int64) SaturatingInt256() Int256 {
+	return a.Int256()
+}
+
+// SaturatingInt512 converts a to an Int512.
+// If the value of a is out of the range of Int512, the result is clamped to the range.
+func (a Int64) SaturatingInt512() Int512 {
+	return a.Int512()
+}
+
+// SaturatingInt1024 converts a to an Int1024.
+// If the value of a is out of the range of Int1024, the result is clamped to the range.
+func (a Int64) SaturatingInt1024() Int1024 {
+	return a.Int1024()
+}
+
+// SaturatingUint8 converts a to a Uint8.
+// If the value of a is out of the range of Uint8, the result is clamped to the range.
+func (a Int64) SaturatingUint8() Uint8 {
+	b, ok := a.TryUint8()
+	if !ok {
+		if a.Sign() < 0 {
+			return 0
+		}
+		return MaxUint8
+	}
+	return b
+}
+
+// SaturatingUint16 converts a to a Uint16.
+// If the value of a is out of the range of Uint16, the result is clamped to the range.
+func (a Int64) SaturatingUint16() Uint16 {
+	b, ok := a.TryUint16()
+	if !ok {
+		if a.Sign() < 0 {
+			return 0
+		}
+		return MaxUint16
+	}
+	return b
+}
+
+// SaturatingUint32 converts a to a Uint32.
+// If the value of a is out of the range of Uint32, the result is clamped to the range.
+func (a Int64) SaturatingUint32() Uint32 {
+	b, ok := a.TryUint32()
+	if !ok {
+		if a.Sign() < 0 {
+			return 0
+		}
+		return MaxUint32
+	}
+	return b
+}
+
+// SaturatingUint64 converts a to a Uint64.
+// If the value of a is out of the range of Uint64, the result is clamped to the range.
+func (a Int64) SaturatingUint64() Uint64 {
+	b, ok := a.TryUint64()
+	if !ok {
+		if a.Sign() < 0 {
+			return 0
+		}
+		return MaxUint64
+	}
+	return b
+}
+
+// SaturatingUint128 converts a to a Uint128.
+// If the value of a is out of the range of Uint128, the result is clamped to the range.
+func (a Int64) SaturatingUint128() Uint128 {
+	b, ok := a.TryUint128()
+	if !ok {
+		if a.Sign() < 0 {
+			return Uint128{}
+		}
+		return Uint128{math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingUint256 converts a to a Uint256.
+// If the value of a is out of the range of Uint256, the result is clamped to the range.
+func (a Int64) SaturatingUint256() Uint256 {
+	b, ok := a.TryUint256()
+	if !ok {
+		if a.Sign() < 0 {
+			return Uint256{}
+		}
+		return Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingUint512 converts a to a Uint512.
+// If the value of a is out of the range of Uint512, the result is clamped to the range.
+func (a Int64) SaturatingUint512() Uint512 {
+	b, ok := a.TryUint512()
+	if !ok {
+		if a.Sign() < 0 {
+			return Uint512{}
+		}
+		return Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingUint1024 converts a to a Uint1024.
+// If the value of a is out of the range of Uint1024, the result is clamped to the range.
+func (a Int64) SaturatingUint1024() Uint1024 {
+	b, ok := a.TryUint1024()
+	if !ok {
+		if a.Sign() < 0 {
+			return Uint1024{}
+		}
+		return Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// Int8 converts a to an Int8.
+func (a Int128) Int8() Int8 {
+	return Int8(a[1])
+}
+
+// Int16 converts a to an Int16.
+func (a Int128) Int16() Int16 {
+	return Int16(a[1])
+}
+
+// Int32 converts a to an Int32.
+func (a Int128) Int32() Int32 {
+	return Int32(a[1])
+}
+
+// Int64 converts a to an Int64.
+func (a Int128) Int64() Int64 {
+	return Int64(a[1])
+}
+
+// Int128 returns a itself.
+func (a Int128) Int128() Int128 {
+	return a
+}
+
+// Int256 converts a to an Int256.
+func (a Int128) Int256() Int256 {
+	sign := uint64(int64(a[0]) >> 63)
+	return Int256{
+		sign,
+		sign,
+		a[0],
+		a[1],
+	}
+}
+
+// Int512 converts a to an Int512.
+func (a Int128) Int512() Int512 {
+	sign := uint64(int64(a[0]) >> 63)
+	return Int512{
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		a[0],
+		a[1],
+	}
+}
+
+// Int1024 converts a to an Int1024.
+func (a Int128) Int1024() Int1024 {
+	sign := uint64(int64(a[0]) >> 63)
+	return Int1024{
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		a[0],
+		a[1],
+	}
+}
+
+// Uint8 converts a to a Uint8, reinterpreting its two's complement representation.
+// a is truncated to its low 8 bits, so the result is a mod 2**8.
+func (a Int128) Uint8() Uint8 {
+	return Uint8(a[1])
+}
+
+// Uint16 converts a to a Uint16, reinterpreting its two's complement representation.
+// a is truncated to its low 16 bits, so the result is a mod 2**16.
+func (a Int128) Uint16() Uint16 {
+	return Uint16(a[1])
+}
+
+// Uint32 converts a to a Uint32, reinterpreting its two's complement representation.
+// a is truncated to its low 32 bits, so the result is a mod 2**32.
+func (a Int128) Uint32() Uint32 {
+	return Uint32(a[1])
+}
+
+// Uint64 converts a to a Uint64, reinterpreting its two's complement representation.
+// a is truncated to its low 64 bits, so the result is a mod 2**64.
+func (a Int128) Uint64() Uint64 {
+	return Uint64(a[1])
+}
+
+// Uint128 converts a to a Uint128, reinterpreting its two's complement representation.
+// If a is negative, it is sign-extended and the result is a+2**128.
+func (a Int128) Uint128() Uint128 {
+	return Uint128(a)
+}
+
+// Uint256 converts a to a Uint256, reinterpreting its two's complement representation.
+// If a is negative, it is sign-extended and the result is a+2**256.
+func (a Int128) Uint256() Uint256 {
+	sign := uint64(int64(a[0]) >> 63)
+	return Uint256{
+		sign,
+		sign,
+		a[0],
+		a[1],
+	}
+}
+
+// Uint512 converts a to a Uint512, reinterpreting its two's complement representation.
+// If a is negative, it is sign-extended and the result is a+2**512.
+func (a Int128) Uint512() Uint512 {
+	sign := uint64(int64(a[0]) >> 63)
+	return Uint512{
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		a[0],
+		a[1],
+	}
+}
+
+// Uint1024 converts a to a Uint1024, reinterpreting its two's complement representation.
+// If a is negative, it is sign-extended and the result is a+2**1024.
+func (a Int128) Uint1024() Uint1024 {
+	sign := uint64(int64(a[0]) >> 63)
+	return Uint1024{
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		a[0],
+		a[1],
+	}
+}
+
+// TryUint8 converts a to a Uint8.
+// It reports whether the value of a is representable as a Uint8;
+// if not, the result is the same as [Int128.Uint8].
+func (a Int128) TryUint8() (Uint8, bool) {
+	b := a.Uint8()
+	return b, a.Sign() >= 0 && b.Int128() == a
+}
+
+// TryUint16 converts a to a Uint16.
+// It reports whether the value of a is representable as a Uint16;
+// if not, the result is the same as [Int128.Uint16].
+func (a Int128) TryUint16() (Uint16, bool) {
+	b := a.Uint16()
+	return b, a.Sign() >= 0 && b.Int128() == a
+}
+
+// TryUint32 converts a to a Uint32.
+// It reports whether the value of a is representable as a Uint32;
+// if not, the result is the same as [Int128.Uint32].
+func (a Int128) TryUint32() (Uint32, bool) {
+	b := a.Uint32()
+	return b, a.Sign() >= 0 && b.Int128() == a
+}
+
+// TryUint64 converts a to a Uint64.
+// It reports whether the value of a is representable as a Uint64;
+// if not, the result is the same as [Int128.Uint64].
+func (a Int128) TryUint64() (Uint64, bool) {
+	b := a.Uint64()
+	return b, a.Sign() >= 0 && b.Int128() == a
+}
+
+// TryUint128 converts a to a Uint128.
+// It reports whether the value of a is representable as a Uint128;
+// if not, the result is the same as [Int128.Uint128].
+func (a Int128) TryUint128() (Uint128, bool) {
+	b := a.Uint128()
+	return b, a.Sign() >= 0 && b.Int128() == a
+}
+
+// TryUint256 converts a to a Uint256.
+// It reports whether the value of a is representable as a Uint256;
+// if not, the result is the same as [Int128.Uint256].
+func (a Int128) TryUint256() (Uint256, bool) {
+	b := a.Uint256()
+	return b, a.Sign() >= 0 && b.Int128() == a
+}
+
+// TryUint512 converts a to a Uint512.
+// It reports whether the value of a is representable as a Uint512;
+// if not, the result is the same as [Int128.Uint512].
+func (a Int128) TryUint512() (Uint512, bool) {
+	b := a.Uint512()
+	return b, a.Sign() >= 0 && b.Int128() == a
+}
+
+// TryUint1024 converts a to a Uint1024.
+// It reports whether the value of a is representable as a Uint1024;
+// if not, the result is the same as [Int128.Uint1024].
+func (a Int128) TryUint1024() (Uint1024, bool) {
+	b := a.Uint1024()
+	return b, a.Sign() >= 0 && b.Int128() == a
+}
+
+// TryInt8 converts a to an Int8.
+// It reports whether the value of a is representable as an Int8;
+// if not, the result is the same as [Int128.Int8].
+func (a Int128) TryInt8() (Int8, bool) {
+	b := a.Int8()
+	return b, b.Int128() == a
+}
+
+// TryInt16 converts a to an Int16.
+// It reports whether the value of a is representable as an Int16;
+// if not, the result is the same as [Int128.Int16].
+func (a Int128) TryInt16() (Int16, bool) {
+	b := a.Int16()
+	return b, b.Int128() == a
+}
+
+// TryInt32 converts a to an Int32.
+// It reports whether the value of a is representable as an Int32;
+// if not, the result is the same as [Int128.Int32].
+func (a Int128) TryInt32() (Int32, bool) {
+	b := a.Int32()
+	return b, b.Int128() == a
+}
+
+// TryInt64 converts a to an Int64.
+// It reports whether the value of a is representable as an Int64;
+// if not, the result is the same as [Int128.Int64].
+func (a Int128) TryInt64() (Int64, bool) {
+	b := a.Int64()
+	return b, b.Int128() == a
+}
+
+// TryInt128 converts a to an Int128.
+// It reports whether the value of a is representable as an Int128;
+// if not, the result is the same as [Int128.Int128].
+func (a Int128) TryInt128() (Int128, bool) {
+	return a, true
+}
+
+// TryInt256 converts a to an Int256.
+// It reports whether the value of a is representable as an Int256;
+// if not, the result is the same as [Int128.Int256].
+func (a Int128) TryInt256() (Int256, bool) {
+	return a.Int256(), true
+}
+
+// TryInt512 converts a to an Int512.
+// It reports whether the value of a is representable as an Int512;
+// if not, the result is the same as [Int128.Int512].
+func (a Int128) TryInt512() (Int512, bool) {
+	return a.Int512(), true
+}
+
+// TryInt1024 converts a to an Int1024.
+// It reports whether the value of a is representable as an Int1024;
+// if not, the result is the same as [Int128.Int1024].
+func (a Int128) TryInt1024() (Int1024, bool) {
+	return a.Int1024(), true
+}
+
+// SaturatingInt8 converts a to an Int8.
+// If the value of a is out of the range of Int8, the result is clamped to the range.
+func (a Int128) SaturatingInt8() Int8 {
+	b, ok := a.TryInt8()
+	if !ok {
+		if a.Sign() < 0 {
+			return MinInt8
+		}
+		return MaxInt8
+	}
+	return b
+}
+
+// SaturatingInt16 converts a to an Int16.
+// If the value of a is out of the range of Int16, the result is clamped to the range.
+func (a Int128) SaturatingInt16() Int16 {
+	b, ok := a.TryInt16()
+	if !ok {
+		if a.Sign() < 0 {
+			return MinInt16
+		}
+		return MaxInt16
+	}
+	return b
+}
+
+// SaturatingInt32 converts a to an Int32.
+// If the value of a is out of the range of Int32, the result is clamped to the range.
+func (a Int128) SaturatingInt32() Int32 {
+	b, ok := a.TryInt32()
+	if !ok {
+		if a.Sign() < 0 {
+			return MinInt32
+		}
+		return MaxInt32
+	}
+	return b
+}
+
+// SaturatingInt64 converts a to an Int64.
+// If the value of a is out of the range of Int64, the result is clamped to the range.
+func (a Int128) SaturatingInt64() Int64 {
+	b, ok := a.TryInt64()
+	if !ok {
+		if a.Sign() < 0 {
+			return MinInt64
+		}
+		return MaxInt64
+	}
+	return b
+}
+
+// SaturatingInt128 converts a to an Int128.
+// If the value of a is out of the range of Int128, the result is clamped to the range.
+func (a Int128) SaturatingInt128() Int128 {
+	return a.Int128()
+}
+
+// SaturatingInt256 converts a to an Int256.
+// If the value of a is out of the range of Int256, the result is clamped to the range.
+func (a Int128) SaturatingInt256() Int256 {
+	return a.Int256()
+}
+
+// SaturatingInt512 converts a to an Int512.
+// If the value of a is out of the range of Int512, the result is clamped to the range.
+func (a Int128) SaturatingInt512() Int512 {
+	return a.Int512()
+}
+
+// SaturatingInt1024 converts a to an Int1024.
+// If the value of a is out of the range of Int1024, the result is clamped to the range.
+func (a Int128) SaturatingInt1024() Int1024 {
+	return a.Int1024()
+}
+
+// SaturatingUint8 converts a to a Uint8.
+// If the value of a is out of the range of Uint8, the result is clamped to the range.
+func (a Int128) SaturatingUint8() Uint8 {
+	b, ok := a.TryUint8()
+	if !ok {
+		if a.Sign() < 0 {
+			return 0
+		}
+		return MaxUint8
+	}
+	return b
+}
+
+// SaturatingUint16 converts a to a Uint16.
+// If the value of a is out of the range of Uint16, the result is clamped to the range.
+func (a Int128) SaturatingUint16() Uint16 {
+	b, ok := a.TryUint16()
+	if !ok {
+		if a.Sign() < 0 {
+			return 0
+		}
+		return MaxUint16
+	}
+	return b
+}
+
+// SaturatingUint32 converts a to a Uint32.
+// If the value of a is out of the range of Uint32, the result is clamped to the range.
+func (a Int128) SaturatingUint32() Uint32 {
+	b, ok := a.TryUint32()
+	if !ok {
+		if a.Sign() < 0 {
+			return 0
+		}
+		return MaxUint32
+	}
+	return b
+}
+
+// SaturatingUint64 converts a to a Uint64.
+// If the value of a is out of the range of Uint64, the result is clamped to the range.
+func (a Int128) SaturatingUint64() Uint64 {
+	b, ok := a.TryUint64()
+	if !ok {
+		if a.Sign() < 0 {
+			return 0
+		}
+		return MaxUint64
+	}
+	return b
+}
+
+// SaturatingUint128 converts a to a Uint128.
+// If the value of a is out of the range of Uint128, the result is clamped to the range.
+func (a Int128) SaturatingUint128() Uint128 {
+	b, ok := a.TryUint128()
+	if !ok {
+		if a.Sign() < 0 {
+			return Uint128{}
+		}
+		return Uint128{math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingUint256 converts a to a Uint256.
+// If the value of a is out of the range of Uint256, the result is clamped to the range.
+func (a Int128) SaturatingUint256() Uint256 {
+	b, ok := a.TryUint256()
+	if !ok {
+		if a.Sign() < 0 {
+			return Uint256{}
+		}
+		return Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingUint512 converts a to a Uint512.
+// If the value of a is out of the range of Uint512, the result is clamped to the range.
+func (a Int128) SaturatingUint512() Uint512 {
+	b, ok := a.TryUint512()
+	if !ok {
+		if a.Sign() < 0 {
+			return Uint512{}
+		}
+		return Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingUint1024 converts a to a Uint1024.
+// If the value of a is out of the range of Uint1024, the result is clamped to the range.
+func (a Int128) SaturatingUint1024() Uint1024 {
+	b, ok := a.TryUint1024()
+	if !ok {
+		if a.Sign() < 0 {
+			return Uint1024{}
+		}
+		return Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// Int8 converts a to an Int8.
+func (a Int256) Int8() Int8 {
+	return Int8(a[3])
+}
+
+// Int16 converts a to an Int16.
+func (a Int256) Int16() Int16 {
+	return Int16(a[3])
+}
+
+// Int32 converts a to an Int32.
+func (a Int256) Int32() Int32 {
+	return Int32(a[3])
+}
+
+// Int64 converts a to an Int64.
+func (a Int256) Int64() Int64 {
+	return Int64(a[3])
+}
+
+// Int128 converts a to an Int128.
+func (a Int256) Int128() Int128 {
+	return Int128{
+		a[2],
+		a[3],
+	}
+}
+
+// Int256 returns a itself.
+func (a Int256) Int256() Int256 {
+	return a
+}
+
+// Int512 converts a to an Int512.
+func (a Int256) Int512() Int512 {
+	sign := uint64(int64(a[0]) >> 63)
+	return Int512{
+		sign,
+		sign,
+		sign,
+		sign,
+		a[0],
+		a[1],
+		a[2],
+		a[3],
+	}
+}
+
+// Int1024 converts a to an Int1024.
+func (a Int256) Int1024() Int1024 {
+	sign := uint64(int64(a[0]) >> 63)
+	return Int1024{
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		a[0],
+		a[1],
+		a[2],
+		a[3],
+	}
+}
+
+// Uint8 converts a to a Uint8, reinterpreting its two's complement representation.
+// a is truncated to its low 8 bits, so the result is a mod 2**8.
+func (a Int256) Uint8() Uint8 {
+	return Uint8(a[3])
+}
+
+// Uint16 converts a to a Uint16, reinterpreting its two's complement representation.
+// a is truncated to its low 16 bits, so the result is a mod 2**16.
+func (a Int256) Uint16() Uint16 {
+	return Uint16(a[3])
+}
+
+// Uint32 converts a to a Uint32, reinterpreting its two's complement representation.
+// a is truncated to its low 32 bits, so the result is a mod 2**32.
+func (a Int256) Uint32() Uint32 {
+	return Uint32(a[3])
+}
+
+// Uint64 converts a to a Uint64, reinterpreting its two's complement representation.
+// a is truncated to its low 64 bits, so the result is a mod 2**64.
+func (a Int256) Uint64() Uint64 {
+	return Uint64(a[3])
+}
+
+// Uint128 converts a to a Uint128, reinterpreting its two's complement representation.
+// a is truncated to its low 128 bits, so the result is a mod 2**128.
+func (a Int256) Uint128() Uint128 {
+	return Uint128{
+		a[2],
+		a[3],
+	}
+}
+
+// Uint256 converts a to a Uint256, reinterpreting its two's complement representation.
+// If a is negative, it is sign-extended and the result is a+2**256.
+func (a Int256) Uint256() Uint256 {
+	return Uint256(a)
+}
+
+// Uint512 converts a to a Uint512, reinterpreting its two's complement representation.
+// If a is negative, it is sign-extended and the result is a+2**512.
+func (a Int256) Uint512() Uint512 {
+	sign := uint64(int64(a[0]) >> 63)
+	return Uint512{
+		sign,
+		sign,
+		sign,
+		sign,
+		a[0],
+		a[1],
+		a[2],
+		a[3],
+	}
+}
+
+// Uint1024 converts a to a Uint1024, reinterpreting its two's complement representation.
+// If a is negative, it is sign-extended and the result is a+2**1024.
+func (a Int256) Uint1024() Uint1024 {
+	sign := uint64(int64(a[0]) >> 63)
+	return Uint1024{
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		a[0],
+		a[1],
+		a[2],
+		a[3],
+	}
+}
+
+// TryUint8 converts a to a Uint8.
+// It reports whether the value of a is representable as a Uint8;
+// if not, the result is the same as [Int256.Uint8].
+func (a Int256) TryUint8() (Uint8, bool) {
+	b := a.Uint8()
+	return b, a.Sign() >= 0 && b.Int256() == a
+}
+
+// TryUint16 converts a to a Uint16.
+// It reports whether the value of a is representable as a Uint16;
+// if not, the result is the same as [Int256.Uint16].
+func (a Int256) TryUint16() (Uint16, bool) {
+	b := a.Uint16()
+	return b, a.Sign() >= 0 && b.Int256() == a
+}
+
+// TryUint32 converts a to a Uint32.
+// It reports whether the value of a is representable as a Uint32;
+// if not, the result is the same as [Int256.Uint32].
+func (a Int256) TryUint32() (Uint32, bool) {
+	b := a.Uint32()
+	return b, a.Sign() >= 0 && b.Int256() == a
+}
+
+// TryUint64 converts a to a Uint64.
+// It reports whether the value of a is representable as a Uint64;
+// if not, the result is the same as [Int256.Uint64].
+func (a Int256) TryUint64() (Uint64, bool) {
+	b := a.Uint64()
+	return b, a.Sign() >= 0 && b.Int256() == a
+}
+
+// TryUint128 converts a to a Uint128.
+// It reports whether the value of a is representable as a Uint128;
+// if not, the result is the same as [Int256.Uint128].
+func (a Int256) TryUint128() (Uint128, bool) {
+	b := a.Uint128()
+	return b, a.Sign() >= 0 && b.Int256() == a
+}
+
+// TryUint256 converts a to a Uint256.
+// It reports whether the value of a is representable as a Uint256;
+// if not, the result is the same as [Int256.Uint256].
+func (a Int256) TryUint256() (Uint256, bool) {
+	b := a.Uint256()
+	return b, a.Sign() >= 0 && b.Int256() == a
+}
+
+// TryUint512 converts a to a Uint512.
+// It reports whether the value of a is representable as a Uint512;
+// if not, the result is the same as [Int256.Uint512].
+func (a Int256) TryUint512() (Uint512, bool) {
+	b := a.Uint512()
+	return b, a.Sign() >= 0 && b.Int256() == a
+}
+
+// TryUint1024 converts a to a Uint1024.
+// It reports whether the value of a is representable as a Uint1024;
+// if not, the result is the same as [Int256.Uint1024].
+func (a Int256) TryUint1024() (Uint1024, bool) {
+	b := a.Uint1024()
+	return b, a.Sign() >= 0 && b.Int256() == a
+}
+
+// TryInt8 converts a to an Int8.
+// It reports whether the value of a is representable as an Int8;
+// if not, the result is the same as [Int256.Int8].
+func (a Int256) TryInt8() (Int8, bool) {
+	b := a.Int8()
+	return b, b.Int256() == a
+}
+
+// TryInt16 converts a to an Int16.
+// It reports whether the value of a is representable as an Int16;
+// if not, the result is the same as [Int256.Int16].
+func (a Int256) TryInt16() (Int16, bool) {
+	b := a.Int16()
+	return b, b.Int256() == a
+}
+
+// TryInt32 converts a to an Int32.
+// It reports whether the value of a is representable as an Int32;
+// if not, the result is the same as [Int256.Int32].
+func (a Int256) TryInt32() (Int32, bool) {
+	b := a.Int32()
+	return b, b.Int256() == a
+}
+
+// TryInt64 converts a to an Int64.
+// It reports whether the value of a is representable as an Int64;
+// if not, the result is the same as [Int256.Int64].
+func (a Int256) TryInt64() (Int64, bool) {
+	b := a.Int64()
+	return b, b.Int256() == a
+}
+
+// TryInt128 converts a to an Int128.
+// It reports whether the value of a is representable as an Int128;
+// if not, the result is the same as [Int256.Int128].
+func (a Int256) TryInt128() (Int128, bool) {
+	b := a.Int128()
+	return b, b.Int256() == a
+}
+
+// TryInt256 converts a to an Int256.
+// It reports whether the value of a is representable as an Int256;
+// if not, the result is the same as [Int256.Int256].
+func (a Int256) TryInt256() (Int256, bool) {
+	return a, true
+}
+
+// TryInt512 converts a to an Int512.
+// It reports whether the value of a is representable as an Int512;
+// if not, the result is the same as [Int256.Int512].
+func (a Int256) TryInt512() (Int512, bool) {
+	return a.Int512(), true
+}
+
+// TryInt1024 converts a to an Int1024.
+// It reports whether the value of a is representable as an Int1024;
+// if not, the result is the same as [Int256.Int1024].
+func (a Int256) TryInt1024() (Int1024, bool) {
+	return a.Int1024(), true
+}
+
+// SaturatingInt8 converts a to an Int8.
+// If the value of a is out of the range of Int8, the result is clamped to the range.
+func (a Int256) SaturatingInt8() Int8 {
+	b, ok := a.TryInt8()
+	if !ok {
+		if a.Sign() < 0 {
+			return MinInt8
+		}
+		return MaxInt8
+	}
+	return b
+}
+
+// SaturatingInt16 converts a to an Int16.
+// If the value of a is out of the range of Int16, the result is clamped to the range.
+func (a Int256) SaturatingInt16() Int16 {
+	b, ok := a.TryInt16()
+	if !ok {
+		if a.Sign() < 0 {
+			return MinInt16
+		}
+		return MaxInt16
+	}
+	return b
+}
+
+// SaturatingInt32 converts a to an Int32.
+// If the value of a is out of the range of Int32, the result is clamped to the range.
+func (a Int256) SaturatingInt32() Int32 {
+	b, ok := a.TryInt32()
+	if !ok {
+		if a.Sign() < 0 {
+			return MinInt32
+		}
+		return MaxInt32
+	}
+	return b
+}
+
+// SaturatingInt64 converts a to an Int64.
+// If the value of a is out of the range of Int64, the result is clamped to the range.
+func (a Int256) SaturatingInt64() Int64 {
+	b, ok := a.TryInt64()
+	if !ok {
+		if a.Sign() < 0 {
+			return MinInt64
+		}
+		return MaxInt64
+	}
+	return b
+}
+
+// SaturatingInt128 converts a to an Int128.
+// If the value of a is out of the range of Int128, the result is clamped to the range.
+func (a Int256) SaturatingInt128() Int128 {
+	b, ok := a.TryInt128()
+	if !ok {
+		if a.Sign() < 0 {
+			return Int128{1 << 63, 0}
+		}
+		return Int128{math.MaxInt64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingInt256 converts a to an Int256.
+// If the value of a is out of the range of Int256, the result is clamped to the range.
+func (a Int256) SaturatingInt256() Int256 {
+	return a.Int256()
+}
+
+// SaturatingInt512 converts a to an Int512.
+// If the value of a is out of the range of Int512, the result is clamped to the range.
+func (a Int256) SaturatingInt512() Int512 {
+	return a.Int512()
+}
+
+// SaturatingInt1024 converts a to an Int1024.
+// If the value of a is out of the range of Int1024, the result is clamped to the range.
+func (a Int256) SaturatingInt1024() Int1024 {
+	return a.Int1024()
+}
+
+// SaturatingUint8 converts a to a Uint8.
+// If the value of a is out of the range of Uint8, the result is clamped to the range.
+func (a Int256) SaturatingUint8() Uint8 {
+	b, ok := a.TryUint8()
+	if !ok {
+		if a.Sign() < 0 {
+			return 0
+		}
+		return MaxUint8
+	}
+	return b
+}
+
+// SaturatingUint16 converts a to a Uint16.
+// If the value of a is out of the range of Uint16, the result is clamped to the range.
+func (a Int256) SaturatingUint16() Uint16 {
+	b, ok := a.TryUint16()
+	if !ok {
+		if a.Sign() < 0 {
+			return 0
+		}
+		return MaxUint16
+	}
+	return b
+}
+
+// SaturatingUint32 converts a to a Uint32.
+// If the value of a is out of the range of Uint32, the result is clamped to the range.
+func (a Int256) SaturatingUint32() Uint32 {
+	b, ok := a.TryUint32()
+	if !ok {
+		if a.Sign() < 0 {
+			return 0
+		}
+		return MaxUint32
+	}
+	return b
+}
+
+// SaturatingUint64 converts a to a Uint64.
+// If the value of a is out of the range of Uint64, the result is clamped to the range.
+func (a Int256) SaturatingUint64() Uint64 {
+	b, ok := a.TryUint64()
+	if !ok {
+		if a.Sign() < 0 {
+			return 0
+		}
+		return MaxUint64
+	}
+	return b
+}
+
+// SaturatingUint128 converts a to a Uint128.
+// If the value of a is out of the range of Uint128, the result is clamped to the range.
+func (a Int256) SaturatingUint128() Uint128 {
+	b, ok := a.TryUint128()
+	if !ok {
+		if a.Sign() < 0 {
+			return Uint128{}
+		}
+		return Uint128{math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingUint256 converts a to a Uint256.
+// If the value of a is out of the range of Uint256, the result is clamped to the range.
+func (a Int256) SaturatingUint256() Uint256 {
+	b, ok := a.TryUint256()
+	if !ok {
+		if a.Sign() < 0 {
+			return Uint256{}
+		}
+		return Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingUint512 converts a to a Uint512.
+// If the value of a is out of the range of Uint512, the result is clamped to the range.
+func (a Int256) SaturatingUint512() Uint512 {
+	b, ok := a.TryUint512()
+	if !ok {
+		if a.Sign() < 0 {
+			return Uint512{}
+		}
+		return Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingUint1024 converts a to a Uint1024.
+// If the value of a is out of the range of Uint1024, the result is clamped to the range.
+func (a Int256) SaturatingUint1024() Uint1024 {
+	b, ok := a.TryUint1024()
+	if !ok {
+		if a.Sign() < 0 {
+			return Uint1024{}
+		}
+		return Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// Int8 converts a to an Int8.
+func (a Int512) Int8() Int8 {
+	return Int8(a[7])
+}
+
+// Int16 converts a to an Int16.
+func (a Int512) Int16() Int16 {
+	return Int16(a[7])
+}
+
+// Int32 converts a to an Int32.
+func (a Int512) Int32() Int32 {
+	return Int32(a[7])
+}
+
+// Int64 converts a to an Int64.
+func (a Int512) Int64() Int64 {
+	return Int64(a[7])
+}
+
+// Int128 converts a to an Int128.
+func (a Int512) Int128() Int128 {
+	return Int128{
+		a[6],
+		a[7],
+	}
+}
+
+// Int256 converts a to an Int256.
+func (a Int512) Int256() Int256 {
+	return Int256{
+		a[4],
+		a[5],
+		a[6],
+		a[7],
+	}
+}
+
+// Int512 returns a itself.
+func (a Int512) Int512() Int512 {
+	return a
+}
+
+// Int1024 converts a to an Int1024.
+func (a Int512) Int1024() Int1024 {
+	sign := uint64(int64(a[0]) >> 63)
+	return Int1024{
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		a[0],
+		a[1],
+		a[2],
+		a[3],
+		a[4],
+		a[5],
+		a[6],
+		a[7],
+	}
+}
+
+// Uint8 converts a to a Uint8, reinterpreting its two's complement representation.
+// a is truncated to its low 8 bits, so the result is a mod 2**8.
+func (a Int512) Uint8() Uint8 {
+	return Uint8(a[7])
+}
+
+// Uint16 converts a to a Uint16, reinterpreting its two's complement representation.
+// a is truncated to its low 16 bits, so the result is a mod 2**16.
+func (a Int512) Uint16() Uint16 {
+	return Uint16(a[7])
+}
+
+// Uint32 converts a to a Uint32, reinterpreting its two's complement representation.
+// a is truncated to its low 32 bits, so the result is a mod 2**32.
+func (a Int512) Uint32() Uint32 {
+	return Uint32(a[7])
+}
+
+// Uint64 converts a to a Uint64, reinterpreting its two's complement representation.
+// a is truncated to its low 64 bits, so the result is a mod 2**64.
+func (a Int512) Uint64() Uint64 {
+	return Uint64(a[7])
+}
+
+// Uint128 converts a to a Uint128, reinterpreting its two's complement representation.
+// a is truncated to its low 128 bits, so the result is a mod 2**128.
+func (a Int512) Uint128() Uint128 {
+	return Uint128{
+		a[6],
+		a[7],
+	}
+}
+
+// Uint256 converts a to a Uint256, reinterpreting its two's complement representation.
+// a is truncated to its low 256 bits, so the result is a mod 2**256.
+func (a Int512) Uint256() Uint256 {
+	return Uint256{
+		a[4],
+		a[5],
+		a[6],
+		a[7],
+	}
+}
+
+// Uint512 converts a to a Uint512, reinterpreting its two's complement representation.
+// If a is negative, it is sign-extended and the result is a+2**512.
+func (a Int512) Uint512() Uint512 {
+	return Uint512(a)
+}
+
+// Uint1024 converts a to a Uint1024, reinterpreting its two's complement representation.
+// If a is negative, it is sign-extended and the result is a+2**1024.
+func (a Int512) Uint1024() Uint1024 {
+	sign := uint64(int64(a[0]) >> 63)
+	return Uint1024{
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		sign,
+		a[0],
+		a[1],
+		a[2],
+		a[3],
+		a[4],
+		a[5],
+		a[6],
+		a[7],
+	}
+}
+
+// TryUint8 converts a to a Uint8.
+// It reports whether the value of a is representable as a Uint8;
+// if not, the result is the same as [Int512.Uint8].
+func (a Int512) TryUint8() (Uint8, bool) {
+	b := a.Uint8()
+	return b, a.Sign() >= 0 && b.Int512() == a
+}
+
+// TryUint16 converts a to a Uint16.
+// It reports whether the value of a is representable as a Uint16;
+// if not, the result is the same as [Int512.Uint16].
+func (a Int512) TryUint16() (Uint16, bool) {
+	b := a.Uint16()
+	return b, a.Sign() >= 0 && b.Int512() == a
+}
+
+// TryUint32 converts a to a Uint32.
+// It reports whether the value of a is representable as a Uint32;
+// if not, the result is the same as [Int512.Uint32].
+func (a Int512) TryUint32() (Uint32, bool) {
+	b := a.Uint32()
+	return b, a.Sign() >= 0 && b.Int512() == a
+}
+
+// TryUint64 converts a to a Uint64.
+// It reports whether the value of a is representable as a Uint64;
+// if not, the result is the same as [Int512.Uint64].
+func (a Int512) TryUint64() (Uint64, bool) {
+	b := a.Uint64()
+	return b, a.Sign() >= 0 && b.Int512() == a
+}
+
+// TryUint128 converts a to a Uint128.
+// It reports whether the value of a is representable as a Uint128;
+// if not, the result is the same as [Int512.Uint128].
+func (a Int512) TryUint128() (Uint128, bool) {
+	b := a.Uint128()
+	return b, a.Sign() >= 0 && b.Int512() == a
+}
+
+// TryUint256 converts a to a Uint256.
+// It reports whether the value of a is representable as a Uint256;
+// if not, the result is the same as [Int512.Uint256].
+func (a Int512) TryUint256() (Uint256, bool) {
+	b := a.Uint256()
+	return b, a.Sign() >= 0 && b.Int512() == a
+}
+
+// TryUint512 converts a to a Uint512.
+// It reports whether the value of a is representable as a Uint512;
+// if not, the result is the same as [Int512.Uint512].
+func (a Int512) TryUint512() (Uint512, bool) {
+	b := a.Uint512()
+	return b, a.Sign() >= 0 && b.Int512() == a
+}
+
+// TryUint1024 converts a to a Uint1024.
+// It reports whether the value of a is representable as a Uint1024;
+// if not, the result is the same as [Int512.Uint1024].
+func (a Int512) TryUint1024() (Uint1024, bool) {
+	b := a.Uint1024()
+	return b, a.Sign() >= 0 && b.Int512() == a
+}
+
+// TryInt8 converts a to an Int8.
+// It reports whether the value of a is representable as an Int8;
+// if not, the result is the same as [Int512.Int8].
+func (a Int512) TryInt8() (Int8, bool) {
+	b := a.Int8()
+	return b, b.Int512() == a
+}
+
+// TryInt16 converts a to an Int16.
+// It reports whether the value of a is representable as an Int16;
+// if not, the result is the same as [Int512.Int16].
+func (a Int512) TryInt16() (Int16, bool) {
+	b := a.Int16()
+	return b, b.Int512() == a
+}
+
+// TryInt32 converts a to an Int32.
+// It reports whether the value of a is representable as an Int32;
+// if not, the result is the same as [Int512.Int32].
+func (a Int512) TryInt32() (Int32, bool) {
+	b := a.Int32()
+	return b, b.Int512() == a
+}
+
+// TryInt64 converts a to an Int64.
+// It reports whether the value of a is representable as an Int64;
+// if not, the result is the same as [Int512.Int64].
+func (a Int512) TryInt64() (Int64, bool) {
+	b := a.Int64()
+	return b, b.Int512() == a
+}
+
+// TryInt128 converts a to an Int128.
+// It reports whether the value of a is representable as an Int128;
+// if not, the result is the same as [Int512.Int128].
+func (a Int512) TryInt128() (Int128, bool) {
+	b := a.Int128()
+	return b, b.Int512() == a
+}
+
+// TryInt256 converts a to an Int256.
+// It reports whether the value of a is representable as an Int256;
+// if not, the result is the same as [Int512.Int256].
+func (a Int512) TryInt256() (Int256, bool) {
+	b := a.Int256()
+	return b, b.Int512() == a
+}
+
+// TryInt512 converts a to an Int512.
+// It reports whether the value of a is representable as an Int512;
+// if not, the result is the same as [Int512.Int512].
+func (a Int512) TryInt512() (Int512, bool) {
+	return a, true
+}
+
+// TryInt1024 converts a to an Int1024.
+// It reports whether the value of a is representable as an Int1024;
+// if not, the result is the same as [Int512.Int1024].
+func (a Int512) TryInt1024() (Int1024, bool) {
+	return a.Int1024(), true
+}
+
+// SaturatingInt8 converts a to an Int8.
+// If the value of a is out of the range of Int8, the result is clamped to the range.
+func (a Int512) SaturatingInt8() Int8 {
+	b, ok := a.TryInt8()
+	if !ok {
+		if a.Sign() < 0 {
+			return MinInt8
+		}
+		return MaxInt8
+	}
+	return b
+}
+
+// SaturatingInt16 converts a to an Int16.
+// If the value of a is out of the range of Int16, the result is clamped to the range.
+func (a Int512) SaturatingInt16() Int16 {
+	b, ok := a.TryInt16()
+	if !ok {
+		if a.Sign() < 0 {
+			return MinInt16
+		}
+		return MaxInt16
+	}
+	return b
+}
+
+// SaturatingInt32 converts a to an Int32.
+// If the value of a is out of the range of Int32, the result is clamped to the range.
+func (a Int512) SaturatingInt32() Int32 {
+	b, ok := a.TryInt32()
+	if !ok {
+		if a.Sign() < 0 {
+			return MinInt32
+		}
+		return MaxInt32
+	}
+	return b
+}
+
+// SaturatingInt64 converts a to an Int64.
+// If the value of a is out of the range of Int64, the result is clamped to the range.
+func (a Int512) SaturatingInt64() Int64 {
+	b, ok := a.TryInt64()
+	if !ok {
+		if a.Sign() < 0 {
+			return MinInt64
+		}
+		return MaxInt64
+	}
+	return b
+}
+
+// SaturatingInt128 converts a to an Int128.
+// If the value of a is out of the range of Int128, the result is clamped to the range.
+func (a Int512) SaturatingInt128() Int128 {
+	b, ok := a.TryInt128()
+	if !ok {
+		if a.Sign() < 0 {
+			return Int128{1 << 63, 0}
+		}
+		return Int128{math.MaxInt64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingInt256 converts a to an Int256.
+// If the value of a is out of the range of Int256, the result is clamped to the range.
+func (a Int512) SaturatingInt256() Int256 {
+	b, ok := a.TryInt256()
+	if !ok {
+		if a.Sign() < 0 {
+			return Int256{1 << 63, 0, 0, 0}
+		}
+		return Int256{math.MaxInt64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingInt512 converts a to an Int512.
+// If the value of a is out of the range of Int512, the result is clamped to the range.
+func (a Int512) SaturatingInt512() Int512 {
+	return a.Int512()
+}
+
+// SaturatingInt1024 converts a to an Int1024.
+// If the value of a is out of the range of Int1024, the result is clamped to the range.
+func (a Int512) SaturatingInt1024() Int1024 {
+	return a.Int1024()
+}
+
+// SaturatingUint8 converts a to a Uint8.
+// If the value of a is out of the range of Uint8, the result is clamped to the range.
+func (a Int512) SaturatingUint8() Uint8 {
+	b, ok := a.TryUint8()
+	if !ok {
+		if a.Sign() < 0 {
+			return 0
+		}
+		return MaxUint8
+	}
+	return b
+}
+
+// SaturatingUint16 converts a to a Uint16.
+// If the value of a is out of the range of Uint16, the result is clamped to the range.
+func (a Int512) SaturatingUint16() Uint16 {
+	b, ok := a.TryUint16()
+	if !ok {
+		if a.Sign() < 0 {
+			return 0
+		}
+		return MaxUint16
+	}
+	return b
+}
+
+// SaturatingUint32 converts a to a Uint32.
+// If the value of a is out of the range of Uint32, the result is clamped to the range.
+func (a Int512) SaturatingUint32() Uint32 {
+	b, ok := a.TryUint32()
+	if !ok {
+		if a.Sign() < 0 {
+			return 0
+		}
+		return MaxUint32
+	}
+	return b
+}
+
+// SaturatingUint64 converts a to a Uint64.
+// If the value of a is out of the range of Uint64, the result is clamped to the range.
+func (a Int512) SaturatingUint64() Uint64 {
+	b, ok := a.TryUint64()
+	if !ok {
+		if a.Sign() < 0 {
+			return 0
+		}
+		return MaxUint64
+	}
+	return b
+}
+
+// SaturatingUint128 converts a to a Uint128.
+// If the value of a is out of the range of Uint128, the result is clamped to the range.
+func (a Int512) SaturatingUint128() Uint128 {
+	b, ok := a.TryUint128()
+	if !ok {
+		if a.Sign() < 0 {
+			return Uint128{}
+		}
+		return Uint128{math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingUint256 converts a to a Uint256.
+// If the value of a is out of the range of Uint256, the result is clamped to the range.
+func (a Int512) SaturatingUint256() Uint256 {
+	b, ok := a.TryUint256()
+	if !ok {
+		if a.Sign() < 0 {
+			return Uint256{}
+		}
+		return Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingUint512 converts a to a Uint512.
+// If the value of a is out of the range of Uint512, the result is clamped to the range.
+func (a Int512) SaturatingUint512() Uint512 {
+	b, ok := a.TryUint512()
+	if !ok {
+		if a.Sign() < 0 {
+			return Uint512{}
+		}
+		return Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingUint1024 converts a to a Uint1024.
+// If the value of a is out of the range of Uint1024, the result is clamped to the range.
+func (a Int512) SaturatingUint1024() Uint1024 {
+	b, ok := a.TryUint1024()
+	if !ok {
+		if a.Sign() < 0 {
+			return Uint1024{}
+		}
+		return Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// Int8 converts a to an Int8.
+func (a Int1024) Int8() Int8 {
+	return Int8(a[15])
+}
+
+// Int16 converts a to an Int16.
+func (a Int1024) Int16() Int16 {
+	return Int16(a[15])
+}
+
+// Int32 converts a to an Int32.
+func (a Int1024) Int32() Int32 {
+	return Int32(a[15])
+}
+
+// Int64 converts a to an Int64.
+func (a Int1024) Int64() Int64 {
+	return Int64(a[15])
+}
+
+// Int128 converts a to an Int128.
+func (a Int1024) Int128() Int128 {
+	return Int128{
+		a[14],
+		a[15],
+	}
+}
+
+// Int256 converts a to an Int256.
+func (a Int1024) Int256() Int256 {
+	return Int256{
+		a[12],
+		a[13],
+		a[14],
+		a[15],
+	}
+}
+
+// Int512 converts a to an Int512.
+func (a Int1024) Int512() Int512 {
+	return Int512{
+		a[8],
+		a[9],
+		a[10],
+		a[11],
+		a[12],
+		a[13],
+		a[14],
+		a[15],
+	}
+}
+
+// Int1024 returns a itself.
+func (a Int1024) Int1024() Int1024 {
+	return a
+}
+
+// Uint8 converts a to a Uint8, reinterpreting its two's complement representation.
+// a is truncated to its low 8 bits, so the result is a mod 2**8.
+func (a Int1024) Uint8() Uint8 {
+	return Uint8(a[15])
+}
+
+// Uint16 converts a to a Uint16, reinterpreting its two's complement representation.
+// a is truncated to its low 16 bits, so the result is a mod 2**16.
+func (a Int1024) Uint16() Uint16 {
+	return Uint16(a[15])
+}
+
+// Uint32 converts a to a Uint32, reinterpreting its two's complement representation.
+// a is truncated to its low 32 bits, so the result is a mod 2**32.
+func (a Int1024) Uint32() Uint32 {
+	return Uint32(a[15])
+}
+
+// Uint64 converts a to a Uint64, reinterpreting its two's complement representation.
+// a is truncated to its low 64 bits, so the result is a mod 2**64.
+func (a Int1024) Uint64() Uint64 {
+	return Uint64(a[15])
+}
+
+// Uint128 converts a to a Uint128, reinterpreting its two's complement representation.
+// a is truncated to its low 128 bits, so the result is a mod 2**128.
+func (a Int1024) Uint128() Uint128 {
+	return Uint128{
+		a[14],
+		a[15],
+	}
+}
+
+// Uint256 converts a to a Uint256, reinterpreting its two's complement representation.
+// a is truncated to its low 256 bits, so the result is a mod 2**256.
+func (a Int1024) Uint256() Uint256 {
+	return Uint256{
+		a[12],
+		a[13],
+		a[14],
+		a[15],
+	}
+}
+
+// Uint512 converts a to a Uint512, reinterpreting its two's complement representation.
+// a is truncated to its low 512 bits, so the result is a mod 2**512.
+func (a Int1024) Uint512() Uint512 {
+	return Uint512{
 		a[8],
 		a[9],
 		a[10],
@@ -559,48 +3260,1999 @@ func (a Int1024) Int512() Int512 {
 	}
 }
 
-// Int1024 returns a itself.
-func (a Int1024) Int1024() Int1024 {
-	return a
+// Uint1024 converts a to a Uint1024, reinterpreting its two's complement representation.
+// If a is negative, it is sign-extended and the result is a+2**1024.
+func (a Int1024) Uint1024() Uint1024 {
+	return Uint1024(a)
+}
+
+// TryUint8 converts a to a Uint8.
+// It reports whether the value of a is representable as a Uint8;
+// if not, the result is the same as [Int1024.Uint8].
+func (a Int1024) TryUint8() (Uint8, bool) {
+	b := a.Uint8()
+	return b, a.Sign() >= 0 && b.Int1024() == a
+}
+
+// TryUint16 converts a to a Uint16.
+// It reports whether the value of a is representable as a Uint16;
+// if not, the result is the same as [Int1024.Uint16].
+func (a Int1024) TryUint16() (Uint16, bool) {
+	b := a.Uint16()
+	return b, a.Sign() >= 0 && b.Int1024() == a
+}
+
+// TryUint32 converts a to a Uint32.
+// It reports whether the value of a is representable as a Uint32;
+// if not, the result is the same as [Int1024.Uint32].
+func (a Int1024) TryUint32() (Uint32, bool) {
+	b := a.Uint32()
+	return b, a.Sign() >= 0 && b.Int1024() == a
+}
+
+// TryUint64 converts a to a Uint64.
+// It reports whether the value of a is representable as a Uint64;
+// if not, the result is the same as [Int1024.Uint64].
+func (a Int1024) TryUint64() (Uint64, bool) {
+	b := a.Uint64()
+	return b, a.Sign() >= 0 && b.Int1024() == a
+}
+
+// TryUint128 converts a to a Uint128.
+// It reports whether the value of a is representable as a Uint128;
+// if not, the result is the same as [Int1024.Uint128].
+func (a Int1024) TryUint128() (Uint128, bool) {
+	b := a.Uint128()
+	return b, a.Sign() >= 0 && b.Int1024() == a
+}
+
+// TryUint256 converts a to a Uint256.
+// It reports whether the value of a is representable as a Uint256;
+// if not, the result is the same as [Int1024.Uint256].
+func (a Int1024) TryUint256() (Uint256, bool) {
+	b := a.Uint256()
+	return b, a.Sign() >= 0 && b.Int1024() == a
+}
+
+// TryUint512 converts a to a Uint512.
+// It reports whether the value of a is representable as a Uint512;
+// if not, the result is the same as [Int1024.Uint512].
+func (a Int1024) TryUint512() (Uint512, bool) {
+	b := a.Uint512()
+	return b, a.Sign() >= 0 && b.Int1024() == a
+}
+
+// TryUint1024 converts a to a Uint1024.
+// It reports whether the value of a is representable as a Uint1024;
+// if not, the result is the same as [Int1024.Uint1024].
+func (a Int1024) TryUint1024() (Uint1024, bool) {
+	b := a.Uint1024()
+	return b, a.Sign() >= 0 && b.Int1024() == a
+}
+
+// TryInt8 converts a to an Int8.
+// It reports whether the value of a is representable as an Int8;
+// if not, the result is the same as [Int1024.Int8].
+func (a Int1024) TryInt8() (Int8, bool) {
+	b := a.Int8()
+	return b, b.Int1024() == a
+}
+
+// TryInt16 converts a to an Int16.
+// It reports whether the value of a is representable as an Int16;
+// if not, the result is the same as [Int1024.Int16].
+func (a Int1024) TryInt16() (Int16, bool) {
+	b := a.Int16()
+	return b, b.Int1024() == a
+}
+
+// TryInt32 converts a to an Int32.
+// It reports whether the value of a is representable as an Int32;
+// if not, the result is the same as [Int1024.Int32].
+func (a Int1024) TryInt32() (Int32, bool) {
+	b := a.Int32()
+	return b, b.Int1024() == a
+}
+
+// TryInt64 converts a to an Int64.
+// It reports whether the value of a is representable as an Int64;
+// if not, the result is the same as [Int1024.Int64].
+func (a Int1024) TryInt64() (Int64, bool) {
+	b := a.Int64()
+	return b, b.Int1024() == a
+}
+
+// TryInt128 converts a to an Int128.
+// It reports whether the value of a is representable as an Int128;
+// if not, the result is the same as [Int1024.Int128].
+func (a Int1024) TryInt128() (Int128, bool) {
+	b := a.Int128()
+	return b, b.Int1024() == a
+}
+
+// TryInt256 converts a to an Int256.
+// It reports whether the value of a is representable as an Int256;
+// if not, the result is the same as [Int1024.Int256].
+func (a Int1024) TryInt256() (Int256, bool) {
+	b := a.Int256()
+	return b, b.Int1024() == a
+}
+
+// TryInt512 converts a to an Int512.
+// It reports whether the value of a is representable as an Int512;
+// if not, the result is the same as [Int1024.Int512].
+func (a Int1024) TryInt512() (Int512, bool) {
+	b := a.Int512()
+	return b, b.Int1024() == a
+}
+
+// TryInt1024 converts a to an Int1024.
+// It reports whether the value of a is representable as an Int1024;
+// if not, the result is the same as [Int1024.Int1024].
+func (a Int1024) TryInt1024() (Int1024, bool) {
+	return a, true
+}
+
+// SaturatingInt8 converts a to an Int8.
+// If the value of a is out of the range of Int8, the result is clamped to the range.
+func (a Int1024) SaturatingInt8() Int8 {
+	b, ok := a.TryInt8()
+	if !ok {
+		if a.Sign() < 0 {
+			return MinInt8
+		}
+		return MaxInt8
+	}
+	return b
+}
+
+// SaturatingInt16 converts a to an Int16.
+// If the value of a is out of the range of Int16, the result is clamped to the range.
+func (a Int1024) SaturatingInt16() Int16 {
+	b, ok := a.TryInt16()
+	if !ok {
+		if a.Sign() < 0 {
+			return MinInt16
+		}
+		return MaxInt16
+	}
+	return b
+}
+
+// SaturatingInt32 converts a to an Int32.
+// If the value of a is out of the range of Int32, the result is clamped to the range.
+func (a Int1024) SaturatingInt32() Int32 {
+	b, ok := a.TryInt32()
+	if !ok {
+		if a.Sign() < 0 {
+			return MinInt32
+		}
+		return MaxInt32
+	}
+	return b
+}
+
+// SaturatingInt64 converts a to an Int64.
+// If the value of a is out of the range of Int64, the result is clamped to the range.
+func (a Int1024) SaturatingInt64() Int64 {
+	b, ok := a.TryInt64()
+	if !ok {
+		if a.Sign() < 0 {
+			return MinInt64
+		}
+		return MaxInt64
+	}
+	return b
+}
+
+// SaturatingInt128 converts a to an Int128.
+// If the value of a is out of the range of Int128, the result is clamped to the range.
+func (a Int1024) SaturatingInt128() Int128 {
+	b, ok := a.TryInt128()
+	if !ok {
+		if a.Sign() < 0 {
+			return Int128{1 << 63, 0}
+		}
+		return Int128{math.MaxInt64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingInt256 converts a to an Int256.
+// If the value of a is out of the range of Int256, the result is clamped to the range.
+func (a Int1024) SaturatingInt256() Int256 {
+	b, ok := a.TryInt256()
+	if !ok {
+		if a.Sign() < 0 {
+			return Int256{1 << 63, 0, 0, 0}
+		}
+		return Int256{math.MaxInt64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingInt512 converts a to an Int512.
+// If the value of a is out of the range of Int512, the result is clamped to the range.
+func (a Int1024) SaturatingInt512() Int512 {
+	b, ok := a.TryInt512()
+	if !ok {
+		if a.Sign() < 0 {
+			return Int512{1 << 63, 0, 0, 0, 0, 0, 0, 0}
+		}
+		return Int512{math.MaxInt64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingInt1024 converts a to an Int1024.
+// If the value of a is out of the range of Int1024, the result is clamped to the range.
+func (a Int1024) SaturatingInt1024() Int1024 {
+	return a.Int1024()
+}
+
+// SaturatingUint8 converts a to a Uint8.
+// If the value of a is out of the range of Uint8, the result is clamped to the range.
+func (a Int1024) SaturatingUint8() Uint8 {
+	b, ok := a.TryUint8()
+	if !ok {
+		if a.Sign() < 0 {
+			return 0
+		}
+		return MaxUint8
+	}
+	return b
+}
+
+// SaturatingUint16 converts a to a Uint16.
+// If the value of a is out of the range of Uint16, the result is clamped to the range.
+func (a Int1024) SaturatingUint16() Uint16 {
+	b, ok := a.TryUint16()
+	if !ok {
+		if a.Sign() < 0 {
+			return 0
+		}
+		return MaxUint16
+	}
+	return b
+}
+
+// SaturatingUint32 converts a to a Uint32.
+// If the value of a is out of the range of Uint32, the result is clamped to the range.
+func (a Int1024) SaturatingUint32() Uint32 {
+	b, ok := a.TryUint32()
+	if !ok {
+		if a.Sign() < 0 {
+			return 0
+		}
+		return MaxUint32
+	}
+	return b
+}
+
+// SaturatingUint64 converts a to a Uint64.
+// If the value of a is out of the range of Uint64, the result is clamped to the range.
+func (a Int1024) SaturatingUint64() Uint64 {
+	b, ok := a.TryUint64()
+	if !ok {
+		if a.Sign() < 0 {
+			return 0
+		}
+		return MaxUint64
+	}
+	return b
+}
+
+// SaturatingUint128 converts a to a Uint128.
+// If the value of a is out of the range of Uint128, the result is clamped to the range.
+func (a Int1024) SaturatingUint128() Uint128 {
+	b, ok := a.TryUint128()
+	if !ok {
+		if a.Sign() < 0 {
+			return Uint128{}
+		}
+		return Uint128{math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingUint256 converts a to a Uint256.
+// If the value of a is out of the range of Uint256, the result is clamped to the range.
+func (a Int1024) SaturatingUint256() Uint256 {
+	b, ok := a.TryUint256()
+	if !ok {
+		if a.Sign() < 0 {
+			return Uint256{}
+		}
+		return Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingUint512 converts a to a Uint512.
+// If the value of a is out of the range of Uint512, the result is clamped to the range.
+func (a Int1024) SaturatingUint512() Uint512 {
+	b, ok := a.TryUint512()
+	if !ok {
+		if a.Sign() < 0 {
+			return Uint512{}
+		}
+		return Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingUint1024 converts a to a Uint1024.
+// If the value of a is out of the range of Uint1024, the result is clamped to the range.
+func (a Int1024) SaturatingUint1024() Uint1024 {
+	b, ok := a.TryUint1024()
+	if !ok {
+		if a.Sign() < 0 {
+			return Uint1024{}
+		}
+		return Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// Uint8 returns a itself.
+func (a Uint8) Uint8() Uint8 {
+	return a
+}
+
+// Uint16 converts a to an Uint16.
+func (a Uint8) Uint16() Uint16 {
+	return Uint16(a)
+}
+
+// Uint32 converts a to an Uint32.
+func (a Uint8) Uint32() Uint32 {
+	return Uint32(a)
+}
+
+// Uint64 converts a to an Uint64.
+func (a Uint8) Uint64() Uint64 {
+	return Uint64(a)
+}
+
+// Uint128 converts a to an Uint128.
+func (a Uint8) Uint128() Uint128 {
+	return Uint128{0, uint64(a)}
+}
+
+// Uint256 converts a to an Uint256.
+func (a Uint8) Uint256() Uint256 {
+	return Uint256{
+		0,
+		0,
+		0,
+		uint64(a),
+	}
+}
+
+// Uint512 converts a to an Uint512.
+func (a Uint8) Uint512() Uint512 {
+	return Uint512{
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		uint64(a),
+	}
+}
+
+// Uint1024 converts a to an Uint1024.
+func (a Uint8) Uint1024() Uint1024 {
+	return Uint1024{
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		uint64(a),
+	}
+}
+
+// Int8 converts a to an Int8, reinterpreting the bits of a as a two's complement signed integer.
+// If a >= 2**7, the result is a-2**8.
+func (a Uint8) Int8() Int8 {
+	return Int8(a)
+}
+
+// Int16 converts a to an Int16.
+// a is zero-extended, so the result always equals a.
+func (a Uint8) Int16() Int16 {
+	return Int16(a)
+}
+
+// Int32 converts a to an Int32.
+// a is zero-extended, so the result always equals a.
+func (a Uint8) Int32() Int32 {
+	return Int32(a)
+}
+
+// Int64 converts a to an Int64.
+// a is zero-extended, so the result always equals a.
+func (a Uint8) Int64() Int64 {
+	return Int64(a)
+}
+
+// Int128 converts a to an Int128.
+// a is zero-extended, so the result always equals a.
+func (a Uint8) Int128() Int128 {
+	return Int128{0, uint64(a)}
+}
+
+// Int256 converts a to an Int256.
+// a is zero-extended, so the result always equals a.
+func (a Uint8) Int256() Int256 {
+	return Int256{
+		0,
+		0,
+		0,
+		uint64(a),
+	}
+}
+
+// Int512 converts a to an Int512.
+// a is zero-extended, so the result always equals a.
+func (a Uint8) Int512() Int512 {
+	return Int512{
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		uint64(a),
+	}
+}
+
+// Int1024 converts a to an Int1024.
+// a is zero-extended, so the result always equals a.
+func (a Uint8) Int1024() Int1024 {
+	return Int1024{
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		uint64(a),
+	}
+}
+
+// TryInt8 converts a to an Int8.
+// It reports whether the value of a is representable as an Int8;
+// if not, the result is the same as [Uint8.Int8].
+func (a Uint8) TryInt8() (Int8, bool) {
+	b := a.Int8()
+	return b, b.Sign() >= 0 && b.Uint8() == a
+}
+
+// TryInt16 converts a to an Int16.
+// It reports whether the value of a is representable as an Int16;
+// if not, the result is the same as [Uint8.Int16].
+func (a Uint8) TryInt16() (Int16, bool) {
+	b := a.Int16()
+	return b, b.Sign() >= 0 && b.Uint8() == a
+}
+
+// TryInt32 converts a to an Int32.
+// It reports whether the value of a is representable as an Int32;
+// if not, the result is the same as [Uint8.Int32].
+func (a Uint8) TryInt32() (Int32, bool) {
+	b := a.Int32()
+	return b, b.Sign() >= 0 && b.Uint8() == a
+}
+
+// TryInt64 converts a to an Int64.
+// It reports whether the value of a is representable as an Int64;
+// if not, the result is the same as [Uint8.Int64].
+func (a Uint8) TryInt64() (Int64, bool) {
+	b := a.Int64()
+	return b, b.Sign() >= 0 && b.Uint8() == a
+}
+
+// TryInt128 converts a to an Int128.
+// It reports whether the value of a is representable as an Int128;
+// if not, the result is the same as [Uint8.Int128].
+func (a Uint8) TryInt128() (Int128, bool) {
+	b := a.Int128()
+	return b, b.Sign() >= 0 && b.Uint8() == a
+}
+
+// TryInt256 converts a to an Int256.
+// It reports whether the value of a is representable as an Int256;
+// if not, the result is the same as [Uint8.Int256].
+func (a Uint8) TryInt256() (Int256, bool) {
+	b := a.Int256()
+	return b, b.Sign() >= 0 && b.Uint8() == a
+}
+
+// TryInt512 converts a to an Int512.
+// It reports whether the value of a is representable as an Int512;
+// if not, the result is the same as [Uint8.Int512].
+func (a Uint8) TryInt512() (Int512, bool) {
+	b := a.Int512()
+	return b, b.Sign() >= 0 && b.Uint8() == a
+}
+
+// TryInt1024 converts a to an Int1024.
+// It reports whether the value of a is representable as an Int1024;
+// if not, the result is the same as [Uint8.Int1024].
+func (a Uint8) TryInt1024() (Int1024, bool) {
+	b := a.Int1024()
+	return b, b.Sign() >= 0 && b.Uint8() == a
+}
+
+// TryUint8 converts a to a Uint8.
+// It reports whether the value of a is representable as a Uint8;
+// if not, the result is the same as [Uint8.Uint8].
+func (a Uint8) TryUint8() (Uint8, bool) {
+	return a, true
+}
+
+// TryUint16 converts a to a Uint16.
+// It reports whether the value of a is representable as a Uint16;
+// if not, the result is the same as [Uint8.Uint16].
+func (a Uint8) TryUint16() (Uint16, bool) {
+	return a.Uint16(), true
+}
+
+// TryUint32 converts a to a Uint32.
+// It reports whether the value of a is representable as a Uint32;
+// if not, the result is the same as [Uint8.Uint32].
+func (a Uint8) TryUint32() (Uint32, bool) {
+	return a.Uint32(), true
+}
+
+// TryUint64 converts a to a Uint64.
+// It reports whether the value of a is representable as a Uint64;
+// if not, the result is the same as [Uint8.Uint64].
+func (a Uint8) TryUint64() (Uint64, bool) {
+	return a.Uint64(), true
+}
+
+// TryUint128 converts a to a Uint128.
+// It reports whether the value of a is representable as a Uint128;
+// if not, the result is the same as [Uint8.Uint128].
+func (a Uint8) TryUint128() (Uint128, bool) {
+	return a.Uint128(), true
+}
+
+// TryUint256 converts a to a Uint256.
+// It reports whether the value of a is representable as a Uint256;
+// if not, the result is the same as [Uint8.Uint256].
+func (a Uint8) TryUint256() (Uint256, bool) {
+	return a.Uint256(), true
+}
+
+// TryUint512 converts a to a Uint512.
+// It reports whether the value of a is representable as a Uint512;
+// if not, the result is the same as [Uint8.Uint512].
+func (a Uint8) TryUint512() (Uint512, bool) {
+	return a.Uint512(), true
+}
+
+// TryUint1024 converts a to a Uint1024.
+// It reports whether the value of a is representable as a Uint1024;
+// if not, the result is the same as [Uint8.Uint1024].
+func (a Uint8) TryUint1024() (Uint1024, bool) {
+	return a.Uint1024(), true
+}
+
+// SaturatingUint8 converts a to a Uint8.
+// If the value of a is out of the range of Uint8, the result is clamped to the range.
+func (a Uint8) SaturatingUint8() Uint8 {
+	return a.Uint8()
+}
+
+// SaturatingUint16 converts a to a Uint16.
+// If the value of a is out of the range of Uint16, the result is clamped to the range.
+func (a Uint8) SaturatingUint16() Uint16 {
+	return a.Uint16()
+}
+
+// SaturatingUint32 converts a to a Uint32.
+// If the value of a is out of the range of Uint32, the result is clamped to the range.
+func (a Uint8) SaturatingUint32() Uint32 {
+	return a.Uint32()
+}
+
+// SaturatingUint64 converts a to a Uint64.
+// If the value of a is out of the range of Uint64, the result is clamped to the range.
+func (a Uint8) SaturatingUint64() Uint64 {
+	return a.Uint64()
+}
+
+// SaturatingUint128 converts a to a Uint128.
+// If the value of a is out of the range of Uint128, the result is clamped to the range.
+func (a Uint8) SaturatingUint128() Uint128 {
+	return a.Uint128()
+}
+
+// SaturatingUint256 converts a to a Uint256.
+// If the value of a is out of the range of Uint256, the result is clamped to the range.
+func (a Uint8) SaturatingUint256() Uint256 {
+	return a.Uint256()
+}
+
+// SaturatingUint512 converts a to a Uint512.
+// If the value of a is out of the range of Uint512, the result is clamped to the range.
+func (a Uint8) SaturatingUint512() Uint512 {
+	return a.Uint512()
+}
+
+// SaturatingUint1024 converts a to a Uint1024.
+// If the value of a is out of the range of Uint1024, the result is clamped to the range.
+func (a Uint8) SaturatingUint1024() Uint1024 {
+	return a.Uint1024()
+}
+
+// SaturatingInt8 converts a to an Int8.
+// If the value of a is out of the range of Int8, the result is clamped to the range.
+func (a Uint8) SaturatingInt8() Int8 {
+	b, ok := a.TryInt8()
+	if !ok {
+		return MaxInt8
+	}
+	return b
+}
+
+// SaturatingInt16 converts a to an Int16.
+// If the value of a is out of the range of Int16, the result is clamped to the range.
+func (a Uint8) SaturatingInt16() Int16 {
+	b, ok := a.TryInt16()
+	if !ok {
+		return MaxInt16
+	}
+	return b
+}
+
+// SaturatingInt32 converts a to an Int32.
+// If the value of a is out of the range of Int32, the result is clamped to the range.
+func (a Uint8) SaturatingInt32() Int32 {
+	b, ok := a.TryInt32()
+	if !ok {
+		return MaxInt32
+	}
+	return b
+}
+
+// SaturatingInt64 converts a to an Int64.
+// If the value of a is out of the range of Int64, the result is clamped to the range.
+func (a Uint8) SaturatingInt64() Int64 {
+	b, ok := a.TryInt64()
+	if !ok {
+		return MaxInt64
+	}
+	return b
+}
+
+// SaturatingInt128 converts a to an Int128.
+// If the value of a is out of the range of Int128, the result is clamped to the range.
+func (a Uint8) SaturatingInt128() Int128 {
+	b, ok := a.TryInt128()
+	if !ok {
+		return Int128{math.MaxInt64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingInt256 converts a to an Int256.
+// If the value of a is out of the range of Int256, the result is clamped to the range.
+func (a Uint8) SaturatingInt256() Int256 {
+	b, ok := a.TryInt256()
+	if !ok {
+		return Int256{math.MaxInt64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingInt512 converts a to an Int512.
+// If the value of a is out of the range of Int512, the result is clamped to the range.
+func (a Uint8) SaturatingInt512() Int512 {
+	b, ok := a.TryInt512()
+	if !ok {
+		return Int512{math.MaxInt64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingInt1024 converts a to an Int1024.
+// If the value of a is out of the range of Int1024, the result is clamped to the range.
+func (a Uint8) SaturatingInt1024() Int1024 {
+	b, ok := a.TryInt1024()
+	if !ok {
+		return Int1024{math.MaxInt64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// Uint8 converts a to an Uint8.
+func (a Uint16) Uint8() Uint8 {
+	return Uint8(a)
+}
+
+// Uint16 returns a itself.
+func (a Uint16) Uint16() Uint16 {
+	return a
+}
+
+// Uint32 converts a to an Uint32.
+func (a Uint16) Uint32() Uint32 {
+	return Uint32(a)
+}
+
+// Uint64 converts a to an Uint64.
+func (a Uint16) Uint64() Uint64 {
+	return Uint64(a)
+}
+
+// Uint128 converts a to an Uint128.
+func (a Uint16) Uint128() Uint128 {
+	return Uint128{0, uint64(a)}
+}
+
+// Uint256 converts a to an Uint256.
+func (a Uint16) Uint256() Uint256 {
+	return Uint256{
+		0,
+		0,
+		0,
+		uint64(a),
+	}
+}
+
+// Uint512 converts a to an Uint512.
+func (a Uint16) Uint512() Uint512 {
+	return Uint512{
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		uint64(a),
+	}
+}
+
+// Uint1024 converts a to an Uint1024.
+func (a Uint16) Uint1024() Uint1024 {
+	return Uint1024{
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		uint64(a),
+	}
+}
+
+// Int8 converts a to an Int8.
+// a is truncated to its low 8 bits, which are interpreted as a two's complement signed integer.
+func (a Uint16) Int8() Int8 {
+	return Int8(a)
+}
+
+// Int16 converts a to an Int16, reinterpreting the bits of a as a two's complement signed integer.
+// If a >= 2**15, the result is a-2**16.
+func (a Uint16) Int16() Int16 {
+	return Int16(a)
+}
+
+// Int32 converts a to an Int32.
+// a is zero-extended, so the result always equals a.
+func (a Uint16) Int32() Int32 {
+	return Int32(a)
+}
+
+// Int64 converts a to an Int64.
+// a is zero-extended, so the result always equals a.
+func (a Uint16) Int64() Int64 {
+	return Int64(a)
+}
+
+// Int128 converts a to an Int128.
+// a is zero-extended, so the result always equals a.
+func (a Uint16) Int128() Int128 {
+	return Int128{0, uint64(a)}
+}
+
+// Int256 converts a to an Int256.
+// a is zero-extended, so the result always equals a.
+func (a Uint16) Int256() Int256 {
+	return Int256{
+		0,
+		0,
+		0,
+		uint64(a),
+	}
+}
+
+// Int512 converts a to an Int512.
+// a is zero-extended, so the result always equals a.
+func (a Uint16) Int512() Int512 {
+	return Int512{
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		uint64(a),
+	}
+}
+
+// Int1024 converts a to an Int1024.
+// a is zero-extended, so the result always equals a.
+func (a Uint16) Int1024() Int1024 {
+	return Int1024{
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		uint64(a),
+	}
+}
+
+// TryInt8 converts a to an Int8.
+// It reports whether the value of a is representable as an Int8;
+// if not, the result is the same as [Uint16.Int8].
+func (a Uint16) TryInt8() (Int8, bool) {
+	b := a.Int8()
+	return b, b.Sign() >= 0 && b.Uint16() == a
+}
+
+// TryInt16 converts a to an Int16.
+// It reports whether the value of a is representable as an Int16;
+// if not, the result is the same as [Uint16.Int16].
+func (a Uint16) TryInt16() (Int16, bool) {
+	b := a.Int16()
+	return b, b.Sign() >= 0 && b.Uint16() == a
+}
+
+// TryInt32 converts a to an Int32.
+// It reports whether the value of a is representable as an Int32;
+// if not, the result is the same as [Uint16.Int32].
+func (a Uint16) TryInt32() (Int32, bool) {
+	b := a.Int32()
+	return b, b.Sign() >= 0 && b.Uint16() == a
+}
+
+// TryInt64 converts a to an Int64.
+// It reports whether the value of a is representable as an Int64;
+// if not, the result is the same as [Uint16.Int64].
+func (a Uint16) TryInt64() (Int64, bool) {
+	b := a.Int64()
+	return b, b.Sign() >= 0 && b.Uint16() == a
+}
+
+// TryInt128 converts a to an Int128.
+// It reports whether the value of a is representable as an Int128;
+// if not, the result is the same as [Uint16.Int128].
+func (a Uint16) TryInt128() (Int128, bool) {
+	b := a.Int128()
+	return b, b.Sign() >= 0 && b.Uint16() == a
+}
+
+// TryInt256 converts a to an Int256.
+// It reports whether the value of a is representable as an Int256;
+// if not, the result is the same as [Uint16.Int256].
+func (a Uint16) TryInt256() (Int256, bool) {
+	b := a.Int256()
+	return b, b.Sign() >= 0 && b.Uint16() == a
+}
+
+// TryInt512 converts a to an Int512.
+// It reports whether the value of a is representable as an Int512;
+// if not, the result is the same as [Uint16.Int512].
+func (a Uint16) TryInt512() (Int512, bool) {
+	b := a.Int512()
+	return b, b.Sign() >= 0 && b.Uint16() == a
+}
+
+// TryInt1024 converts a to an Int1024.
+// It reports whether the value of a is representable as an Int1024;
+// if not, the result is the same as [Uint16.Int1024].
+func (a Uint16) TryInt1024() (Int1024, bool) {
+	b := a.Int1024()
+	return b, b.Sign() >= 0 && b.Uint16() == a
+}
+
+// TryUint8 converts a to a Uint8.
+// It reports whether the value of a is representable as a Uint8;
+// if not, the result is the same as [Uint16.Uint8].
+func (a Uint16) TryUint8() (Uint8, bool) {
+	b := a.Uint8()
+	return b, b.Uint16() == a
+}
+
+// TryUint16 converts a to a Uint16.
+// It reports whether the value of a is representable as a Uint16;
+// if not, the result is the same as [Uint16.Uint16].
+func (a Uint16) TryUint16() (Uint16, bool) {
+	return a, true
+}
+
+// TryUint32 converts a to a Uint32.
+// It reports whether the value of a is representable as a Uint32;
+// if not, the result is the same as [Uint16.Uint32].
+func (a Uint16) TryUint32() (Uint32, bool) {
+	return a.Uint32(), true
+}
+
+// TryUint64 converts a to a Uint64.
+// It reports whether the value of a is representable as a Uint64;
+// if not, the result is the same as [Uint16.Uint64].
+func (a Uint16) TryUint64() (Uint64, bool) {
+	return a.Uint64(), true
+}
+
+// TryUint128 converts a to a Uint128.
+// It reports whether the value of a is representable as a Uint128;
+// if not, the result is the same as [Uint16.Uint128].
+func (a Uint16) TryUint128() (Uint128, bool) {
+	return a.Uint128(), true
+}
+
+// TryUint256 converts a to a Uint256.
+// It reports whether the value of a is representable as a Uint256;
+// if not, the result is the same as [Uint16.Uint256].
+func (a Uint16) TryUint256() (Uint256, bool) {
+	return a.Uint256(), true
+}
+
+// TryUint512 converts a to a Uint512.
+// It reports whether the value of a is representable as a Uint512;
+// if not, the result is the same as [Uint16.Uint512].
+func (a Uint16) TryUint512() (Uint512, bool) {
+	return a.Uint512(), true
+}
+
+// TryUint1024 converts a to a Uint1024.
+// It reports whether the value of a is representable as a Uint1024;
+// if not, the result is the same as [Uint16.Uint1024].
+func (a Uint16) TryUint1024() (Uint1024, bool) {
+	return a.Uint1024(), true
+}
+
+// SaturatingUint8 converts a to a Uint8.
+// If the value of a is out of the range of Uint8, the result is clamped to the range.
+func (a Uint16) SaturatingUint8() Uint8 {
+	b, ok := a.TryUint8()
+	if !ok {
+		return MaxUint8
+	}
+	return b
+}
+
+// SaturatingUint16 converts a to a Uint16.
+// If the value of a is out of the range of Uint16, the result is clamped to the range.
+func (a Uint16) SaturatingUint16() Uint16 {
+	return a.Uint16()
+}
+
+// SaturatingUint32 converts a to a Uint32.
+// If the value of a is out of the range of Uint32, the result is clamped to the range.
+func (a Uint16) SaturatingUint32() Uint32 {
+	return a.Uint32()
+}
+
+// SaturatingUint64 converts a to a Uint64.
+// If the value of a is out of the range of Uint64, the result is clamped to the range.
+func (a Uint16) SaturatingUint64() Uint64 {
+	return a.Uint64()
+}
+
+// SaturatingUint128 converts a to a Uint128.
+// If the value of a is out of the range of Uint128, the result is clamped to the range.
+func (a Uint16) SaturatingUint128() Uint128 {
+	return a.Uint128()
+}
+
+// SaturatingUint256 converts a to a Uint256.
+// If the value of a is out of the range of Uint256, the result is clamped to the range.
+func (a Uint16) SaturatingUint256() Uint256 {
+	return a.Uint256()
+}
+
+// SaturatingUint512 converts a to a Uint512.
+// If the value of a is out of the range of Uint512, the result is clamped to the range.
+func (a Uint16) SaturatingUint512() Uint512 {
+	return a.Uint512()
+}
+
+// SaturatingUint1024 converts a to a Uint1024.
+// If the value of a is out of the range of Uint1024, the result is clamped to the range.
+func (a Uint16) SaturatingUint1024() Uint1024 {
+	return a.Uint1024()
+}
+
+// SaturatingInt8 converts a to an Int8.
+// If the value of a is out of the range of Int8, the result is clamped to the range.
+func (a Uint16) SaturatingInt8() Int8 {
+	b, ok := a.TryInt8()
+	if !ok {
+		return MaxInt8
+	}
+	return b
+}
+
+// SaturatingInt16 converts a to an Int16.
+// If the value of a is out of the range of Int16, the result is clamped to the range.
+func (a Uint16) SaturatingInt16() Int16 {
+	b, ok := a.TryInt16()
+	if !ok {
+		return MaxInt16
+	}
+	return b
+}
+
+// SaturatingInt32 converts a to an Int32.
+// If the value of a is out of the range of Int32, the result is clamped to the range.
+func (a Uint16) SaturatingInt32() Int32 {
+	b, ok := a.TryInt32()
+	if !ok {
+		return MaxInt32
+	}
+	return b
+}
+
+// SaturatingInt64 converts a to an Int64.
+// If the value of a is out of the range of Int64, the result is clamped to the range.
+func (a Uint16) SaturatingInt64() Int64 {
+	b, ok := a.TryInt64()
+	if !ok {
+		return MaxInt64
+	}
+	return b
+}
+
+// SaturatingInt128 converts a to an Int128.
+// If the value of a is out of the range of Int128, the result is clamped to the range.
+func (a Uint16) SaturatingInt128() Int128 {
+	b, ok := a.TryInt128()
+	if !ok {
+		return Int128{math.MaxInt64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingInt256 converts a to an Int256.
+// If the value of a is out of the range of Int256, the result is clamped to the range.
+func (a Uint16) SaturatingInt256() Int256 {
+	b, ok := a.TryInt256()
+	if !ok {
+		return Int256{math.MaxInt64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingInt512 converts a to an Int512.
+// If the value of a is out of the range of Int512, the result is clamped to the range.
+func (a Uint16) SaturatingInt512() Int512 {
+	b, ok := a.TryInt512()
+	if !ok {
+		return Int512{math.MaxInt64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingInt1024 converts a to an Int1024.
+// If the value of a is out of the range of Int1024, the result is clamped to the range.
+func (a Uint16) SaturatingInt1024() Int1024 {
+	b, ok := a.TryInt1024()
+	if !ok {
+		return Int1024{math.MaxInt64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// Uint8 converts a to an Uint8.
+func (a Uint32) Uint8() Uint8 {
+	return Uint8(a)
+}
+
+// Uint16 converts a to an Uint16.
+func (a Uint32) Uint16() Uint16 {
+	return Uint16(a)
+}
+
+// Uint32 returns a itself.
+func (a Uint32) Uint32() Uint32 {
+	return a
+}
+
+// Uint64 converts a to an Uint64.
+func (a Uint32) Uint64() Uint64 {
+	return Uint64(a)
+}
+
+// Uint128 converts a to an Uint128.
+func (a Uint32) Uint128() Uint128 {
+	return Uint128{0, uint64(a)}
+}
+
+// Uint256 converts a to an Uint256.
+func (a Uint32) Uint256() Uint256 {
+	return Uint256{
+		0,
+		0,
+		0,
+		uint64(a),
+	}
+}
+
+// Uint512 converts a to an Uint512.
+func (a Uint32) Uint512() Uint512 {
+	return Uint512{
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		uint64(a),
+	}
+}
+
+// Uint1024 converts a to an Uint1024.
+func (a Uint32) Uint1024() Uint1024 {
+	return Uint1024{
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		uint64(a),
+	}
+}
+
+// Int8 converts a to an Int8.
+// a is truncated to its low 8 bits, which are interpreted as a two's complement signed integer.
+func (a Uint32) Int8() Int8 {
+	return Int8(a)
+}
+
+// Int16 converts a to an Int16.
+// a is truncated to its low 16 bits, which are interpreted as a two's complement signed integer.
+func (a Uint32) Int16() Int16 {
+	return Int16(a)
+}
+
+// Int32 converts a to an Int32, reinterpreting the bits of a as a two's complement signed integer.
+// If a >= 2**31, the result is a-2**32.
+func (a Uint32) Int32() Int32 {
+	return Int32(a)
+}
+
+// Int64 converts a to an Int64.
+// a is zero-extended, so the result always equals a.
+func (a Uint32) Int64() Int64 {
+	return Int64(a)
+}
+
+// Int128 converts a to an Int128.
+// a is zero-extended, so the result always equals a.
+func (a Uint32) Int128() Int128 {
+	return Int128{0, uint64(a)}
+}
+
+// Int256 converts a to an Int256.
+// a is zero-extended, so the result always equals a.
+func (a Uint32) Int256() Int256 {
+	return Int256{
+		0,
+		0,
+		0,
+		uint64(a),
+	}
+}
+
+// Int512 converts a to an Int512.
+// a is zero-extended, so the result always equals a.
+func (a Uint32) Int512() Int512 {
+	return Int512{
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		uint64(a),
+	}
+}
+
+// Int1024 converts a to an Int1024.
+// a is zero-extended, so the result always equals a.
+func (a Uint32) Int1024() Int1024 {
+	return Int1024{
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		uint64(a),
+	}
+}
+
+// TryInt8 converts a to an Int8.
+// It reports whether the value of a is representable as an Int8;
+// if not, the result is the same as [Uint32.Int8].
+func (a Uint32) TryInt8() (Int8, bool) {
+	b := a.Int8()
+	return b, b.Sign() >= 0 && b.Uint32() == a
+}
+
+// TryInt16 converts a to an Int16.
+// It reports whether the value of a is representable as an Int16;
+// if not, the result is the same as [Uint32.Int16].
+func (a Uint32) TryInt16() (Int16, bool) {
+	b := a.Int16()
+	return b, b.Sign() >= 0 && b.Uint32() == a
+}
+
+// TryInt32 converts a to an Int32.
+// It reports whether the value of a is representable as an Int32;
+// if not, the result is the same as [Uint32.Int32].
+func (a Uint32) TryInt32() (Int32, bool) {
+	b := a.Int32()
+	return b, b.Sign() >= 0 && b.Uint32() == a
+}
+
+// TryInt64 converts a to an Int64.
+// It reports whether the value of a is representable as an Int64;
+// if not, the result is the same as [Uint32.Int64].
+func (a Uint32) TryInt64() (Int64, bool) {
+	b := a.Int64()
+	return b, b.Sign() >= 0 && b.Uint32() == a
+}
+
+// TryInt128 converts a to an Int128.
+// It reports whether the value of a is representable as an Int128;
+// if not, the result is the same as [Uint32.Int128].
+func (a Uint32) TryInt128() (Int128, bool) {
+	b := a.Int128()
+	return b, b.Sign() >= 0 && b.Uint32() == a
+}
+
+// TryInt256 converts a to an Int256.
+// It reports whether the value of a is representable as an Int256;
+// if not, the result is the same as [Uint32.Int256].
+func (a Uint32) TryInt256() (Int256, bool) {
+	b := a.Int256()
+	return b, b.Sign() >= 0 && b.Uint32() == a
+}
+
+// TryInt512 converts a to an Int512.
+// It reports whether the value of a is representable as an Int512;
+// if not, the result is the same as [Uint32.Int512].
+func (a Uint32) TryInt512() (Int512, bool) {
+	b := a.Int512()
+	return b, b.Sign() >= 0 && b.Uint32() == a
+}
+
+// TryInt1024 converts a to an Int1024.
+// It reports whether the value of a is representable as an Int1024;
+// if not, the result is the same as [Uint32.Int1024].
+func (a Uint32) TryInt1024() (Int1024, bool) {
+	b := a.Int1024()
+	return b, b.Sign() >= 0 && b.Uint32() == a
+}
+
+// TryUint8 converts a to a Uint8.
+// It reports whether the value of a is representable as a Uint8;
+// if not, the result is the same as [Uint32.Uint8].
+func (a Uint32) TryUint8() (Uint8, bool) {
+	b := a.Uint8()
+	return b, b.Uint32() == a
+}
+
+// TryUint16 converts a to a Uint16.
+// It reports whether the value of a is representable as a Uint16;
+// if not, the result is the same as [Uint32.Uint16].
+func (a Uint32) TryUint16() (Uint16, bool) {
+	b := a.Uint16()
+	return b, b.Uint32() == a
+}
+
+// TryUint32 converts a to a Uint32.
+// It reports whether the value of a is representable as a Uint32;
+// if not, the result is the same as [Uint32.Uint32].
+func (a Uint32) TryUint32() (Uint32, bool) {
+	return a, true
+}
+
+// TryUint64 converts a to a Uint64.
+// It reports whether the value of a is representable as a Uint64;
+// if not, the result is the same as [Uint32.Uint64].
+func (a Uint32) TryUint64() (Uint64, bool) {
+	return a.Uint64(), true
+}
+
+// TryUint128 converts a to a Uint128.
+// It reports whether the value of a is representable as a Uint128;
+// if not, the result is the same as [Uint32.Uint128].
+func (a Uint32) TryUint128() (Uint128, bool) {
+	return a.Uint128(), true
+}
+
+// TryUint256 converts a to a Uint256.
+// It reports whether the value of a is representable as a Uint256;
+// if not, the result is the same as [Uint32.Uint256].
+func (a Uint32) TryUint256() (Uint256, bool) {
+	return a.Uint256(), true
+}
+
+// TryUint512 converts a to a Uint512.
+// It reports whether the value of a is representable as a Uint512;
+// if not, the result is the same as [Uint32.Uint512].
+func (a Uint32) TryUint512() (Uint512, bool) {
+	return a.Uint512(), true
+}
+
+// TryUint1024 converts a to a Uint1024.
+// It reports whether the value of a is representable as a Uint1024;
+// if not, the result is the same as [Uint32.Uint1024].
+func (a Uint32) TryUint1024() (Uint1024, bool) {
+	return a.Uint1024(), true
+}
+
+// SaturatingUint8 converts a to a Uint8.
+// If the value of a is out of the range of Uint8, the result is clamped to the range.
+func (a Uint32) SaturatingUint8() Uint8 {
+	b, ok := a.TryUint8()
+	if !ok {
+		return MaxUint8
+	}
+	return b
+}
+
+// SaturatingUint16 converts a to a Uint16.
+// If the value of a is out of the range of Uint16, the result is clamped to the range.
+func (a Uint32) SaturatingUint16() Uint16 {
+	b, ok := a.TryUint16()
+	if !ok {
+		return MaxUint16
+	}
+	return b
+}
+
+// SaturatingUint32 converts a to a Uint32.
+// If the value of a is out of the range of Uint32, the result is clamped to the range.
+func (a Uint32) SaturatingUint32() Uint32 {
+	return a.Uint32()
+}
+
+// SaturatingUint64 converts a to a Uint64.
+// If the value of a is out of the range of Uint64, the result is clamped to the range.
+func (a Uint32) SaturatingUint64() Uint64 {
+	return a.Uint64()
+}
+
+// SaturatingUint128 converts a to a Uint128.
+// If the value of a is out of the range of Uint128, the result is clamped to the range.
+func (a Uint32) SaturatingUint128() Uint128 {
+	return a.Uint128()
+}
+
+// SaturatingUint256 converts a to a Uint256.
+// If the value of a is out of the range of Uint256, the result is clamped to the range.
+func (a Uint32) SaturatingUint256() Uint256 {
+	return a.Uint256()
+}
+
+// SaturatingUint512 converts a to a Uint512.
+// If the value of a is out of the range of Uint512, the result is clamped to the range.
+func (a Uint32) SaturatingUint512() Uint512 {
+	return a.Uint512()
+}
+
+// SaturatingUint1024 converts a to a Uint1024.
+// If the value of a is out of the range of Uint1024, the result is clamped to the range.
+func (a Uint32) SaturatingUint1024() Uint1024 {
+	return a.Uint1024()
+}
+
+// SaturatingInt8 converts a to an Int8.
+// If the value of a is out of the range of Int8, the result is clamped to the range.
+func (a Uint32) SaturatingInt8() Int8 {
+	b, ok := a.TryInt8()
+	if !ok {
+		return MaxInt8
+	}
+	return b
+}
+
+// SaturatingInt16 converts a to an Int16.
+// If the value of a is out of the range of Int16, the result is clamped to the range.
+func (a Uint32) SaturatingInt16() Int16 {
+	b, ok := a.TryInt16()
+	if !ok {
+		return MaxInt16
+	}
+	return b
+}
+
+// SaturatingInt32 converts a to an Int32.
+// If the value of a is out of the range of Int32, the result is clamped to the range.
+func (a Uint32) SaturatingInt32() Int32 {
+	b, ok := a.TryInt32()
+	if !ok {
+		return MaxInt32
+	}
+	return b
+}
+
+// SaturatingInt64 converts a to an Int64.
+// If the value of a is out of the range of Int64, the result is clamped to the range.
+func (a Uint32) SaturatingInt64() Int64 {
+	b, ok := a.TryInt64()
+	if !ok {
+		return MaxInt64
+	}
+	return b
+}
+
+// SaturatingInt128 converts a to an Int128.
+// If the value of a is out of the range of Int128, the result is clamped to the range.
+func (a Uint32) SaturatingInt128() Int128 {
+	b, ok := a.TryInt128()
+	if !ok {
+		return Int128{math.MaxInt64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingInt256 converts a to an Int256.
+// If the value of a is out of the range of Int256, the result is clamped to the range.
+func (a Uint32) SaturatingInt256() Int256 {
+	b, ok := a.TryInt256()
+	if !ok {
+		return Int256{math.MaxInt64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingInt512 converts a to an Int512.
+// If the value of a is out of the range of Int512, the result is clamped to the range.
+func (a Uint32) SaturatingInt512() Int512 {
+	b, ok := a.TryInt512()
+	if !ok {
+		return Int512{math.MaxInt64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingInt1024 converts a to an Int1024.
+// If the value of a is out of the range of Int1024, the result is clamped to the range.
+func (a Uint32) SaturatingInt1024() Int1024 {
+	b, ok := a.TryInt1024()
+	if !ok {
+		return Int1024{math.MaxInt64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// Uint8 converts a to an Uint8.
+func (a Uint64) Uint8() Uint8 {
+	return Uint8(a)
+}
+
+// Uint16 converts a to an Uint16.
+func (a Uint64) Uint16() Uint16 {
+	return Uint16(a)
+}
+
+// Uint32 converts a to an Uint32.
+func (a Uint64) Uint32() Uint32 {
+	return Uint32(a)
+}
+
+// Uint64 returns a itself.
+func (a Uint64) Uint64() Uint64 {
+	return a
+}
+
+// Uint128 converts a to an Uint128.
+func (a Uint64) Uint128() Uint128 {
+	return Uint128{0, uint64(a)}
+}
+
+// Uint256 converts a to an Uint256.
+func (a Uint64) Uint256() Uint256 {
+	return Uint256{
+		0,
+		0,
+		0,
+		uint64(a),
+	}
+}
+
+// Uint512 converts a to an Uint512.
+func (a Uint64) Uint512() Uint512 {
+	return Uint512{
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		uint64(a),
+	}
+}
+
+// Uint1024 converts a to an Uint1024.
+func (a Uint64) Uint1024() Uint1024 {
+	return Uint1024{
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		uint64(a),
+	}
+}
+
+// Int8 converts a to an Int8.
+// a is truncated to its low 8 bits, which are interpreted as a two's complement signed integer.
+func (a Uint64) Int8() Int8 {
+	return Int8(a)
+}
+
+// Int16 converts a to an Int16.
+// a is truncated to its low 16 bits, which are interpreted as a two's complement signed integer.
+func (a Uint64) Int16() Int16 {
+	return Int16(a)
+}
+
+// Int32 converts a to an Int32.
+// a is truncated to its low 32 bits, which are interpreted as a two's complement signed integer.
+func (a Uint64) Int32() Int32 {
+	return Int32(a)
+}
+
+// Int64 converts a to an Int64, reinterpreting the bits of a as a two's complement signed integer.
+// If a >= 2**63, the result is a-2**64.
+func (a Uint64) Int64() Int64 {
+	return Int64(a)
+}
+
+// Int128 converts a to an Int128.
+// a is zero-extended, so the result always equals a.
+func (a Uint64) Int128() Int128 {
+	return Int128{0, uint64(a)}
+}
+
+// Int256 converts a to an Int256.
+// a is zero-extended, so the result always equals a.
+func (a Uint64) Int256() Int256 {
+	return Int256{
+		0,
+		0,
+		0,
+		uint64(a),
+	}
+}
+
+// Int512 converts a to an Int512.
+// a is zero-extended, so the result always equals a.
+func (a Uint64) Int512() Int512 {
+	return Int512{
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		uint64(a),
+	}
+}
+
+// Int1024 converts a to an Int1024.
+// a is zero-extended, so the result always equals a.
+func (a Uint64) Int1024() Int1024 {
+	return Int1024{
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		uint64(a),
+	}
+}
+
+// TryInt8 converts a to an Int8.
+// It reports whether the value of a is representable as an Int8;
+// if not, the result is the same as [Uint64.Int8].
+func (a Uint64) TryInt8() (Int8, bool) {
+	b := a.Int8()
+	return b, b.Sign() >= 0 && b.Uint64() == a
+}
+
+// TryInt16 converts a to an Int16.
+// It reports whether the value of a is representable as an Int16;
+// if not, the result is the same as [Uint64.Int16].
+func (a Uint64) TryInt16() (Int16, bool) {
+	b := a.Int16()
+	return b, b.Sign() >= 0 && b.Uint64() == a
+}
+
+// TryInt32 converts a to an Int32.
+// It reports whether the value of a is representable as an Int32;
+// if not, the result is the same as [Uint64.Int32].
+func (a Uint64) TryInt32() (Int32, bool) {
+	b := a.Int32()
+	return b, b.Sign() >= 0 && b.Uint64() == a
+}
+
+// TryInt64 converts a to an Int64.
+// It reports whether the value of a is representable as an Int64;
+// if not, the result is the same as [Uint64.Int64].
+func (a Uint64) TryInt64() (Int64, bool) {
+	b := a.Int64()
+	return b, b.Sign() >= 0 && b.Uint64() == a
+}
+
+// TryInt128 converts a to an Int128.
+// It reports whether the value of a is representable as an Int128;
+// if not, the result is the same as [Uint64.Int128].
+func (a Uint64) TryInt128() (Int128, bool) {
+	b := a.Int128()
+	return b, b.Sign() >= 0 && b.Uint64() == a
+}
+
+// TryInt256 converts a to an Int256.
+// It reports whether the value of a is representable as an Int256;
+// if not, the result is the same as [Uint64.Int256].
+func (a Uint64) TryInt256() (Int256, bool) {
+	b := a.Int256()
+	return b, b.Sign() >= 0 && b.Uint64() == a
+}
+
+// TryInt512 converts a to an Int512.
+// It reports whether the value of a is representable as an Int512;
+// if not, the result is the same as [Uint64.Int512].
+func (a Uint64) TryInt512() (Int512, bool) {
+	b := a.Int512()
+	return b, b.Sign() >= 0 && b.Uint64() == a
+}
+
+// TryInt1024 converts a to an Int1024.
+// It reports whether the value of a is representable as an Int1024;
+// if not, the result is the same as [Uint64.Int1024].
+func (a Uint64) TryInt1024() (Int1024, bool) {
+	b := a.Int1024()
+	return b, b.Sign() >= 0 && b.Uint64() == a
+}
+
+// TryUint8 converts a to a Uint8.
+// It reports whether the value of a is representable as a Uint8;
+// if not, the result is the same as [Uint64.Uint8].
+func (a Uint64) TryUint8() (Uint8, bool) {
+	b := a.Uint8()
+	return b, b.Uint64() == a
+}
+
+// TryUint16 converts a to a Uint16.
+// It reports whether the value of a is representable as a Uint16;
+// if not, the result is the same as [Uint64.Uint16].
+func (a Uint64) TryUint16() (Uint16, bool) {
+	b := a.Uint16()
+	return b, b.Uint64() == a
+}
+
+// TryUint32 converts a to a Uint32.
+// It reports whether the value of a is representable as a Uint32;
+// if not, the result is the same as [Uint64.Uint32].
+func (a Uint64) TryUint32() (Uint32, bool) {
+	b := a.Uint32()
+	return b, b.Uint64() == a
+}
+
+// TryUint64 converts a to a Uint64.
+// It reports whether the value of a is representable as a Uint64;
+// if not, the result is the same as [Uint64.Uint64].
+func (a Uint64) TryUint64() (Uint64, bool) {
+	return a, true
+}
+
+// TryUint128 converts a to a Uint128.
+// It reports whether the value of a is representable as a Uint128;
+// if not, the result is the same as [Uint64.Uint128].
+func (a Uint64) TryUint128() (Uint128, bool) {
+	return a.Uint128(), true
+}
+
+// TryUint256 converts a to a Uint256.
+// It reports whether the value of a is representable as a Uint256;
+// if not, the result is the same as [Uint64.Uint256].
+func (a Uint64) TryUint256() (Uint256, bool) {
+	return a.Uint256(), true
+}
+
+// TryUint512 converts a to a Uint512.
+// It reports whether the value of a is representable as a Uint512;
+// if not, the result is the same as [Uint64.Uint512].
+func (a Uint64) TryUint512() (Uint512, bool) {
+	return a.Uint512(), true
+}
+
+// TryUint1024 converts a to a Uint1024.
+// It reports whether the value of a is representable as a Uint1024;
+// if not, the result is the same as [Uint64.Uint1024].
+func (a Uint64) TryUint1024() (Uint1024, bool) {
+	return a.Uint1024(), true
+}
+
+// SaturatingUint8 converts a to a Uint8.
+// If the value of a is out of the range of Uint8, the result is clamped to the range.
+func (a Uint64) SaturatingUint8() Uint8 {
+	b, ok := a.TryUint8()
+	if !ok {
+		return MaxUint8
+	}
+	return b
+}
+
+// SaturatingUint16 converts a to a Uint16.
+// If the value of a is out of the range of Uint16, the result is clamped to the range.
+func (a Uint64) SaturatingUint16() Uint16 {
+	b, ok := a.TryUint16()
+	if !ok {
+		return MaxUint16
+	}
+	return b
+}
+
+// SaturatingUint32 converts a to a Uint32.
+// If the value of a is out of the range of Uint32, the result is clamped to the range.
+func (a Uint64) SaturatingUint32() Uint32 {
+	b, ok := a.TryUint32()
+	if !ok {
+		return MaxUint32
+	}
+	return b
+}
+
+// SaturatingUint64 converts a to a Uint64.
+// If the value of a is out of the range of Uint64, the result is clamped to the range.
+func (a Uint64) SaturatingUint64() Uint64 {
+	return a.Uint64()
+}
+
+// SaturatingUint128 converts a to a Uint128.
+// If the value of a is out of the range of Uint128, the result is clamped to the range.
+func (a Uint64) SaturatingUint128() Uint128 {
+	return a.Uint128()
+}
+
+// SaturatingUint256 converts a to a Uint256.
+// If the value of a is out of the range of Uint256, the result is clamped to the range.
+func (a Uint64) SaturatingUint256() Uint256 {
+	return a.Uint256()
+}
+
+// SaturatingUint512 converts a to a Uint512.
+// If the value of a is out of the range of Uint512, the result is clamped to the range.
+func (a Uint64) SaturatingUint512() Uint512 {
+	return a.Uint512()
+}
+
+// SaturatingUint1024 converts a to a Uint1024.
+// If the value of a is out of the range of Uint1024, the result is clamped to the range.
+func (a Uint64) SaturatingUint1024() Uint1024 {
+	return a.Uint1024()
+}
+
+// SaturatingInt8 converts a to an Int8.
+// If the value of a is out of the range of Int8, the result is clamped to the range.
+func (a Uint64) SaturatingInt8() Int8 {
+	b, ok := a.TryInt8()
+	if !ok {
+		return MaxInt8
+	}
+	return b
+}
+
+// SaturatingInt16 converts a to an Int16.
+// If the value of a is out of the range of Int16, the result is clamped to the range.
+func (a Uint64) SaturatingInt16() Int16 {
+	b, ok := a.TryInt16()
+	if !ok {
+		return MaxInt16
+	}
+	return b
 }
 
-// Uint8 returns a itself.
-func (a Uint8) Uint8() Uint8 {
-	return a
+// SaturatingInt32 converts a to an Int32.
+// If the value of a is out of the range of Int32, the result is clamped to the range.
+func (a Uint64) SaturatingInt32() Int32 {
+	b, ok := a.TryInt32()
+	if !ok {
+		return MaxInt32
+	}
+	return b
+}
+
+// SaturatingInt64 converts a to an Int64.
+// If the value of a is out of the range of Int64, the result is clamped to the range.
+func (a Uint64) SaturatingInt64() Int64 {
+	b, ok := a.TryInt64()
+	if !ok {
+		return MaxInt64
+	}
+	return b
+}
+
+// SaturatingInt128 converts a to an Int128.
+// If the value of a is out of the range of Int128, the result is clamped to the range.
+func (a Uint64) SaturatingInt128() Int128 {
+	b, ok := a.TryInt128()
+	if !ok {
+		return Int128{math.MaxInt64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingInt256 converts a to an Int256.
+// If the value of a is out of the range of Int256, the result is clamped to the range.
+func (a Uint64) SaturatingInt256() Int256 {
+	b, ok := a.TryInt256()
+	if !ok {
+		return Int256{math.MaxInt64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingInt512 converts a to an Int512.
+// If the value of a is out of the range of Int512, the result is clamped to the range.
+func (a Uint64) SaturatingInt512() Int512 {
+	b, ok := a.TryInt512()
+	if !ok {
+		return Int512{math.MaxInt64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingInt1024 converts a to an Int1024.
+// If the value of a is out of the range of Int1024, the result is clamped to the range.
+func (a Uint64) SaturatingInt1024() Int1024 {
+	b, ok := a.TryInt1024()
+	if !ok {
+		return Int1024{math.MaxInt64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// Uint8 converts a to an Uint8.
+func (a Uint128) Uint8() Uint8 {
+	return Uint8(a[1])
 }
 
 // Uint16 converts a to an Uint16.
-func (a Uint8) Uint16() Uint16 {
-	return Uint16(a)
+func (a Uint128) Uint16() Uint16 {
+	return Uint16(a[1])
 }
 
 // Uint32 converts a to an Uint32.
-func (a Uint8) Uint32() Uint32 {
-	return Uint32(a)
+func (a Uint128) Uint32() Uint32 {
+	return Uint32(a[1])
 }
 
 // Uint64 converts a to an Uint64.
-func (a Uint8) Uint64() Uint64 {
-	return Uint64(a)
+func (a Uint128) Uint64() Uint64 {
+	return Uint64(a[1])
 }
 
-// Uint128 converts a to an Uint128.
-func (a Uint8) Uint128() Uint128 {
-	return Uint128{0, uint64(a)}
+// Uint128 returns a itself.
+func (a Uint128) Uint128() Uint128 {
+	return a
 }
 
 // Uint256 converts a to an Uint256.
-func (a Uint8) Uint256() Uint256 {
+func (a Uint128) Uint256() Uint256 {
 	return Uint256{
 		0,
 		0,
-		0,
-		uint64(a),
+		a[0],
+		a[1],
 	}
 }
 
 // Uint512 converts a to an Uint512.
-func (a Uint8) Uint512() Uint512 {
+func (a Uint128) Uint512() Uint512 {
 	return Uint512{
 		0,
 		0,
@@ -608,13 +5260,13 @@ func (a Uint8) Uint512() Uint512 {
 		0,
 		0,
 		0,
-		0,
-		uint64(a),
+		a[0],
+		a[1],
 	}
 }
 
 // Uint1024 converts a to an Uint1024.
-func (a Uint8) Uint1024() Uint1024 {
+func (a Uint128) Uint1024() Uint1024 {
 	return Uint1024{
 		0,
 		0,
@@ -630,49 +5282,71 @@ func (a Uint8) Uint1024() Uint1024 {
 		0,
 		0,
 		0,
-		0,
-		uint64(a),
+		a[0],
+		a[1],
 	}
 }
 
-// Uint8 converts a to an Uint8.
-func (a Uint16) Uint8() Uint8 {
-	return Uint8(a)
+// Int8 converts a to an Int8.
+// a is truncated to its low 8 bits, which are interpreted as a two's complement signed integer.
+func (a Uint128) Int8() Int8 {
+	return Int8(a[1])
 }
 
-// Uint16 returns a itself.
-func (a Uint16) Uint16() Uint16 {
-	return a
+// Int16 converts a to an Int16.
+// a is truncated to its low 16 bits, which are interpreted as a two's complement signed integer.
+func (a Uint128) Int16() Int16 {
+	return Int16(a[1])
 }
 
-// Uint32 converts a to an Uint32.
-func (a Uint16) Uint32() Uint32 {
-	return Uint32(a)
+// Int32 converts a to an Int32.
+// a is truncated to its low 32 bits, which are interpreted as a two's complement signed integer.
+func (a Uint128) Int32() Int32 {
+	return Int32(a[1])
 }
 
-// Uint64 converts a to an Uint64.
-func (a Uint16) Uint64() Uint64 {
-	return Uint64(a)
+// Int64 converts a to an Int64.
+// a is truncated to its low 64 bits, which are interpreted as a two's complement signed integer.
+func (a Uint128) Int64() Int64 {
+	return Int64(a[1])
 }
 
-// Uint128 converts a to an Uint128.
-func (a Uint16) Uint128() Uint128 {
-	return Uint128{0, uint64(a)}
+// Int128 converts a to an Int128, reinterpreting the bits of a as a two's complement signed integer.
+// If a >= 2**127, the result is a-2**128.
+func (a Uint128) Int128() Int128 {
+	return Int128(a)
 }
 
-// Uint256 converts a to an Uint256.
-func (a Uint16) Uint256() Uint256 {
-	return Uint256{
+// Int256 converts a to an Int256.
+// a is zero-extended, so the result always equals a.
+func (a Uint128) Int256() Int256 {
+	return Int256{
+		0,
+		0,
+		a[0],
+		a[1],
+	}
+}
+
+// Int512 converts a to an Int512.
+// a is zero-extended, so the result always equals a.
+func (a Uint128) Int512() Int512 {
+	return Int512{
 		0,
 		0,
 		0,
-		uint64(a),
+		0,
+		0,
+		0,
+		a[0],
+		a[1],
 	}
 }
 
-// Uint512 converts a to an Uint512.
-func (a Uint16) Uint512() Uint512 {
-	return Uint512{
+// Int1024 converts a to an Int1024.
+// a is zero-extended, so the result always equals a.
+func (a Uint128) Int1024() Int1024 {
+	return Int1024{
 		0,
 		0,
 		0,
@@ -680,16 +5354,336 @@ func (a Uint16) Uint512() Uint512 {
 		0,
 		0,
 		0,
-		uint64(a),
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		a[0],
+		a[1],
 	}
 }
 
-// Uint1024 converts a to an Uint1024.
-func (a Uint16) Uint1024() Uint1024 {
-	return Uint1024{
+// TryInt8 converts a to an Int8.
+// It reports whether the value of a is representable as an Int8;
+// if not, the result is the same as [Uint128.Int8].
+func (a Uint128) TryInt8() (Int8, bool) {
+	b := a.Int8()
+	return b, b.Sign() >= 0 && b.Uint128() == a
+}
+
+// TryInt16 converts a to an Int16.
+// It reports whether the value of a is representable as an Int16;
+// if not, the result is the same as [Uint128.Int16].
+func (a Uint128) TryInt16() (Int16, bool) {
+	b := a.Int16()
+	return b, b.Sign() >= 0 && b.Uint128() == a
+}
+
+// TryInt32 converts a to an Int32.
+// It reports whether the value of a is representable as an Int32;
+// if not, the result is the same as [Uint128.Int32].
+func (a Uint128) TryInt32() (Int32, bool) {
+	b := a.Int32()
+	return b, b.Sign() >= 0 && b.Uint128() == a
+}
+
+// TryInt64 converts a to an Int64.
+// It reports whether the value of a is representable as an Int64;
+// if not, the result is the same as [Uint128.Int64].
+func (a Uint128) TryInt64() (Int64, bool) {
+	b := a.Int64()
+	return b, b.Sign() >= 0 && b.Uint128() == a
+}
+
+// TryInt128 converts a to an Int128.
+// It reports whether the value of a is representable as an Int128;
+// if not, the result is the same as [Uint128.Int128].
+func (a Uint128) TryInt128() (Int128, bool) {
+	b := a.Int128()
+	return b, b.Sign() >= 0 && b.Uint128() == a
+}
+
+// TryInt256 converts a to an Int256.
+// It reports whether the value of a is representable as an Int256;
+// if not, the result is the same as [Uint128.Int256].
+func (a Uint128) TryInt256() (Int256, bool) {
+	b := a.Int256()
+	return b, b.Sign() >= 0 && b.Uint128() == a
+}
+
+// TryInt512 converts a to an Int512.
+// It reports whether the value of a is representable as an Int512;
+// if not, the result is the same as [Uint128.Int512].
+func (a Uint128) TryInt512() (Int512, bool) {
+	b := a.Int512()
+	return b, b.Sign() >= 0 && b.Uint128() == a
+}
+
+// TryInt1024 converts a to an Int1024.
+// It reports whether the value of a is representable as an Int1024;
+// if not, the result is the same as [Uint128.Int1024].
+func (a Uint128) TryInt1024() (Int1024, bool) {
+	b := a.Int1024()
+	return b, b.Sign() >= 0 && b.Uint128() == a
+}
+
+// TryUint8 converts a to a Uint8.
+// It reports whether the value of a is representable as a Uint8;
+// if not, the result is the same as [Uint128.Uint8].
+func (a Uint128) TryUint8() (Uint8, bool) {
+	b := a.Uint8()
+	return b, b.Uint128() == a
+}
+
+// TryUint16 converts a to a Uint16.
+// It reports whether the value of a is representable as a Uint16;
+// if not, the result is the same as [Uint128.Uint16].
+func (a Uint128) TryUint16() (Uint16, bool) {
+	b := a.Uint16()
+	return b, b.Uint128() == a
+}
+
+// TryUint32 converts a to a Uint32.
+// It reports whether the value of a is representable as a Uint32;
+// if not, the result is the same as [Uint128.Uint32].
+func (a Uint128) TryUint32() (Uint32, bool) {
+	b := a.Uint32()
+	return b, b.Uint128() == a
+}
+
+// TryUint64 converts a to a Uint64.
+// It reports whether the value of a is representable as a Uint64;
+// if not, the result is the same as [Uint128.Uint64].
+func (a Uint128) TryUint64() (Uint64, bool) {
+	b := a.Uint64()
+	return b, b.Uint128() == a
+}
+
+// TryUint128 converts a to a Uint128.
+// It reports whether the value of a is representable as a Uint128;
+// if not, the result is the same as [Uint128.Uint128].
+func (a Uint128) TryUint128() (Uint128, bool) {
+	return a, true
+}
+
+// TryUint256 converts a to a Uint256.
+// It reports whether the value of a is representable as a Uint256;
+// if not, the result is the same as [Uint128.Uint256].
+func (a Uint128) TryUint256() (Uint256, bool) {
+	return a.Uint256(), true
+}
+
+// TryUint512 converts a to a Uint512.
+// It reports whether the value of a is representable as a Uint512;
+// if not, the result is the same as [Uint128.Uint512].
+func (a Uint128) TryUint512() (Uint512, bool) {
+	return a.Uint512(), true
+}
+
+// TryUint1024 converts a to a Uint1024.
+// It reports whether the value of a is representable as a Uint1024;
+// if not, the result is the same as [Uint128.Uint1024].
+func (a Uint128) TryUint1024() (Uint1024, bool) {
+	return a.Uint1024(), true
+}
+
+// SaturatingUint8 converts a to a Uint8.
+// If the value of a is out of the range of Uint8, the result is clamped to the range.
+func (a Uint128) SaturatingUint8() Uint8 {
+	b, ok := a.TryUint8()
+	if !ok {
+		return MaxUint8
+	}
+	return b
+}
+
+// SaturatingUint16 converts a to a Uint16.
+// If the value of a is out of the range of Uint16, the result is clamped to the range.
+func (a Uint128) SaturatingUint16() Uint16 {
+	b, ok := a.TryUint16()
+	if !ok {
+		return MaxUint16
+	}
+	return b
+}
+
+// SaturatingUint32 converts a to a Uint32.
+// If the value of a is out of the range of Uint32, the result is clamped to the range.
+func (a Uint128) SaturatingUint32() Uint32 {
+	b, ok := a.TryUint32()
+	if !ok {
+		return MaxUint32
+	}
+	return b
+}
+
+// SaturatingUint64 converts a to a Uint64.
+// If the value of a is out of the range of Uint64, the result is clamped to the range.
+func (a Uint128) SaturatingUint64() Uint64 {
+	b, ok := a.TryUint64()
+	if !ok {
+		return MaxUint64
+	}
+	return b
+}
+
+// SaturatingUint128 converts a to a Uint128.
+// If the value of a is out of the range of Uint128, the result is clamped to the range.
+func (a Uint128) SaturatingUint128() Uint128 {
+	return a.Uint128()
+}
+
+// SaturatingUint256 converts a to a Uint256.
+// If the value of a is out of the range of Uint256, the result is clamped to the range.
+func (a Uint128) SaturatingUint256() Uint256 {
+	return a.Uint256()
+}
+
+// SaturatingUint512 converts a to a Uint512.
+// If the value of a is out of the range of Uint512, the result is clamped to the range.
+func (a Uint128) SaturatingUint512() Uint512 {
+	return a.Uint512()
+}
+
+// SaturatingUint1024 converts a to a Uint1024.
+// If the value of a is out of the range of Uint1024, the result is clamped to the range.
+func (a Uint128) SaturatingUint1024() Uint1024 {
+	return a.Uint1024()
+}
+
+// SaturatingInt8 converts a to an Int8.
+// If the value of a is out of the range of Int8, the result is clamped to the range.
+func (a Uint128) SaturatingInt8() Int8 {
+	b, ok := a.TryInt8()
+	if !ok {
+		return MaxInt8
+	}
+	return b
+}
+
+// SaturatingInt16 converts a to an Int16.
+// If the value of a is out of the range of Int16, the result is clamped to the range.
+func (a Uint128) SaturatingInt16() Int16 {
+	b, ok := a.TryInt16()
+	if !ok {
+		return MaxInt16
+	}
+	return b
+}
+
+// SaturatingInt32 converts a to an Int32.
+// If the value of a is out of the range of Int32, the result is clamped to the range.
+func (a Uint128) SaturatingInt32() Int32 {
+	b, ok := a.TryInt32()
+	if !ok {
+		return MaxInt32
+	}
+	return b
+}
+
+// SaturatingInt64 converts a to an Int64.
+// If the value of a is out of the range of Int64, the result is clamped to the range.
+func (a Uint128) SaturatingInt64() Int64 {
+	b, ok := a.TryInt64()
+	if !ok {
+		return MaxInt64
+	}
+	return b
+}
+
+// SaturatingInt128 converts a to an Int128.
+// If the value of a is out of the range of Int128, the result is clamped to the range.
+func (a Uint128) SaturatingInt128() Int128 {
+	b, ok := a.TryInt128()
+	if !ok {
+		return Int128{math.MaxInt64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingInt256 converts a to an Int256.
+// If the value of a is out of the range of Int256, the result is clamped to the range.
+func (a Uint128) SaturatingInt256() Int256 {
+	b, ok := a.TryInt256()
+	if !ok {
+		return Int256{math.MaxInt64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingInt512 converts a to an Int512.
+// If the value of a is out of the range of Int512, the result is clamped to the range.
+func (a Uint128) SaturatingInt512() Int512 {
+	b, ok := a.TryInt512()
+	if !ok {
+		return Int512{math.MaxInt64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingInt1024 converts a to an Int1024.
+// If the value of a is out of the range of Int1024, the result is clamped to the range.
+func (a Uint128) SaturatingInt1024() Int1024 {
+	b, ok := a.TryInt1024()
+	if !ok {
+		return Int1024{math.MaxInt64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// Uint8 converts a to an Uint8.
+func (a Uint256) Uint8() Uint8 {
+	return Uint8(a[3])
+}
+
+// Uint16 converts a to an Uint16.
+func (a Uint256) Uint16() Uint16 {
+	return Uint16(a[3])
+}
+
+// Uint32 converts a to an Uint32.
+func (a Uint256) Uint32() Uint32 {
+	return Uint32(a[3])
+}
+
+// Uint64 converts a to an Uint64.
+func (a Uint256) Uint64() Uint64 {
+	return Uint64(a[3])
+}
+
+// Uint128 converts a to an Uint128.
+func (a Uint256) Uint128() Uint128 {
+	return Uint128{
+		a[2],
+		a[3],
+	}
+}
+
+// Uint256 returns a itself.
+func (a Uint256) Uint256() Uint256 {
+	return a
+}
+
+// Uint512 converts a to an Uint512.
+func (a Uint256) Uint512() Uint512 {
+	return Uint512{
+		0,
 		0,
 		0,
 		0,
+		a[0],
+		a[1],
+		a[2],
+		a[3],
+	}
+}
+
+// Uint1024 converts a to an Uint1024.
+func (a Uint256) Uint1024() Uint1024 {
+	return Uint1024{
 		0,
 		0,
 		0,
@@ -702,65 +5696,71 @@ func (a Uint16) Uint1024() Uint1024 {
 		0,
 		0,
 		0,
-		uint64(a),
+		a[0],
+		a[1],
+		a[2],
+		a[3],
 	}
 }
 
-// Uint8 converts a to an Uint8.
-func (a Uint32) Uint8() Uint8 {
-	return Uint8(a)
+// Int8 converts a to an Int8.
+// a is truncated to its low 8 bits, which are interpreted as a two's complement signed integer.
+func (a Uint256) Int8() Int8 {
+	return Int8(a[3])
 }
 
-// Uint16 converts a to an Uint16.
-func (a Uint32) Uint16() Uint16 {
-	return Uint16(a)
+// Int16 converts a to an Int16.
+// a is truncated to its low 16 bits, which are interpreted as a two's complement signed integer.
+func (a Uint256) Int16() Int16 {
+	return Int16(a[3])
 }
 
-// Uint32 returns a itself.
-func (a Uint32) Uint32() Uint32 {
-	return a
+// Int32 converts a to an Int32.
+// a is truncated to its low 32 bits, which are interpreted as a two's complement signed integer.
+func (a Uint256) Int32() Int32 {
+	return Int32(a[3])
 }
 
-// Uint64 converts a to an Uint64.
-func (a Uint32) Uint64() Uint64 {
-	return Uint64(a)
+// Int64 converts a to an Int64.
+// a is truncated to its low 64 bits, which are interpreted as a two's complement signed integer.
+func (a Uint256) Int64() Int64 {
+	return Int64(a[3])
 }
 
-// Uint128 converts a to an Uint128.
-func (a Uint32) Uint128() Uint128 {
-	return Uint128{0, uint64(a)}
+// Int128 converts a to an Int128.
+// a is truncated to its low 128 bits, which are interpreted as a two's complement signed integer.
+func (a Uint256) Int128() Int128 {
+	return Int128{
+		a[2],
+		a[3],
+	}
 }
 
-// Uint256 converts a to an Uint256.
-func (a Uint32) Uint256() Uint256 {
-	return Uint256{
-		0,
-		0,
-		0,
-		uint64(a),
-	}
+// Int256 converts a to an Int256, reinterpreting the bits of a as a two's complement signed integer.
+// If a >= 2**255, the result is a-2**256.
+func (a Uint256) Int256() Int256 {
+	return Int256(a)
 }
 
-// Uint512 converts a to an Uint512.
-func (a Uint32) Uint512() Uint512 {
-	return Uint512{
-		0,
-		0,
-		0,
+// Int512 converts a to an Int512.
+// a is zero-extended, so the result always equals a.
+func (a Uint256) Int512() Int512 {
+	return Int512{
 		0,
 		0,
 		0,
 		0,
-		uint64(a),
+		a[0],
+		a[1],
+		a[2],
+		a[3],
 	}
 }
 
-// Uint1024 converts a to an Uint1024.
-func (a Uint32) Uint1024() Uint1024 {
-	return Uint1024{
-		0,
-		0,
-		0,
+// Int1024 converts a to an Int1024.
+// a is zero-extended, so the result always equals a.
+func (a Uint256) Int1024() Int1024 {
+	return Int1024{
 		0,
 		0,
 		0,
@@ -773,201 +5773,331 @@ func (a Uint32) Uint1024() Uint1024 {
 		0,
 		0,
 		0,
-		uint64(a),
+		a[0],
+		a[1],
+		a[2],
+		a[3],
 	}
 }
 
-// Uint8 converts a to an Uint8.
-func (a Uint64) Uint8() Uint8 {
-	return Uint8(a)
+// TryInt8 converts a to an Int8.
+// It reports whether the value of a is representable as an Int8;
+// if not, the result is the same as [Uint256.Int8].
+func (a Uint256) TryInt8() (Int8, bool) {
+	b := a.Int8()
+	return b, b.Sign() >= 0 && b.Uint256() == a
 }
 
-// Uint16 converts a to an Uint16.
-func (a Uint64) Uint16() Uint16 {
-	return Uint16(a)
+// TryInt16 converts a to an Int16.
+// It reports whether the value of a is representable as an Int16;
+// if not, the result is the same as [Uint256.Int16].
+func (a Uint256) TryInt16() (Int16, bool) {
+	b := a.Int16()
+	return b, b.Sign() >= 0 && b.Uint256() == a
 }
 
-// Uint32 converts a to an Uint32.
-func (a Uint64) Uint32() Uint32 {
-	return Uint32(a)
+// TryInt32 converts a to an Int32.
+// It reports whether the value of a is representable as an Int32;
+// if not, the result is the same as [Uint256.Int32].
+func (a Uint256) TryInt32() (Int32, bool) {
+	b := a.Int32()
+	return b, b.Sign() >= 0 && b.Uint256() == a
 }
 
-// Uint64 returns a itself.
-func (a Uint64) Uint64() Uint64 {
-	return a
+// TryInt64 converts a to an Int64.
+// It reports whether the value of a is representable as an Int64;
+// if not, the result is the same as [Uint256.Int64].
+func (a Uint256) TryInt64() (Int64, bool) {
+	b := a.Int64()
+	return b, b.Sign() >= 0 && b.Uint256() == a
 }
 
-// Uint128 converts a to an Uint128.
-func (a Uint64) Uint128() Uint128 {
-	return Uint128{0, uint64(a)}
+// TryInt128 converts a to an Int128.
+// It reports whether the value of a is representable as an Int128;
+// if not, the result is the same as [Uint256.Int128].
+func (a Uint256) TryInt128() (Int128, bool) {
+	b := a.Int128()
+	return b, b.Sign() >= 0 && b.Uint256() == a
 }
 
-// Uint256 converts a to an Uint256.
-func (a Uint64) Uint256() Uint256 {
-	return Uint256{
-		0,
-		0,
-		0,
-		uint64(a),
+// TryInt256 converts a to an Int256.
+// It reports whether the value of a is representable as an Int256;
+// if not, the result is the same as [Uint256.Int256].
+func (a Uint256) TryInt256() (Int256, bool) {
+	b := a.Int256()
+	return b, b.Sign() >= 0 && b.Uint256() == a
+}
+
+// TryInt512 converts a to an Int512.
+// It reports whether the value of a is representable as an Int512;
+// if not, the result is the same as [Uint256.Int512].
+func (a Uint256) TryInt512() (Int512, bool) {
+	b := a.Int512()
+	return b, b.Sign() >= 0 && b.Uint256() == a
+}
+
+// TryInt1024 converts a to an Int1024.
+// It reports whether the value of a is representable as an Int1024;
+// if not, the result is the same as [Uint256.Int1024].
+func (a Uint256) TryInt1024() (Int1024, bool) {
+	b := a.Int1024()
+	return b, b.Sign() >= 0 && b.Uint256() == a
+}
+
+// TryUint8 converts a to a Uint8.
+// It reports whether the value of a is representable as a Uint8;
+// if not, the result is the same as [Uint256.Uint8].
+func (a Uint256) TryUint8() (Uint8, bool) {
+	b := a.Uint8()
+	return b, b.Uint256() == a
+}
+
+// TryUint16 converts a to a Uint16.
+// It reports whether the value of a is representable as a Uint16;
+// if not, the result is the same as [Uint256.Uint16].
+func (a Uint256) TryUint16() (Uint16, bool) {
+	b := a.Uint16()
+	return b, b.Uint256() == a
+}
+
+// TryUint32 converts a to a Uint32.
+// It reports whether the value of a is representable as a Uint32;
+// if not, the result is the same as [Uint256.Uint32].
+func (a Uint256) TryUint32() (Uint32, bool) {
+	b := a.Uint32()
+	return b, b.Uint256() == a
+}
+
+// TryUint64 converts a to a Uint64.
+// It reports whether the value of a is representable as a Uint64;
+// if not, the result is the same as [Uint256.Uint64].
+func (a Uint256) TryUint64() (Uint64, bool) {
+	b := a.Uint64()
+	return b, b.Uint256() == a
+}
+
+// TryUint128 converts a to a Uint128.
+// It reports whether the value of a is representable as a Uint128;
+// if not, the result is the same as [Uint256.Uint128].
+func (a Uint256) TryUint128() (Uint128, bool) {
+	b := a.Uint128()
+	return b, b.Uint256() == a
+}
+
+// TryUint256 converts a to a Uint256.
+// It reports whether the value of a is representable as a Uint256;
+// if not, the result is the same as [Uint256.Uint256].
+func (a Uint256) TryUint256() (Uint256, bool) {
+	return a, true
+}
+
+// TryUint512 converts a to a Uint512.
+// It reports whether the value of a is representable as a Uint512;
+// if not, the result is the same as [Uint256.Uint512].
+func (a Uint256) TryUint512() (Uint512, bool) {
+	return a.Uint512(), true
+}
+
+// TryUint1024 converts a to a Uint1024.
+// It reports whether the value of a is representable as a Uint1024;
+// if not, the result is the same as [Uint256.Uint1024].
+func (a Uint256) TryUint1024() (Uint1024, bool) {
+	return a.Uint1024(), true
+}
+
+// SaturatingUint8 converts a to a Uint8.
+// If the value of a is out of the range of Uint8, the result is clamped to the range.
+func (a Uint256) SaturatingUint8() Uint8 {
+	b, ok := a.TryUint8()
+	if !ok {
+		return MaxUint8
 	}
+	return b
 }
 
-// Uint512 converts a to an Uint512.
-func (a Uint64) Uint512() Uint512 {
-	return Uint512{
-		0,
-		0,
-		0,
-		0,
-		0,
-		0,
-		0,
-		uint64(a),
+// SaturatingUint16 converts a to a Uint16.
+// If the value of a is out of the range of Uint16, the result is clamped to the range.
+func (a Uint256) SaturatingUint16() Uint16 {
+	b, ok := a.TryUint16()
+	if !ok {
+		return MaxUint16
 	}
+	return b
 }
 
-// Uint1024 converts a to an Uint1024.
-func (a Uint64) Uint1024() Uint1024 {
-	return Uint1024{
-		0,
-		0,
-		0,
-		0,
-		0,
-		0,
-		0,
-		0,
-		0,
-		0,
-		0,
-		0,
-		0,
-		0,
-		0,
-		uint64(a),
+// SaturatingUint32 converts a to a Uint32.
+// If the value of a is out of the range of Uint32, the result is clamped to the range.
+func (a Uint256) SaturatingUint32() Uint32 {
+	b, ok := a.TryUint32()
+	if !ok {
+		return MaxUint32
 	}
+	return b
 }
 
-// Uint8 converts a to an Uint8.
-func (a Uint128) Uint8() Uint8 {
-	return Uint8(a[1])
+// SaturatingUint64 converts a to a Uint64.
+// If the value of a is out of the range of Uint64, the result is clamped to the range.
+func (a Uint256) SaturatingUint64() Uint64 {
+	b, ok := a.TryUint64()
+	if !ok {
+		return MaxUint64
+	}
+	return b
 }
 
-// Uint16 converts a to an Uint16.
-func (a Uint128) Uint16() Uint16 {
-	return Uint16(a[1])
+// SaturatingUint128 converts a to a Uint128.
+// If the value of a is out of the range of Uint128, the result is clamped to the range.
+func (a Uint256) SaturatingUint128() Uint128 {
+	b, ok := a.TryUint128()
+	if !ok {
+		return Uint128{math.MaxUint64, math.MaxUint64}
+	}
+	return b
 }
 
-// Uint32 converts a to an Uint32.
-func (a Uint128) Uint32() Uint32 {
-	return Uint32(a[1])
+// SaturatingUint256 converts a to a Uint256.
+// If the value of a is out of the range of Uint256, the result is clamped to the range.
+func (a Uint256) SaturatingUint256() Uint256 {
+	return a.Uint256()
 }
 
-// Uint64 converts a to an Uint64.
-func (a Uint128) Uint64() Uint64 {
-	return Uint64(a[1])
+// SaturatingUint512 converts a to a Uint512.
+// If the value of a is out of the range of Uint512, the result is clamped to the range.
+func (a Uint256) SaturatingUint512() Uint512 {
+	return a.Uint512()
 }
 
-// Uint128 returns a itself.
-func (a Uint128) Uint128() Uint128 {
-	return a
+// SaturatingUint1024 converts a to a Uint1024.
+// If the value of a is out of the range of Uint1024, the result is clamped to the range.
+func (a Uint256) SaturatingUint1024() Uint1024 {
+	return a.Uint1024()
 }
 
-// Uint256 converts a to an Uint256.
-func (a Uint128) Uint256() Uint256 {
-	return Uint256{
-		0,
-		0,
-		a[0],
-		a[1],
+// SaturatingInt8 converts a to an Int8.
+// If the value of a is out of the range of Int8, the result is clamped to the range.
+func (a Uint256) SaturatingInt8() Int8 {
+	b, ok := a.TryInt8()
+	if !ok {
+		return MaxInt8
 	}
+	return b
 }
 
-// Uint512 converts a to an Uint512.
-func (a Uint128) Uint512() Uint512 {
-	return Uint512{
-		0,
-		0,
-		0,
-		0,
-		0,
-		0,
-		a[0],
-		a[1],
+// SaturatingInt16 converts a to an Int16.
+// If the value of a is out of the range of Int16, the result is clamped to the range.
+func (a Uint256) SaturatingInt16() Int16 {
+	b, ok := a.TryInt16()
+	if !ok {
+		return MaxInt16
 	}
+	return b
 }
 
-// Uint1024 converts a to an Uint1024.
-func (a Uint128) Uint1024() Uint1024 {
-	return Uint1024{
-		0,
-		0,
-		0,
-		0,
-		0,
-		0,
-		0,
-		0,
-		0,
-		0,
-		0,
-		0,
-		0,
-		0,
-		a[0],
-		a[1],
+// SaturatingInt32 converts a to an Int32.
+// If the value of a is out of the range of Int32, the result is clamped to the range.
+func (a Uint256) SaturatingInt32() Int32 {
+	b, ok := a.TryInt32()
+	if !ok {
+		return MaxInt32
+	}
+	return b
+}
+
+// SaturatingInt64 converts a to an Int64.
+// If the value of a is out of the range of Int64, the result is clamped to the range.
+func (a Uint256) SaturatingInt64() Int64 {
+	b, ok := a.TryInt64()
+	if !ok {
+		return MaxInt64
+	}
+	return b
+}
+
+// SaturatingInt128 converts a to an Int128.
+// If the value of a is out of the range of Int128, the result is clamped to the range.
+func (a Uint256) SaturatingInt128() Int128 {
+	b, ok := a.TryInt128()
+	if !ok {
+		return Int128{math.MaxInt64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingInt256 converts a to an Int256.
+// If the value of a is out of the range of Int256, the result is clamped to the range.
+func (a Uint256) SaturatingInt256() Int256 {
+	b, ok := a.TryInt256()
+	if !ok {
+		return Int256{math.MaxInt64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingInt512 converts a to an Int512.
+// If the value of a is out of the range of Int512, the result is clamped to the range.
+func (a Uint256) SaturatingInt512() Int512 {
+	b, ok := a.TryInt512()
+	if !ok {
+		return Int512{math.MaxInt64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingInt1024 converts a to an Int1024.
+// If the value of a is out of the range of Int1024, the result is clamped to the range.
+func (a Uint256) SaturatingInt1024() Int1024 {
+	b, ok := a.TryInt1024()
+	if !ok {
+		return Int1024{math.MaxInt64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
 	}
+	return b
 }
 
 // Uint8 converts a to an Uint8.
-func (a Uint256) Uint8() Uint8 {
-	return Uint8(a[3])
+func (a Uint512) Uint8() Uint8 {
+	return Uint8(a[7])
 }
 
 // Uint16 converts a to an Uint16.
-func (a Uint256) Uint16() Uint16 {
-	return Uint16(a[3])
+func (a Uint512) Uint16() Uint16 {
+	return Uint16(a[7])
 }
 
 // Uint32 converts a to an Uint32.
-func (a Uint256) Uint32() Uint32 {
-	return Uint32(a[3])
+func (a Uint512) Uint32() Uint32 {
+	return Uint32(a[7])
 }
 
 // Uint64 converts a to an Uint64.
-func (a Uint256) Uint64() Uint64 {
-	return Uint64(a[3])
+func (a Uint512) Uint64() Uint64 {
+	return Uint64(a[7])
 }
 
 // Uint128 converts a to an Uint128.
-func (a Uint256) Uint128() Uint128 {
+func (a Uint512) Uint128() Uint128 {
 	return Uint128{
-		a[2],
-		a[3],
+		a[6],
+		a[7],
 	}
 }
 
-// Uint256 returns a itself.
-func (a Uint256) Uint256() Uint256 {
-	return a
-}
-
-// Uint512 converts a to an Uint512.
-func (a Uint256) Uint512() Uint512 {
-	return Uint512{
-		0,
-		0,
-		0,
-		0,
-		a[0],
-		a[1],
-		a[2],
-		a[3],
+// Uint256 converts a to an Uint256.
+func (a Uint512) Uint256() Uint256 {
+	return Uint256{
+		a[4],
+		a[5],
+		a[6],
+		a[7],
 	}
 }
 
+// Uint512 returns a itself.
+func (a Uint512) Uint512() Uint512 {
+	return a
+}
+
 // Uint1024 converts a to an Uint1024.
-func (a Uint256) Uint1024() Uint1024 {
+func (a Uint512) Uint1024() Uint1024 {
 	return Uint1024{
 		0,
 		0,
@@ -977,48 +6107,54 @@ func (a Uint256) Uint1024() Uint1024 {
 		0,
 		0,
 		0,
-		0,
-		0,
-		0,
-		0,
 		a[0],
 		a[1],
 		a[2],
 		a[3],
+		a[4],
+		a[5],
+		a[6],
+		a[7],
 	}
 }
 
-// Uint8 converts a to an Uint8.
-func (a Uint512) Uint8() Uint8 {
-	return Uint8(a[7])
+// Int8 converts a to an Int8.
+// a is truncated to its low 8 bits, which are interpreted as a two's complement signed integer.
+func (a Uint512) Int8() Int8 {
+	return Int8(a[7])
 }
 
-// Uint16 converts a to an Uint16.
-func (a Uint512) Uint16() Uint16 {
-	return Uint16(a[7])
+// Int16 converts a to an Int16.
+// a is truncated to its low 16 bits, which are interpreted as a two's complement signed integer.
+func (a Uint512) Int16() Int16 {
+	return Int16(a[7])
 }
 
-// Uint32 converts a to an Uint32.
-func (a Uint512) Uint32() Uint32 {
-	return Uint32(a[7])
+// Int32 converts a to an Int32.
+// a is truncated to its low 32 bits, which are interpreted as a two's complement signed integer.
+func (a Uint512) Int32() Int32 {
+	return Int32(a[7])
 }
 
-// Uint64 converts a to an Uint64.
-func (a Uint512) Uint64() Uint64 {
-	return Uint64(a[7])
+// Int64 converts a to an Int64.
+// a is truncated to its low 64 bits, which are interpreted as a two's complement signed integer.
+func (a Uint512) Int64() Int64 {
+	return Int64(a[7])
 }
 
-// Uint128 converts a to an Uint128.
-func (a Uint512) Uint128() Uint128 {
-	return Uint128{
+// Int128 converts a to an Int128.
+// a is truncated to its low 128 bits, which are interpreted as a two's complement signed integer.
+func (a Uint512) Int128() Int128 {
+	return Int128{
 		a[6],
 		a[7],
 	}
 }
 
-// Uint256 converts a to an Uint256.
-func (a Uint512) Uint256() Uint256 {
-	return Uint256{
+// Int256 converts a to an Int256.
+// a is truncated to its low 256 bits, which are interpreted as a two's complement signed integer.
+func (a Uint512) Int256() Int256 {
+	return Int256{
 		a[4],
 		a[5],
 		a[6],
@@ -1026,14 +6162,16 @@ func (a Uint512) Uint256() Uint256 {
 	}
 }
 
-// Uint512 returns a itself.
-func (a Uint512) Uint512() Uint512 {
-	return a
+// Int512 converts a to an Int512, reinterpreting the bits of a as a two's complement signed integer.
+// If a >= 2**511, the result is a-2**512.
+func (a Uint512) Int512() Int512 {
+	return Int512(a)
 }
 
-// Uint1024 converts a to an Uint1024.
-func (a Uint512) Uint1024() Uint1024 {
-	return Uint1024{
+// Int1024 converts a to an Int1024.
+// a is zero-extended, so the result always equals a.
+func (a Uint512) Int1024() Int1024 {
+	return Int1024{
 		0,
 		0,
 		0,
@@ -1053,6 +6191,284 @@ func (a Uint512) Uint1024() Uint1024 {
 	}
 }
 
+// TryInt8 converts a to an Int8.
+// It reports whether the value of a is representable as an Int8;
+// if not, the result is the same as [Uint512.Int8].
+func (a Uint512) TryInt8() (Int8, bool) {
+	b := a.Int8()
+	return b, b.Sign() >= 0 && b.Uint512() == a
+}
+
+// TryInt16 converts a to an Int16.
+// It reports whether the value of a is representable as an Int16;
+// if not, the result is the same as [Uint512.Int16].
+func (a Uint512) TryInt16() (Int16, bool) {
+	b := a.Int16()
+	return b, b.Sign() >= 0 && b.Uint512() == a
+}
+
+// TryInt32 converts a to an Int32.
+// It reports whether the value of a is representable as an Int32;
+// if not, the result is the same as [Uint512.Int32].
+func (a Uint512) TryInt32() (Int32, bool) {
+	b := a.Int32()
+	return b, b.Sign() >= 0 && b.Uint512() == a
+}
+
+// TryInt64 converts a to an Int64.
+// It reports whether the value of a is representable as an Int64;
+// if not, the result is the same as [Uint512.Int64].
+func (a Uint512) TryInt64() (Int64, bool) {
+	b := a.Int64()
+	return b, b.Sign() >= 0 && b.Uint512() == a
+}
+
+// TryInt128 converts a to an Int128.
+// It reports whether the value of a is representable as an Int128;
+// if not, the result is the same as [Uint512.Int128].
+func (a Uint512) TryInt128() (Int128, bool) {
+	b := a.Int128()
+	return b, b.Sign() >= 0 && b.Uint512() == a
+}
+
+// TryInt256 converts a to an Int256.
+// It reports whether the value of a is representable as an Int256;
+// if not, the result is the same as [Uint512.Int256].
+func (a Uint512) TryInt256() (Int256, bool) {
+	b := a.Int256()
+	return b, b.Sign() >= 0 && b.Uint512() == a
+}
+
+// TryInt512 converts a to an Int512.
+// It reports whether the value of a is representable as an Int512;
+// if not, the result is the same as [Uint512.Int512].
+func (a Uint512) TryInt512() (Int512, bool) {
+	b := a.Int512()
+	return b, b.Sign() >= 0 && b.Uint512() == a
+}
+
+// TryInt1024 converts a to an Int1024.
+// It reports whether the value of a is representable as an Int1024;
+// if not, the result is the same as [Uint512.Int1024].
+func (a Uint512) TryInt1024() (Int1024, bool) {
+	b := a.Int1024()
+	return b, b.Sign() >= 0 && b.Uint512() == a
+}
+
+// TryUint8 converts a to a Uint8.
+// It reports whether the value of a is representable as a Uint8;
+// if not, the result is the same as [Uint512.Uint8].
+func (a Uint512) TryUint8() (Uint8, bool) {
+	b := a.Uint8()
+	return b, b.Uint512() == a
+}
+
+// TryUint16 converts a to a Uint16.
+// It reports whether the value of a is representable as a Uint16;
+// if not, the result is the same as [Uint512.Uint16].
+func (a Uint512) TryUint16() (Uint16, bool) {
+	b := a.Uint16()
+	return b, b.Uint512() == a
+}
+
+// TryUint32 converts a to a Uint32.
+// It reports whether the value of a is representable as a Uint32;
+// if not, the result is the same as [Uint512.Uint32].
+func (a Uint512) TryUint32() (Uint32, bool) {
+	b := a.Uint32()
+	return b, b.Uint512() == a
+}
+
+// TryUint64 converts a to a Uint64.
+// It reports whether the value of a is representable as a Uint64;
+// if not, the result is the same as [Uint512.Uint64].
+func (a Uint512) TryUint64() (Uint64, bool) {
+	b := a.Uint64()
+	return b, b.Uint512() == a
+}
+
+// TryUint128 converts a to a Uint128.
+// It reports whether the value of a is representable as a Uint128;
+// if not, the result is the same as [Uint512.Uint128].
+func (a Uint512) TryUint128() (Uint128, bool) {
+	b := a.Uint128()
+	return b, b.Uint512() == a
+}
+
+// TryUint256 converts a to a Uint256.
+// It reports whether the value of a is representable as a Uint256;
+// if not, the result is the same as [Uint512.Uint256].
+func (a Uint512) TryUint256() (Uint256, bool) {
+	b := a.Uint256()
+	return b, b.Uint512() == a
+}
+
+// TryUint512 converts a to a Uint512.
+// It reports whether the value of a is representable as a Uint512;
+// if not, the result is the same as [Uint512.Uint512].
+func (a Uint512) TryUint512() (Uint512, bool) {
+	return a, true
+}
+
+// TryUint1024 converts a to a Uint1024.
+// It reports whether the value of a is representable as a Uint1024;
+// if not, the result is the same as [Uint512.Uint1024].
+func (a Uint512) TryUint1024() (Uint1024, bool) {
+	return a.Uint1024(), true
+}
+
+// SaturatingUint8 converts a to a Uint8.
+// If the value of a is out of the range of Uint8, the result is clamped to the range.
+func (a Uint512) SaturatingUint8() Uint8 {
+	b, ok := a.TryUint8()
+	if !ok {
+		return MaxUint8
+	}
+	return b
+}
+
+// SaturatingUint16 converts a to a Uint16.
+// If the value of a is out of the range of Uint16, the result is clamped to the range.
+func (a Uint512) SaturatingUint16() Uint16 {
+	b, ok := a.TryUint16()
+	if !ok {
+		return MaxUint16
+	}
+	return b
+}
+
+// SaturatingUint32 converts a to a Uint32.
+// If the value of a is out of the range of Uint32, the result is clamped to the range.
+func (a Uint512) SaturatingUint32() Uint32 {
+	b, ok := a.TryUint32()
+	if !ok {
+		return MaxUint32
+	}
+	return b
+}
+
+// SaturatingUint64 converts a to a Uint64.
+// If the value of a is out of the range of Uint64, the result is clamped to the range.
+func (a Uint512) SaturatingUint64() Uint64 {
+	b, ok := a.TryUint64()
+	if !ok {
+		return MaxUint64
+	}
+	return b
+}
+
+// SaturatingUint128 converts a to a Uint128.
+// If the value of a is out of the range of Uint128, the result is clamped to the range.
+func (a Uint512) SaturatingUint128() Uint128 {
+	b, ok := a.TryUint128()
+	if !ok {
+		return Uint128{math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingUint256 converts a to a Uint256.
+// If the value of a is out of the range of Uint256, the result is clamped to the range.
+func (a Uint512) SaturatingUint256() Uint256 {
+	b, ok := a.TryUint256()
+	if !ok {
+		return Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingUint512 converts a to a Uint512.
+// If the value of a is out of the range of Uint512, the result is clamped to the range.
+func (a Uint512) SaturatingUint512() Uint512 {
+	return a.Uint512()
+}
+
+// SaturatingUint1024 converts a to a Uint1024.
+// If the value of a is out of the range of Uint1024, the result is clamped to the range.
+func (a Uint512) SaturatingUint1024() Uint1024 {
+	return a.Uint1024()
+}
+
+// SaturatingInt8 converts a to an Int8.
+// If the value of a is out of the range of Int8, the result is clamped to the range.
+func (a Uint512) SaturatingInt8() Int8 {
+	b, ok := a.TryInt8()
+	if !ok {
+		return MaxInt8
+	}
+	return b
+}
+
+// SaturatingInt16 converts a to an Int16.
+// If the value of a is out of the range of Int16, the result is clamped to the range.
+func (a Uint512) SaturatingInt16() Int16 {
+	b, ok := a.TryInt16()
+	if !ok {
+		return MaxInt16
+	}
+	return b
+}
+
+// SaturatingInt32 converts a to an Int32.
+// If the value of a is out of the range of Int32, the result is clamped to the range.
+func (a Uint512) SaturatingInt32() Int32 {
+	b, ok := a.TryInt32()
+	if !ok {
+		return MaxInt32
+	}
+	return b
+}
+
+// SaturatingInt64 converts a to an Int64.
+// If the value of a is out of the range of Int64, the result is clamped to the range.
+func (a Uint512) SaturatingInt64() Int64 {
+	b, ok := a.TryInt64()
+	if !ok {
+		return MaxInt64
+	}
+	return b
+}
+
+// SaturatingInt128 converts a to an Int128.
+// If the value of a is out of the range of Int128, the result is clamped to the range.
+func (a Uint512) SaturatingInt128() Int128 {
+	b, ok := a.TryInt128()
+	if !ok {
+		return Int128{math.MaxInt64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingInt256 converts a to an Int256.
+// If the value of a is out of the range of Int256, the result is clamped to the range.
+func (a Uint512) SaturatingInt256() Int256 {
+	b, ok := a.TryInt256()
+	if !ok {
+		return Int256{math.MaxInt64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingInt512 converts a to an Int512.
+// If the value of a is out of the range of Int512, the result is clamped to the range.
+func (a Uint512) SaturatingInt512() Int512 {
+	b, ok := a.TryInt512()
+	if !ok {
+		return Int512{math.MaxInt64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingInt1024 converts a to an Int1024.
+// If the value of a is out of the range of Int1024, the result is clamped to the range.
+func (a Uint512) SaturatingInt1024() Int1024 {
+	b, ok := a.TryInt1024()
+	if !ok {
+		return Int1024{math.MaxInt64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
 // Uint8 converts a to an Uint8.
 func (a Uint1024) Uint8() Uint8 {
 	return Uint8(a[15])
@@ -1109,3 +6525,351 @@ func (a Uint1024) Uint512() Uint512 {
 func (a Uint1024) Uint1024() Uint1024 {
 	return a
 }
+
+// Int8 converts a to an Int8.
+// a is truncated to its low 8 bits, which are interpreted as a two's complement signed integer.
+func (a Uint1024) Int8() Int8 {
+	return Int8(a[15])
+}
+
+// Int16 converts a to an Int16.
+// a is truncated to its low 16 bits, which are interpreted as a two's complement signed integer.
+func (a Uint1024) Int16() Int16 {
+	return Int16(a[15])
+}
+
+// Int32 converts a to an Int32.
+// a is truncated to its low 32 bits, which are interpreted as a two's complement signed integer.
+func (a Uint1024) Int32() Int32 {
+	return Int32(a[15])
+}
+
+// Int64 converts a to an Int64.
+// a is truncated to its low 64 bits, which are interpreted as a two's complement signed integer.
+func (a Uint1024) Int64() Int64 {
+	return Int64(a[15])
+}
+
+// Int128 converts a to an Int128.
+// a is truncated to its low 128 bits, which are interpreted as a two's complement signed integer.
+func (a Uint1024) Int128() Int128 {
+	return Int128{
+		a[14],
+		a[15],
+	}
+}
+
+// Int256 converts a to an Int256.
+// a is truncated to its low 256 bits, which are interpreted as a two's complement signed integer.
+func (a Uint1024) Int256() Int256 {
+	return Int256{
+		a[12],
+		a[13],
+		a[14],
+		a[15],
+	}
+}
+
+// Int512 converts a to an Int512.
+// a is truncated to its low 512 bits, which are interpreted as a two's complement signed integer.
+func (a Uint1024) Int512() Int512 {
+	return Int512{
+		a[8],
+		a[9],
+		a[10],
+		a[11],
+		a[12],
+		a[13],
+		a[14],
+		a[15],
+	}
+}
+
+// Int1024 converts a to an Int1024, reinterpreting the bits of a as a two's complement signed integer.
+// If a >= 2**1023, the result is a-2**1024.
+func (a Uint1024) Int1024() Int1024 {
+	return Int1024(a)
+}
+
+// TryInt8 converts a to an Int8.
+// It reports whether the value of a is representable as an Int8;
+// if not, the result is the same as [Uint1024.Int8].
+func (a Uint1024) TryInt8() (Int8, bool) {
+	b := a.Int8()
+	return b, b.Sign() >= 0 && b.Uint1024() == a
+}
+
+// TryInt16 converts a to an Int16.
+// It reports whether the value of a is representable as an Int16;
+// if not, the result is the same as [Uint1024.Int16].
+func (a Uint1024) TryInt16() (Int16, bool) {
+	b := a.Int16()
+	return b, b.Sign() >= 0 && b.Uint1024() == a
+}
+
+// TryInt32 converts a to an Int32.
+// It reports whether the value of a is representable as an Int32;
+// if not, the result is the same as [Uint1024.Int32].
+func (a Uint1024) TryInt32() (Int32, bool) {
+	b := a.Int32()
+	return b, b.Sign() >= 0 && b.Uint1024() == a
+}
+
+// TryInt64 converts a to an Int64.
+// It reports whether the value of a is representable as an Int64;
+// if not, the result is the same as [Uint1024.Int64].
+func (a Uint1024) TryInt64() (Int64, bool) {
+	b := a.Int64()
+	return b, b.Sign() >= 0 && b.Uint1024() == a
+}
+
+// TryInt128 converts a to an Int128.
+// It reports whether the value of a is representable as an Int128;
+// if not, the result is the same as [Uint1024.Int128].
+func (a Uint1024) TryInt128() (Int128, bool) {
+	b := a.Int128()
+	return b, b.Sign() >= 0 && b.Uint1024() == a
+}
+
+// TryInt256 converts a to an Int256.
+// It reports whether the value of a is representable as an Int256;
+// if not, the result is the same as [Uint1024.Int256].
+func (a Uint1024) TryInt256() (Int256, bool) {
+	b := a.Int256()
+	return b, b.Sign() >= 0 && b.Uint1024() == a
+}
+
+// TryInt512 converts a to an Int512.
+// It reports whether the value of a is representable as an Int512;
+// if not, the result is the same as [Uint1024.Int512].
+func (a Uint1024) TryInt512() (Int512, bool) {
+	b := a.Int512()
+	return b, b.Sign() >= 0 && b.Uint1024() == a
+}
+
+// TryInt1024 converts a to an Int1024.
+// It reports whether the value of a is representable as an Int1024;
+// if not, the result is the same as [Uint1024.Int1024].
+func (a Uint1024) TryInt1024() (Int1024, bool) {
+	b := a.Int1024()
+	return b, b.Sign() >= 0 && b.Uint1024() == a
+}
+
+// TryUint8 converts a to a Uint8.
+// It reports whether the value of a is representable as a Uint8;
+// if not, the result is the same as [Uint1024.Uint8].
+func (a Uint1024) TryUint8() (Uint8, bool) {
+	b := a.Uint8()
+	return b, b.Uint1024() == a
+}
+
+// TryUint16 converts a to a Uint16.
+// It reports whether the value of a is representable as a Uint16;
+// if not, the result is the same as [Uint1024.Uint16].
+func (a Uint1024) TryUint16() (Uint16, bool) {
+	b := a.Uint16()
+	return b, b.Uint1024() == a
+}
+
+// TryUint32 converts a to a Uint32.
+// It reports whether the value of a is representable as a Uint32;
+// if not, the result is the same as [Uint1024.Uint32].
+func (a Uint1024) TryUint32() (Uint32, bool) {
+	b := a.Uint32()
+	return b, b.Uint1024() == a
+}
+
+// TryUint64 converts a to a Uint64.
+// It reports whether the value of a is representable as a Uint64;
+// if not, the result is the same as [Uint1024.Uint64].
+func (a Uint1024) TryUint64() (Uint64, bool) {
+	b := a.Uint64()
+	return b, b.Uint1024() == a
+}
+
+// TryUint128 converts a to a Uint128.
+// It reports whether the value of a is representable as a Uint128;
+// if not, the result is the same as [Uint1024.Uint128].
+func (a Uint1024) TryUint128() (Uint128, bool) {
+	b := a.Uint128()
+	return b, b.Uint1024() == a
+}
+
+// TryUint256 converts a to a Uint256.
+// It reports whether the value of a is representable as a Uint256;
+// if not, the result is the same as [Uint1024.Uint256].
+func (a Uint1024) TryUint256() (Uint256, bool) {
+	b := a.Uint256()
+	return b, b.Uint1024() == a
+}
+
+// TryUint512 converts a to a Uint512.
+// It reports whether the value of a is representable as a Uint512;
+// if not, the result is the same as [Uint1024.Uint512].
+func (a Uint1024) TryUint512() (Uint512, bool) {
+	b := a.Uint512()
+	return b, b.Uint1024() == a
+}
+
+// TryUint1024 converts a to a Uint1024.
+// It reports whether the value of a is representable as a Uint1024;
+// if not, the result is the same as [Uint1024.Uint1024].
+func (a Uint1024) TryUint1024() (Uint1024, bool) {
+	return a, true
+}
+
+// SaturatingUint8 converts a to a Uint8.
+// If the value of a is out of the range of Uint8, the result is clamped to the range.
+func (a Uint1024) SaturatingUint8() Uint8 {
+	b, ok := a.TryUint8()
+	if !ok {
+		return MaxUint8
+	}
+	return b
+}
+
+// SaturatingUint16 converts a to a Uint16.
+// If the value of a is out of the range of Uint16, the result is clamped to the range.
+func (a Uint1024) SaturatingUint16() Uint16 {
+	b, ok := a.TryUint16()
+	if !ok {
+		return MaxUint16
+	}
+	return b
+}
+
+// SaturatingUint32 converts a to a Uint32.
+// If the value of a is out of the range of Uint32, the result is clamped to the range.
+func (a Uint1024) SaturatingUint32() Uint32 {
+	b, ok := a.TryUint32()
+	if !ok {
+		return MaxUint32
+	}
+	return b
+}
+
+// SaturatingUint64 converts a to a Uint64.
+// If the value of a is out of the range of Uint64, the result is clamped to the range.
+func (a Uint1024) SaturatingUint64() Uint64 {
+	b, ok := a.TryUint64()
+	if !ok {
+		return MaxUint64
+	}
+	return b
+}
+
+// SaturatingUint128 converts a to a Uint128.
+// If the value of a is out of the range of Uint128, the result is clamped to the range.
+func (a Uint1024) SaturatingUint128() Uint128 {
+	b, ok := a.TryUint128()
+	if !ok {
+		return Uint128{math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingUint256 converts a to a Uint256.
+// If the value of a is out of the range of Uint256, the result is clamped to the range.
+func (a Uint1024) SaturatingUint256() Uint256 {
+	b, ok := a.TryUint256()
+	if !ok {
+		return Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingUint512 converts a to a Uint512.
+// If the value of a is out of the range of Uint512, the result is clamped to the range.
+func (a Uint1024) SaturatingUint512() Uint512 {
+	b, ok := a.TryUint512()
+	if !ok {
+		return Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingUint1024 converts a to a Uint1024.
+// If the value of a is out of the range of Uint1024, the result is clamped to the range.
+func (a Uint1024) SaturatingUint1024() Uint1024 {
+	return a.Uint1024()
+}
+
+// SaturatingInt8 converts a to an Int8.
+// If the value of a is out of the range of Int8, the result is clamped to the range.
+func (a Uint1024) SaturatingInt8() Int8 {
+	b, ok := a.TryInt8()
+	if !ok {
+		return MaxInt8
+	}
+	return b
+}
+
+// SaturatingInt16 converts a to an Int16.
+// If the value of a is out of the range of Int16, the result is clamped to the range.
+func (a Uint1024) SaturatingInt16() Int16 {
+	b, ok := a.TryInt16()
+	if !ok {
+		return MaxInt16
+	}
+	return b
+}
+
+// SaturatingInt32 converts a to an Int32.
+// If the value of a is out of the range of Int32, the result is clamped to the range.
+func (a Uint1024) SaturatingInt32() Int32 {
+	b, ok := a.TryInt32()
+	if !ok {
+		return MaxInt32
+	}
+	return b
+}
+
+// SaturatingInt64 converts a to an Int64.
+// If the value of a is out of the range of Int64, the result is clamped to the range.
+func (a Uint1024) SaturatingInt64() Int64 {
+	b, ok := a.TryInt64()
+	if !ok {
+		return MaxInt64
+	}
+	return b
+}
+
+// SaturatingInt128 converts a to an Int128.
+// If the value of a is out of the range of Int128, the result is clamped to the range.
+func (a Uint1024) SaturatingInt128() Int128 {
+	b, ok := a.TryInt128()
+	if !ok {
+		return Int128{math.MaxInt64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingInt256 converts a to an Int256.
+// If the value of a is out of the range of Int256, the result is clamped to the range.
+func (a Uint1024) SaturatingInt256() Int256 {
+	b, ok := a.TryInt256()
+	if !ok {
+		return Int256{math.MaxInt64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingInt512 converts a to an Int512.
+// If the value of a is out of the range of Int512, the result is clamped to the range.
+func (a Uint1024) SaturatingInt512() Int512 {
+	b, ok := a.TryInt512()
+	if !ok {
+		return Int512{math.MaxInt64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
+
+// SaturatingInt1024 converts a to an Int1024.
+// If the value of a is out of the range of Int1024, the result is clamped to the range.
+func (a Uint1024) SaturatingInt1024() Int1024 {
+	b, ok := a.TryInt1024()
+	if !ok {
+		return Int1024{math.MaxInt64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return b
+}
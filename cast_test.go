@@ -2923,3 +2923,9155 @@ func TestUint1024_Uint1024(t *testing.T) {
 		}
 	}
 }
+
+func TestInt8_Uint8(t *testing.T) {
+	testCases := []struct {
+		a    Int8
+		want Uint8
+		ok   bool
+	}{
+		{0, 0, true},
+		{1, 1, true},
+		{-1, 255, false},
+		{127, 127, true},
+		{-128, 128, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint8()
+		if got != tc.want {
+			t.Errorf("Int8(%#04x).Uint8() = %#04x, want %#04x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint8()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int8(%#04x).TryUint8() = %#04x, %t, want %#04x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt8_Uint16(t *testing.T) {
+	testCases := []struct {
+		a    Int8
+		want Uint16
+		ok   bool
+	}{
+		{0, 0, true},
+		{1, 1, true},
+		{-1, 65535, false},
+		{127, 127, true},
+		{-128, 65408, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint16()
+		if got != tc.want {
+			t.Errorf("Int8(%#04x).Uint16() = %#06x, want %#06x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint16()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int8(%#04x).TryUint16() = %#06x, %t, want %#06x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt8_Uint32(t *testing.T) {
+	testCases := []struct {
+		a    Int8
+		want Uint32
+		ok   bool
+	}{
+		{0, 0, true},
+		{1, 1, true},
+		{-1, 4294967295, false},
+		{127, 127, true},
+		{-128, 4294967168, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint32()
+		if got != tc.want {
+			t.Errorf("Int8(%#04x).Uint32() = %#010x, want %#010x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint32()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int8(%#04x).TryUint32() = %#010x, %t, want %#010x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt8_Uint64(t *testing.T) {
+	testCases := []struct {
+		a    Int8
+		want Uint64
+		ok   bool
+	}{
+		{0, 0, true},
+		{1, 1, true},
+		{-1, 18446744073709551615, false},
+		{127, 127, true},
+		{-128, 18446744073709551488, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint64()
+		if got != tc.want {
+			t.Errorf("Int8(%#04x).Uint64() = %#018x, want %#018x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint64()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int8(%#04x).TryUint64() = %#018x, %t, want %#018x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt8_Uint128(t *testing.T) {
+	testCases := []struct {
+		a    Int8
+		want Uint128
+		ok   bool
+	}{
+		{0, Uint128{0, 0}, true},
+		{1, Uint128{0, 0x1}, true},
+		{-1, Uint128{math.MaxUint64, math.MaxUint64}, false},
+		{127, Uint128{0, 0x7f}, true},
+		{-128, Uint128{math.MaxUint64, 0xffffffffffffff80}, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint128()
+		if got != tc.want {
+			t.Errorf("Int8(%#04x).Uint128() = %#034x, want %#034x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint128()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int8(%#04x).TryUint128() = %#034x, %t, want %#034x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt8_Uint256(t *testing.T) {
+	testCases := []struct {
+		a    Int8
+		want Uint256
+		ok   bool
+	}{
+		{0, Uint256{0, 0, 0, 0}, true},
+		{1, Uint256{0, 0, 0, 0x1}, true},
+		{-1, Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false},
+		{127, Uint256{0, 0, 0, 0x7f}, true},
+		{-128, Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xffffffffffffff80}, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint256()
+		if got != tc.want {
+			t.Errorf("Int8(%#04x).Uint256() = %#066x, want %#066x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint256()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int8(%#04x).TryUint256() = %#066x, %t, want %#066x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt8_Uint512(t *testing.T) {
+	testCases := []struct {
+		a    Int8
+		want Uint512
+		ok   bool
+	}{
+		{0, Uint512{0, 0, 0, 0, 0, 0, 0, 0}, true},
+		{1, Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}, true},
+		{-1, Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false},
+		{127, Uint512{0, 0, 0, 0, 0, 0, 0, 0x7f}, true},
+		{-128, Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xffffffffffffff80}, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint512()
+		if got != tc.want {
+			t.Errorf("Int8(%#04x).Uint512() = %#0130x, want %#0130x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint512()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int8(%#04x).TryUint512() = %#0130x, %t, want %#0130x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt8_Uint1024(t *testing.T) {
+	testCases := []struct {
+		a    Int8
+		want Uint1024
+		ok   bool
+	}{
+		{0, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, true},
+		{1, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, true},
+		{-1, Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false},
+		{127, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x7f}, true},
+		{-128, Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xffffffffffffff80}, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint1024()
+		if got != tc.want {
+			t.Errorf("Int8(%#04x).Uint1024() = %#0258x, want %#0258x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint1024()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int8(%#04x).TryUint1024() = %#0258x, %t, want %#0258x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt16_Uint8(t *testing.T) {
+	testCases := []struct {
+		a    Int16
+		want Uint8
+		ok   bool
+	}{
+		{0, 0, true},
+		{1, 1, true},
+		{-1, 255, false},
+		{32767, 255, false},
+		{-32768, 0, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint8()
+		if got != tc.want {
+			t.Errorf("Int16(%#06x).Uint8() = %#04x, want %#04x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint8()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int16(%#06x).TryUint8() = %#04x, %t, want %#04x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt16_Uint16(t *testing.T) {
+	testCases := []struct {
+		a    Int16
+		want Uint16
+		ok   bool
+	}{
+		{0, 0, true},
+		{1, 1, true},
+		{-1, 65535, false},
+		{32767, 32767, true},
+		{-32768, 32768, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint16()
+		if got != tc.want {
+			t.Errorf("Int16(%#06x).Uint16() = %#06x, want %#06x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint16()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int16(%#06x).TryUint16() = %#06x, %t, want %#06x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt16_Uint32(t *testing.T) {
+	testCases := []struct {
+		a    Int16
+		want Uint32
+		ok   bool
+	}{
+		{0, 0, true},
+		{1, 1, true},
+		{-1, 4294967295, false},
+		{32767, 32767, true},
+		{-32768, 4294934528, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint32()
+		if got != tc.want {
+			t.Errorf("Int16(%#06x).Uint32() = %#010x, want %#010x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint32()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int16(%#06x).TryUint32() = %#010x, %t, want %#010x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt16_Uint64(t *testing.T) {
+	testCases := []struct {
+		a    Int16
+		want Uint64
+		ok   bool
+	}{
+		{0, 0, true},
+		{1, 1, true},
+		{-1, 18446744073709551615, false},
+		{32767, 32767, true},
+		{-32768, 18446744073709518848, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint64()
+		if got != tc.want {
+			t.Errorf("Int16(%#06x).Uint64() = %#018x, want %#018x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint64()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int16(%#06x).TryUint64() = %#018x, %t, want %#018x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt16_Uint128(t *testing.T) {
+	testCases := []struct {
+		a    Int16
+		want Uint128
+		ok   bool
+	}{
+		{0, Uint128{0, 0}, true},
+		{1, Uint128{0, 0x1}, true},
+		{-1, Uint128{math.MaxUint64, math.MaxUint64}, false},
+		{32767, Uint128{0, 0x7fff}, true},
+		{-32768, Uint128{math.MaxUint64, 0xffffffffffff8000}, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint128()
+		if got != tc.want {
+			t.Errorf("Int16(%#06x).Uint128() = %#034x, want %#034x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint128()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int16(%#06x).TryUint128() = %#034x, %t, want %#034x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt16_Uint256(t *testing.T) {
+	testCases := []struct {
+		a    Int16
+		want Uint256
+		ok   bool
+	}{
+		{0, Uint256{0, 0, 0, 0}, true},
+		{1, Uint256{0, 0, 0, 0x1}, true},
+		{-1, Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false},
+		{32767, Uint256{0, 0, 0, 0x7fff}, true},
+		{-32768, Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xffffffffffff8000}, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint256()
+		if got != tc.want {
+			t.Errorf("Int16(%#06x).Uint256() = %#066x, want %#066x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint256()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int16(%#06x).TryUint256() = %#066x, %t, want %#066x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt16_Uint512(t *testing.T) {
+	testCases := []struct {
+		a    Int16
+		want Uint512
+		ok   bool
+	}{
+		{0, Uint512{0, 0, 0, 0, 0, 0, 0, 0}, true},
+		{1, Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}, true},
+		{-1, Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false},
+		{32767, Uint512{0, 0, 0, 0, 0, 0, 0, 0x7fff}, true},
+		{-32768, Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xffffffffffff8000}, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint512()
+		if got != tc.want {
+			t.Errorf("Int16(%#06x).Uint512() = %#0130x, want %#0130x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint512()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int16(%#06x).TryUint512() = %#0130x, %t, want %#0130x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt16_Uint1024(t *testing.T) {
+	testCases := []struct {
+		a    Int16
+		want Uint1024
+		ok   bool
+	}{
+		{0, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, true},
+		{1, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, true},
+		{-1, Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false},
+		{32767, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x7fff}, true},
+		{-32768, Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xffffffffffff8000}, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint1024()
+		if got != tc.want {
+			t.Errorf("Int16(%#06x).Uint1024() = %#0258x, want %#0258x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint1024()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int16(%#06x).TryUint1024() = %#0258x, %t, want %#0258x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt32_Uint8(t *testing.T) {
+	testCases := []struct {
+		a    Int32
+		want Uint8
+		ok   bool
+	}{
+		{0, 0, true},
+		{1, 1, true},
+		{-1, 255, false},
+		{2147483647, 255, false},
+		{-2147483648, 0, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint8()
+		if got != tc.want {
+			t.Errorf("Int32(%#010x).Uint8() = %#04x, want %#04x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint8()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int32(%#010x).TryUint8() = %#04x, %t, want %#04x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt32_Uint16(t *testing.T) {
+	testCases := []struct {
+		a    Int32
+		want Uint16
+		ok   bool
+	}{
+		{0, 0, true},
+		{1, 1, true},
+		{-1, 65535, false},
+		{2147483647, 65535, false},
+		{-2147483648, 0, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint16()
+		if got != tc.want {
+			t.Errorf("Int32(%#010x).Uint16() = %#06x, want %#06x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint16()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int32(%#010x).TryUint16() = %#06x, %t, want %#06x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt32_Uint32(t *testing.T) {
+	testCases := []struct {
+		a    Int32
+		want Uint32
+		ok   bool
+	}{
+		{0, 0, true},
+		{1, 1, true},
+		{-1, 4294967295, false},
+		{2147483647, 2147483647, true},
+		{-2147483648, 2147483648, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint32()
+		if got != tc.want {
+			t.Errorf("Int32(%#010x).Uint32() = %#010x, want %#010x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint32()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int32(%#010x).TryUint32() = %#010x, %t, want %#010x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt32_Uint64(t *testing.T) {
+	testCases := []struct {
+		a    Int32
+		want Uint64
+		ok   bool
+	}{
+		{0, 0, true},
+		{1, 1, true},
+		{-1, 18446744073709551615, false},
+		{2147483647, 2147483647, true},
+		{-2147483648, 18446744071562067968, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint64()
+		if got != tc.want {
+			t.Errorf("Int32(%#010x).Uint64() = %#018x, want %#018x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint64()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int32(%#010x).TryUint64() = %#018x, %t, want %#018x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt32_Uint128(t *testing.T) {
+	testCases := []struct {
+		a    Int32
+		want Uint128
+		ok   bool
+	}{
+		{0, Uint128{0, 0}, true},
+		{1, Uint128{0, 0x1}, true},
+		{-1, Uint128{math.MaxUint64, math.MaxUint64}, false},
+		{2147483647, Uint128{0, 0x7fffffff}, true},
+		{-2147483648, Uint128{math.MaxUint64, 0xffffffff80000000}, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint128()
+		if got != tc.want {
+			t.Errorf("Int32(%#010x).Uint128() = %#034x, want %#034x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint128()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int32(%#010x).TryUint128() = %#034x, %t, want %#034x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt32_Uint256(t *testing.T) {
+	testCases := []struct {
+		a    Int32
+		want Uint256
+		ok   bool
+	}{
+		{0, Uint256{0, 0, 0, 0}, true},
+		{1, Uint256{0, 0, 0, 0x1}, true},
+		{-1, Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false},
+		{2147483647, Uint256{0, 0, 0, 0x7fffffff}, true},
+		{-2147483648, Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xffffffff80000000}, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint256()
+		if got != tc.want {
+			t.Errorf("Int32(%#010x).Uint256() = %#066x, want %#066x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint256()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int32(%#010x).TryUint256() = %#066x, %t, want %#066x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt32_Uint512(t *testing.T) {
+	testCases := []struct {
+		a    Int32
+		want Uint512
+		ok   bool
+	}{
+		{0, Uint512{0, 0, 0, 0, 0, 0, 0, 0}, true},
+		{1, Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}, true},
+		{-1, Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false},
+		{2147483647, Uint512{0, 0, 0, 0, 0, 0, 0, 0x7fffffff}, true},
+		{-2147483648, Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xffffffff80000000}, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint512()
+		if got != tc.want {
+			t.Errorf("Int32(%#010x).Uint512() = %#0130x, want %#0130x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint512()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int32(%#010x).TryUint512() = %#0130x, %t, want %#0130x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt32_Uint1024(t *testing.T) {
+	testCases := []struct {
+		a    Int32
+		want Uint1024
+		ok   bool
+	}{
+		{0, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, true},
+		{1, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, true},
+		{-1, Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false},
+		{2147483647, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x7fffffff}, true},
+		{-2147483648, Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xffffffff80000000}, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint1024()
+		if got != tc.want {
+			t.Errorf("Int32(%#010x).Uint1024() = %#0258x, want %#0258x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint1024()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int32(%#010x).TryUint1024() = %#0258x, %t, want %#0258x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt64_Uint8(t *testing.T) {
+	testCases := []struct {
+		a    Int64
+		want Uint8
+		ok   bool
+	}{
+		{0, 0, true},
+		{1, 1, true},
+		{-1, 255, false},
+		{9223372036854775807, 255, false},
+		{-9223372036854775808, 0, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint8()
+		if got != tc.want {
+			t.Errorf("Int64(%#018x).Uint8() = %#04x, want %#04x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint8()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int64(%#018x).TryUint8() = %#04x, %t, want %#04x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt64_Uint16(t *testing.T) {
+	testCases := []struct {
+		a    Int64
+		want Uint16
+		ok   bool
+	}{
+		{0, 0, true},
+		{1, 1, true},
+		{-1, 65535, false},
+		{9223372036854775807, 65535, false},
+		{-9223372036854775808, 0, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint16()
+		if got != tc.want {
+			t.Errorf("Int64(%#018x).Uint16() = %#06x, want %#06x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint16()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int64(%#018x).TryUint16() = %#06x, %t, want %#06x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt64_Uint32(t *testing.T) {
+	testCases := []struct {
+		a    Int64
+		want Uint32
+		ok   bool
+	}{
+		{0, 0, true},
+		{1, 1, true},
+		{-1, 4294967295, false},
+		{9223372036854775807, 4294967295, false},
+		{-9223372036854775808, 0, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint32()
+		if got != tc.want {
+			t.Errorf("Int64(%#018x).Uint32() = %#010x, want %#010x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint32()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int64(%#018x).TryUint32() = %#010x, %t, want %#010x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt64_Uint64(t *testing.T) {
+	testCases := []struct {
+		a    Int64
+		want Uint64
+		ok   bool
+	}{
+		{0, 0, true},
+		{1, 1, true},
+		{-1, 18446744073709551615, false},
+		{9223372036854775807, 9223372036854775807, true},
+		{-9223372036854775808, 9223372036854775808, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint64()
+		if got != tc.want {
+			t.Errorf("Int64(%#018x).Uint64() = %#018x, want %#018x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint64()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int64(%#018x).TryUint64() = %#018x, %t, want %#018x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt64_Uint128(t *testing.T) {
+	testCases := []struct {
+		a    Int64
+		want Uint128
+		ok   bool
+	}{
+		{0, Uint128{0, 0}, true},
+		{1, Uint128{0, 0x1}, true},
+		{-1, Uint128{math.MaxUint64, math.MaxUint64}, false},
+		{9223372036854775807, Uint128{0, 0x7fffffffffffffff}, true},
+		{-9223372036854775808, Uint128{math.MaxUint64, 0x8000000000000000}, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint128()
+		if got != tc.want {
+			t.Errorf("Int64(%#018x).Uint128() = %#034x, want %#034x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint128()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int64(%#018x).TryUint128() = %#034x, %t, want %#034x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt64_Uint256(t *testing.T) {
+	testCases := []struct {
+		a    Int64
+		want Uint256
+		ok   bool
+	}{
+		{0, Uint256{0, 0, 0, 0}, true},
+		{1, Uint256{0, 0, 0, 0x1}, true},
+		{-1, Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false},
+		{9223372036854775807, Uint256{0, 0, 0, 0x7fffffffffffffff}, true},
+		{-9223372036854775808, Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, 0x8000000000000000}, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint256()
+		if got != tc.want {
+			t.Errorf("Int64(%#018x).Uint256() = %#066x, want %#066x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint256()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int64(%#018x).TryUint256() = %#066x, %t, want %#066x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt64_Uint512(t *testing.T) {
+	testCases := []struct {
+		a    Int64
+		want Uint512
+		ok   bool
+	}{
+		{0, Uint512{0, 0, 0, 0, 0, 0, 0, 0}, true},
+		{1, Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}, true},
+		{-1, Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false},
+		{9223372036854775807, Uint512{0, 0, 0, 0, 0, 0, 0, 0x7fffffffffffffff}, true},
+		{-9223372036854775808, Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0x8000000000000000}, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint512()
+		if got != tc.want {
+			t.Errorf("Int64(%#018x).Uint512() = %#0130x, want %#0130x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint512()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int64(%#018x).TryUint512() = %#0130x, %t, want %#0130x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt64_Uint1024(t *testing.T) {
+	testCases := []struct {
+		a    Int64
+		want Uint1024
+		ok   bool
+	}{
+		{0, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, true},
+		{1, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, true},
+		{-1, Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false},
+		{9223372036854775807, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x7fffffffffffffff}, true},
+		{-9223372036854775808, Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0x8000000000000000}, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint1024()
+		if got != tc.want {
+			t.Errorf("Int64(%#018x).Uint1024() = %#0258x, want %#0258x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint1024()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int64(%#018x).TryUint1024() = %#0258x, %t, want %#0258x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt128_Uint8(t *testing.T) {
+	testCases := []struct {
+		a    Int128
+		want Uint8
+		ok   bool
+	}{
+		{Int128{0, 0}, 0, true},
+		{Int128{0, 0x1}, 1, true},
+		{Int128{math.MaxUint64, math.MaxUint64}, 255, false},
+		{Int128{0x7fffffffffffffff, math.MaxUint64}, 255, false},
+		{Int128{0x8000000000000000, 0}, 0, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint8()
+		if got != tc.want {
+			t.Errorf("Int128(%#034x).Uint8() = %#04x, want %#04x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint8()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int128(%#034x).TryUint8() = %#04x, %t, want %#04x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt128_Uint16(t *testing.T) {
+	testCases := []struct {
+		a    Int128
+		want Uint16
+		ok   bool
+	}{
+		{Int128{0, 0}, 0, true},
+		{Int128{0, 0x1}, 1, true},
+		{Int128{math.MaxUint64, math.MaxUint64}, 65535, false},
+		{Int128{0x7fffffffffffffff, math.MaxUint64}, 65535, false},
+		{Int128{0x8000000000000000, 0}, 0, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint16()
+		if got != tc.want {
+			t.Errorf("Int128(%#034x).Uint16() = %#06x, want %#06x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint16()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int128(%#034x).TryUint16() = %#06x, %t, want %#06x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt128_Uint32(t *testing.T) {
+	testCases := []struct {
+		a    Int128
+		want Uint32
+		ok   bool
+	}{
+		{Int128{0, 0}, 0, true},
+		{Int128{0, 0x1}, 1, true},
+		{Int128{math.MaxUint64, math.MaxUint64}, 4294967295, false},
+		{Int128{0x7fffffffffffffff, math.MaxUint64}, 4294967295, false},
+		{Int128{0x8000000000000000, 0}, 0, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint32()
+		if got != tc.want {
+			t.Errorf("Int128(%#034x).Uint32() = %#010x, want %#010x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint32()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int128(%#034x).TryUint32() = %#010x, %t, want %#010x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt128_Uint64(t *testing.T) {
+	testCases := []struct {
+		a    Int128
+		want Uint64
+		ok   bool
+	}{
+		{Int128{0, 0}, 0, true},
+		{Int128{0, 0x1}, 1, true},
+		{Int128{math.MaxUint64, math.MaxUint64}, 18446744073709551615, false},
+		{Int128{0x7fffffffffffffff, math.MaxUint64}, 18446744073709551615, false},
+		{Int128{0x8000000000000000, 0}, 0, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint64()
+		if got != tc.want {
+			t.Errorf("Int128(%#034x).Uint64() = %#018x, want %#018x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint64()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int128(%#034x).TryUint64() = %#018x, %t, want %#018x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt128_Uint128(t *testing.T) {
+	testCases := []struct {
+		a    Int128
+		want Uint128
+		ok   bool
+	}{
+		{Int128{0, 0}, Uint128{0, 0}, true},
+		{Int128{0, 0x1}, Uint128{0, 0x1}, true},
+		{Int128{math.MaxUint64, math.MaxUint64}, Uint128{math.MaxUint64, math.MaxUint64}, false},
+		{Int128{0x7fffffffffffffff, math.MaxUint64}, Uint128{0x7fffffffffffffff, math.MaxUint64}, true},
+		{Int128{0x8000000000000000, 0}, Uint128{0x8000000000000000, 0}, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint128()
+		if got != tc.want {
+			t.Errorf("Int128(%#034x).Uint128() = %#034x, want %#034x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint128()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int128(%#034x).TryUint128() = %#034x, %t, want %#034x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt128_Uint256(t *testing.T) {
+	testCases := []struct {
+		a    Int128
+		want Uint256
+		ok   bool
+	}{
+		{Int128{0, 0}, Uint256{0, 0, 0, 0}, true},
+		{Int128{0, 0x1}, Uint256{0, 0, 0, 0x1}, true},
+		{Int128{math.MaxUint64, math.MaxUint64}, Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false},
+		{Int128{0x7fffffffffffffff, math.MaxUint64}, Uint256{0, 0, 0x7fffffffffffffff, math.MaxUint64}, true},
+		{Int128{0x8000000000000000, 0}, Uint256{math.MaxUint64, math.MaxUint64, 0x8000000000000000, 0}, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint256()
+		if got != tc.want {
+			t.Errorf("Int128(%#034x).Uint256() = %#066x, want %#066x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint256()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int128(%#034x).TryUint256() = %#066x, %t, want %#066x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt128_Uint512(t *testing.T) {
+	testCases := []struct {
+		a    Int128
+		want Uint512
+		ok   bool
+	}{
+		{Int128{0, 0}, Uint512{0, 0, 0, 0, 0, 0, 0, 0}, true},
+		{Int128{0, 0x1}, Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}, true},
+		{Int128{math.MaxUint64, math.MaxUint64}, Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false},
+		{Int128{0x7fffffffffffffff, math.MaxUint64}, Uint512{0, 0, 0, 0, 0, 0, 0x7fffffffffffffff, math.MaxUint64}, true},
+		{Int128{0x8000000000000000, 0}, Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0x8000000000000000, 0}, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint512()
+		if got != tc.want {
+			t.Errorf("Int128(%#034x).Uint512() = %#0130x, want %#0130x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint512()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int128(%#034x).TryUint512() = %#0130x, %t, want %#0130x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt128_Uint1024(t *testing.T) {
+	testCases := []struct {
+		a    Int128
+		want Uint1024
+		ok   bool
+	}{
+		{Int128{0, 0}, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, true},
+		{Int128{0, 0x1}, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, true},
+		{Int128{math.MaxUint64, math.MaxUint64}, Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false},
+		{Int128{0x7fffffffffffffff, math.MaxUint64}, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x7fffffffffffffff, math.MaxUint64}, true},
+		{Int128{0x8000000000000000, 0}, Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0x8000000000000000, 0}, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint1024()
+		if got != tc.want {
+			t.Errorf("Int128(%#034x).Uint1024() = %#0258x, want %#0258x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint1024()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int128(%#034x).TryUint1024() = %#0258x, %t, want %#0258x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt256_Uint8(t *testing.T) {
+	testCases := []struct {
+		a    Int256
+		want Uint8
+		ok   bool
+	}{
+		{Int256{0, 0, 0, 0}, 0, true},
+		{Int256{0, 0, 0, 0x1}, 1, true},
+		{Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 255, false},
+		{Int256{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 255, false},
+		{Int256{0x8000000000000000, 0, 0, 0}, 0, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint8()
+		if got != tc.want {
+			t.Errorf("Int256(%#066x).Uint8() = %#04x, want %#04x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint8()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int256(%#066x).TryUint8() = %#04x, %t, want %#04x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt256_Uint16(t *testing.T) {
+	testCases := []struct {
+		a    Int256
+		want Uint16
+		ok   bool
+	}{
+		{Int256{0, 0, 0, 0}, 0, true},
+		{Int256{0, 0, 0, 0x1}, 1, true},
+		{Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 65535, false},
+		{Int256{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 65535, false},
+		{Int256{0x8000000000000000, 0, 0, 0}, 0, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint16()
+		if got != tc.want {
+			t.Errorf("Int256(%#066x).Uint16() = %#06x, want %#06x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint16()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int256(%#066x).TryUint16() = %#06x, %t, want %#06x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt256_Uint32(t *testing.T) {
+	testCases := []struct {
+		a    Int256
+		want Uint32
+		ok   bool
+	}{
+		{Int256{0, 0, 0, 0}, 0, true},
+		{Int256{0, 0, 0, 0x1}, 1, true},
+		{Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 4294967295, false},
+		{Int256{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 4294967295, false},
+		{Int256{0x8000000000000000, 0, 0, 0}, 0, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint32()
+		if got != tc.want {
+			t.Errorf("Int256(%#066x).Uint32() = %#010x, want %#010x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint32()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int256(%#066x).TryUint32() = %#010x, %t, want %#010x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt256_Uint64(t *testing.T) {
+	testCases := []struct {
+		a    Int256
+		want Uint64
+		ok   bool
+	}{
+		{Int256{0, 0, 0, 0}, 0, true},
+		{Int256{0, 0, 0, 0x1}, 1, true},
+		{Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 18446744073709551615, false},
+		{Int256{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 18446744073709551615, false},
+		{Int256{0x8000000000000000, 0, 0, 0}, 0, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint64()
+		if got != tc.want {
+			t.Errorf("Int256(%#066x).Uint64() = %#018x, want %#018x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint64()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int256(%#066x).TryUint64() = %#018x, %t, want %#018x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt256_Uint128(t *testing.T) {
+	testCases := []struct {
+		a    Int256
+		want Uint128
+		ok   bool
+	}{
+		{Int256{0, 0, 0, 0}, Uint128{0, 0}, true},
+		{Int256{0, 0, 0, 0x1}, Uint128{0, 0x1}, true},
+		{Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint128{math.MaxUint64, math.MaxUint64}, false},
+		{Int256{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint128{math.MaxUint64, math.MaxUint64}, false},
+		{Int256{0x8000000000000000, 0, 0, 0}, Uint128{0, 0}, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint128()
+		if got != tc.want {
+			t.Errorf("Int256(%#066x).Uint128() = %#034x, want %#034x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint128()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int256(%#066x).TryUint128() = %#034x, %t, want %#034x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt256_Uint256(t *testing.T) {
+	testCases := []struct {
+		a    Int256
+		want Uint256
+		ok   bool
+	}{
+		{Int256{0, 0, 0, 0}, Uint256{0, 0, 0, 0}, true},
+		{Int256{0, 0, 0, 0x1}, Uint256{0, 0, 0, 0x1}, true},
+		{Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false},
+		{Int256{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint256{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}, true},
+		{Int256{0x8000000000000000, 0, 0, 0}, Uint256{0x8000000000000000, 0, 0, 0}, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint256()
+		if got != tc.want {
+			t.Errorf("Int256(%#066x).Uint256() = %#066x, want %#066x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint256()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int256(%#066x).TryUint256() = %#066x, %t, want %#066x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt256_Uint512(t *testing.T) {
+	testCases := []struct {
+		a    Int256
+		want Uint512
+		ok   bool
+	}{
+		{Int256{0, 0, 0, 0}, Uint512{0, 0, 0, 0, 0, 0, 0, 0}, true},
+		{Int256{0, 0, 0, 0x1}, Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}, true},
+		{Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false},
+		{Int256{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint512{0, 0, 0, 0, 0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}, true},
+		{Int256{0x8000000000000000, 0, 0, 0}, Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0x8000000000000000, 0, 0, 0}, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint512()
+		if got != tc.want {
+			t.Errorf("Int256(%#066x).Uint512() = %#0130x, want %#0130x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint512()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int256(%#066x).TryUint512() = %#0130x, %t, want %#0130x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt256_Uint1024(t *testing.T) {
+	testCases := []struct {
+		a    Int256
+		want Uint1024
+		ok   bool
+	}{
+		{Int256{0, 0, 0, 0}, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, true},
+		{Int256{0, 0, 0, 0x1}, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, true},
+		{Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false},
+		{Int256{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}, true},
+		{Int256{0x8000000000000000, 0, 0, 0}, Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0x8000000000000000, 0, 0, 0}, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint1024()
+		if got != tc.want {
+			t.Errorf("Int256(%#066x).Uint1024() = %#0258x, want %#0258x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint1024()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int256(%#066x).TryUint1024() = %#0258x, %t, want %#0258x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt512_Uint8(t *testing.T) {
+	testCases := []struct {
+		a    Int512
+		want Uint8
+		ok   bool
+	}{
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0}, 0, true},
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0x1}, 1, true},
+		{Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 255, false},
+		{Int512{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 255, false},
+		{Int512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, 0, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint8()
+		if got != tc.want {
+			t.Errorf("Int512(%#0130x).Uint8() = %#04x, want %#04x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint8()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int512(%#0130x).TryUint8() = %#04x, %t, want %#04x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt512_Uint16(t *testing.T) {
+	testCases := []struct {
+		a    Int512
+		want Uint16
+		ok   bool
+	}{
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0}, 0, true},
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0x1}, 1, true},
+		{Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 65535, false},
+		{Int512{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 65535, false},
+		{Int512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, 0, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint16()
+		if got != tc.want {
+			t.Errorf("Int512(%#0130x).Uint16() = %#06x, want %#06x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint16()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int512(%#0130x).TryUint16() = %#06x, %t, want %#06x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt512_Uint32(t *testing.T) {
+	testCases := []struct {
+		a    Int512
+		want Uint32
+		ok   bool
+	}{
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0}, 0, true},
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0x1}, 1, true},
+		{Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 4294967295, false},
+		{Int512{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 4294967295, false},
+		{Int512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, 0, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint32()
+		if got != tc.want {
+			t.Errorf("Int512(%#0130x).Uint32() = %#010x, want %#010x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint32()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int512(%#0130x).TryUint32() = %#010x, %t, want %#010x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt512_Uint64(t *testing.T) {
+	testCases := []struct {
+		a    Int512
+		want Uint64
+		ok   bool
+	}{
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0}, 0, true},
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0x1}, 1, true},
+		{Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 18446744073709551615, false},
+		{Int512{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 18446744073709551615, false},
+		{Int512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, 0, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint64()
+		if got != tc.want {
+			t.Errorf("Int512(%#0130x).Uint64() = %#018x, want %#018x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint64()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int512(%#0130x).TryUint64() = %#018x, %t, want %#018x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt512_Uint128(t *testing.T) {
+	testCases := []struct {
+		a    Int512
+		want Uint128
+		ok   bool
+	}{
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0}, Uint128{0, 0}, true},
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0x1}, Uint128{0, 0x1}, true},
+		{Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint128{math.MaxUint64, math.MaxUint64}, false},
+		{Int512{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint128{math.MaxUint64, math.MaxUint64}, false},
+		{Int512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, Uint128{0, 0}, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint128()
+		if got != tc.want {
+			t.Errorf("Int512(%#0130x).Uint128() = %#034x, want %#034x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint128()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int512(%#0130x).TryUint128() = %#034x, %t, want %#034x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt512_Uint256(t *testing.T) {
+	testCases := []struct {
+		a    Int512
+		want Uint256
+		ok   bool
+	}{
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0}, Uint256{0, 0, 0, 0}, true},
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0x1}, Uint256{0, 0, 0, 0x1}, true},
+		{Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false},
+		{Int512{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false},
+		{Int512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, Uint256{0, 0, 0, 0}, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint256()
+		if got != tc.want {
+			t.Errorf("Int512(%#0130x).Uint256() = %#066x, want %#066x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint256()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int512(%#0130x).TryUint256() = %#066x, %t, want %#066x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt512_Uint512(t *testing.T) {
+	testCases := []struct {
+		a    Int512
+		want Uint512
+		ok   bool
+	}{
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0}, Uint512{0, 0, 0, 0, 0, 0, 0, 0}, true},
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0x1}, Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}, true},
+		{Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false},
+		{Int512{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint512{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, true},
+		{Int512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, Uint512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint512()
+		if got != tc.want {
+			t.Errorf("Int512(%#0130x).Uint512() = %#0130x, want %#0130x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint512()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int512(%#0130x).TryUint512() = %#0130x, %t, want %#0130x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt512_Uint1024(t *testing.T) {
+	testCases := []struct {
+		a    Int512
+		want Uint1024
+		ok   bool
+	}{
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0}, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, true},
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0x1}, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, true},
+		{Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false},
+		{Int512{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, true},
+		{Int512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint1024()
+		if got != tc.want {
+			t.Errorf("Int512(%#0130x).Uint1024() = %#0258x, want %#0258x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint1024()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int512(%#0130x).TryUint1024() = %#0258x, %t, want %#0258x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt1024_Uint8(t *testing.T) {
+	testCases := []struct {
+		a    Int1024
+		want Uint8
+		ok   bool
+	}{
+		{Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, 0, true},
+		{Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, 1, true},
+		{Int1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 255, false},
+		{Int1024{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 255, false},
+		{Int1024{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, 0, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint8()
+		if got != tc.want {
+			t.Errorf("Int1024(%#0258x).Uint8() = %#04x, want %#04x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint8()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int1024(%#0258x).TryUint8() = %#04x, %t, want %#04x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt1024_Uint16(t *testing.T) {
+	testCases := []struct {
+		a    Int1024
+		want Uint16
+		ok   bool
+	}{
+		{Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, 0, true},
+		{Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, 1, true},
+		{Int1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 65535, false},
+		{Int1024{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 65535, false},
+		{Int1024{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, 0, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint16()
+		if got != tc.want {
+			t.Errorf("Int1024(%#0258x).Uint16() = %#06x, want %#06x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint16()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int1024(%#0258x).TryUint16() = %#06x, %t, want %#06x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt1024_Uint32(t *testing.T) {
+	testCases := []struct {
+		a    Int1024
+		want Uint32
+		ok   bool
+	}{
+		{Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, 0, true},
+		{Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, 1, true},
+		{Int1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 4294967295, false},
+		{Int1024{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 4294967295, false},
+		{Int1024{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, 0, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint32()
+		if got != tc.want {
+			t.Errorf("Int1024(%#0258x).Uint32() = %#010x, want %#010x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint32()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int1024(%#0258x).TryUint32() = %#010x, %t, want %#010x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt1024_Uint64(t *testing.T) {
+	testCases := []struct {
+		a    Int1024
+		want Uint64
+		ok   bool
+	}{
+		{Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, 0, true},
+		{Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, 1, true},
+		{Int1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 18446744073709551615, false},
+		{Int1024{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 18446744073709551615, false},
+		{Int1024{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, 0, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint64()
+		if got != tc.want {
+			t.Errorf("Int1024(%#0258x).Uint64() = %#018x, want %#018x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint64()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int1024(%#0258x).TryUint64() = %#018x, %t, want %#018x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt1024_Uint128(t *testing.T) {
+	testCases := []struct {
+		a    Int1024
+		want Uint128
+		ok   bool
+	}{
+		{Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, Uint128{0, 0}, true},
+		{Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, Uint128{0, 0x1}, true},
+		{Int1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint128{math.MaxUint64, math.MaxUint64}, false},
+		{Int1024{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint128{math.MaxUint64, math.MaxUint64}, false},
+		{Int1024{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, Uint128{0, 0}, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint128()
+		if got != tc.want {
+			t.Errorf("Int1024(%#0258x).Uint128() = %#034x, want %#034x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint128()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int1024(%#0258x).TryUint128() = %#034x, %t, want %#034x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt1024_Uint256(t *testing.T) {
+	testCases := []struct {
+		a    Int1024
+		want Uint256
+		ok   bool
+	}{
+		{Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, Uint256{0, 0, 0, 0}, true},
+		{Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, Uint256{0, 0, 0, 0x1}, true},
+		{Int1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false},
+		{Int1024{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false},
+		{Int1024{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, Uint256{0, 0, 0, 0}, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint256()
+		if got != tc.want {
+			t.Errorf("Int1024(%#0258x).Uint256() = %#066x, want %#066x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint256()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int1024(%#0258x).TryUint256() = %#066x, %t, want %#066x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt1024_Uint512(t *testing.T) {
+	testCases := []struct {
+		a    Int1024
+		want Uint512
+		ok   bool
+	}{
+		{Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, Uint512{0, 0, 0, 0, 0, 0, 0, 0}, true},
+		{Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}, true},
+		{Int1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false},
+		{Int1024{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false},
+		{Int1024{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, Uint512{0, 0, 0, 0, 0, 0, 0, 0}, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint512()
+		if got != tc.want {
+			t.Errorf("Int1024(%#0258x).Uint512() = %#0130x, want %#0130x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint512()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int1024(%#0258x).TryUint512() = %#0130x, %t, want %#0130x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt1024_Uint1024(t *testing.T) {
+	testCases := []struct {
+		a    Int1024
+		want Uint1024
+		ok   bool
+	}{
+		{Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, true},
+		{Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, true},
+		{Int1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false},
+		{Int1024{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint1024{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, true},
+		{Int1024{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, Uint1024{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Uint1024()
+		if got != tc.want {
+			t.Errorf("Int1024(%#0258x).Uint1024() = %#0258x, want %#0258x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryUint1024()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int1024(%#0258x).TryUint1024() = %#0258x, %t, want %#0258x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint8_Int8(t *testing.T) {
+	testCases := []struct {
+		a    Uint8
+		want Int8
+		ok   bool
+	}{
+		{0, 0, true},
+		{1, 1, true},
+		{255, -1, false},
+		{128, -128, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int8()
+		if got != tc.want {
+			t.Errorf("Uint8(%#04x).Int8() = %#04x, want %#04x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt8()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint8(%#04x).TryInt8() = %#04x, %t, want %#04x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint8_Int16(t *testing.T) {
+	testCases := []struct {
+		a    Uint8
+		want Int16
+		ok   bool
+	}{
+		{0, 0, true},
+		{1, 1, true},
+		{255, 255, true},
+		{128, 128, true},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int16()
+		if got != tc.want {
+			t.Errorf("Uint8(%#04x).Int16() = %#06x, want %#06x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt16()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint8(%#04x).TryInt16() = %#06x, %t, want %#06x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint8_Int32(t *testing.T) {
+	testCases := []struct {
+		a    Uint8
+		want Int32
+		ok   bool
+	}{
+		{0, 0, true},
+		{1, 1, true},
+		{255, 255, true},
+		{128, 128, true},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int32()
+		if got != tc.want {
+			t.Errorf("Uint8(%#04x).Int32() = %#010x, want %#010x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt32()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint8(%#04x).TryInt32() = %#010x, %t, want %#010x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint8_Int64(t *testing.T) {
+	testCases := []struct {
+		a    Uint8
+		want Int64
+		ok   bool
+	}{
+		{0, 0, true},
+		{1, 1, true},
+		{255, 255, true},
+		{128, 128, true},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int64()
+		if got != tc.want {
+			t.Errorf("Uint8(%#04x).Int64() = %#018x, want %#018x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt64()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint8(%#04x).TryInt64() = %#018x, %t, want %#018x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint8_Int128(t *testing.T) {
+	testCases := []struct {
+		a    Uint8
+		want Int128
+		ok   bool
+	}{
+		{0, Int128{0, 0}, true},
+		{1, Int128{0, 0x1}, true},
+		{255, Int128{0, 0xff}, true},
+		{128, Int128{0, 0x80}, true},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int128()
+		if got != tc.want {
+			t.Errorf("Uint8(%#04x).Int128() = %#034x, want %#034x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt128()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint8(%#04x).TryInt128() = %#034x, %t, want %#034x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint8_Int256(t *testing.T) {
+	testCases := []struct {
+		a    Uint8
+		want Int256
+		ok   bool
+	}{
+		{0, Int256{0, 0, 0, 0}, true},
+		{1, Int256{0, 0, 0, 0x1}, true},
+		{255, Int256{0, 0, 0, 0xff}, true},
+		{128, Int256{0, 0, 0, 0x80}, true},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int256()
+		if got != tc.want {
+			t.Errorf("Uint8(%#04x).Int256() = %#066x, want %#066x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt256()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint8(%#04x).TryInt256() = %#066x, %t, want %#066x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint8_Int512(t *testing.T) {
+	testCases := []struct {
+		a    Uint8
+		want Int512
+		ok   bool
+	}{
+		{0, Int512{0, 0, 0, 0, 0, 0, 0, 0}, true},
+		{1, Int512{0, 0, 0, 0, 0, 0, 0, 0x1}, true},
+		{255, Int512{0, 0, 0, 0, 0, 0, 0, 0xff}, true},
+		{128, Int512{0, 0, 0, 0, 0, 0, 0, 0x80}, true},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int512()
+		if got != tc.want {
+			t.Errorf("Uint8(%#04x).Int512() = %#0130x, want %#0130x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt512()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint8(%#04x).TryInt512() = %#0130x, %t, want %#0130x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint8_Int1024(t *testing.T) {
+	testCases := []struct {
+		a    Uint8
+		want Int1024
+		ok   bool
+	}{
+		{0, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, true},
+		{1, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, true},
+		{255, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0xff}, true},
+		{128, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x80}, true},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int1024()
+		if got != tc.want {
+			t.Errorf("Uint8(%#04x).Int1024() = %#0258x, want %#0258x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt1024()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint8(%#04x).TryInt1024() = %#0258x, %t, want %#0258x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint16_Int8(t *testing.T) {
+	testCases := []struct {
+		a    Uint16
+		want Int8
+		ok   bool
+	}{
+		{0, 0, true},
+		{1, 1, true},
+		{65535, -1, false},
+		{32768, 0, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int8()
+		if got != tc.want {
+			t.Errorf("Uint16(%#06x).Int8() = %#04x, want %#04x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt8()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint16(%#06x).TryInt8() = %#04x, %t, want %#04x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint16_Int16(t *testing.T) {
+	testCases := []struct {
+		a    Uint16
+		want Int16
+		ok   bool
+	}{
+		{0, 0, true},
+		{1, 1, true},
+		{65535, -1, false},
+		{32768, -32768, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int16()
+		if got != tc.want {
+			t.Errorf("Uint16(%#06x).Int16() = %#06x, want %#06x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt16()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint16(%#06x).TryInt16() = %#06x, %t, want %#06x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint16_Int32(t *testing.T) {
+	testCases := []struct {
+		a    Uint16
+		want Int32
+		ok   bool
+	}{
+		{0, 0, true},
+		{1, 1, true},
+		{65535, 65535, true},
+		{32768, 32768, true},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int32()
+		if got != tc.want {
+			t.Errorf("Uint16(%#06x).Int32() = %#010x, want %#010x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt32()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint16(%#06x).TryInt32() = %#010x, %t, want %#010x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint16_Int64(t *testing.T) {
+	testCases := []struct {
+		a    Uint16
+		want Int64
+		ok   bool
+	}{
+		{0, 0, true},
+		{1, 1, true},
+		{65535, 65535, true},
+		{32768, 32768, true},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int64()
+		if got != tc.want {
+			t.Errorf("Uint16(%#06x).Int64() = %#018x, want %#018x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt64()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint16(%#06x).TryInt64() = %#018x, %t, want %#018x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint16_Int128(t *testing.T) {
+	testCases := []struct {
+		a    Uint16
+		want Int128
+		ok   bool
+	}{
+		{0, Int128{0, 0}, true},
+		{1, Int128{0, 0x1}, true},
+		{65535, Int128{0, 0xffff}, true},
+		{32768, Int128{0, 0x8000}, true},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int128()
+		if got != tc.want {
+			t.Errorf("Uint16(%#06x).Int128() = %#034x, want %#034x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt128()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint16(%#06x).TryInt128() = %#034x, %t, want %#034x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint16_Int256(t *testing.T) {
+	testCases := []struct {
+		a    Uint16
+		want Int256
+		ok   bool
+	}{
+		{0, Int256{0, 0, 0, 0}, true},
+		{1, Int256{0, 0, 0, 0x1}, true},
+		{65535, Int256{0, 0, 0, 0xffff}, true},
+		{32768, Int256{0, 0, 0, 0x8000}, true},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int256()
+		if got != tc.want {
+			t.Errorf("Uint16(%#06x).Int256() = %#066x, want %#066x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt256()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint16(%#06x).TryInt256() = %#066x, %t, want %#066x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint16_Int512(t *testing.T) {
+	testCases := []struct {
+		a    Uint16
+		want Int512
+		ok   bool
+	}{
+		{0, Int512{0, 0, 0, 0, 0, 0, 0, 0}, true},
+		{1, Int512{0, 0, 0, 0, 0, 0, 0, 0x1}, true},
+		{65535, Int512{0, 0, 0, 0, 0, 0, 0, 0xffff}, true},
+		{32768, Int512{0, 0, 0, 0, 0, 0, 0, 0x8000}, true},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int512()
+		if got != tc.want {
+			t.Errorf("Uint16(%#06x).Int512() = %#0130x, want %#0130x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt512()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint16(%#06x).TryInt512() = %#0130x, %t, want %#0130x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint16_Int1024(t *testing.T) {
+	testCases := []struct {
+		a    Uint16
+		want Int1024
+		ok   bool
+	}{
+		{0, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, true},
+		{1, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, true},
+		{65535, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0xffff}, true},
+		{32768, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x8000}, true},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int1024()
+		if got != tc.want {
+			t.Errorf("Uint16(%#06x).Int1024() = %#0258x, want %#0258x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt1024()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint16(%#06x).TryInt1024() = %#0258x, %t, want %#0258x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint32_Int8(t *testing.T) {
+	testCases := []struct {
+		a    Uint32
+		want Int8
+		ok   bool
+	}{
+		{0, 0, true},
+		{1, 1, true},
+		{4294967295, -1, false},
+		{2147483648, 0, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int8()
+		if got != tc.want {
+			t.Errorf("Uint32(%#010x).Int8() = %#04x, want %#04x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt8()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint32(%#010x).TryInt8() = %#04x, %t, want %#04x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint32_Int16(t *testing.T) {
+	testCases := []struct {
+		a    Uint32
+		want Int16
+		ok   bool
+	}{
+		{0, 0, true},
+		{1, 1, true},
+		{4294967295, -1, false},
+		{2147483648, 0, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int16()
+		if got != tc.want {
+			t.Errorf("Uint32(%#010x).Int16() = %#06x, want %#06x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt16()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint32(%#010x).TryInt16() = %#06x, %t, want %#06x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint32_Int32(t *testing.T) {
+	testCases := []struct {
+		a    Uint32
+		want Int32
+		ok   bool
+	}{
+		{0, 0, true},
+		{1, 1, true},
+		{4294967295, -1, false},
+		{2147483648, -2147483648, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int32()
+		if got != tc.want {
+			t.Errorf("Uint32(%#010x).Int32() = %#010x, want %#010x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt32()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint32(%#010x).TryInt32() = %#010x, %t, want %#010x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint32_Int64(t *testing.T) {
+	testCases := []struct {
+		a    Uint32
+		want Int64
+		ok   bool
+	}{
+		{0, 0, true},
+		{1, 1, true},
+		{4294967295, 4294967295, true},
+		{2147483648, 2147483648, true},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int64()
+		if got != tc.want {
+			t.Errorf("Uint32(%#010x).Int64() = %#018x, want %#018x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt64()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint32(%#010x).TryInt64() = %#018x, %t, want %#018x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint32_Int128(t *testing.T) {
+	testCases := []struct {
+		a    Uint32
+		want Int128
+		ok   bool
+	}{
+		{0, Int128{0, 0}, true},
+		{1, Int128{0, 0x1}, true},
+		{4294967295, Int128{0, 0xffffffff}, true},
+		{2147483648, Int128{0, 0x80000000}, true},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int128()
+		if got != tc.want {
+			t.Errorf("Uint32(%#010x).Int128() = %#034x, want %#034x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt128()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint32(%#010x).TryInt128() = %#034x, %t, want %#034x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint32_Int256(t *testing.T) {
+	testCases := []struct {
+		a    Uint32
+		want Int256
+		ok   bool
+	}{
+		{0, Int256{0, 0, 0, 0}, true},
+		{1, Int256{0, 0, 0, 0x1}, true},
+		{4294967295, Int256{0, 0, 0, 0xffffffff}, true},
+		{2147483648, Int256{0, 0, 0, 0x80000000}, true},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int256()
+		if got != tc.want {
+			t.Errorf("Uint32(%#010x).Int256() = %#066x, want %#066x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt256()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint32(%#010x).TryInt256() = %#066x, %t, want %#066x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint32_Int512(t *testing.T) {
+	testCases := []struct {
+		a    Uint32
+		want Int512
+		ok   bool
+	}{
+		{0, Int512{0, 0, 0, 0, 0, 0, 0, 0}, true},
+		{1, Int512{0, 0, 0, 0, 0, 0, 0, 0x1}, true},
+		{4294967295, Int512{0, 0, 0, 0, 0, 0, 0, 0xffffffff}, true},
+		{2147483648, Int512{0, 0, 0, 0, 0, 0, 0, 0x80000000}, true},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int512()
+		if got != tc.want {
+			t.Errorf("Uint32(%#010x).Int512() = %#0130x, want %#0130x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt512()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint32(%#010x).TryInt512() = %#0130x, %t, want %#0130x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint32_Int1024(t *testing.T) {
+	testCases := []struct {
+		a    Uint32
+		want Int1024
+		ok   bool
+	}{
+		{0, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, true},
+		{1, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, true},
+		{4294967295, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0xffffffff}, true},
+		{2147483648, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x80000000}, true},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int1024()
+		if got != tc.want {
+			t.Errorf("Uint32(%#010x).Int1024() = %#0258x, want %#0258x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt1024()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint32(%#010x).TryInt1024() = %#0258x, %t, want %#0258x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint64_Int8(t *testing.T) {
+	testCases := []struct {
+		a    Uint64
+		want Int8
+		ok   bool
+	}{
+		{0, 0, true},
+		{1, 1, true},
+		{18446744073709551615, -1, false},
+		{9223372036854775808, 0, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int8()
+		if got != tc.want {
+			t.Errorf("Uint64(%#018x).Int8() = %#04x, want %#04x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt8()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint64(%#018x).TryInt8() = %#04x, %t, want %#04x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint64_Int16(t *testing.T) {
+	testCases := []struct {
+		a    Uint64
+		want Int16
+		ok   bool
+	}{
+		{0, 0, true},
+		{1, 1, true},
+		{18446744073709551615, -1, false},
+		{9223372036854775808, 0, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int16()
+		if got != tc.want {
+			t.Errorf("Uint64(%#018x).Int16() = %#06x, want %#06x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt16()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint64(%#018x).TryInt16() = %#06x, %t, want %#06x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint64_Int32(t *testing.T) {
+	testCases := []struct {
+		a    Uint64
+		want Int32
+		ok   bool
+	}{
+		{0, 0, true},
+		{1, 1, true},
+		{18446744073709551615, -1, false},
+		{9223372036854775808, 0, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int32()
+		if got != tc.want {
+			t.Errorf("Uint64(%#018x).Int32() = %#010x, want %#010x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt32()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint64(%#018x).TryInt32() = %#010x, %t, want %#010x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint64_Int64(t *testing.T) {
+	testCases := []struct {
+		a    Uint64
+		want Int64
+		ok   bool
+	}{
+		{0, 0, true},
+		{1, 1, true},
+		{18446744073709551615, -1, false},
+		{9223372036854775808, -9223372036854775808, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int64()
+		if got != tc.want {
+			t.Errorf("Uint64(%#018x).Int64() = %#018x, want %#018x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt64()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint64(%#018x).TryInt64() = %#018x, %t, want %#018x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint64_Int128(t *testing.T) {
+	testCases := []struct {
+		a    Uint64
+		want Int128
+		ok   bool
+	}{
+		{0, Int128{0, 0}, true},
+		{1, Int128{0, 0x1}, true},
+		{18446744073709551615, Int128{0, math.MaxUint64}, true},
+		{9223372036854775808, Int128{0, 0x8000000000000000}, true},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int128()
+		if got != tc.want {
+			t.Errorf("Uint64(%#018x).Int128() = %#034x, want %#034x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt128()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint64(%#018x).TryInt128() = %#034x, %t, want %#034x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint64_Int256(t *testing.T) {
+	testCases := []struct {
+		a    Uint64
+		want Int256
+		ok   bool
+	}{
+		{0, Int256{0, 0, 0, 0}, true},
+		{1, Int256{0, 0, 0, 0x1}, true},
+		{18446744073709551615, Int256{0, 0, 0, math.MaxUint64}, true},
+		{9223372036854775808, Int256{0, 0, 0, 0x8000000000000000}, true},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int256()
+		if got != tc.want {
+			t.Errorf("Uint64(%#018x).Int256() = %#066x, want %#066x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt256()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint64(%#018x).TryInt256() = %#066x, %t, want %#066x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint64_Int512(t *testing.T) {
+	testCases := []struct {
+		a    Uint64
+		want Int512
+		ok   bool
+	}{
+		{0, Int512{0, 0, 0, 0, 0, 0, 0, 0}, true},
+		{1, Int512{0, 0, 0, 0, 0, 0, 0, 0x1}, true},
+		{18446744073709551615, Int512{0, 0, 0, 0, 0, 0, 0, math.MaxUint64}, true},
+		{9223372036854775808, Int512{0, 0, 0, 0, 0, 0, 0, 0x8000000000000000}, true},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int512()
+		if got != tc.want {
+			t.Errorf("Uint64(%#018x).Int512() = %#0130x, want %#0130x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt512()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint64(%#018x).TryInt512() = %#0130x, %t, want %#0130x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint64_Int1024(t *testing.T) {
+	testCases := []struct {
+		a    Uint64
+		want Int1024
+		ok   bool
+	}{
+		{0, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, true},
+		{1, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, true},
+		{18446744073709551615, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, math.MaxUint64}, true},
+		{9223372036854775808, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x8000000000000000}, true},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int1024()
+		if got != tc.want {
+			t.Errorf("Uint64(%#018x).Int1024() = %#0258x, want %#0258x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt1024()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint64(%#018x).TryInt1024() = %#0258x, %t, want %#0258x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint128_Int8(t *testing.T) {
+	testCases := []struct {
+		a    Uint128
+		want Int8
+		ok   bool
+	}{
+		{Uint128{0, 0}, 0, true},
+		{Uint128{0, 0x1}, 1, true},
+		{Uint128{math.MaxUint64, math.MaxUint64}, -1, false},
+		{Uint128{0x8000000000000000, 0}, 0, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int8()
+		if got != tc.want {
+			t.Errorf("Uint128(%#034x).Int8() = %#04x, want %#04x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt8()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint128(%#034x).TryInt8() = %#04x, %t, want %#04x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint128_Int16(t *testing.T) {
+	testCases := []struct {
+		a    Uint128
+		want Int16
+		ok   bool
+	}{
+		{Uint128{0, 0}, 0, true},
+		{Uint128{0, 0x1}, 1, true},
+		{Uint128{math.MaxUint64, math.MaxUint64}, -1, false},
+		{Uint128{0x8000000000000000, 0}, 0, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int16()
+		if got != tc.want {
+			t.Errorf("Uint128(%#034x).Int16() = %#06x, want %#06x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt16()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint128(%#034x).TryInt16() = %#06x, %t, want %#06x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint128_Int32(t *testing.T) {
+	testCases := []struct {
+		a    Uint128
+		want Int32
+		ok   bool
+	}{
+		{Uint128{0, 0}, 0, true},
+		{Uint128{0, 0x1}, 1, true},
+		{Uint128{math.MaxUint64, math.MaxUint64}, -1, false},
+		{Uint128{0x8000000000000000, 0}, 0, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int32()
+		if got != tc.want {
+			t.Errorf("Uint128(%#034x).Int32() = %#010x, want %#010x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt32()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint128(%#034x).TryInt32() = %#010x, %t, want %#010x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint128_Int64(t *testing.T) {
+	testCases := []struct {
+		a    Uint128
+		want Int64
+		ok   bool
+	}{
+		{Uint128{0, 0}, 0, true},
+		{Uint128{0, 0x1}, 1, true},
+		{Uint128{math.MaxUint64, math.MaxUint64}, -1, false},
+		{Uint128{0x8000000000000000, 0}, 0, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int64()
+		if got != tc.want {
+			t.Errorf("Uint128(%#034x).Int64() = %#018x, want %#018x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt64()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint128(%#034x).TryInt64() = %#018x, %t, want %#018x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint128_Int128(t *testing.T) {
+	testCases := []struct {
+		a    Uint128
+		want Int128
+		ok   bool
+	}{
+		{Uint128{0, 0}, Int128{0, 0}, true},
+		{Uint128{0, 0x1}, Int128{0, 0x1}, true},
+		{Uint128{math.MaxUint64, math.MaxUint64}, Int128{math.MaxUint64, math.MaxUint64}, false},
+		{Uint128{0x8000000000000000, 0}, Int128{0x8000000000000000, 0}, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int128()
+		if got != tc.want {
+			t.Errorf("Uint128(%#034x).Int128() = %#034x, want %#034x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt128()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint128(%#034x).TryInt128() = %#034x, %t, want %#034x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint128_Int256(t *testing.T) {
+	testCases := []struct {
+		a    Uint128
+		want Int256
+		ok   bool
+	}{
+		{Uint128{0, 0}, Int256{0, 0, 0, 0}, true},
+		{Uint128{0, 0x1}, Int256{0, 0, 0, 0x1}, true},
+		{Uint128{math.MaxUint64, math.MaxUint64}, Int256{0, 0, math.MaxUint64, math.MaxUint64}, true},
+		{Uint128{0x8000000000000000, 0}, Int256{0, 0, 0x8000000000000000, 0}, true},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int256()
+		if got != tc.want {
+			t.Errorf("Uint128(%#034x).Int256() = %#066x, want %#066x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt256()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint128(%#034x).TryInt256() = %#066x, %t, want %#066x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint128_Int512(t *testing.T) {
+	testCases := []struct {
+		a    Uint128
+		want Int512
+		ok   bool
+	}{
+		{Uint128{0, 0}, Int512{0, 0, 0, 0, 0, 0, 0, 0}, true},
+		{Uint128{0, 0x1}, Int512{0, 0, 0, 0, 0, 0, 0, 0x1}, true},
+		{Uint128{math.MaxUint64, math.MaxUint64}, Int512{0, 0, 0, 0, 0, 0, math.MaxUint64, math.MaxUint64}, true},
+		{Uint128{0x8000000000000000, 0}, Int512{0, 0, 0, 0, 0, 0, 0x8000000000000000, 0}, true},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int512()
+		if got != tc.want {
+			t.Errorf("Uint128(%#034x).Int512() = %#0130x, want %#0130x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt512()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint128(%#034x).TryInt512() = %#0130x, %t, want %#0130x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint128_Int1024(t *testing.T) {
+	testCases := []struct {
+		a    Uint128
+		want Int1024
+		ok   bool
+	}{
+		{Uint128{0, 0}, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, true},
+		{Uint128{0, 0x1}, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, true},
+		{Uint128{math.MaxUint64, math.MaxUint64}, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, math.MaxUint64, math.MaxUint64}, true},
+		{Uint128{0x8000000000000000, 0}, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x8000000000000000, 0}, true},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int1024()
+		if got != tc.want {
+			t.Errorf("Uint128(%#034x).Int1024() = %#0258x, want %#0258x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt1024()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint128(%#034x).TryInt1024() = %#0258x, %t, want %#0258x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint256_Int8(t *testing.T) {
+	testCases := []struct {
+		a    Uint256
+		want Int8
+		ok   bool
+	}{
+		{Uint256{0, 0, 0, 0}, 0, true},
+		{Uint256{0, 0, 0, 0x1}, 1, true},
+		{Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, -1, false},
+		{Uint256{0x8000000000000000, 0, 0, 0}, 0, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int8()
+		if got != tc.want {
+			t.Errorf("Uint256(%#066x).Int8() = %#04x, want %#04x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt8()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint256(%#066x).TryInt8() = %#04x, %t, want %#04x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint256_Int16(t *testing.T) {
+	testCases := []struct {
+		a    Uint256
+		want Int16
+		ok   bool
+	}{
+		{Uint256{0, 0, 0, 0}, 0, true},
+		{Uint256{0, 0, 0, 0x1}, 1, true},
+		{Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, -1, false},
+		{Uint256{0x8000000000000000, 0, 0, 0}, 0, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int16()
+		if got != tc.want {
+			t.Errorf("Uint256(%#066x).Int16() = %#06x, want %#06x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt16()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint256(%#066x).TryInt16() = %#06x, %t, want %#06x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint256_Int32(t *testing.T) {
+	testCases := []struct {
+		a    Uint256
+		want Int32
+		ok   bool
+	}{
+		{Uint256{0, 0, 0, 0}, 0, true},
+		{Uint256{0, 0, 0, 0x1}, 1, true},
+		{Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, -1, false},
+		{Uint256{0x8000000000000000, 0, 0, 0}, 0, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int32()
+		if got != tc.want {
+			t.Errorf("Uint256(%#066x).Int32() = %#010x, want %#010x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt32()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint256(%#066x).TryInt32() = %#010x, %t, want %#010x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint256_Int64(t *testing.T) {
+	testCases := []struct {
+		a    Uint256
+		want Int64
+		ok   bool
+	}{
+		{Uint256{0, 0, 0, 0}, 0, true},
+		{Uint256{0, 0, 0, 0x1}, 1, true},
+		{Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, -1, false},
+		{Uint256{0x8000000000000000, 0, 0, 0}, 0, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int64()
+		if got != tc.want {
+			t.Errorf("Uint256(%#066x).Int64() = %#018x, want %#018x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt64()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint256(%#066x).TryInt64() = %#018x, %t, want %#018x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint256_Int128(t *testing.T) {
+	testCases := []struct {
+		a    Uint256
+		want Int128
+		ok   bool
+	}{
+		{Uint256{0, 0, 0, 0}, Int128{0, 0}, true},
+		{Uint256{0, 0, 0, 0x1}, Int128{0, 0x1}, true},
+		{Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Int128{math.MaxUint64, math.MaxUint64}, false},
+		{Uint256{0x8000000000000000, 0, 0, 0}, Int128{0, 0}, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int128()
+		if got != tc.want {
+			t.Errorf("Uint256(%#066x).Int128() = %#034x, want %#034x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt128()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint256(%#066x).TryInt128() = %#034x, %t, want %#034x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint256_Int256(t *testing.T) {
+	testCases := []struct {
+		a    Uint256
+		want Int256
+		ok   bool
+	}{
+		{Uint256{0, 0, 0, 0}, Int256{0, 0, 0, 0}, true},
+		{Uint256{0, 0, 0, 0x1}, Int256{0, 0, 0, 0x1}, true},
+		{Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false},
+		{Uint256{0x8000000000000000, 0, 0, 0}, Int256{0x8000000000000000, 0, 0, 0}, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int256()
+		if got != tc.want {
+			t.Errorf("Uint256(%#066x).Int256() = %#066x, want %#066x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt256()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint256(%#066x).TryInt256() = %#066x, %t, want %#066x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint256_Int512(t *testing.T) {
+	testCases := []struct {
+		a    Uint256
+		want Int512
+		ok   bool
+	}{
+		{Uint256{0, 0, 0, 0}, Int512{0, 0, 0, 0, 0, 0, 0, 0}, true},
+		{Uint256{0, 0, 0, 0x1}, Int512{0, 0, 0, 0, 0, 0, 0, 0x1}, true},
+		{Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Int512{0, 0, 0, 0, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, true},
+		{Uint256{0x8000000000000000, 0, 0, 0}, Int512{0, 0, 0, 0, 0x8000000000000000, 0, 0, 0}, true},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int512()
+		if got != tc.want {
+			t.Errorf("Uint256(%#066x).Int512() = %#0130x, want %#0130x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt512()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint256(%#066x).TryInt512() = %#0130x, %t, want %#0130x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint256_Int1024(t *testing.T) {
+	testCases := []struct {
+		a    Uint256
+		want Int1024
+		ok   bool
+	}{
+		{Uint256{0, 0, 0, 0}, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, true},
+		{Uint256{0, 0, 0, 0x1}, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, true},
+		{Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, true},
+		{Uint256{0x8000000000000000, 0, 0, 0}, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x8000000000000000, 0, 0, 0}, true},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int1024()
+		if got != tc.want {
+			t.Errorf("Uint256(%#066x).Int1024() = %#0258x, want %#0258x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt1024()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint256(%#066x).TryInt1024() = %#0258x, %t, want %#0258x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint512_Int8(t *testing.T) {
+	testCases := []struct {
+		a    Uint512
+		want Int8
+		ok   bool
+	}{
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0}, 0, true},
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}, 1, true},
+		{Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, -1, false},
+		{Uint512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, 0, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int8()
+		if got != tc.want {
+			t.Errorf("Uint512(%#0130x).Int8() = %#04x, want %#04x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt8()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint512(%#0130x).TryInt8() = %#04x, %t, want %#04x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint512_Int16(t *testing.T) {
+	testCases := []struct {
+		a    Uint512
+		want Int16
+		ok   bool
+	}{
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0}, 0, true},
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}, 1, true},
+		{Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, -1, false},
+		{Uint512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, 0, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int16()
+		if got != tc.want {
+			t.Errorf("Uint512(%#0130x).Int16() = %#06x, want %#06x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt16()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint512(%#0130x).TryInt16() = %#06x, %t, want %#06x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint512_Int32(t *testing.T) {
+	testCases := []struct {
+		a    Uint512
+		want Int32
+		ok   bool
+	}{
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0}, 0, true},
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}, 1, true},
+		{Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, -1, false},
+		{Uint512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, 0, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int32()
+		if got != tc.want {
+			t.Errorf("Uint512(%#0130x).Int32() = %#010x, want %#010x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt32()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint512(%#0130x).TryInt32() = %#010x, %t, want %#010x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint512_Int64(t *testing.T) {
+	testCases := []struct {
+		a    Uint512
+		want Int64
+		ok   bool
+	}{
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0}, 0, true},
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}, 1, true},
+		{Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, -1, false},
+		{Uint512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, 0, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int64()
+		if got != tc.want {
+			t.Errorf("Uint512(%#0130x).Int64() = %#018x, want %#018x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt64()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint512(%#0130x).TryInt64() = %#018x, %t, want %#018x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint512_Int128(t *testing.T) {
+	testCases := []struct {
+		a    Uint512
+		want Int128
+		ok   bool
+	}{
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0}, Int128{0, 0}, true},
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}, Int128{0, 0x1}, true},
+		{Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Int128{math.MaxUint64, math.MaxUint64}, false},
+		{Uint512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, Int128{0, 0}, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int128()
+		if got != tc.want {
+			t.Errorf("Uint512(%#0130x).Int128() = %#034x, want %#034x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt128()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint512(%#0130x).TryInt128() = %#034x, %t, want %#034x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint512_Int256(t *testing.T) {
+	testCases := []struct {
+		a    Uint512
+		want Int256
+		ok   bool
+	}{
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0}, Int256{0, 0, 0, 0}, true},
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}, Int256{0, 0, 0, 0x1}, true},
+		{Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false},
+		{Uint512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, Int256{0, 0, 0, 0}, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int256()
+		if got != tc.want {
+			t.Errorf("Uint512(%#0130x).Int256() = %#066x, want %#066x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt256()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint512(%#0130x).TryInt256() = %#066x, %t, want %#066x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint512_Int512(t *testing.T) {
+	testCases := []struct {
+		a    Uint512
+		want Int512
+		ok   bool
+	}{
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0}, Int512{0, 0, 0, 0, 0, 0, 0, 0}, true},
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}, Int512{0, 0, 0, 0, 0, 0, 0, 0x1}, true},
+		{Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false},
+		{Uint512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, Int512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int512()
+		if got != tc.want {
+			t.Errorf("Uint512(%#0130x).Int512() = %#0130x, want %#0130x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt512()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint512(%#0130x).TryInt512() = %#0130x, %t, want %#0130x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint512_Int1024(t *testing.T) {
+	testCases := []struct {
+		a    Uint512
+		want Int1024
+		ok   bool
+	}{
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0}, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, true},
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, true},
+		{Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Int1024{0, 0, 0, 0, 0, 0, 0, 0, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, true},
+		{Uint512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, true},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int1024()
+		if got != tc.want {
+			t.Errorf("Uint512(%#0130x).Int1024() = %#0258x, want %#0258x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt1024()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint512(%#0130x).TryInt1024() = %#0258x, %t, want %#0258x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint1024_Int8(t *testing.T) {
+	testCases := []struct {
+		a    Uint1024
+		want Int8
+		ok   bool
+	}{
+		{Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, 0, true},
+		{Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, 1, true},
+		{Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, -1, false},
+		{Uint1024{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, 0, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int8()
+		if got != tc.want {
+			t.Errorf("Uint1024(%#0258x).Int8() = %#04x, want %#04x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt8()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint1024(%#0258x).TryInt8() = %#04x, %t, want %#04x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint1024_Int16(t *testing.T) {
+	testCases := []struct {
+		a    Uint1024
+		want Int16
+		ok   bool
+	}{
+		{Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, 0, true},
+		{Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, 1, true},
+		{Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, -1, false},
+		{Uint1024{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, 0, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int16()
+		if got != tc.want {
+			t.Errorf("Uint1024(%#0258x).Int16() = %#06x, want %#06x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt16()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint1024(%#0258x).TryInt16() = %#06x, %t, want %#06x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint1024_Int32(t *testing.T) {
+	testCases := []struct {
+		a    Uint1024
+		want Int32
+		ok   bool
+	}{
+		{Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, 0, true},
+		{Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, 1, true},
+		{Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, -1, false},
+		{Uint1024{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, 0, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int32()
+		if got != tc.want {
+			t.Errorf("Uint1024(%#0258x).Int32() = %#010x, want %#010x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt32()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint1024(%#0258x).TryInt32() = %#010x, %t, want %#010x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint1024_Int64(t *testing.T) {
+	testCases := []struct {
+		a    Uint1024
+		want Int64
+		ok   bool
+	}{
+		{Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, 0, true},
+		{Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, 1, true},
+		{Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, -1, false},
+		{Uint1024{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, 0, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int64()
+		if got != tc.want {
+			t.Errorf("Uint1024(%#0258x).Int64() = %#018x, want %#018x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt64()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint1024(%#0258x).TryInt64() = %#018x, %t, want %#018x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint1024_Int128(t *testing.T) {
+	testCases := []struct {
+		a    Uint1024
+		want Int128
+		ok   bool
+	}{
+		{Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, Int128{0, 0}, true},
+		{Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, Int128{0, 0x1}, true},
+		{Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Int128{math.MaxUint64, math.MaxUint64}, false},
+		{Uint1024{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, Int128{0, 0}, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int128()
+		if got != tc.want {
+			t.Errorf("Uint1024(%#0258x).Int128() = %#034x, want %#034x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt128()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint1024(%#0258x).TryInt128() = %#034x, %t, want %#034x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint1024_Int256(t *testing.T) {
+	testCases := []struct {
+		a    Uint1024
+		want Int256
+		ok   bool
+	}{
+		{Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, Int256{0, 0, 0, 0}, true},
+		{Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, Int256{0, 0, 0, 0x1}, true},
+		{Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false},
+		{Uint1024{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, Int256{0, 0, 0, 0}, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int256()
+		if got != tc.want {
+			t.Errorf("Uint1024(%#0258x).Int256() = %#066x, want %#066x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt256()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint1024(%#0258x).TryInt256() = %#066x, %t, want %#066x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint1024_Int512(t *testing.T) {
+	testCases := []struct {
+		a    Uint1024
+		want Int512
+		ok   bool
+	}{
+		{Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, Int512{0, 0, 0, 0, 0, 0, 0, 0}, true},
+		{Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, Int512{0, 0, 0, 0, 0, 0, 0, 0x1}, true},
+		{Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false},
+		{Uint1024{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, Int512{0, 0, 0, 0, 0, 0, 0, 0}, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int512()
+		if got != tc.want {
+			t.Errorf("Uint1024(%#0258x).Int512() = %#0130x, want %#0130x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt512()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint1024(%#0258x).TryInt512() = %#0130x, %t, want %#0130x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint1024_Int1024(t *testing.T) {
+	testCases := []struct {
+		a    Uint1024
+		want Int1024
+		ok   bool
+	}{
+		{Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, true},
+		{Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, true},
+		{Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Int1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false},
+		{Uint1024{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, Int1024{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Int1024()
+		if got != tc.want {
+			t.Errorf("Uint1024(%#0258x).Int1024() = %#0258x, want %#0258x", tc.a, got, tc.want)
+		}
+
+		got, ok := tc.a.TryInt1024()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint1024(%#0258x).TryInt1024() = %#0258x, %t, want %#0258x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt8_TryInt8(t *testing.T) {
+	testCases := []struct {
+		a    Int8
+		want Int8
+		ok   bool
+		sat  Int8
+	}{
+		{0, 0, true, 0},
+		{1, 1, true, 1},
+		{-1, -1, true, -1},
+		{127, 127, true, 127},
+		{-128, -128, true, -128},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt8()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int8(%#04x).TryInt8() = %#04x, %t, want %#04x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt8()
+		if got != tc.sat {
+			t.Errorf("Int8(%#04x).SaturatingInt8() = %#04x, want %#04x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt8_TryInt16(t *testing.T) {
+	testCases := []struct {
+		a    Int8
+		want Int16
+		ok   bool
+		sat  Int16
+	}{
+		{0, 0, true, 0},
+		{1, 1, true, 1},
+		{-1, -1, true, -1},
+		{127, 127, true, 127},
+		{-128, -128, true, -128},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt16()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int8(%#04x).TryInt16() = %#06x, %t, want %#06x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt16()
+		if got != tc.sat {
+			t.Errorf("Int8(%#04x).SaturatingInt16() = %#06x, want %#06x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt8_TryInt32(t *testing.T) {
+	testCases := []struct {
+		a    Int8
+		want Int32
+		ok   bool
+		sat  Int32
+	}{
+		{0, 0, true, 0},
+		{1, 1, true, 1},
+		{-1, -1, true, -1},
+		{127, 127, true, 127},
+		{-128, -128, true, -128},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt32()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int8(%#04x).TryInt32() = %#010x, %t, want %#010x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt32()
+		if got != tc.sat {
+			t.Errorf("Int8(%#04x).SaturatingInt32() = %#010x, want %#010x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt8_TryInt64(t *testing.T) {
+	testCases := []struct {
+		a    Int8
+		want Int64
+		ok   bool
+		sat  Int64
+	}{
+		{0, 0, true, 0},
+		{1, 1, true, 1},
+		{-1, -1, true, -1},
+		{127, 127, true, 127},
+		{-128, -128, true, -128},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt64()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int8(%#04x).TryInt64() = %#018x, %t, want %#018x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt64()
+		if got != tc.sat {
+			t.Errorf("Int8(%#04x).SaturatingInt64() = %#018x, want %#018x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt8_TryInt128(t *testing.T) {
+	testCases := []struct {
+		a    Int8
+		want Int128
+		ok   bool
+		sat  Int128
+	}{
+		{0, Int128{0, 0}, true, Int128{0, 0}},
+		{1, Int128{0, 0x1}, true, Int128{0, 0x1}},
+		{-1, Int128{math.MaxUint64, math.MaxUint64}, true, Int128{math.MaxUint64, math.MaxUint64}},
+		{127, Int128{0, 0x7f}, true, Int128{0, 0x7f}},
+		{-128, Int128{math.MaxUint64, 0xffffffffffffff80}, true, Int128{math.MaxUint64, 0xffffffffffffff80}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt128()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int8(%#04x).TryInt128() = %#034x, %t, want %#034x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt128()
+		if got != tc.sat {
+			t.Errorf("Int8(%#04x).SaturatingInt128() = %#034x, want %#034x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt8_TryInt256(t *testing.T) {
+	testCases := []struct {
+		a    Int8
+		want Int256
+		ok   bool
+		sat  Int256
+	}{
+		{0, Int256{0, 0, 0, 0}, true, Int256{0, 0, 0, 0}},
+		{1, Int256{0, 0, 0, 0x1}, true, Int256{0, 0, 0, 0x1}},
+		{-1, Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, true, Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{127, Int256{0, 0, 0, 0x7f}, true, Int256{0, 0, 0, 0x7f}},
+		{-128, Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xffffffffffffff80}, true, Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xffffffffffffff80}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt256()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int8(%#04x).TryInt256() = %#066x, %t, want %#066x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt256()
+		if got != tc.sat {
+			t.Errorf("Int8(%#04x).SaturatingInt256() = %#066x, want %#066x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt8_TryInt512(t *testing.T) {
+	testCases := []struct {
+		a    Int8
+		want Int512
+		ok   bool
+		sat  Int512
+	}{
+		{0, Int512{0, 0, 0, 0, 0, 0, 0, 0}, true, Int512{0, 0, 0, 0, 0, 0, 0, 0}},
+		{1, Int512{0, 0, 0, 0, 0, 0, 0, 0x1}, true, Int512{0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{-1, Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, true, Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{127, Int512{0, 0, 0, 0, 0, 0, 0, 0x7f}, true, Int512{0, 0, 0, 0, 0, 0, 0, 0x7f}},
+		{-128, Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xffffffffffffff80}, true, Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xffffffffffffff80}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt512()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int8(%#04x).TryInt512() = %#0130x, %t, want %#0130x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt512()
+		if got != tc.sat {
+			t.Errorf("Int8(%#04x).SaturatingInt512() = %#0130x, want %#0130x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt8_TryInt1024(t *testing.T) {
+	testCases := []struct {
+		a    Int8
+		want Int1024
+		ok   bool
+		sat  Int1024
+	}{
+		{0, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, true, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{1, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, true, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{-1, Int1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, true, Int1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{127, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x7f}, true, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x7f}},
+		{-128, Int1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xffffffffffffff80}, true, Int1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xffffffffffffff80}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt1024()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int8(%#04x).TryInt1024() = %#0258x, %t, want %#0258x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt1024()
+		if got != tc.sat {
+			t.Errorf("Int8(%#04x).SaturatingInt1024() = %#0258x, want %#0258x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt8_SaturatingUint8(t *testing.T) {
+	testCases := []struct {
+		a    Int8
+		want Uint8
+	}{
+		{0, 0},
+		{1, 1},
+		{-1, 0},
+		{127, 127},
+		{-128, 0},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint8()
+		if got != tc.want {
+			t.Errorf("Int8(%#04x).SaturatingUint8() = %#04x, want %#04x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt8_SaturatingUint16(t *testing.T) {
+	testCases := []struct {
+		a    Int8
+		want Uint16
+	}{
+		{0, 0},
+		{1, 1},
+		{-1, 0},
+		{127, 127},
+		{-128, 0},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint16()
+		if got != tc.want {
+			t.Errorf("Int8(%#04x).SaturatingUint16() = %#06x, want %#06x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt8_SaturatingUint32(t *testing.T) {
+	testCases := []struct {
+		a    Int8
+		want Uint32
+	}{
+		{0, 0},
+		{1, 1},
+		{-1, 0},
+		{127, 127},
+		{-128, 0},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint32()
+		if got != tc.want {
+			t.Errorf("Int8(%#04x).SaturatingUint32() = %#010x, want %#010x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt8_SaturatingUint64(t *testing.T) {
+	testCases := []struct {
+		a    Int8
+		want Uint64
+	}{
+		{0, 0},
+		{1, 1},
+		{-1, 0},
+		{127, 127},
+		{-128, 0},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint64()
+		if got != tc.want {
+			t.Errorf("Int8(%#04x).SaturatingUint64() = %#018x, want %#018x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt8_SaturatingUint128(t *testing.T) {
+	testCases := []struct {
+		a    Int8
+		want Uint128
+	}{
+		{0, Uint128{0, 0}},
+		{1, Uint128{0, 0x1}},
+		{-1, Uint128{0, 0}},
+		{127, Uint128{0, 0x7f}},
+		{-128, Uint128{0, 0}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint128()
+		if got != tc.want {
+			t.Errorf("Int8(%#04x).SaturatingUint128() = %#034x, want %#034x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt8_SaturatingUint256(t *testing.T) {
+	testCases := []struct {
+		a    Int8
+		want Uint256
+	}{
+		{0, Uint256{0, 0, 0, 0}},
+		{1, Uint256{0, 0, 0, 0x1}},
+		{-1, Uint256{0, 0, 0, 0}},
+		{127, Uint256{0, 0, 0, 0x7f}},
+		{-128, Uint256{0, 0, 0, 0}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint256()
+		if got != tc.want {
+			t.Errorf("Int8(%#04x).SaturatingUint256() = %#066x, want %#066x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt8_SaturatingUint512(t *testing.T) {
+	testCases := []struct {
+		a    Int8
+		want Uint512
+	}{
+		{0, Uint512{0, 0, 0, 0, 0, 0, 0, 0}},
+		{1, Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{-1, Uint512{0, 0, 0, 0, 0, 0, 0, 0}},
+		{127, Uint512{0, 0, 0, 0, 0, 0, 0, 0x7f}},
+		{-128, Uint512{0, 0, 0, 0, 0, 0, 0, 0}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint512()
+		if got != tc.want {
+			t.Errorf("Int8(%#04x).SaturatingUint512() = %#0130x, want %#0130x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt8_SaturatingUint1024(t *testing.T) {
+	testCases := []struct {
+		a    Int8
+		want Uint1024
+	}{
+		{0, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{1, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{-1, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{127, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x7f}},
+		{-128, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint1024()
+		if got != tc.want {
+			t.Errorf("Int8(%#04x).SaturatingUint1024() = %#0258x, want %#0258x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt16_TryInt8(t *testing.T) {
+	testCases := []struct {
+		a    Int16
+		want Int8
+		ok   bool
+		sat  Int8
+	}{
+		{0, 0, true, 0},
+		{1, 1, true, 1},
+		{-1, -1, true, -1},
+		{32767, -1, false, 127},
+		{-32768, 0, false, -128},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt8()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int16(%#06x).TryInt8() = %#04x, %t, want %#04x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt8()
+		if got != tc.sat {
+			t.Errorf("Int16(%#06x).SaturatingInt8() = %#04x, want %#04x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt16_TryInt16(t *testing.T) {
+	testCases := []struct {
+		a    Int16
+		want Int16
+		ok   bool
+		sat  Int16
+	}{
+		{0, 0, true, 0},
+		{1, 1, true, 1},
+		{-1, -1, true, -1},
+		{32767, 32767, true, 32767},
+		{-32768, -32768, true, -32768},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt16()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int16(%#06x).TryInt16() = %#06x, %t, want %#06x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt16()
+		if got != tc.sat {
+			t.Errorf("Int16(%#06x).SaturatingInt16() = %#06x, want %#06x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt16_TryInt32(t *testing.T) {
+	testCases := []struct {
+		a    Int16
+		want Int32
+		ok   bool
+		sat  Int32
+	}{
+		{0, 0, true, 0},
+		{1, 1, true, 1},
+		{-1, -1, true, -1},
+		{32767, 32767, true, 32767},
+		{-32768, -32768, true, -32768},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt32()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int16(%#06x).TryInt32() = %#010x, %t, want %#010x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt32()
+		if got != tc.sat {
+			t.Errorf("Int16(%#06x).SaturatingInt32() = %#010x, want %#010x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt16_TryInt64(t *testing.T) {
+	testCases := []struct {
+		a    Int16
+		want Int64
+		ok   bool
+		sat  Int64
+	}{
+		{0, 0, true, 0},
+		{1, 1, true, 1},
+		{-1, -1, true, -1},
+		{32767, 32767, true, 32767},
+		{-32768, -32768, true, -32768},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt64()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int16(%#06x).TryInt64() = %#018x, %t, want %#018x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt64()
+		if got != tc.sat {
+			t.Errorf("Int16(%#06x).SaturatingInt64() = %#018x, want %#018x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt16_TryInt128(t *testing.T) {
+	testCases := []struct {
+		a    Int16
+		want Int128
+		ok   bool
+		sat  Int128
+	}{
+		{0, Int128{0, 0}, true, Int128{0, 0}},
+		{1, Int128{0, 0x1}, true, Int128{0, 0x1}},
+		{-1, Int128{math.MaxUint64, math.MaxUint64}, true, Int128{math.MaxUint64, math.MaxUint64}},
+		{32767, Int128{0, 0x7fff}, true, Int128{0, 0x7fff}},
+		{-32768, Int128{math.MaxUint64, 0xffffffffffff8000}, true, Int128{math.MaxUint64, 0xffffffffffff8000}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt128()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int16(%#06x).TryInt128() = %#034x, %t, want %#034x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt128()
+		if got != tc.sat {
+			t.Errorf("Int16(%#06x).SaturatingInt128() = %#034x, want %#034x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt16_TryInt256(t *testing.T) {
+	testCases := []struct {
+		a    Int16
+		want Int256
+		ok   bool
+		sat  Int256
+	}{
+		{0, Int256{0, 0, 0, 0}, true, Int256{0, 0, 0, 0}},
+		{1, Int256{0, 0, 0, 0x1}, true, Int256{0, 0, 0, 0x1}},
+		{-1, Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, true, Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{32767, Int256{0, 0, 0, 0x7fff}, true, Int256{0, 0, 0, 0x7fff}},
+		{-32768, Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xffffffffffff8000}, true, Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xffffffffffff8000}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt256()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int16(%#06x).TryInt256() = %#066x, %t, want %#066x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt256()
+		if got != tc.sat {
+			t.Errorf("Int16(%#06x).SaturatingInt256() = %#066x, want %#066x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt16_TryInt512(t *testing.T) {
+	testCases := []struct {
+		a    Int16
+		want Int512
+		ok   bool
+		sat  Int512
+	}{
+		{0, Int512{0, 0, 0, 0, 0, 0, 0, 0}, true, Int512{0, 0, 0, 0, 0, 0, 0, 0}},
+		{1, Int512{0, 0, 0, 0, 0, 0, 0, 0x1}, true, Int512{0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{-1, Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, true, Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{32767, Int512{0, 0, 0, 0, 0, 0, 0, 0x7fff}, true, Int512{0, 0, 0, 0, 0, 0, 0, 0x7fff}},
+		{-32768, Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xffffffffffff8000}, true, Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xffffffffffff8000}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt512()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int16(%#06x).TryInt512() = %#0130x, %t, want %#0130x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt512()
+		if got != tc.sat {
+			t.Errorf("Int16(%#06x).SaturatingInt512() = %#0130x, want %#0130x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt16_TryInt1024(t *testing.T) {
+	testCases := []struct {
+		a    Int16
+		want Int1024
+		ok   bool
+		sat  Int1024
+	}{
+		{0, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, true, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{1, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, true, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{-1, Int1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, true, Int1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{32767, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x7fff}, true, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x7fff}},
+		{-32768, Int1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xffffffffffff8000}, true, Int1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xffffffffffff8000}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt1024()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int16(%#06x).TryInt1024() = %#0258x, %t, want %#0258x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt1024()
+		if got != tc.sat {
+			t.Errorf("Int16(%#06x).SaturatingInt1024() = %#0258x, want %#0258x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt16_SaturatingUint8(t *testing.T) {
+	testCases := []struct {
+		a    Int16
+		want Uint8
+	}{
+		{0, 0},
+		{1, 1},
+		{-1, 0},
+		{32767, 255},
+		{-32768, 0},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint8()
+		if got != tc.want {
+			t.Errorf("Int16(%#06x).SaturatingUint8() = %#04x, want %#04x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt16_SaturatingUint16(t *testing.T) {
+	testCases := []struct {
+		a    Int16
+		want Uint16
+	}{
+		{0, 0},
+		{1, 1},
+		{-1, 0},
+		{32767, 32767},
+		{-32768, 0},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint16()
+		if got != tc.want {
+			t.Errorf("Int16(%#06x).SaturatingUint16() = %#06x, want %#06x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt16_SaturatingUint32(t *testing.T) {
+	testCases := []struct {
+		a    Int16
+		want Uint32
+	}{
+		{0, 0},
+		{1, 1},
+		{-1, 0},
+		{32767, 32767},
+		{-32768, 0},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint32()
+		if got != tc.want {
+			t.Errorf("Int16(%#06x).SaturatingUint32() = %#010x, want %#010x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt16_SaturatingUint64(t *testing.T) {
+	testCases := []struct {
+		a    Int16
+		want Uint64
+	}{
+		{0, 0},
+		{1, 1},
+		{-1, 0},
+		{32767, 32767},
+		{-32768, 0},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint64()
+		if got != tc.want {
+			t.Errorf("Int16(%#06x).SaturatingUint64() = %#018x, want %#018x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt16_SaturatingUint128(t *testing.T) {
+	testCases := []struct {
+		a    Int16
+		want Uint128
+	}{
+		{0, Uint128{0, 0}},
+		{1, Uint128{0, 0x1}},
+		{-1, Uint128{0, 0}},
+		{32767, Uint128{0, 0x7fff}},
+		{-32768, Uint128{0, 0}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint128()
+		if got != tc.want {
+			t.Errorf("Int16(%#06x).SaturatingUint128() = %#034x, want %#034x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt16_SaturatingUint256(t *testing.T) {
+	testCases := []struct {
+		a    Int16
+		want Uint256
+	}{
+		{0, Uint256{0, 0, 0, 0}},
+		{1, Uint256{0, 0, 0, 0x1}},
+		{-1, Uint256{0, 0, 0, 0}},
+		{32767, Uint256{0, 0, 0, 0x7fff}},
+		{-32768, Uint256{0, 0, 0, 0}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint256()
+		if got != tc.want {
+			t.Errorf("Int16(%#06x).SaturatingUint256() = %#066x, want %#066x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt16_SaturatingUint512(t *testing.T) {
+	testCases := []struct {
+		a    Int16
+		want Uint512
+	}{
+		{0, Uint512{0, 0, 0, 0, 0, 0, 0, 0}},
+		{1, Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{-1, Uint512{0, 0, 0, 0, 0, 0, 0, 0}},
+		{32767, Uint512{0, 0, 0, 0, 0, 0, 0, 0x7fff}},
+		{-32768, Uint512{0, 0, 0, 0, 0, 0, 0, 0}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint512()
+		if got != tc.want {
+			t.Errorf("Int16(%#06x).SaturatingUint512() = %#0130x, want %#0130x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt16_SaturatingUint1024(t *testing.T) {
+	testCases := []struct {
+		a    Int16
+		want Uint1024
+	}{
+		{0, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{1, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{-1, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{32767, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x7fff}},
+		{-32768, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint1024()
+		if got != tc.want {
+			t.Errorf("Int16(%#06x).SaturatingUint1024() = %#0258x, want %#0258x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt32_TryInt8(t *testing.T) {
+	testCases := []struct {
+		a    Int32
+		want Int8
+		ok   bool
+		sat  Int8
+	}{
+		{0, 0, true, 0},
+		{1, 1, true, 1},
+		{-1, -1, true, -1},
+		{2147483647, -1, false, 127},
+		{-2147483648, 0, false, -128},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt8()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int32(%#010x).TryInt8() = %#04x, %t, want %#04x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt8()
+		if got != tc.sat {
+			t.Errorf("Int32(%#010x).SaturatingInt8() = %#04x, want %#04x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt32_TryInt16(t *testing.T) {
+	testCases := []struct {
+		a    Int32
+		want Int16
+		ok   bool
+		sat  Int16
+	}{
+		{0, 0, true, 0},
+		{1, 1, true, 1},
+		{-1, -1, true, -1},
+		{2147483647, -1, false, 32767},
+		{-2147483648, 0, false, -32768},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt16()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int32(%#010x).TryInt16() = %#06x, %t, want %#06x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt16()
+		if got != tc.sat {
+			t.Errorf("Int32(%#010x).SaturatingInt16() = %#06x, want %#06x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt32_TryInt32(t *testing.T) {
+	testCases := []struct {
+		a    Int32
+		want Int32
+		ok   bool
+		sat  Int32
+	}{
+		{0, 0, true, 0},
+		{1, 1, true, 1},
+		{-1, -1, true, -1},
+		{2147483647, 2147483647, true, 2147483647},
+		{-2147483648, -2147483648, true, -2147483648},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt32()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int32(%#010x).TryInt32() = %#010x, %t, want %#010x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt32()
+		if got != tc.sat {
+			t.Errorf("Int32(%#010x).SaturatingInt32() = %#010x, want %#010x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt32_TryInt64(t *testing.T) {
+	testCases := []struct {
+		a    Int32
+		want Int64
+		ok   bool
+		sat  Int64
+	}{
+		{0, 0, true, 0},
+		{1, 1, true, 1},
+		{-1, -1, true, -1},
+		{2147483647, 2147483647, true, 2147483647},
+		{-2147483648, -2147483648, true, -2147483648},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt64()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int32(%#010x).TryInt64() = %#018x, %t, want %#018x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt64()
+		if got != tc.sat {
+			t.Errorf("Int32(%#010x).SaturatingInt64() = %#018x, want %#018x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt32_TryInt128(t *testing.T) {
+	testCases := []struct {
+		a    Int32
+		want Int128
+		ok   bool
+		sat  Int128
+	}{
+		{0, Int128{0, 0}, true, Int128{0, 0}},
+		{1, Int128{0, 0x1}, true, Int128{0, 0x1}},
+		{-1, Int128{math.MaxUint64, math.MaxUint64}, true, Int128{math.MaxUint64, math.MaxUint64}},
+		{2147483647, Int128{0, 0x7fffffff}, true, Int128{0, 0x7fffffff}},
+		{-2147483648, Int128{math.MaxUint64, 0xffffffff80000000}, true, Int128{math.MaxUint64, 0xffffffff80000000}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt128()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int32(%#010x).TryInt128() = %#034x, %t, want %#034x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt128()
+		if got != tc.sat {
+			t.Errorf("Int32(%#010x).SaturatingInt128() = %#034x, want %#034x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt32_TryInt256(t *testing.T) {
+	testCases := []struct {
+		a    Int32
+		want Int256
+		ok   bool
+		sat  Int256
+	}{
+		{0, Int256{0, 0, 0, 0}, true, Int256{0, 0, 0, 0}},
+		{1, Int256{0, 0, 0, 0x1}, true, Int256{0, 0, 0, 0x1}},
+		{-1, Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, true, Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{2147483647, Int256{0, 0, 0, 0x7fffffff}, true, Int256{0, 0, 0, 0x7fffffff}},
+		{-2147483648, Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xffffffff80000000}, true, Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xffffffff80000000}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt256()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int32(%#010x).TryInt256() = %#066x, %t, want %#066x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt256()
+		if got != tc.sat {
+			t.Errorf("Int32(%#010x).SaturatingInt256() = %#066x, want %#066x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt32_TryInt512(t *testing.T) {
+	testCases := []struct {
+		a    Int32
+		want Int512
+		ok   bool
+		sat  Int512
+	}{
+		{0, Int512{0, 0, 0, 0, 0, 0, 0, 0}, true, Int512{0, 0, 0, 0, 0, 0, 0, 0}},
+		{1, Int512{0, 0, 0, 0, 0, 0, 0, 0x1}, true, Int512{0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{-1, Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, true, Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{2147483647, Int512{0, 0, 0, 0, 0, 0, 0, 0x7fffffff}, true, Int512{0, 0, 0, 0, 0, 0, 0, 0x7fffffff}},
+		{-2147483648, Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xffffffff80000000}, true, Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xffffffff80000000}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt512()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int32(%#010x).TryInt512() = %#0130x, %t, want %#0130x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt512()
+		if got != tc.sat {
+			t.Errorf("Int32(%#010x).SaturatingInt512() = %#0130x, want %#0130x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt32_TryInt1024(t *testing.T) {
+	testCases := []struct {
+		a    Int32
+		want Int1024
+		ok   bool
+		sat  Int1024
+	}{
+		{0, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, true, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{1, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, true, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{-1, Int1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, true, Int1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{2147483647, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x7fffffff}, true, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x7fffffff}},
+		{-2147483648, Int1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xffffffff80000000}, true, Int1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xffffffff80000000}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt1024()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int32(%#010x).TryInt1024() = %#0258x, %t, want %#0258x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt1024()
+		if got != tc.sat {
+			t.Errorf("Int32(%#010x).SaturatingInt1024() = %#0258x, want %#0258x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt32_SaturatingUint8(t *testing.T) {
+	testCases := []struct {
+		a    Int32
+		want Uint8
+	}{
+		{0, 0},
+		{1, 1},
+		{-1, 0},
+		{2147483647, 255},
+		{-2147483648, 0},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint8()
+		if got != tc.want {
+			t.Errorf("Int32(%#010x).SaturatingUint8() = %#04x, want %#04x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt32_SaturatingUint16(t *testing.T) {
+	testCases := []struct {
+		a    Int32
+		want Uint16
+	}{
+		{0, 0},
+		{1, 1},
+		{-1, 0},
+		{2147483647, 65535},
+		{-2147483648, 0},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint16()
+		if got != tc.want {
+			t.Errorf("Int32(%#010x).SaturatingUint16() = %#06x, want %#06x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt32_SaturatingUint32(t *testing.T) {
+	testCases := []struct {
+		a    Int32
+		want Uint32
+	}{
+		{0, 0},
+		{1, 1},
+		{-1, 0},
+		{2147483647, 2147483647},
+		{-2147483648, 0},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint32()
+		if got != tc.want {
+			t.Errorf("Int32(%#010x).SaturatingUint32() = %#010x, want %#010x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt32_SaturatingUint64(t *testing.T) {
+	testCases := []struct {
+		a    Int32
+		want Uint64
+	}{
+		{0, 0},
+		{1, 1},
+		{-1, 0},
+		{2147483647, 2147483647},
+		{-2147483648, 0},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint64()
+		if got != tc.want {
+			t.Errorf("Int32(%#010x).SaturatingUint64() = %#018x, want %#018x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt32_SaturatingUint128(t *testing.T) {
+	testCases := []struct {
+		a    Int32
+		want Uint128
+	}{
+		{0, Uint128{0, 0}},
+		{1, Uint128{0, 0x1}},
+		{-1, Uint128{0, 0}},
+		{2147483647, Uint128{0, 0x7fffffff}},
+		{-2147483648, Uint128{0, 0}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint128()
+		if got != tc.want {
+			t.Errorf("Int32(%#010x).SaturatingUint128() = %#034x, want %#034x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt32_SaturatingUint256(t *testing.T) {
+	testCases := []struct {
+		a    Int32
+		want Uint256
+	}{
+		{0, Uint256{0, 0, 0, 0}},
+		{1, Uint256{0, 0, 0, 0x1}},
+		{-1, Uint256{0, 0, 0, 0}},
+		{2147483647, Uint256{0, 0, 0, 0x7fffffff}},
+		{-2147483648, Uint256{0, 0, 0, 0}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint256()
+		if got != tc.want {
+			t.Errorf("Int32(%#010x).SaturatingUint256() = %#066x, want %#066x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt32_SaturatingUint512(t *testing.T) {
+	testCases := []struct {
+		a    Int32
+		want Uint512
+	}{
+		{0, Uint512{0, 0, 0, 0, 0, 0, 0, 0}},
+		{1, Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{-1, Uint512{0, 0, 0, 0, 0, 0, 0, 0}},
+		{2147483647, Uint512{0, 0, 0, 0, 0, 0, 0, 0x7fffffff}},
+		{-2147483648, Uint512{0, 0, 0, 0, 0, 0, 0, 0}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint512()
+		if got != tc.want {
+			t.Errorf("Int32(%#010x).SaturatingUint512() = %#0130x, want %#0130x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt32_SaturatingUint1024(t *testing.T) {
+	testCases := []struct {
+		a    Int32
+		want Uint1024
+	}{
+		{0, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{1, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{-1, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{2147483647, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x7fffffff}},
+		{-2147483648, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint1024()
+		if got != tc.want {
+			t.Errorf("Int32(%#010x).SaturatingUint1024() = %#0258x, want %#0258x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt64_TryInt8(t *testing.T) {
+	testCases := []struct {
+		a    Int64
+		want Int8
+		ok   bool
+		sat  Int8
+	}{
+		{0, 0, true, 0},
+		{1, 1, true, 1},
+		{-1, -1, true, -1},
+		{9223372036854775807, -1, false, 127},
+		{-9223372036854775808, 0, false, -128},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt8()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int64(%#018x).TryInt8() = %#04x, %t, want %#04x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt8()
+		if got != tc.sat {
+			t.Errorf("Int64(%#018x).SaturatingInt8() = %#04x, want %#04x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt64_TryInt16(t *testing.T) {
+	testCases := []struct {
+		a    Int64
+		want Int16
+		ok   bool
+		sat  Int16
+	}{
+		{0, 0, true, 0},
+		{1, 1, true, 1},
+		{-1, -1, true, -1},
+		{9223372036854775807, -1, false, 32767},
+		{-9223372036854775808, 0, false, -32768},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt16()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int64(%#018x).TryInt16() = %#06x, %t, want %#06x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt16()
+		if got != tc.sat {
+			t.Errorf("Int64(%#018x).SaturatingInt16() = %#06x, want %#06x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt64_TryInt32(t *testing.T) {
+	testCases := []struct {
+		a    Int64
+		want Int32
+		ok   bool
+		sat  Int32
+	}{
+		{0, 0, true, 0},
+		{1, 1, true, 1},
+		{-1, -1, true, -1},
+		{9223372036854775807, -1, false, 2147483647},
+		{-9223372036854775808, 0, false, -2147483648},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt32()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int64(%#018x).TryInt32() = %#010x, %t, want %#010x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt32()
+		if got != tc.sat {
+			t.Errorf("Int64(%#018x).SaturatingInt32() = %#010x, want %#010x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt64_TryInt64(t *testing.T) {
+	testCases := []struct {
+		a    Int64
+		want Int64
+		ok   bool
+		sat  Int64
+	}{
+		{0, 0, true, 0},
+		{1, 1, true, 1},
+		{-1, -1, true, -1},
+		{9223372036854775807, 9223372036854775807, true, 9223372036854775807},
+		{-9223372036854775808, -9223372036854775808, true, -9223372036854775808},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt64()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int64(%#018x).TryInt64() = %#018x, %t, want %#018x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt64()
+		if got != tc.sat {
+			t.Errorf("Int64(%#018x).SaturatingInt64() = %#018x, want %#018x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt64_TryInt128(t *testing.T) {
+	testCases := []struct {
+		a    Int64
+		want Int128
+		ok   bool
+		sat  Int128
+	}{
+		{0, Int128{0, 0}, true, Int128{0, 0}},
+		{1, Int128{0, 0x1}, true, Int128{0, 0x1}},
+		{-1, Int128{math.MaxUint64, math.MaxUint64}, true, Int128{math.MaxUint64, math.MaxUint64}},
+		{9223372036854775807, Int128{0, 0x7fffffffffffffff}, true, Int128{0, 0x7fffffffffffffff}},
+		{-9223372036854775808, Int128{math.MaxUint64, 0x8000000000000000}, true, Int128{math.MaxUint64, 0x8000000000000000}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt128()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int64(%#018x).TryInt128() = %#034x, %t, want %#034x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt128()
+		if got != tc.sat {
+			t.Errorf("Int64(%#018x).SaturatingInt128() = %#034x, want %#034x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt64_TryInt256(t *testing.T) {
+	testCases := []struct {
+		a    Int64
+		want Int256
+		ok   bool
+		sat  Int256
+	}{
+		{0, Int256{0, 0, 0, 0}, true, Int256{0, 0, 0, 0}},
+		{1, Int256{0, 0, 0, 0x1}, true, Int256{0, 0, 0, 0x1}},
+		{-1, Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, true, Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{9223372036854775807, Int256{0, 0, 0, 0x7fffffffffffffff}, true, Int256{0, 0, 0, 0x7fffffffffffffff}},
+		{-9223372036854775808, Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, 0x8000000000000000}, true, Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, 0x8000000000000000}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt256()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int64(%#018x).TryInt256() = %#066x, %t, want %#066x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt256()
+		if got != tc.sat {
+			t.Errorf("Int64(%#018x).SaturatingInt256() = %#066x, want %#066x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt64_TryInt512(t *testing.T) {
+	testCases := []struct {
+		a    Int64
+		want Int512
+		ok   bool
+		sat  Int512
+	}{
+		{0, Int512{0, 0, 0, 0, 0, 0, 0, 0}, true, Int512{0, 0, 0, 0, 0, 0, 0, 0}},
+		{1, Int512{0, 0, 0, 0, 0, 0, 0, 0x1}, true, Int512{0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{-1, Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, true, Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{9223372036854775807, Int512{0, 0, 0, 0, 0, 0, 0, 0x7fffffffffffffff}, true, Int512{0, 0, 0, 0, 0, 0, 0, 0x7fffffffffffffff}},
+		{-9223372036854775808, Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0x8000000000000000}, true, Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0x8000000000000000}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt512()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int64(%#018x).TryInt512() = %#0130x, %t, want %#0130x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt512()
+		if got != tc.sat {
+			t.Errorf("Int64(%#018x).SaturatingInt512() = %#0130x, want %#0130x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt64_TryInt1024(t *testing.T) {
+	testCases := []struct {
+		a    Int64
+		want Int1024
+		ok   bool
+		sat  Int1024
+	}{
+		{0, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, true, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{1, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, true, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{-1, Int1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, true, Int1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{9223372036854775807, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x7fffffffffffffff}, true, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x7fffffffffffffff}},
+		{-9223372036854775808, Int1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0x8000000000000000}, true, Int1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0x8000000000000000}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt1024()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int64(%#018x).TryInt1024() = %#0258x, %t, want %#0258x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt1024()
+		if got != tc.sat {
+			t.Errorf("Int64(%#018x).SaturatingInt1024() = %#0258x, want %#0258x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt64_SaturatingUint8(t *testing.T) {
+	testCases := []struct {
+		a    Int64
+		want Uint8
+	}{
+		{0, 0},
+		{1, 1},
+		{-1, 0},
+		{9223372036854775807, 255},
+		{-9223372036854775808, 0},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint8()
+		if got != tc.want {
+			t.Errorf("Int64(%#018x).SaturatingUint8() = %#04x, want %#04x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt64_SaturatingUint16(t *testing.T) {
+	testCases := []struct {
+		a    Int64
+		want Uint16
+	}{
+		{0, 0},
+		{1, 1},
+		{-1, 0},
+		{9223372036854775807, 65535},
+		{-9223372036854775808, 0},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint16()
+		if got != tc.want {
+			t.Errorf("Int64(%#018x).SaturatingUint16() = %#06x, want %#06x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt64_SaturatingUint32(t *testing.T) {
+	testCases := []struct {
+		a    Int64
+		want Uint32
+	}{
+		{0, 0},
+		{1, 1},
+		{-1, 0},
+		{9223372036854775807, 4294967295},
+		{-9223372036854775808, 0},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint32()
+		if got != tc.want {
+			t.Errorf("Int64(%#018x).SaturatingUint32() = %#010x, want %#010x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt64_SaturatingUint64(t *testing.T) {
+	testCases := []struct {
+		a    Int64
+		want Uint64
+	}{
+		{0, 0},
+		{1, 1},
+		{-1, 0},
+		{9223372036854775807, 9223372036854775807},
+		{-9223372036854775808, 0},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint64()
+		if got != tc.want {
+			t.Errorf("Int64(%#018x).SaturatingUint64() = %#018x, want %#018x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt64_SaturatingUint128(t *testing.T) {
+	testCases := []struct {
+		a    Int64
+		want Uint128
+	}{
+		{0, Uint128{0, 0}},
+		{1, Uint128{0, 0x1}},
+		{-1, Uint128{0, 0}},
+		{9223372036854775807, Uint128{0, 0x7fffffffffffffff}},
+		{-9223372036854775808, Uint128{0, 0}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint128()
+		if got != tc.want {
+			t.Errorf("Int64(%#018x).SaturatingUint128() = %#034x, want %#034x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt64_SaturatingUint256(t *testing.T) {
+	testCases := []struct {
+		a    Int64
+		want Uint256
+	}{
+		{0, Uint256{0, 0, 0, 0}},
+		{1, Uint256{0, 0, 0, 0x1}},
+		{-1, Uint256{0, 0, 0, 0}},
+		{9223372036854775807, Uint256{0, 0, 0, 0x7fffffffffffffff}},
+		{-9223372036854775808, Uint256{0, 0, 0, 0}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint256()
+		if got != tc.want {
+			t.Errorf("Int64(%#018x).SaturatingUint256() = %#066x, want %#066x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt64_SaturatingUint512(t *testing.T) {
+	testCases := []struct {
+		a    Int64
+		want Uint512
+	}{
+		{0, Uint512{0, 0, 0, 0, 0, 0, 0, 0}},
+		{1, Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{-1, Uint512{0, 0, 0, 0, 0, 0, 0, 0}},
+		{9223372036854775807, Uint512{0, 0, 0, 0, 0, 0, 0, 0x7fffffffffffffff}},
+		{-9223372036854775808, Uint512{0, 0, 0, 0, 0, 0, 0, 0}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint512()
+		if got != tc.want {
+			t.Errorf("Int64(%#018x).SaturatingUint512() = %#0130x, want %#0130x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt64_SaturatingUint1024(t *testing.T) {
+	testCases := []struct {
+		a    Int64
+		want Uint1024
+	}{
+		{0, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{1, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{-1, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{9223372036854775807, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x7fffffffffffffff}},
+		{-9223372036854775808, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint1024()
+		if got != tc.want {
+			t.Errorf("Int64(%#018x).SaturatingUint1024() = %#0258x, want %#0258x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt128_TryInt8(t *testing.T) {
+	testCases := []struct {
+		a    Int128
+		want Int8
+		ok   bool
+		sat  Int8
+	}{
+		{Int128{0, 0}, 0, true, 0},
+		{Int128{0, 0x1}, 1, true, 1},
+		{Int128{math.MaxUint64, math.MaxUint64}, -1, true, -1},
+		{Int128{0x7fffffffffffffff, math.MaxUint64}, -1, false, 127},
+		{Int128{0x8000000000000000, 0}, 0, false, -128},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt8()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int128(%#034x).TryInt8() = %#04x, %t, want %#04x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt8()
+		if got != tc.sat {
+			t.Errorf("Int128(%#034x).SaturatingInt8() = %#04x, want %#04x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt128_TryInt16(t *testing.T) {
+	testCases := []struct {
+		a    Int128
+		want Int16
+		ok   bool
+		sat  Int16
+	}{
+		{Int128{0, 0}, 0, true, 0},
+		{Int128{0, 0x1}, 1, true, 1},
+		{Int128{math.MaxUint64, math.MaxUint64}, -1, true, -1},
+		{Int128{0x7fffffffffffffff, math.MaxUint64}, -1, false, 32767},
+		{Int128{0x8000000000000000, 0}, 0, false, -32768},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt16()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int128(%#034x).TryInt16() = %#06x, %t, want %#06x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt16()
+		if got != tc.sat {
+			t.Errorf("Int128(%#034x).SaturatingInt16() = %#06x, want %#06x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt128_TryInt32(t *testing.T) {
+	testCases := []struct {
+		a    Int128
+		want Int32
+		ok   bool
+		sat  Int32
+	}{
+		{Int128{0, 0}, 0, true, 0},
+		{Int128{0, 0x1}, 1, true, 1},
+		{Int128{math.MaxUint64, math.MaxUint64}, -1, true, -1},
+		{Int128{0x7fffffffffffffff, math.MaxUint64}, -1, false, 2147483647},
+		{Int128{0x8000000000000000, 0}, 0, false, -2147483648},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt32()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int128(%#034x).TryInt32() = %#010x, %t, want %#010x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt32()
+		if got != tc.sat {
+			t.Errorf("Int128(%#034x).SaturatingInt32() = %#010x, want %#010x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt128_TryInt64(t *testing.T) {
+	testCases := []struct {
+		a    Int128
+		want Int64
+		ok   bool
+		sat  Int64
+	}{
+		{Int128{0, 0}, 0, true, 0},
+		{Int128{0, 0x1}, 1, true, 1},
+		{Int128{math.MaxUint64, math.MaxUint64}, -1, true, -1},
+		{Int128{0x7fffffffffffffff, math.MaxUint64}, -1, false, 9223372036854775807},
+		{Int128{0x8000000000000000, 0}, 0, false, -9223372036854775808},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt64()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int128(%#034x).TryInt64() = %#018x, %t, want %#018x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt64()
+		if got != tc.sat {
+			t.Errorf("Int128(%#034x).SaturatingInt64() = %#018x, want %#018x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt128_TryInt128(t *testing.T) {
+	testCases := []struct {
+		a    Int128
+		want Int128
+		ok   bool
+		sat  Int128
+	}{
+		{Int128{0, 0}, Int128{0, 0}, true, Int128{0, 0}},
+		{Int128{0, 0x1}, Int128{0, 0x1}, true, Int128{0, 0x1}},
+		{Int128{math.MaxUint64, math.MaxUint64}, Int128{math.MaxUint64, math.MaxUint64}, true, Int128{math.MaxUint64, math.MaxUint64}},
+		{Int128{0x7fffffffffffffff, math.MaxUint64}, Int128{0x7fffffffffffffff, math.MaxUint64}, true, Int128{0x7fffffffffffffff, math.MaxUint64}},
+		{Int128{0x8000000000000000, 0}, Int128{0x8000000000000000, 0}, true, Int128{0x8000000000000000, 0}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt128()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int128(%#034x).TryInt128() = %#034x, %t, want %#034x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt128()
+		if got != tc.sat {
+			t.Errorf("Int128(%#034x).SaturatingInt128() = %#034x, want %#034x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt128_TryInt256(t *testing.T) {
+	testCases := []struct {
+		a    Int128
+		want Int256
+		ok   bool
+		sat  Int256
+	}{
+		{Int128{0, 0}, Int256{0, 0, 0, 0}, true, Int256{0, 0, 0, 0}},
+		{Int128{0, 0x1}, Int256{0, 0, 0, 0x1}, true, Int256{0, 0, 0, 0x1}},
+		{Int128{math.MaxUint64, math.MaxUint64}, Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, true, Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{Int128{0x7fffffffffffffff, math.MaxUint64}, Int256{0, 0, 0x7fffffffffffffff, math.MaxUint64}, true, Int256{0, 0, 0x7fffffffffffffff, math.MaxUint64}},
+		{Int128{0x8000000000000000, 0}, Int256{math.MaxUint64, math.MaxUint64, 0x8000000000000000, 0}, true, Int256{math.MaxUint64, math.MaxUint64, 0x8000000000000000, 0}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt256()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int128(%#034x).TryInt256() = %#066x, %t, want %#066x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt256()
+		if got != tc.sat {
+			t.Errorf("Int128(%#034x).SaturatingInt256() = %#066x, want %#066x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt128_TryInt512(t *testing.T) {
+	testCases := []struct {
+		a    Int128
+		want Int512
+		ok   bool
+		sat  Int512
+	}{
+		{Int128{0, 0}, Int512{0, 0, 0, 0, 0, 0, 0, 0}, true, Int512{0, 0, 0, 0, 0, 0, 0, 0}},
+		{Int128{0, 0x1}, Int512{0, 0, 0, 0, 0, 0, 0, 0x1}, true, Int512{0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{Int128{math.MaxUint64, math.MaxUint64}, Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, true, Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{Int128{0x7fffffffffffffff, math.MaxUint64}, Int512{0, 0, 0, 0, 0, 0, 0x7fffffffffffffff, math.MaxUint64}, true, Int512{0, 0, 0, 0, 0, 0, 0x7fffffffffffffff, math.MaxUint64}},
+		{Int128{0x8000000000000000, 0}, Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0x8000000000000000, 0}, true, Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0x8000000000000000, 0}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt512()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int128(%#034x).TryInt512() = %#0130x, %t, want %#0130x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt512()
+		if got != tc.sat {
+			t.Errorf("Int128(%#034x).SaturatingInt512() = %#0130x, want %#0130x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt128_TryInt1024(t *testing.T) {
+	testCases := []struct {
+		a    Int128
+		want Int1024
+		ok   bool
+		sat  Int1024
+	}{
+		{Int128{0, 0}, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, true, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{Int128{0, 0x1}, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, true, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{Int128{math.MaxUint64, math.MaxUint64}, Int1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, true, Int1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{Int128{0x7fffffffffffffff, math.MaxUint64}, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x7fffffffffffffff, math.MaxUint64}, true, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x7fffffffffffffff, math.MaxUint64}},
+		{Int128{0x8000000000000000, 0}, Int1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0x8000000000000000, 0}, true, Int1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0x8000000000000000, 0}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt1024()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int128(%#034x).TryInt1024() = %#0258x, %t, want %#0258x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt1024()
+		if got != tc.sat {
+			t.Errorf("Int128(%#034x).SaturatingInt1024() = %#0258x, want %#0258x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt128_SaturatingUint8(t *testing.T) {
+	testCases := []struct {
+		a    Int128
+		want Uint8
+	}{
+		{Int128{0, 0}, 0},
+		{Int128{0, 0x1}, 1},
+		{Int128{math.MaxUint64, math.MaxUint64}, 0},
+		{Int128{0x7fffffffffffffff, math.MaxUint64}, 255},
+		{Int128{0x8000000000000000, 0}, 0},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint8()
+		if got != tc.want {
+			t.Errorf("Int128(%#034x).SaturatingUint8() = %#04x, want %#04x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt128_SaturatingUint16(t *testing.T) {
+	testCases := []struct {
+		a    Int128
+		want Uint16
+	}{
+		{Int128{0, 0}, 0},
+		{Int128{0, 0x1}, 1},
+		{Int128{math.MaxUint64, math.MaxUint64}, 0},
+		{Int128{0x7fffffffffffffff, math.MaxUint64}, 65535},
+		{Int128{0x8000000000000000, 0}, 0},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint16()
+		if got != tc.want {
+			t.Errorf("Int128(%#034x).SaturatingUint16() = %#06x, want %#06x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt128_SaturatingUint32(t *testing.T) {
+	testCases := []struct {
+		a    Int128
+		want Uint32
+	}{
+		{Int128{0, 0}, 0},
+		{Int128{0, 0x1}, 1},
+		{Int128{math.MaxUint64, math.MaxUint64}, 0},
+		{Int128{0x7fffffffffffffff, math.MaxUint64}, 4294967295},
+		{Int128{0x8000000000000000, 0}, 0},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint32()
+		if got != tc.want {
+			t.Errorf("Int128(%#034x).SaturatingUint32() = %#010x, want %#010x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt128_SaturatingUint64(t *testing.T) {
+	testCases := []struct {
+		a    Int128
+		want Uint64
+	}{
+		{Int128{0, 0}, 0},
+		{Int128{0, 0x1}, 1},
+		{Int128{math.MaxUint64, math.MaxUint64}, 0},
+		{Int128{0x7fffffffffffffff, math.MaxUint64}, 18446744073709551615},
+		{Int128{0x8000000000000000, 0}, 0},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint64()
+		if got != tc.want {
+			t.Errorf("Int128(%#034x).SaturatingUint64() = %#018x, want %#018x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt128_SaturatingUint128(t *testing.T) {
+	testCases := []struct {
+		a    Int128
+		want Uint128
+	}{
+		{Int128{0, 0}, Uint128{0, 0}},
+		{Int128{0, 0x1}, Uint128{0, 0x1}},
+		{Int128{math.MaxUint64, math.MaxUint64}, Uint128{0, 0}},
+		{Int128{0x7fffffffffffffff, math.MaxUint64}, Uint128{0x7fffffffffffffff, math.MaxUint64}},
+		{Int128{0x8000000000000000, 0}, Uint128{0, 0}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint128()
+		if got != tc.want {
+			t.Errorf("Int128(%#034x).SaturatingUint128() = %#034x, want %#034x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt128_SaturatingUint256(t *testing.T) {
+	testCases := []struct {
+		a    Int128
+		want Uint256
+	}{
+		{Int128{0, 0}, Uint256{0, 0, 0, 0}},
+		{Int128{0, 0x1}, Uint256{0, 0, 0, 0x1}},
+		{Int128{math.MaxUint64, math.MaxUint64}, Uint256{0, 0, 0, 0}},
+		{Int128{0x7fffffffffffffff, math.MaxUint64}, Uint256{0, 0, 0x7fffffffffffffff, math.MaxUint64}},
+		{Int128{0x8000000000000000, 0}, Uint256{0, 0, 0, 0}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint256()
+		if got != tc.want {
+			t.Errorf("Int128(%#034x).SaturatingUint256() = %#066x, want %#066x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt128_SaturatingUint512(t *testing.T) {
+	testCases := []struct {
+		a    Int128
+		want Uint512
+	}{
+		{Int128{0, 0}, Uint512{0, 0, 0, 0, 0, 0, 0, 0}},
+		{Int128{0, 0x1}, Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{Int128{math.MaxUint64, math.MaxUint64}, Uint512{0, 0, 0, 0, 0, 0, 0, 0}},
+		{Int128{0x7fffffffffffffff, math.MaxUint64}, Uint512{0, 0, 0, 0, 0, 0, 0x7fffffffffffffff, math.MaxUint64}},
+		{Int128{0x8000000000000000, 0}, Uint512{0, 0, 0, 0, 0, 0, 0, 0}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint512()
+		if got != tc.want {
+			t.Errorf("Int128(%#034x).SaturatingUint512() = %#0130x, want %#0130x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt128_SaturatingUint1024(t *testing.T) {
+	testCases := []struct {
+		a    Int128
+		want Uint1024
+	}{
+		{Int128{0, 0}, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{Int128{0, 0x1}, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{Int128{math.MaxUint64, math.MaxUint64}, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{Int128{0x7fffffffffffffff, math.MaxUint64}, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x7fffffffffffffff, math.MaxUint64}},
+		{Int128{0x8000000000000000, 0}, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint1024()
+		if got != tc.want {
+			t.Errorf("Int128(%#034x).SaturatingUint1024() = %#0258x, want %#0258x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt256_TryInt8(t *testing.T) {
+	testCases := []struct {
+		a    Int256
+		want Int8
+		ok   bool
+		sat  Int8
+	}{
+		{Int256{0, 0, 0, 0}, 0, true, 0},
+		{Int256{0, 0, 0, 0x1}, 1, true, 1},
+		{Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, -1, true, -1},
+		{Int256{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}, -1, false, 127},
+		{Int256{0x8000000000000000, 0, 0, 0}, 0, false, -128},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt8()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int256(%#066x).TryInt8() = %#04x, %t, want %#04x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt8()
+		if got != tc.sat {
+			t.Errorf("Int256(%#066x).SaturatingInt8() = %#04x, want %#04x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt256_TryInt16(t *testing.T) {
+	testCases := []struct {
+		a    Int256
+		want Int16
+		ok   bool
+		sat  Int16
+	}{
+		{Int256{0, 0, 0, 0}, 0, true, 0},
+		{Int256{0, 0, 0, 0x1}, 1, true, 1},
+		{Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, -1, true, -1},
+		{Int256{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}, -1, false, 32767},
+		{Int256{0x8000000000000000, 0, 0, 0}, 0, false, -32768},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt16()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int256(%#066x).TryInt16() = %#06x, %t, want %#06x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt16()
+		if got != tc.sat {
+			t.Errorf("Int256(%#066x).SaturatingInt16() = %#06x, want %#06x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt256_TryInt32(t *testing.T) {
+	testCases := []struct {
+		a    Int256
+		want Int32
+		ok   bool
+		sat  Int32
+	}{
+		{Int256{0, 0, 0, 0}, 0, true, 0},
+		{Int256{0, 0, 0, 0x1}, 1, true, 1},
+		{Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, -1, true, -1},
+		{Int256{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}, -1, false, 2147483647},
+		{Int256{0x8000000000000000, 0, 0, 0}, 0, false, -2147483648},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt32()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int256(%#066x).TryInt32() = %#010x, %t, want %#010x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt32()
+		if got != tc.sat {
+			t.Errorf("Int256(%#066x).SaturatingInt32() = %#010x, want %#010x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt256_TryInt64(t *testing.T) {
+	testCases := []struct {
+		a    Int256
+		want Int64
+		ok   bool
+		sat  Int64
+	}{
+		{Int256{0, 0, 0, 0}, 0, true, 0},
+		{Int256{0, 0, 0, 0x1}, 1, true, 1},
+		{Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, -1, true, -1},
+		{Int256{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}, -1, false, 9223372036854775807},
+		{Int256{0x8000000000000000, 0, 0, 0}, 0, false, -9223372036854775808},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt64()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int256(%#066x).TryInt64() = %#018x, %t, want %#018x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt64()
+		if got != tc.sat {
+			t.Errorf("Int256(%#066x).SaturatingInt64() = %#018x, want %#018x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt256_TryInt128(t *testing.T) {
+	testCases := []struct {
+		a    Int256
+		want Int128
+		ok   bool
+		sat  Int128
+	}{
+		{Int256{0, 0, 0, 0}, Int128{0, 0}, true, Int128{0, 0}},
+		{Int256{0, 0, 0, 0x1}, Int128{0, 0x1}, true, Int128{0, 0x1}},
+		{Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Int128{math.MaxUint64, math.MaxUint64}, true, Int128{math.MaxUint64, math.MaxUint64}},
+		{Int256{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Int128{math.MaxUint64, math.MaxUint64}, false, Int128{0x7fffffffffffffff, math.MaxUint64}},
+		{Int256{0x8000000000000000, 0, 0, 0}, Int128{0, 0}, false, Int128{0x8000000000000000, 0}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt128()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int256(%#066x).TryInt128() = %#034x, %t, want %#034x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt128()
+		if got != tc.sat {
+			t.Errorf("Int256(%#066x).SaturatingInt128() = %#034x, want %#034x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt256_TryInt256(t *testing.T) {
+	testCases := []struct {
+		a    Int256
+		want Int256
+		ok   bool
+		sat  Int256
+	}{
+		{Int256{0, 0, 0, 0}, Int256{0, 0, 0, 0}, true, Int256{0, 0, 0, 0}},
+		{Int256{0, 0, 0, 0x1}, Int256{0, 0, 0, 0x1}, true, Int256{0, 0, 0, 0x1}},
+		{Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, true, Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{Int256{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Int256{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}, true, Int256{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{Int256{0x8000000000000000, 0, 0, 0}, Int256{0x8000000000000000, 0, 0, 0}, true, Int256{0x8000000000000000, 0, 0, 0}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt256()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int256(%#066x).TryInt256() = %#066x, %t, want %#066x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt256()
+		if got != tc.sat {
+			t.Errorf("Int256(%#066x).SaturatingInt256() = %#066x, want %#066x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt256_TryInt512(t *testing.T) {
+	testCases := []struct {
+		a    Int256
+		want Int512
+		ok   bool
+		sat  Int512
+	}{
+		{Int256{0, 0, 0, 0}, Int512{0, 0, 0, 0, 0, 0, 0, 0}, true, Int512{0, 0, 0, 0, 0, 0, 0, 0}},
+		{Int256{0, 0, 0, 0x1}, Int512{0, 0, 0, 0, 0, 0, 0, 0x1}, true, Int512{0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, true, Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{Int256{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Int512{0, 0, 0, 0, 0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}, true, Int512{0, 0, 0, 0, 0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{Int256{0x8000000000000000, 0, 0, 0}, Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0x8000000000000000, 0, 0, 0}, true, Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0x8000000000000000, 0, 0, 0}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt512()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int256(%#066x).TryInt512() = %#0130x, %t, want %#0130x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt512()
+		if got != tc.sat {
+			t.Errorf("Int256(%#066x).SaturatingInt512() = %#0130x, want %#0130x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt256_TryInt1024(t *testing.T) {
+	testCases := []struct {
+		a    Int256
+		want Int1024
+		ok   bool
+		sat  Int1024
+	}{
+		{Int256{0, 0, 0, 0}, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, true, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{Int256{0, 0, 0, 0x1}, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, true, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Int1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, true, Int1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{Int256{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}, true, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{Int256{0x8000000000000000, 0, 0, 0}, Int1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0x8000000000000000, 0, 0, 0}, true, Int1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0x8000000000000000, 0, 0, 0}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt1024()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int256(%#066x).TryInt1024() = %#0258x, %t, want %#0258x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt1024()
+		if got != tc.sat {
+			t.Errorf("Int256(%#066x).SaturatingInt1024() = %#0258x, want %#0258x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt256_SaturatingUint8(t *testing.T) {
+	testCases := []struct {
+		a    Int256
+		want Uint8
+	}{
+		{Int256{0, 0, 0, 0}, 0},
+		{Int256{0, 0, 0, 0x1}, 1},
+		{Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 0},
+		{Int256{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 255},
+		{Int256{0x8000000000000000, 0, 0, 0}, 0},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint8()
+		if got != tc.want {
+			t.Errorf("Int256(%#066x).SaturatingUint8() = %#04x, want %#04x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt256_SaturatingUint16(t *testing.T) {
+	testCases := []struct {
+		a    Int256
+		want Uint16
+	}{
+		{Int256{0, 0, 0, 0}, 0},
+		{Int256{0, 0, 0, 0x1}, 1},
+		{Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 0},
+		{Int256{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 65535},
+		{Int256{0x8000000000000000, 0, 0, 0}, 0},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint16()
+		if got != tc.want {
+			t.Errorf("Int256(%#066x).SaturatingUint16() = %#06x, want %#06x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt256_SaturatingUint32(t *testing.T) {
+	testCases := []struct {
+		a    Int256
+		want Uint32
+	}{
+		{Int256{0, 0, 0, 0}, 0},
+		{Int256{0, 0, 0, 0x1}, 1},
+		{Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 0},
+		{Int256{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 4294967295},
+		{Int256{0x8000000000000000, 0, 0, 0}, 0},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint32()
+		if got != tc.want {
+			t.Errorf("Int256(%#066x).SaturatingUint32() = %#010x, want %#010x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt256_SaturatingUint64(t *testing.T) {
+	testCases := []struct {
+		a    Int256
+		want Uint64
+	}{
+		{Int256{0, 0, 0, 0}, 0},
+		{Int256{0, 0, 0, 0x1}, 1},
+		{Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 0},
+		{Int256{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 18446744073709551615},
+		{Int256{0x8000000000000000, 0, 0, 0}, 0},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint64()
+		if got != tc.want {
+			t.Errorf("Int256(%#066x).SaturatingUint64() = %#018x, want %#018x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt256_SaturatingUint128(t *testing.T) {
+	testCases := []struct {
+		a    Int256
+		want Uint128
+	}{
+		{Int256{0, 0, 0, 0}, Uint128{0, 0}},
+		{Int256{0, 0, 0, 0x1}, Uint128{0, 0x1}},
+		{Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint128{0, 0}},
+		{Int256{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint128{math.MaxUint64, math.MaxUint64}},
+		{Int256{0x8000000000000000, 0, 0, 0}, Uint128{0, 0}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint128()
+		if got != tc.want {
+			t.Errorf("Int256(%#066x).SaturatingUint128() = %#034x, want %#034x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt256_SaturatingUint256(t *testing.T) {
+	testCases := []struct {
+		a    Int256
+		want Uint256
+	}{
+		{Int256{0, 0, 0, 0}, Uint256{0, 0, 0, 0}},
+		{Int256{0, 0, 0, 0x1}, Uint256{0, 0, 0, 0x1}},
+		{Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint256{0, 0, 0, 0}},
+		{Int256{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint256{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{Int256{0x8000000000000000, 0, 0, 0}, Uint256{0, 0, 0, 0}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint256()
+		if got != tc.want {
+			t.Errorf("Int256(%#066x).SaturatingUint256() = %#066x, want %#066x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt256_SaturatingUint512(t *testing.T) {
+	testCases := []struct {
+		a    Int256
+		want Uint512
+	}{
+		{Int256{0, 0, 0, 0}, Uint512{0, 0, 0, 0, 0, 0, 0, 0}},
+		{Int256{0, 0, 0, 0x1}, Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint512{0, 0, 0, 0, 0, 0, 0, 0}},
+		{Int256{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint512{0, 0, 0, 0, 0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{Int256{0x8000000000000000, 0, 0, 0}, Uint512{0, 0, 0, 0, 0, 0, 0, 0}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint512()
+		if got != tc.want {
+			t.Errorf("Int256(%#066x).SaturatingUint512() = %#0130x, want %#0130x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt256_SaturatingUint1024(t *testing.T) {
+	testCases := []struct {
+		a    Int256
+		want Uint1024
+	}{
+		{Int256{0, 0, 0, 0}, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{Int256{0, 0, 0, 0x1}, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{Int256{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{Int256{0x8000000000000000, 0, 0, 0}, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint1024()
+		if got != tc.want {
+			t.Errorf("Int256(%#066x).SaturatingUint1024() = %#0258x, want %#0258x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt512_TryInt8(t *testing.T) {
+	testCases := []struct {
+		a    Int512
+		want Int8
+		ok   bool
+		sat  Int8
+	}{
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0}, 0, true, 0},
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0x1}, 1, true, 1},
+		{Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, -1, true, -1},
+		{Int512{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, -1, false, 127},
+		{Int512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, 0, false, -128},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt8()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int512(%#0130x).TryInt8() = %#04x, %t, want %#04x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt8()
+		if got != tc.sat {
+			t.Errorf("Int512(%#0130x).SaturatingInt8() = %#04x, want %#04x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt512_TryInt16(t *testing.T) {
+	testCases := []struct {
+		a    Int512
+		want Int16
+		ok   bool
+		sat  Int16
+	}{
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0}, 0, true, 0},
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0x1}, 1, true, 1},
+		{Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, -1, true, -1},
+		{Int512{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, -1, false, 32767},
+		{Int512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, 0, false, -32768},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt16()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int512(%#0130x).TryInt16() = %#06x, %t, want %#06x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt16()
+		if got != tc.sat {
+			t.Errorf("Int512(%#0130x).SaturatingInt16() = %#06x, want %#06x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt512_TryInt32(t *testing.T) {
+	testCases := []struct {
+		a    Int512
+		want Int32
+		ok   bool
+		sat  Int32
+	}{
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0}, 0, true, 0},
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0x1}, 1, true, 1},
+		{Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, -1, true, -1},
+		{Int512{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, -1, false, 2147483647},
+		{Int512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, 0, false, -2147483648},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt32()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int512(%#0130x).TryInt32() = %#010x, %t, want %#010x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt32()
+		if got != tc.sat {
+			t.Errorf("Int512(%#0130x).SaturatingInt32() = %#010x, want %#010x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt512_TryInt64(t *testing.T) {
+	testCases := []struct {
+		a    Int512
+		want Int64
+		ok   bool
+		sat  Int64
+	}{
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0}, 0, true, 0},
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0x1}, 1, true, 1},
+		{Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, -1, true, -1},
+		{Int512{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, -1, false, 9223372036854775807},
+		{Int512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, 0, false, -9223372036854775808},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt64()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int512(%#0130x).TryInt64() = %#018x, %t, want %#018x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt64()
+		if got != tc.sat {
+			t.Errorf("Int512(%#0130x).SaturatingInt64() = %#018x, want %#018x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt512_TryInt128(t *testing.T) {
+	testCases := []struct {
+		a    Int512
+		want Int128
+		ok   bool
+		sat  Int128
+	}{
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0}, Int128{0, 0}, true, Int128{0, 0}},
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0x1}, Int128{0, 0x1}, true, Int128{0, 0x1}},
+		{Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Int128{math.MaxUint64, math.MaxUint64}, true, Int128{math.MaxUint64, math.MaxUint64}},
+		{Int512{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Int128{math.MaxUint64, math.MaxUint64}, false, Int128{0x7fffffffffffffff, math.MaxUint64}},
+		{Int512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, Int128{0, 0}, false, Int128{0x8000000000000000, 0}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt128()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int512(%#0130x).TryInt128() = %#034x, %t, want %#034x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt128()
+		if got != tc.sat {
+			t.Errorf("Int512(%#0130x).SaturatingInt128() = %#034x, want %#034x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt512_TryInt256(t *testing.T) {
+	testCases := []struct {
+		a    Int512
+		want Int256
+		ok   bool
+		sat  Int256
+	}{
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0}, Int256{0, 0, 0, 0}, true, Int256{0, 0, 0, 0}},
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0x1}, Int256{0, 0, 0, 0x1}, true, Int256{0, 0, 0, 0x1}},
+		{Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, true, Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{Int512{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false, Int256{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{Int512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, Int256{0, 0, 0, 0}, false, Int256{0x8000000000000000, 0, 0, 0}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt256()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int512(%#0130x).TryInt256() = %#066x, %t, want %#066x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt256()
+		if got != tc.sat {
+			t.Errorf("Int512(%#0130x).SaturatingInt256() = %#066x, want %#066x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt512_TryInt512(t *testing.T) {
+	testCases := []struct {
+		a    Int512
+		want Int512
+		ok   bool
+		sat  Int512
+	}{
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0}, Int512{0, 0, 0, 0, 0, 0, 0, 0}, true, Int512{0, 0, 0, 0, 0, 0, 0, 0}},
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0x1}, Int512{0, 0, 0, 0, 0, 0, 0, 0x1}, true, Int512{0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, true, Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{Int512{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Int512{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, true, Int512{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{Int512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, Int512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, true, Int512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt512()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int512(%#0130x).TryInt512() = %#0130x, %t, want %#0130x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt512()
+		if got != tc.sat {
+			t.Errorf("Int512(%#0130x).SaturatingInt512() = %#0130x, want %#0130x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt512_TryInt1024(t *testing.T) {
+	testCases := []struct {
+		a    Int512
+		want Int1024
+		ok   bool
+		sat  Int1024
+	}{
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0}, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, true, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0x1}, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, true, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Int1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, true, Int1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{Int512{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, true, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{Int512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, Int1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, true, Int1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt1024()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int512(%#0130x).TryInt1024() = %#0258x, %t, want %#0258x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt1024()
+		if got != tc.sat {
+			t.Errorf("Int512(%#0130x).SaturatingInt1024() = %#0258x, want %#0258x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt512_SaturatingUint8(t *testing.T) {
+	testCases := []struct {
+		a    Int512
+		want Uint8
+	}{
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0}, 0},
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0x1}, 1},
+		{Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 0},
+		{Int512{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 255},
+		{Int512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, 0},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint8()
+		if got != tc.want {
+			t.Errorf("Int512(%#0130x).SaturatingUint8() = %#04x, want %#04x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt512_SaturatingUint16(t *testing.T) {
+	testCases := []struct {
+		a    Int512
+		want Uint16
+	}{
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0}, 0},
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0x1}, 1},
+		{Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 0},
+		{Int512{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 65535},
+		{Int512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, 0},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint16()
+		if got != tc.want {
+			t.Errorf("Int512(%#0130x).SaturatingUint16() = %#06x, want %#06x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt512_SaturatingUint32(t *testing.T) {
+	testCases := []struct {
+		a    Int512
+		want Uint32
+	}{
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0}, 0},
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0x1}, 1},
+		{Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 0},
+		{Int512{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 4294967295},
+		{Int512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, 0},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint32()
+		if got != tc.want {
+			t.Errorf("Int512(%#0130x).SaturatingUint32() = %#010x, want %#010x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt512_SaturatingUint64(t *testing.T) {
+	testCases := []struct {
+		a    Int512
+		want Uint64
+	}{
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0}, 0},
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0x1}, 1},
+		{Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 0},
+		{Int512{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 18446744073709551615},
+		{Int512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, 0},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint64()
+		if got != tc.want {
+			t.Errorf("Int512(%#0130x).SaturatingUint64() = %#018x, want %#018x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt512_SaturatingUint128(t *testing.T) {
+	testCases := []struct {
+		a    Int512
+		want Uint128
+	}{
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0}, Uint128{0, 0}},
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0x1}, Uint128{0, 0x1}},
+		{Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint128{0, 0}},
+		{Int512{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint128{math.MaxUint64, math.MaxUint64}},
+		{Int512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, Uint128{0, 0}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint128()
+		if got != tc.want {
+			t.Errorf("Int512(%#0130x).SaturatingUint128() = %#034x, want %#034x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt512_SaturatingUint256(t *testing.T) {
+	testCases := []struct {
+		a    Int512
+		want Uint256
+	}{
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0}, Uint256{0, 0, 0, 0}},
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0x1}, Uint256{0, 0, 0, 0x1}},
+		{Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint256{0, 0, 0, 0}},
+		{Int512{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{Int512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, Uint256{0, 0, 0, 0}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint256()
+		if got != tc.want {
+			t.Errorf("Int512(%#0130x).SaturatingUint256() = %#066x, want %#066x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt512_SaturatingUint512(t *testing.T) {
+	testCases := []struct {
+		a    Int512
+		want Uint512
+	}{
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0}, Uint512{0, 0, 0, 0, 0, 0, 0, 0}},
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0x1}, Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint512{0, 0, 0, 0, 0, 0, 0, 0}},
+		{Int512{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint512{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{Int512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, Uint512{0, 0, 0, 0, 0, 0, 0, 0}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint512()
+		if got != tc.want {
+			t.Errorf("Int512(%#0130x).SaturatingUint512() = %#0130x, want %#0130x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt512_SaturatingUint1024(t *testing.T) {
+	testCases := []struct {
+		a    Int512
+		want Uint1024
+	}{
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0}, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0x1}, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{Int512{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{Int512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint1024()
+		if got != tc.want {
+			t.Errorf("Int512(%#0130x).SaturatingUint1024() = %#0258x, want %#0258x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt1024_TryInt8(t *testing.T) {
+	testCases := []struct {
+		a    Int1024
+		want Int8
+		ok   bool
+		sat  Int8
+	}{
+		{Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, 0, true, 0},
+		{Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, 1, true, 1},
+		{Int1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, -1, true, -1},
+		{Int1024{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, -1, false, 127},
+		{Int1024{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, 0, false, -128},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt8()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int1024(%#0258x).TryInt8() = %#04x, %t, want %#04x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt8()
+		if got != tc.sat {
+			t.Errorf("Int1024(%#0258x).SaturatingInt8() = %#04x, want %#04x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt1024_TryInt16(t *testing.T) {
+	testCases := []struct {
+		a    Int1024
+		want Int16
+		ok   bool
+		sat  Int16
+	}{
+		{Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, 0, true, 0},
+		{Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, 1, true, 1},
+		{Int1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, -1, true, -1},
+		{Int1024{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, -1, false, 32767},
+		{Int1024{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, 0, false, -32768},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt16()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int1024(%#0258x).TryInt16() = %#06x, %t, want %#06x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt16()
+		if got != tc.sat {
+			t.Errorf("Int1024(%#0258x).SaturatingInt16() = %#06x, want %#06x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt1024_TryInt32(t *testing.T) {
+	testCases := []struct {
+		a    Int1024
+		want Int32
+		ok   bool
+		sat  Int32
+	}{
+		{Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, 0, true, 0},
+		{Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, 1, true, 1},
+		{Int1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, -1, true, -1},
+		{Int1024{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, -1, false, 2147483647},
+		{Int1024{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, 0, false, -2147483648},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt32()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int1024(%#0258x).TryInt32() = %#010x, %t, want %#010x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt32()
+		if got != tc.sat {
+			t.Errorf("Int1024(%#0258x).SaturatingInt32() = %#010x, want %#010x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt1024_TryInt64(t *testing.T) {
+	testCases := []struct {
+		a    Int1024
+		want Int64
+		ok   bool
+		sat  Int64
+	}{
+		{Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, 0, true, 0},
+		{Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, 1, true, 1},
+		{Int1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, -1, true, -1},
+		{Int1024{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, -1, false, 9223372036854775807},
+		{Int1024{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, 0, false, -9223372036854775808},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt64()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int1024(%#0258x).TryInt64() = %#018x, %t, want %#018x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt64()
+		if got != tc.sat {
+			t.Errorf("Int1024(%#0258x).SaturatingInt64() = %#018x, want %#018x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt1024_TryInt128(t *testing.T) {
+	testCases := []struct {
+		a    Int1024
+		want Int128
+		ok   bool
+		sat  Int128
+	}{
+		{Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, Int128{0, 0}, true, Int128{0, 0}},
+		{Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, Int128{0, 0x1}, true, Int128{0, 0x1}},
+		{Int1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Int128{math.MaxUint64, math.MaxUint64}, true, Int128{math.MaxUint64, math.MaxUint64}},
+		{Int1024{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Int128{math.MaxUint64, math.MaxUint64}, false, Int128{0x7fffffffffffffff, math.MaxUint64}},
+		{Int1024{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, Int128{0, 0}, false, Int128{0x8000000000000000, 0}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt128()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int1024(%#0258x).TryInt128() = %#034x, %t, want %#034x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt128()
+		if got != tc.sat {
+			t.Errorf("Int1024(%#0258x).SaturatingInt128() = %#034x, want %#034x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt1024_TryInt256(t *testing.T) {
+	testCases := []struct {
+		a    Int1024
+		want Int256
+		ok   bool
+		sat  Int256
+	}{
+		{Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, Int256{0, 0, 0, 0}, true, Int256{0, 0, 0, 0}},
+		{Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, Int256{0, 0, 0, 0x1}, true, Int256{0, 0, 0, 0x1}},
+		{Int1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, true, Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{Int1024{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false, Int256{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{Int1024{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, Int256{0, 0, 0, 0}, false, Int256{0x8000000000000000, 0, 0, 0}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt256()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int1024(%#0258x).TryInt256() = %#066x, %t, want %#066x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt256()
+		if got != tc.sat {
+			t.Errorf("Int1024(%#0258x).SaturatingInt256() = %#066x, want %#066x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt1024_TryInt512(t *testing.T) {
+	testCases := []struct {
+		a    Int1024
+		want Int512
+		ok   bool
+		sat  Int512
+	}{
+		{Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, Int512{0, 0, 0, 0, 0, 0, 0, 0}, true, Int512{0, 0, 0, 0, 0, 0, 0, 0}},
+		{Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, Int512{0, 0, 0, 0, 0, 0, 0, 0x1}, true, Int512{0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{Int1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, true, Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{Int1024{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false, Int512{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{Int1024{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, Int512{0, 0, 0, 0, 0, 0, 0, 0}, false, Int512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt512()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int1024(%#0258x).TryInt512() = %#0130x, %t, want %#0130x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt512()
+		if got != tc.sat {
+			t.Errorf("Int1024(%#0258x).SaturatingInt512() = %#0130x, want %#0130x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt1024_TryInt1024(t *testing.T) {
+	testCases := []struct {
+		a    Int1024
+		want Int1024
+		ok   bool
+		sat  Int1024
+	}{
+		{Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, true, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, true, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{Int1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Int1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, true, Int1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{Int1024{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Int1024{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, true, Int1024{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{Int1024{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, Int1024{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, true, Int1024{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryInt1024()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int1024(%#0258x).TryInt1024() = %#0258x, %t, want %#0258x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingInt1024()
+		if got != tc.sat {
+			t.Errorf("Int1024(%#0258x).SaturatingInt1024() = %#0258x, want %#0258x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestInt1024_SaturatingUint8(t *testing.T) {
+	testCases := []struct {
+		a    Int1024
+		want Uint8
+	}{
+		{Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, 0},
+		{Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, 1},
+		{Int1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 0},
+		{Int1024{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 255},
+		{Int1024{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, 0},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint8()
+		if got != tc.want {
+			t.Errorf("Int1024(%#0258x).SaturatingUint8() = %#04x, want %#04x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt1024_SaturatingUint16(t *testing.T) {
+	testCases := []struct {
+		a    Int1024
+		want Uint16
+	}{
+		{Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, 0},
+		{Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, 1},
+		{Int1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 0},
+		{Int1024{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 65535},
+		{Int1024{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, 0},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint16()
+		if got != tc.want {
+			t.Errorf("Int1024(%#0258x).SaturatingUint16() = %#06x, want %#06x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt1024_SaturatingUint32(t *testing.T) {
+	testCases := []struct {
+		a    Int1024
+		want Uint32
+	}{
+		{Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, 0},
+		{Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, 1},
+		{Int1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 0},
+		{Int1024{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 4294967295},
+		{Int1024{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, 0},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint32()
+		if got != tc.want {
+			t.Errorf("Int1024(%#0258x).SaturatingUint32() = %#010x, want %#010x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt1024_SaturatingUint64(t *testing.T) {
+	testCases := []struct {
+		a    Int1024
+		want Uint64
+	}{
+		{Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, 0},
+		{Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, 1},
+		{Int1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 0},
+		{Int1024{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 18446744073709551615},
+		{Int1024{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, 0},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint64()
+		if got != tc.want {
+			t.Errorf("Int1024(%#0258x).SaturatingUint64() = %#018x, want %#018x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt1024_SaturatingUint128(t *testing.T) {
+	testCases := []struct {
+		a    Int1024
+		want Uint128
+	}{
+		{Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, Uint128{0, 0}},
+		{Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, Uint128{0, 0x1}},
+		{Int1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint128{0, 0}},
+		{Int1024{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint128{math.MaxUint64, math.MaxUint64}},
+		{Int1024{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, Uint128{0, 0}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint128()
+		if got != tc.want {
+			t.Errorf("Int1024(%#0258x).SaturatingUint128() = %#034x, want %#034x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt1024_SaturatingUint256(t *testing.T) {
+	testCases := []struct {
+		a    Int1024
+		want Uint256
+	}{
+		{Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, Uint256{0, 0, 0, 0}},
+		{Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, Uint256{0, 0, 0, 0x1}},
+		{Int1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint256{0, 0, 0, 0}},
+		{Int1024{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{Int1024{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, Uint256{0, 0, 0, 0}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint256()
+		if got != tc.want {
+			t.Errorf("Int1024(%#0258x).SaturatingUint256() = %#066x, want %#066x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt1024_SaturatingUint512(t *testing.T) {
+	testCases := []struct {
+		a    Int1024
+		want Uint512
+	}{
+		{Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, Uint512{0, 0, 0, 0, 0, 0, 0, 0}},
+		{Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{Int1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint512{0, 0, 0, 0, 0, 0, 0, 0}},
+		{Int1024{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{Int1024{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, Uint512{0, 0, 0, 0, 0, 0, 0, 0}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint512()
+		if got != tc.want {
+			t.Errorf("Int1024(%#0258x).SaturatingUint512() = %#0130x, want %#0130x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestInt1024_SaturatingUint1024(t *testing.T) {
+	testCases := []struct {
+		a    Int1024
+		want Uint1024
+	}{
+		{Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{Int1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{Int1024{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint1024{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{Int1024{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingUint1024()
+		if got != tc.want {
+			t.Errorf("Int1024(%#0258x).SaturatingUint1024() = %#0258x, want %#0258x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint8_TryUint8(t *testing.T) {
+	testCases := []struct {
+		a    Uint8
+		want Uint8
+		ok   bool
+		sat  Uint8
+	}{
+		{0, 0, true, 0},
+		{1, 1, true, 1},
+		{255, 255, true, 255},
+		{128, 128, true, 128},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint8()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint8(%#04x).TryUint8() = %#04x, %t, want %#04x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint8()
+		if got != tc.sat {
+			t.Errorf("Uint8(%#04x).SaturatingUint8() = %#04x, want %#04x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint8_TryUint16(t *testing.T) {
+	testCases := []struct {
+		a    Uint8
+		want Uint16
+		ok   bool
+		sat  Uint16
+	}{
+		{0, 0, true, 0},
+		{1, 1, true, 1},
+		{255, 255, true, 255},
+		{128, 128, true, 128},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint16()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint8(%#04x).TryUint16() = %#06x, %t, want %#06x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint16()
+		if got != tc.sat {
+			t.Errorf("Uint8(%#04x).SaturatingUint16() = %#06x, want %#06x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint8_TryUint32(t *testing.T) {
+	testCases := []struct {
+		a    Uint8
+		want Uint32
+		ok   bool
+		sat  Uint32
+	}{
+		{0, 0, true, 0},
+		{1, 1, true, 1},
+		{255, 255, true, 255},
+		{128, 128, true, 128},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint32()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint8(%#04x).TryUint32() = %#010x, %t, want %#010x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint32()
+		if got != tc.sat {
+			t.Errorf("Uint8(%#04x).SaturatingUint32() = %#010x, want %#010x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint8_TryUint64(t *testing.T) {
+	testCases := []struct {
+		a    Uint8
+		want Uint64
+		ok   bool
+		sat  Uint64
+	}{
+		{0, 0, true, 0},
+		{1, 1, true, 1},
+		{255, 255, true, 255},
+		{128, 128, true, 128},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint64()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint8(%#04x).TryUint64() = %#018x, %t, want %#018x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint64()
+		if got != tc.sat {
+			t.Errorf("Uint8(%#04x).SaturatingUint64() = %#018x, want %#018x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint8_TryUint128(t *testing.T) {
+	testCases := []struct {
+		a    Uint8
+		want Uint128
+		ok   bool
+		sat  Uint128
+	}{
+		{0, Uint128{0, 0}, true, Uint128{0, 0}},
+		{1, Uint128{0, 0x1}, true, Uint128{0, 0x1}},
+		{255, Uint128{0, 0xff}, true, Uint128{0, 0xff}},
+		{128, Uint128{0, 0x80}, true, Uint128{0, 0x80}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint128()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint8(%#04x).TryUint128() = %#034x, %t, want %#034x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint128()
+		if got != tc.sat {
+			t.Errorf("Uint8(%#04x).SaturatingUint128() = %#034x, want %#034x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint8_TryUint256(t *testing.T) {
+	testCases := []struct {
+		a    Uint8
+		want Uint256
+		ok   bool
+		sat  Uint256
+	}{
+		{0, Uint256{0, 0, 0, 0}, true, Uint256{0, 0, 0, 0}},
+		{1, Uint256{0, 0, 0, 0x1}, true, Uint256{0, 0, 0, 0x1}},
+		{255, Uint256{0, 0, 0, 0xff}, true, Uint256{0, 0, 0, 0xff}},
+		{128, Uint256{0, 0, 0, 0x80}, true, Uint256{0, 0, 0, 0x80}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint256()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint8(%#04x).TryUint256() = %#066x, %t, want %#066x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint256()
+		if got != tc.sat {
+			t.Errorf("Uint8(%#04x).SaturatingUint256() = %#066x, want %#066x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint8_TryUint512(t *testing.T) {
+	testCases := []struct {
+		a    Uint8
+		want Uint512
+		ok   bool
+		sat  Uint512
+	}{
+		{0, Uint512{0, 0, 0, 0, 0, 0, 0, 0}, true, Uint512{0, 0, 0, 0, 0, 0, 0, 0}},
+		{1, Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}, true, Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{255, Uint512{0, 0, 0, 0, 0, 0, 0, 0xff}, true, Uint512{0, 0, 0, 0, 0, 0, 0, 0xff}},
+		{128, Uint512{0, 0, 0, 0, 0, 0, 0, 0x80}, true, Uint512{0, 0, 0, 0, 0, 0, 0, 0x80}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint512()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint8(%#04x).TryUint512() = %#0130x, %t, want %#0130x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint512()
+		if got != tc.sat {
+			t.Errorf("Uint8(%#04x).SaturatingUint512() = %#0130x, want %#0130x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint8_TryUint1024(t *testing.T) {
+	testCases := []struct {
+		a    Uint8
+		want Uint1024
+		ok   bool
+		sat  Uint1024
+	}{
+		{0, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, true, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{1, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, true, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{255, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0xff}, true, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0xff}},
+		{128, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x80}, true, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x80}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint1024()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint8(%#04x).TryUint1024() = %#0258x, %t, want %#0258x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint1024()
+		if got != tc.sat {
+			t.Errorf("Uint8(%#04x).SaturatingUint1024() = %#0258x, want %#0258x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint8_SaturatingInt8(t *testing.T) {
+	testCases := []struct {
+		a    Uint8
+		want Int8
+	}{
+		{0, 0},
+		{1, 1},
+		{255, 127},
+		{128, 127},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt8()
+		if got != tc.want {
+			t.Errorf("Uint8(%#04x).SaturatingInt8() = %#04x, want %#04x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint8_SaturatingInt16(t *testing.T) {
+	testCases := []struct {
+		a    Uint8
+		want Int16
+	}{
+		{0, 0},
+		{1, 1},
+		{255, 255},
+		{128, 128},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt16()
+		if got != tc.want {
+			t.Errorf("Uint8(%#04x).SaturatingInt16() = %#06x, want %#06x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint8_SaturatingInt32(t *testing.T) {
+	testCases := []struct {
+		a    Uint8
+		want Int32
+	}{
+		{0, 0},
+		{1, 1},
+		{255, 255},
+		{128, 128},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt32()
+		if got != tc.want {
+			t.Errorf("Uint8(%#04x).SaturatingInt32() = %#010x, want %#010x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint8_SaturatingInt64(t *testing.T) {
+	testCases := []struct {
+		a    Uint8
+		want Int64
+	}{
+		{0, 0},
+		{1, 1},
+		{255, 255},
+		{128, 128},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt64()
+		if got != tc.want {
+			t.Errorf("Uint8(%#04x).SaturatingInt64() = %#018x, want %#018x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint8_SaturatingInt128(t *testing.T) {
+	testCases := []struct {
+		a    Uint8
+		want Int128
+	}{
+		{0, Int128{0, 0}},
+		{1, Int128{0, 0x1}},
+		{255, Int128{0, 0xff}},
+		{128, Int128{0, 0x80}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt128()
+		if got != tc.want {
+			t.Errorf("Uint8(%#04x).SaturatingInt128() = %#034x, want %#034x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint8_SaturatingInt256(t *testing.T) {
+	testCases := []struct {
+		a    Uint8
+		want Int256
+	}{
+		{0, Int256{0, 0, 0, 0}},
+		{1, Int256{0, 0, 0, 0x1}},
+		{255, Int256{0, 0, 0, 0xff}},
+		{128, Int256{0, 0, 0, 0x80}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt256()
+		if got != tc.want {
+			t.Errorf("Uint8(%#04x).SaturatingInt256() = %#066x, want %#066x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint8_SaturatingInt512(t *testing.T) {
+	testCases := []struct {
+		a    Uint8
+		want Int512
+	}{
+		{0, Int512{0, 0, 0, 0, 0, 0, 0, 0}},
+		{1, Int512{0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{255, Int512{0, 0, 0, 0, 0, 0, 0, 0xff}},
+		{128, Int512{0, 0, 0, 0, 0, 0, 0, 0x80}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt512()
+		if got != tc.want {
+			t.Errorf("Uint8(%#04x).SaturatingInt512() = %#0130x, want %#0130x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint8_SaturatingInt1024(t *testing.T) {
+	testCases := []struct {
+		a    Uint8
+		want Int1024
+	}{
+		{0, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{1, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{255, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0xff}},
+		{128, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x80}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt1024()
+		if got != tc.want {
+			t.Errorf("Uint8(%#04x).SaturatingInt1024() = %#0258x, want %#0258x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint16_TryUint8(t *testing.T) {
+	testCases := []struct {
+		a    Uint16
+		want Uint8
+		ok   bool
+		sat  Uint8
+	}{
+		{0, 0, true, 0},
+		{1, 1, true, 1},
+		{65535, 255, false, 255},
+		{32768, 0, false, 255},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint8()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint16(%#06x).TryUint8() = %#04x, %t, want %#04x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint8()
+		if got != tc.sat {
+			t.Errorf("Uint16(%#06x).SaturatingUint8() = %#04x, want %#04x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint16_TryUint16(t *testing.T) {
+	testCases := []struct {
+		a    Uint16
+		want Uint16
+		ok   bool
+		sat  Uint16
+	}{
+		{0, 0, true, 0},
+		{1, 1, true, 1},
+		{65535, 65535, true, 65535},
+		{32768, 32768, true, 32768},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint16()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint16(%#06x).TryUint16() = %#06x, %t, want %#06x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint16()
+		if got != tc.sat {
+			t.Errorf("Uint16(%#06x).SaturatingUint16() = %#06x, want %#06x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint16_TryUint32(t *testing.T) {
+	testCases := []struct {
+		a    Uint16
+		want Uint32
+		ok   bool
+		sat  Uint32
+	}{
+		{0, 0, true, 0},
+		{1, 1, true, 1},
+		{65535, 65535, true, 65535},
+		{32768, 32768, true, 32768},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint32()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint16(%#06x).TryUint32() = %#010x, %t, want %#010x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint32()
+		if got != tc.sat {
+			t.Errorf("Uint16(%#06x).SaturatingUint32() = %#010x, want %#010x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint16_TryUint64(t *testing.T) {
+	testCases := []struct {
+		a    Uint16
+		want Uint64
+		ok   bool
+		sat  Uint64
+	}{
+		{0, 0, true, 0},
+		{1, 1, true, 1},
+		{65535, 65535, true, 65535},
+		{32768, 32768, true, 32768},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint64()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint16(%#06x).TryUint64() = %#018x, %t, want %#018x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint64()
+		if got != tc.sat {
+			t.Errorf("Uint16(%#06x).SaturatingUint64() = %#018x, want %#018x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint16_TryUint128(t *testing.T) {
+	testCases := []struct {
+		a    Uint16
+		want Uint128
+		ok   bool
+		sat  Uint128
+	}{
+		{0, Uint128{0, 0}, true, Uint128{0, 0}},
+		{1, Uint128{0, 0x1}, true, Uint128{0, 0x1}},
+		{65535, Uint128{0, 0xffff}, true, Uint128{0, 0xffff}},
+		{32768, Uint128{0, 0x8000}, true, Uint128{0, 0x8000}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint128()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint16(%#06x).TryUint128() = %#034x, %t, want %#034x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint128()
+		if got != tc.sat {
+			t.Errorf("Uint16(%#06x).SaturatingUint128() = %#034x, want %#034x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint16_TryUint256(t *testing.T) {
+	testCases := []struct {
+		a    Uint16
+		want Uint256
+		ok   bool
+		sat  Uint256
+	}{
+		{0, Uint256{0, 0, 0, 0}, true, Uint256{0, 0, 0, 0}},
+		{1, Uint256{0, 0, 0, 0x1}, true, Uint256{0, 0, 0, 0x1}},
+		{65535, Uint256{0, 0, 0, 0xffff}, true, Uint256{0, 0, 0, 0xffff}},
+		{32768, Uint256{0, 0, 0, 0x8000}, true, Uint256{0, 0, 0, 0x8000}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint256()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint16(%#06x).TryUint256() = %#066x, %t, want %#066x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint256()
+		if got != tc.sat {
+			t.Errorf("Uint16(%#06x).SaturatingUint256() = %#066x, want %#066x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint16_TryUint512(t *testing.T) {
+	testCases := []struct {
+		a    Uint16
+		want Uint512
+		ok   bool
+		sat  Uint512
+	}{
+		{0, Uint512{0, 0, 0, 0, 0, 0, 0, 0}, true, Uint512{0, 0, 0, 0, 0, 0, 0, 0}},
+		{1, Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}, true, Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{65535, Uint512{0, 0, 0, 0, 0, 0, 0, 0xffff}, true, Uint512{0, 0, 0, 0, 0, 0, 0, 0xffff}},
+		{32768, Uint512{0, 0, 0, 0, 0, 0, 0, 0x8000}, true, Uint512{0, 0, 0, 0, 0, 0, 0, 0x8000}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint512()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint16(%#06x).TryUint512() = %#0130x, %t, want %#0130x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint512()
+		if got != tc.sat {
+			t.Errorf("Uint16(%#06x).SaturatingUint512() = %#0130x, want %#0130x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint16_TryUint1024(t *testing.T) {
+	testCases := []struct {
+		a    Uint16
+		want Uint1024
+		ok   bool
+		sat  Uint1024
+	}{
+		{0, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, true, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{1, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, true, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{65535, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0xffff}, true, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0xffff}},
+		{32768, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x8000}, true, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x8000}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint1024()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint16(%#06x).TryUint1024() = %#0258x, %t, want %#0258x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint1024()
+		if got != tc.sat {
+			t.Errorf("Uint16(%#06x).SaturatingUint1024() = %#0258x, want %#0258x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint16_SaturatingInt8(t *testing.T) {
+	testCases := []struct {
+		a    Uint16
+		want Int8
+	}{
+		{0, 0},
+		{1, 1},
+		{65535, 127},
+		{32768, 127},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt8()
+		if got != tc.want {
+			t.Errorf("Uint16(%#06x).SaturatingInt8() = %#04x, want %#04x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint16_SaturatingInt16(t *testing.T) {
+	testCases := []struct {
+		a    Uint16
+		want Int16
+	}{
+		{0, 0},
+		{1, 1},
+		{65535, 32767},
+		{32768, 32767},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt16()
+		if got != tc.want {
+			t.Errorf("Uint16(%#06x).SaturatingInt16() = %#06x, want %#06x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint16_SaturatingInt32(t *testing.T) {
+	testCases := []struct {
+		a    Uint16
+		want Int32
+	}{
+		{0, 0},
+		{1, 1},
+		{65535, 65535},
+		{32768, 32768},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt32()
+		if got != tc.want {
+			t.Errorf("Uint16(%#06x).SaturatingInt32() = %#010x, want %#010x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint16_SaturatingInt64(t *testing.T) {
+	testCases := []struct {
+		a    Uint16
+		want Int64
+	}{
+		{0, 0},
+		{1, 1},
+		{65535, 65535},
+		{32768, 32768},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt64()
+		if got != tc.want {
+			t.Errorf("Uint16(%#06x).SaturatingInt64() = %#018x, want %#018x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint16_SaturatingInt128(t *testing.T) {
+	testCases := []struct {
+		a    Uint16
+		want Int128
+	}{
+		{0, Int128{0, 0}},
+		{1, Int128{0, 0x1}},
+		{65535, Int128{0, 0xffff}},
+		{32768, Int128{0, 0x8000}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt128()
+		if got != tc.want {
+			t.Errorf("Uint16(%#06x).SaturatingInt128() = %#034x, want %#034x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint16_SaturatingInt256(t *testing.T) {
+	testCases := []struct {
+		a    Uint16
+		want Int256
+	}{
+		{0, Int256{0, 0, 0, 0}},
+		{1, Int256{0, 0, 0, 0x1}},
+		{65535, Int256{0, 0, 0, 0xffff}},
+		{32768, Int256{0, 0, 0, 0x8000}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt256()
+		if got != tc.want {
+			t.Errorf("Uint16(%#06x).SaturatingInt256() = %#066x, want %#066x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint16_SaturatingInt512(t *testing.T) {
+	testCases := []struct {
+		a    Uint16
+		want Int512
+	}{
+		{0, Int512{0, 0, 0, 0, 0, 0, 0, 0}},
+		{1, Int512{0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{65535, Int512{0, 0, 0, 0, 0, 0, 0, 0xffff}},
+		{32768, Int512{0, 0, 0, 0, 0, 0, 0, 0x8000}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt512()
+		if got != tc.want {
+			t.Errorf("Uint16(%#06x).SaturatingInt512() = %#0130x, want %#0130x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint16_SaturatingInt1024(t *testing.T) {
+	testCases := []struct {
+		a    Uint16
+		want Int1024
+	}{
+		{0, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{1, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{65535, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0xffff}},
+		{32768, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x8000}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt1024()
+		if got != tc.want {
+			t.Errorf("Uint16(%#06x).SaturatingInt1024() = %#0258x, want %#0258x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint32_TryUint8(t *testing.T) {
+	testCases := []struct {
+		a    Uint32
+		want Uint8
+		ok   bool
+		sat  Uint8
+	}{
+		{0, 0, true, 0},
+		{1, 1, true, 1},
+		{4294967295, 255, false, 255},
+		{2147483648, 0, false, 255},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint8()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint32(%#010x).TryUint8() = %#04x, %t, want %#04x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint8()
+		if got != tc.sat {
+			t.Errorf("Uint32(%#010x).SaturatingUint8() = %#04x, want %#04x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint32_TryUint16(t *testing.T) {
+	testCases := []struct {
+		a    Uint32
+		want Uint16
+		ok   bool
+		sat  Uint16
+	}{
+		{0, 0, true, 0},
+		{1, 1, true, 1},
+		{4294967295, 65535, false, 65535},
+		{2147483648, 0, false, 65535},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint16()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint32(%#010x).TryUint16() = %#06x, %t, want %#06x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint16()
+		if got != tc.sat {
+			t.Errorf("Uint32(%#010x).SaturatingUint16() = %#06x, want %#06x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint32_TryUint32(t *testing.T) {
+	testCases := []struct {
+		a    Uint32
+		want Uint32
+		ok   bool
+		sat  Uint32
+	}{
+		{0, 0, true, 0},
+		{1, 1, true, 1},
+		{4294967295, 4294967295, true, 4294967295},
+		{2147483648, 2147483648, true, 2147483648},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint32()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint32(%#010x).TryUint32() = %#010x, %t, want %#010x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint32()
+		if got != tc.sat {
+			t.Errorf("Uint32(%#010x).SaturatingUint32() = %#010x, want %#010x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint32_TryUint64(t *testing.T) {
+	testCases := []struct {
+		a    Uint32
+		want Uint64
+		ok   bool
+		sat  Uint64
+	}{
+		{0, 0, true, 0},
+		{1, 1, true, 1},
+		{4294967295, 4294967295, true, 4294967295},
+		{2147483648, 2147483648, true, 2147483648},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint64()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint32(%#010x).TryUint64() = %#018x, %t, want %#018x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint64()
+		if got != tc.sat {
+			t.Errorf("Uint32(%#010x).SaturatingUint64() = %#018x, want %#018x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint32_TryUint128(t *testing.T) {
+	testCases := []struct {
+		a    Uint32
+		want Uint128
+		ok   bool
+		sat  Uint128
+	}{
+		{0, Uint128{0, 0}, true, Uint128{0, 0}},
+		{1, Uint128{0, 0x1}, true, Uint128{0, 0x1}},
+		{4294967295, Uint128{0, 0xffffffff}, true, Uint128{0, 0xffffffff}},
+		{2147483648, Uint128{0, 0x80000000}, true, Uint128{0, 0x80000000}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint128()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint32(%#010x).TryUint128() = %#034x, %t, want %#034x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint128()
+		if got != tc.sat {
+			t.Errorf("Uint32(%#010x).SaturatingUint128() = %#034x, want %#034x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint32_TryUint256(t *testing.T) {
+	testCases := []struct {
+		a    Uint32
+		want Uint256
+		ok   bool
+		sat  Uint256
+	}{
+		{0, Uint256{0, 0, 0, 0}, true, Uint256{0, 0, 0, 0}},
+		{1, Uint256{0, 0, 0, 0x1}, true, Uint256{0, 0, 0, 0x1}},
+		{4294967295, Uint256{0, 0, 0, 0xffffffff}, true, Uint256{0, 0, 0, 0xffffffff}},
+		{2147483648, Uint256{0, 0, 0, 0x80000000}, true, Uint256{0, 0, 0, 0x80000000}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint256()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint32(%#010x).TryUint256() = %#066x, %t, want %#066x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint256()
+		if got != tc.sat {
+			t.Errorf("Uint32(%#010x).SaturatingUint256() = %#066x, want %#066x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint32_TryUint512(t *testing.T) {
+	testCases := []struct {
+		a    Uint32
+		want Uint512
+		ok   bool
+		sat  Uint512
+	}{
+		{0, Uint512{0, 0, 0, 0, 0, 0, 0, 0}, true, Uint512{0, 0, 0, 0, 0, 0, 0, 0}},
+		{1, Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}, true, Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{4294967295, Uint512{0, 0, 0, 0, 0, 0, 0, 0xffffffff}, true, Uint512{0, 0, 0, 0, 0, 0, 0, 0xffffffff}},
+		{2147483648, Uint512{0, 0, 0, 0, 0, 0, 0, 0x80000000}, true, Uint512{0, 0, 0, 0, 0, 0, 0, 0x80000000}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint512()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint32(%#010x).TryUint512() = %#0130x, %t, want %#0130x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint512()
+		if got != tc.sat {
+			t.Errorf("Uint32(%#010x).SaturatingUint512() = %#0130x, want %#0130x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint32_TryUint1024(t *testing.T) {
+	testCases := []struct {
+		a    Uint32
+		want Uint1024
+		ok   bool
+		sat  Uint1024
+	}{
+		{0, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, true, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{1, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, true, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{4294967295, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0xffffffff}, true, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0xffffffff}},
+		{2147483648, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x80000000}, true, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x80000000}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint1024()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint32(%#010x).TryUint1024() = %#0258x, %t, want %#0258x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint1024()
+		if got != tc.sat {
+			t.Errorf("Uint32(%#010x).SaturatingUint1024() = %#0258x, want %#0258x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint32_SaturatingInt8(t *testing.T) {
+	testCases := []struct {
+		a    Uint32
+		want Int8
+	}{
+		{0, 0},
+		{1, 1},
+		{4294967295, 127},
+		{2147483648, 127},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt8()
+		if got != tc.want {
+			t.Errorf("Uint32(%#010x).SaturatingInt8() = %#04x, want %#04x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint32_SaturatingInt16(t *testing.T) {
+	testCases := []struct {
+		a    Uint32
+		want Int16
+	}{
+		{0, 0},
+		{1, 1},
+		{4294967295, 32767},
+		{2147483648, 32767},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt16()
+		if got != tc.want {
+			t.Errorf("Uint32(%#010x).SaturatingInt16() = %#06x, want %#06x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint32_SaturatingInt32(t *testing.T) {
+	testCases := []struct {
+		a    Uint32
+		want Int32
+	}{
+		{0, 0},
+		{1, 1},
+		{4294967295, 2147483647},
+		{2147483648, 2147483647},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt32()
+		if got != tc.want {
+			t.Errorf("Uint32(%#010x).SaturatingInt32() = %#010x, want %#010x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint32_SaturatingInt64(t *testing.T) {
+	testCases := []struct {
+		a    Uint32
+		want Int64
+	}{
+		{0, 0},
+		{1, 1},
+		{4294967295, 4294967295},
+		{2147483648, 2147483648},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt64()
+		if got != tc.want {
+			t.Errorf("Uint32(%#010x).SaturatingInt64() = %#018x, want %#018x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint32_SaturatingInt128(t *testing.T) {
+	testCases := []struct {
+		a    Uint32
+		want Int128
+	}{
+		{0, Int128{0, 0}},
+		{1, Int128{0, 0x1}},
+		{4294967295, Int128{0, 0xffffffff}},
+		{2147483648, Int128{0, 0x80000000}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt128()
+		if got != tc.want {
+			t.Errorf("Uint32(%#010x).SaturatingInt128() = %#034x, want %#034x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint32_SaturatingInt256(t *testing.T) {
+	testCases := []struct {
+		a    Uint32
+		want Int256
+	}{
+		{0, Int256{0, 0, 0, 0}},
+		{1, Int256{0, 0, 0, 0x1}},
+		{4294967295, Int256{0, 0, 0, 0xffffffff}},
+		{2147483648, Int256{0, 0, 0, 0x80000000}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt256()
+		if got != tc.want {
+			t.Errorf("Uint32(%#010x).SaturatingInt256() = %#066x, want %#066x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint32_SaturatingInt512(t *testing.T) {
+	testCases := []struct {
+		a    Uint32
+		want Int512
+	}{
+		{0, Int512{0, 0, 0, 0, 0, 0, 0, 0}},
+		{1, Int512{0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{4294967295, Int512{0, 0, 0, 0, 0, 0, 0, 0xffffffff}},
+		{2147483648, Int512{0, 0, 0, 0, 0, 0, 0, 0x80000000}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt512()
+		if got != tc.want {
+			t.Errorf("Uint32(%#010x).SaturatingInt512() = %#0130x, want %#0130x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint32_SaturatingInt1024(t *testing.T) {
+	testCases := []struct {
+		a    Uint32
+		want Int1024
+	}{
+		{0, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{1, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{4294967295, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0xffffffff}},
+		{2147483648, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x80000000}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt1024()
+		if got != tc.want {
+			t.Errorf("Uint32(%#010x).SaturatingInt1024() = %#0258x, want %#0258x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint64_TryUint8(t *testing.T) {
+	testCases := []struct {
+		a    Uint64
+		want Uint8
+		ok   bool
+		sat  Uint8
+	}{
+		{0, 0, true, 0},
+		{1, 1, true, 1},
+		{18446744073709551615, 255, false, 255},
+		{9223372036854775808, 0, false, 255},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint8()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint64(%#018x).TryUint8() = %#04x, %t, want %#04x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint8()
+		if got != tc.sat {
+			t.Errorf("Uint64(%#018x).SaturatingUint8() = %#04x, want %#04x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint64_TryUint16(t *testing.T) {
+	testCases := []struct {
+		a    Uint64
+		want Uint16
+		ok   bool
+		sat  Uint16
+	}{
+		{0, 0, true, 0},
+		{1, 1, true, 1},
+		{18446744073709551615, 65535, false, 65535},
+		{9223372036854775808, 0, false, 65535},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint16()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint64(%#018x).TryUint16() = %#06x, %t, want %#06x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint16()
+		if got != tc.sat {
+			t.Errorf("Uint64(%#018x).SaturatingUint16() = %#06x, want %#06x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint64_TryUint32(t *testing.T) {
+	testCases := []struct {
+		a    Uint64
+		want Uint32
+		ok   bool
+		sat  Uint32
+	}{
+		{0, 0, true, 0},
+		{1, 1, true, 1},
+		{18446744073709551615, 4294967295, false, 4294967295},
+		{9223372036854775808, 0, false, 4294967295},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint32()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint64(%#018x).TryUint32() = %#010x, %t, want %#010x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint32()
+		if got != tc.sat {
+			t.Errorf("Uint64(%#018x).SaturatingUint32() = %#010x, want %#010x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint64_TryUint64(t *testing.T) {
+	testCases := []struct {
+		a    Uint64
+		want Uint64
+		ok   bool
+		sat  Uint64
+	}{
+		{0, 0, true, 0},
+		{1, 1, true, 1},
+		{18446744073709551615, 18446744073709551615, true, 18446744073709551615},
+		{9223372036854775808, 9223372036854775808, true, 9223372036854775808},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint64()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint64(%#018x).TryUint64() = %#018x, %t, want %#018x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint64()
+		if got != tc.sat {
+			t.Errorf("Uint64(%#018x).SaturatingUint64() = %#018x, want %#018x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint64_TryUint128(t *testing.T) {
+	testCases := []struct {
+		a    Uint64
+		want Uint128
+		ok   bool
+		sat  Uint128
+	}{
+		{0, Uint128{0, 0}, true, Uint128{0, 0}},
+		{1, Uint128{0, 0x1}, true, Uint128{0, 0x1}},
+		{18446744073709551615, Uint128{0, math.MaxUint64}, true, Uint128{0, math.MaxUint64}},
+		{9223372036854775808, Uint128{0, 0x8000000000000000}, true, Uint128{0, 0x8000000000000000}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint128()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint64(%#018x).TryUint128() = %#034x, %t, want %#034x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint128()
+		if got != tc.sat {
+			t.Errorf("Uint64(%#018x).SaturatingUint128() = %#034x, want %#034x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint64_TryUint256(t *testing.T) {
+	testCases := []struct {
+		a    Uint64
+		want Uint256
+		ok   bool
+		sat  Uint256
+	}{
+		{0, Uint256{0, 0, 0, 0}, true, Uint256{0, 0, 0, 0}},
+		{1, Uint256{0, 0, 0, 0x1}, true, Uint256{0, 0, 0, 0x1}},
+		{18446744073709551615, Uint256{0, 0, 0, math.MaxUint64}, true, Uint256{0, 0, 0, math.MaxUint64}},
+		{9223372036854775808, Uint256{0, 0, 0, 0x8000000000000000}, true, Uint256{0, 0, 0, 0x8000000000000000}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint256()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint64(%#018x).TryUint256() = %#066x, %t, want %#066x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint256()
+		if got != tc.sat {
+			t.Errorf("Uint64(%#018x).SaturatingUint256() = %#066x, want %#066x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint64_TryUint512(t *testing.T) {
+	testCases := []struct {
+		a    Uint64
+		want Uint512
+		ok   bool
+		sat  Uint512
+	}{
+		{0, Uint512{0, 0, 0, 0, 0, 0, 0, 0}, true, Uint512{0, 0, 0, 0, 0, 0, 0, 0}},
+		{1, Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}, true, Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{18446744073709551615, Uint512{0, 0, 0, 0, 0, 0, 0, math.MaxUint64}, true, Uint512{0, 0, 0, 0, 0, 0, 0, math.MaxUint64}},
+		{9223372036854775808, Uint512{0, 0, 0, 0, 0, 0, 0, 0x8000000000000000}, true, Uint512{0, 0, 0, 0, 0, 0, 0, 0x8000000000000000}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint512()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint64(%#018x).TryUint512() = %#0130x, %t, want %#0130x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint512()
+		if got != tc.sat {
+			t.Errorf("Uint64(%#018x).SaturatingUint512() = %#0130x, want %#0130x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint64_TryUint1024(t *testing.T) {
+	testCases := []struct {
+		a    Uint64
+		want Uint1024
+		ok   bool
+		sat  Uint1024
+	}{
+		{0, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, true, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{1, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, true, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{18446744073709551615, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, math.MaxUint64}, true, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, math.MaxUint64}},
+		{9223372036854775808, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x8000000000000000}, true, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x8000000000000000}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint1024()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint64(%#018x).TryUint1024() = %#0258x, %t, want %#0258x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint1024()
+		if got != tc.sat {
+			t.Errorf("Uint64(%#018x).SaturatingUint1024() = %#0258x, want %#0258x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint64_SaturatingInt8(t *testing.T) {
+	testCases := []struct {
+		a    Uint64
+		want Int8
+	}{
+		{0, 0},
+		{1, 1},
+		{18446744073709551615, 127},
+		{9223372036854775808, 127},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt8()
+		if got != tc.want {
+			t.Errorf("Uint64(%#018x).SaturatingInt8() = %#04x, want %#04x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint64_SaturatingInt16(t *testing.T) {
+	testCases := []struct {
+		a    Uint64
+		want Int16
+	}{
+		{0, 0},
+		{1, 1},
+		{18446744073709551615, 32767},
+		{9223372036854775808, 32767},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt16()
+		if got != tc.want {
+			t.Errorf("Uint64(%#018x).SaturatingInt16() = %#06x, want %#06x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint64_SaturatingInt32(t *testing.T) {
+	testCases := []struct {
+		a    Uint64
+		want Int32
+	}{
+		{0, 0},
+		{1, 1},
+		{18446744073709551615, 2147483647},
+		{9223372036854775808, 2147483647},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt32()
+		if got != tc.want {
+			t.Errorf("Uint64(%#018x).SaturatingInt32() = %#010x, want %#010x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint64_SaturatingInt64(t *testing.T) {
+	testCases := []struct {
+		a    Uint64
+		want Int64
+	}{
+		{0, 0},
+		{1, 1},
+		{18446744073709551615, 9223372036854775807},
+		{9223372036854775808, 9223372036854775807},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt64()
+		if got != tc.want {
+			t.Errorf("Uint64(%#018x).SaturatingInt64() = %#018x, want %#018x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint64_SaturatingInt128(t *testing.T) {
+	testCases := []struct {
+		a    Uint64
+		want Int128
+	}{
+		{0, Int128{0, 0}},
+		{1, Int128{0, 0x1}},
+		{18446744073709551615, Int128{0, math.MaxUint64}},
+		{9223372036854775808, Int128{0, 0x8000000000000000}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt128()
+		if got != tc.want {
+			t.Errorf("Uint64(%#018x).SaturatingInt128() = %#034x, want %#034x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint64_SaturatingInt256(t *testing.T) {
+	testCases := []struct {
+		a    Uint64
+		want Int256
+	}{
+		{0, Int256{0, 0, 0, 0}},
+		{1, Int256{0, 0, 0, 0x1}},
+		{18446744073709551615, Int256{0, 0, 0, math.MaxUint64}},
+		{9223372036854775808, Int256{0, 0, 0, 0x8000000000000000}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt256()
+		if got != tc.want {
+			t.Errorf("Uint64(%#018x).SaturatingInt256() = %#066x, want %#066x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint64_SaturatingInt512(t *testing.T) {
+	testCases := []struct {
+		a    Uint64
+		want Int512
+	}{
+		{0, Int512{0, 0, 0, 0, 0, 0, 0, 0}},
+		{1, Int512{0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{18446744073709551615, Int512{0, 0, 0, 0, 0, 0, 0, math.MaxUint64}},
+		{9223372036854775808, Int512{0, 0, 0, 0, 0, 0, 0, 0x8000000000000000}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt512()
+		if got != tc.want {
+			t.Errorf("Uint64(%#018x).SaturatingInt512() = %#0130x, want %#0130x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint64_SaturatingInt1024(t *testing.T) {
+	testCases := []struct {
+		a    Uint64
+		want Int1024
+	}{
+		{0, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{1, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{18446744073709551615, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, math.MaxUint64}},
+		{9223372036854775808, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x8000000000000000}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt1024()
+		if got != tc.want {
+			t.Errorf("Uint64(%#018x).SaturatingInt1024() = %#0258x, want %#0258x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint128_TryUint8(t *testing.T) {
+	testCases := []struct {
+		a    Uint128
+		want Uint8
+		ok   bool
+		sat  Uint8
+	}{
+		{Uint128{0, 0}, 0, true, 0},
+		{Uint128{0, 0x1}, 1, true, 1},
+		{Uint128{math.MaxUint64, math.MaxUint64}, 255, false, 255},
+		{Uint128{0x8000000000000000, 0}, 0, false, 255},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint8()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint128(%#034x).TryUint8() = %#04x, %t, want %#04x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint8()
+		if got != tc.sat {
+			t.Errorf("Uint128(%#034x).SaturatingUint8() = %#04x, want %#04x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint128_TryUint16(t *testing.T) {
+	testCases := []struct {
+		a    Uint128
+		want Uint16
+		ok   bool
+		sat  Uint16
+	}{
+		{Uint128{0, 0}, 0, true, 0},
+		{Uint128{0, 0x1}, 1, true, 1},
+		{Uint128{math.MaxUint64, math.MaxUint64}, 65535, false, 65535},
+		{Uint128{0x8000000000000000, 0}, 0, false, 65535},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint16()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint128(%#034x).TryUint16() = %#06x, %t, want %#06x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint16()
+		if got != tc.sat {
+			t.Errorf("Uint128(%#034x).SaturatingUint16() = %#06x, want %#06x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint128_TryUint32(t *testing.T) {
+	testCases := []struct {
+		a    Uint128
+		want Uint32
+		ok   bool
+		sat  Uint32
+	}{
+		{Uint128{0, 0}, 0, true, 0},
+		{Uint128{0, 0x1}, 1, true, 1},
+		{Uint128{math.MaxUint64, math.MaxUint64}, 4294967295, false, 4294967295},
+		{Uint128{0x8000000000000000, 0}, 0, false, 4294967295},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint32()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint128(%#034x).TryUint32() = %#010x, %t, want %#010x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint32()
+		if got != tc.sat {
+			t.Errorf("Uint128(%#034x).SaturatingUint32() = %#010x, want %#010x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint128_TryUint64(t *testing.T) {
+	testCases := []struct {
+		a    Uint128
+		want Uint64
+		ok   bool
+		sat  Uint64
+	}{
+		{Uint128{0, 0}, 0, true, 0},
+		{Uint128{0, 0x1}, 1, true, 1},
+		{Uint128{math.MaxUint64, math.MaxUint64}, 18446744073709551615, false, 18446744073709551615},
+		{Uint128{0x8000000000000000, 0}, 0, false, 18446744073709551615},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint64()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint128(%#034x).TryUint64() = %#018x, %t, want %#018x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint64()
+		if got != tc.sat {
+			t.Errorf("Uint128(%#034x).SaturatingUint64() = %#018x, want %#018x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint128_TryUint128(t *testing.T) {
+	testCases := []struct {
+		a    Uint128
+		want Uint128
+		ok   bool
+		sat  Uint128
+	}{
+		{Uint128{0, 0}, Uint128{0, 0}, true, Uint128{0, 0}},
+		{Uint128{0, 0x1}, Uint128{0, 0x1}, true, Uint128{0, 0x1}},
+		{Uint128{math.MaxUint64, math.MaxUint64}, Uint128{math.MaxUint64, math.MaxUint64}, true, Uint128{math.MaxUint64, math.MaxUint64}},
+		{Uint128{0x8000000000000000, 0}, Uint128{0x8000000000000000, 0}, true, Uint128{0x8000000000000000, 0}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint128()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint128(%#034x).TryUint128() = %#034x, %t, want %#034x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint128()
+		if got != tc.sat {
+			t.Errorf("Uint128(%#034x).SaturatingUint128() = %#034x, want %#034x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint128_TryUint256(t *testing.T) {
+	testCases := []struct {
+		a    Uint128
+		want Uint256
+		ok   bool
+		sat  Uint256
+	}{
+		{Uint128{0, 0}, Uint256{0, 0, 0, 0}, true, Uint256{0, 0, 0, 0}},
+		{Uint128{0, 0x1}, Uint256{0, 0, 0, 0x1}, true, Uint256{0, 0, 0, 0x1}},
+		{Uint128{math.MaxUint64, math.MaxUint64}, Uint256{0, 0, math.MaxUint64, math.MaxUint64}, true, Uint256{0, 0, math.MaxUint64, math.MaxUint64}},
+		{Uint128{0x8000000000000000, 0}, Uint256{0, 0, 0x8000000000000000, 0}, true, Uint256{0, 0, 0x8000000000000000, 0}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint256()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint128(%#034x).TryUint256() = %#066x, %t, want %#066x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint256()
+		if got != tc.sat {
+			t.Errorf("Uint128(%#034x).SaturatingUint256() = %#066x, want %#066x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint128_TryUint512(t *testing.T) {
+	testCases := []struct {
+		a    Uint128
+		want Uint512
+		ok   bool
+		sat  Uint512
+	}{
+		{Uint128{0, 0}, Uint512{0, 0, 0, 0, 0, 0, 0, 0}, true, Uint512{0, 0, 0, 0, 0, 0, 0, 0}},
+		{Uint128{0, 0x1}, Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}, true, Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{Uint128{math.MaxUint64, math.MaxUint64}, Uint512{0, 0, 0, 0, 0, 0, math.MaxUint64, math.MaxUint64}, true, Uint512{0, 0, 0, 0, 0, 0, math.MaxUint64, math.MaxUint64}},
+		{Uint128{0x8000000000000000, 0}, Uint512{0, 0, 0, 0, 0, 0, 0x8000000000000000, 0}, true, Uint512{0, 0, 0, 0, 0, 0, 0x8000000000000000, 0}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint512()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint128(%#034x).TryUint512() = %#0130x, %t, want %#0130x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint512()
+		if got != tc.sat {
+			t.Errorf("Uint128(%#034x).SaturatingUint512() = %#0130x, want %#0130x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint128_TryUint1024(t *testing.T) {
+	testCases := []struct {
+		a    Uint128
+		want Uint1024
+		ok   bool
+		sat  Uint1024
+	}{
+		{Uint128{0, 0}, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, true, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{Uint128{0, 0x1}, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, true, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{Uint128{math.MaxUint64, math.MaxUint64}, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, math.MaxUint64, math.MaxUint64}, true, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, math.MaxUint64, math.MaxUint64}},
+		{Uint128{0x8000000000000000, 0}, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x8000000000000000, 0}, true, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x8000000000000000, 0}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint1024()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint128(%#034x).TryUint1024() = %#0258x, %t, want %#0258x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint1024()
+		if got != tc.sat {
+			t.Errorf("Uint128(%#034x).SaturatingUint1024() = %#0258x, want %#0258x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint128_SaturatingInt8(t *testing.T) {
+	testCases := []struct {
+		a    Uint128
+		want Int8
+	}{
+		{Uint128{0, 0}, 0},
+		{Uint128{0, 0x1}, 1},
+		{Uint128{math.MaxUint64, math.MaxUint64}, 127},
+		{Uint128{0x8000000000000000, 0}, 127},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt8()
+		if got != tc.want {
+			t.Errorf("Uint128(%#034x).SaturatingInt8() = %#04x, want %#04x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint128_SaturatingInt16(t *testing.T) {
+	testCases := []struct {
+		a    Uint128
+		want Int16
+	}{
+		{Uint128{0, 0}, 0},
+		{Uint128{0, 0x1}, 1},
+		{Uint128{math.MaxUint64, math.MaxUint64}, 32767},
+		{Uint128{0x8000000000000000, 0}, 32767},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt16()
+		if got != tc.want {
+			t.Errorf("Uint128(%#034x).SaturatingInt16() = %#06x, want %#06x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint128_SaturatingInt32(t *testing.T) {
+	testCases := []struct {
+		a    Uint128
+		want Int32
+	}{
+		{Uint128{0, 0}, 0},
+		{Uint128{0, 0x1}, 1},
+		{Uint128{math.MaxUint64, math.MaxUint64}, 2147483647},
+		{Uint128{0x8000000000000000, 0}, 2147483647},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt32()
+		if got != tc.want {
+			t.Errorf("Uint128(%#034x).SaturatingInt32() = %#010x, want %#010x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint128_SaturatingInt64(t *testing.T) {
+	testCases := []struct {
+		a    Uint128
+		want Int64
+	}{
+		{Uint128{0, 0}, 0},
+		{Uint128{0, 0x1}, 1},
+		{Uint128{math.MaxUint64, math.MaxUint64}, 9223372036854775807},
+		{Uint128{0x8000000000000000, 0}, 9223372036854775807},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt64()
+		if got != tc.want {
+			t.Errorf("Uint128(%#034x).SaturatingInt64() = %#018x, want %#018x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint128_SaturatingInt128(t *testing.T) {
+	testCases := []struct {
+		a    Uint128
+		want Int128
+	}{
+		{Uint128{0, 0}, Int128{0, 0}},
+		{Uint128{0, 0x1}, Int128{0, 0x1}},
+		{Uint128{math.MaxUint64, math.MaxUint64}, Int128{0x7fffffffffffffff, math.MaxUint64}},
+		{Uint128{0x8000000000000000, 0}, Int128{0x7fffffffffffffff, math.MaxUint64}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt128()
+		if got != tc.want {
+			t.Errorf("Uint128(%#034x).SaturatingInt128() = %#034x, want %#034x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint128_SaturatingInt256(t *testing.T) {
+	testCases := []struct {
+		a    Uint128
+		want Int256
+	}{
+		{Uint128{0, 0}, Int256{0, 0, 0, 0}},
+		{Uint128{0, 0x1}, Int256{0, 0, 0, 0x1}},
+		{Uint128{math.MaxUint64, math.MaxUint64}, Int256{0, 0, math.MaxUint64, math.MaxUint64}},
+		{Uint128{0x8000000000000000, 0}, Int256{0, 0, 0x8000000000000000, 0}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt256()
+		if got != tc.want {
+			t.Errorf("Uint128(%#034x).SaturatingInt256() = %#066x, want %#066x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint128_SaturatingInt512(t *testing.T) {
+	testCases := []struct {
+		a    Uint128
+		want Int512
+	}{
+		{Uint128{0, 0}, Int512{0, 0, 0, 0, 0, 0, 0, 0}},
+		{Uint128{0, 0x1}, Int512{0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{Uint128{math.MaxUint64, math.MaxUint64}, Int512{0, 0, 0, 0, 0, 0, math.MaxUint64, math.MaxUint64}},
+		{Uint128{0x8000000000000000, 0}, Int512{0, 0, 0, 0, 0, 0, 0x8000000000000000, 0}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt512()
+		if got != tc.want {
+			t.Errorf("Uint128(%#034x).SaturatingInt512() = %#0130x, want %#0130x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint128_SaturatingInt1024(t *testing.T) {
+	testCases := []struct {
+		a    Uint128
+		want Int1024
+	}{
+		{Uint128{0, 0}, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{Uint128{0, 0x1}, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{Uint128{math.MaxUint64, math.MaxUint64}, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, math.MaxUint64, math.MaxUint64}},
+		{Uint128{0x8000000000000000, 0}, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x8000000000000000, 0}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt1024()
+		if got != tc.want {
+			t.Errorf("Uint128(%#034x).SaturatingInt1024() = %#0258x, want %#0258x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint256_TryUint8(t *testing.T) {
+	testCases := []struct {
+		a    Uint256
+		want Uint8
+		ok   bool
+		sat  Uint8
+	}{
+		{Uint256{0, 0, 0, 0}, 0, true, 0},
+		{Uint256{0, 0, 0, 0x1}, 1, true, 1},
+		{Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 255, false, 255},
+		{Uint256{0x8000000000000000, 0, 0, 0}, 0, false, 255},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint8()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint256(%#066x).TryUint8() = %#04x, %t, want %#04x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint8()
+		if got != tc.sat {
+			t.Errorf("Uint256(%#066x).SaturatingUint8() = %#04x, want %#04x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint256_TryUint16(t *testing.T) {
+	testCases := []struct {
+		a    Uint256
+		want Uint16
+		ok   bool
+		sat  Uint16
+	}{
+		{Uint256{0, 0, 0, 0}, 0, true, 0},
+		{Uint256{0, 0, 0, 0x1}, 1, true, 1},
+		{Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 65535, false, 65535},
+		{Uint256{0x8000000000000000, 0, 0, 0}, 0, false, 65535},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint16()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint256(%#066x).TryUint16() = %#06x, %t, want %#06x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint16()
+		if got != tc.sat {
+			t.Errorf("Uint256(%#066x).SaturatingUint16() = %#06x, want %#06x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint256_TryUint32(t *testing.T) {
+	testCases := []struct {
+		a    Uint256
+		want Uint32
+		ok   bool
+		sat  Uint32
+	}{
+		{Uint256{0, 0, 0, 0}, 0, true, 0},
+		{Uint256{0, 0, 0, 0x1}, 1, true, 1},
+		{Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 4294967295, false, 4294967295},
+		{Uint256{0x8000000000000000, 0, 0, 0}, 0, false, 4294967295},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint32()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint256(%#066x).TryUint32() = %#010x, %t, want %#010x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint32()
+		if got != tc.sat {
+			t.Errorf("Uint256(%#066x).SaturatingUint32() = %#010x, want %#010x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint256_TryUint64(t *testing.T) {
+	testCases := []struct {
+		a    Uint256
+		want Uint64
+		ok   bool
+		sat  Uint64
+	}{
+		{Uint256{0, 0, 0, 0}, 0, true, 0},
+		{Uint256{0, 0, 0, 0x1}, 1, true, 1},
+		{Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 18446744073709551615, false, 18446744073709551615},
+		{Uint256{0x8000000000000000, 0, 0, 0}, 0, false, 18446744073709551615},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint64()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint256(%#066x).TryUint64() = %#018x, %t, want %#018x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint64()
+		if got != tc.sat {
+			t.Errorf("Uint256(%#066x).SaturatingUint64() = %#018x, want %#018x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint256_TryUint128(t *testing.T) {
+	testCases := []struct {
+		a    Uint256
+		want Uint128
+		ok   bool
+		sat  Uint128
+	}{
+		{Uint256{0, 0, 0, 0}, Uint128{0, 0}, true, Uint128{0, 0}},
+		{Uint256{0, 0, 0, 0x1}, Uint128{0, 0x1}, true, Uint128{0, 0x1}},
+		{Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint128{math.MaxUint64, math.MaxUint64}, false, Uint128{math.MaxUint64, math.MaxUint64}},
+		{Uint256{0x8000000000000000, 0, 0, 0}, Uint128{0, 0}, false, Uint128{math.MaxUint64, math.MaxUint64}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint128()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint256(%#066x).TryUint128() = %#034x, %t, want %#034x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint128()
+		if got != tc.sat {
+			t.Errorf("Uint256(%#066x).SaturatingUint128() = %#034x, want %#034x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint256_TryUint256(t *testing.T) {
+	testCases := []struct {
+		a    Uint256
+		want Uint256
+		ok   bool
+		sat  Uint256
+	}{
+		{Uint256{0, 0, 0, 0}, Uint256{0, 0, 0, 0}, true, Uint256{0, 0, 0, 0}},
+		{Uint256{0, 0, 0, 0x1}, Uint256{0, 0, 0, 0x1}, true, Uint256{0, 0, 0, 0x1}},
+		{Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, true, Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{Uint256{0x8000000000000000, 0, 0, 0}, Uint256{0x8000000000000000, 0, 0, 0}, true, Uint256{0x8000000000000000, 0, 0, 0}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint256()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint256(%#066x).TryUint256() = %#066x, %t, want %#066x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint256()
+		if got != tc.sat {
+			t.Errorf("Uint256(%#066x).SaturatingUint256() = %#066x, want %#066x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint256_TryUint512(t *testing.T) {
+	testCases := []struct {
+		a    Uint256
+		want Uint512
+		ok   bool
+		sat  Uint512
+	}{
+		{Uint256{0, 0, 0, 0}, Uint512{0, 0, 0, 0, 0, 0, 0, 0}, true, Uint512{0, 0, 0, 0, 0, 0, 0, 0}},
+		{Uint256{0, 0, 0, 0x1}, Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}, true, Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint512{0, 0, 0, 0, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, true, Uint512{0, 0, 0, 0, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{Uint256{0x8000000000000000, 0, 0, 0}, Uint512{0, 0, 0, 0, 0x8000000000000000, 0, 0, 0}, true, Uint512{0, 0, 0, 0, 0x8000000000000000, 0, 0, 0}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint512()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint256(%#066x).TryUint512() = %#0130x, %t, want %#0130x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint512()
+		if got != tc.sat {
+			t.Errorf("Uint256(%#066x).SaturatingUint512() = %#0130x, want %#0130x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint256_TryUint1024(t *testing.T) {
+	testCases := []struct {
+		a    Uint256
+		want Uint1024
+		ok   bool
+		sat  Uint1024
+	}{
+		{Uint256{0, 0, 0, 0}, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, true, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{Uint256{0, 0, 0, 0x1}, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, true, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, true, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{Uint256{0x8000000000000000, 0, 0, 0}, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x8000000000000000, 0, 0, 0}, true, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x8000000000000000, 0, 0, 0}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint1024()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint256(%#066x).TryUint1024() = %#0258x, %t, want %#0258x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint1024()
+		if got != tc.sat {
+			t.Errorf("Uint256(%#066x).SaturatingUint1024() = %#0258x, want %#0258x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint256_SaturatingInt8(t *testing.T) {
+	testCases := []struct {
+		a    Uint256
+		want Int8
+	}{
+		{Uint256{0, 0, 0, 0}, 0},
+		{Uint256{0, 0, 0, 0x1}, 1},
+		{Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 127},
+		{Uint256{0x8000000000000000, 0, 0, 0}, 127},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt8()
+		if got != tc.want {
+			t.Errorf("Uint256(%#066x).SaturatingInt8() = %#04x, want %#04x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint256_SaturatingInt16(t *testing.T) {
+	testCases := []struct {
+		a    Uint256
+		want Int16
+	}{
+		{Uint256{0, 0, 0, 0}, 0},
+		{Uint256{0, 0, 0, 0x1}, 1},
+		{Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 32767},
+		{Uint256{0x8000000000000000, 0, 0, 0}, 32767},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt16()
+		if got != tc.want {
+			t.Errorf("Uint256(%#066x).SaturatingInt16() = %#06x, want %#06x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint256_SaturatingInt32(t *testing.T) {
+	testCases := []struct {
+		a    Uint256
+		want Int32
+	}{
+		{Uint256{0, 0, 0, 0}, 0},
+		{Uint256{0, 0, 0, 0x1}, 1},
+		{Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 2147483647},
+		{Uint256{0x8000000000000000, 0, 0, 0}, 2147483647},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt32()
+		if got != tc.want {
+			t.Errorf("Uint256(%#066x).SaturatingInt32() = %#010x, want %#010x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint256_SaturatingInt64(t *testing.T) {
+	testCases := []struct {
+		a    Uint256
+		want Int64
+	}{
+		{Uint256{0, 0, 0, 0}, 0},
+		{Uint256{0, 0, 0, 0x1}, 1},
+		{Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 9223372036854775807},
+		{Uint256{0x8000000000000000, 0, 0, 0}, 9223372036854775807},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt64()
+		if got != tc.want {
+			t.Errorf("Uint256(%#066x).SaturatingInt64() = %#018x, want %#018x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint256_SaturatingInt128(t *testing.T) {
+	testCases := []struct {
+		a    Uint256
+		want Int128
+	}{
+		{Uint256{0, 0, 0, 0}, Int128{0, 0}},
+		{Uint256{0, 0, 0, 0x1}, Int128{0, 0x1}},
+		{Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Int128{0x7fffffffffffffff, math.MaxUint64}},
+		{Uint256{0x8000000000000000, 0, 0, 0}, Int128{0x7fffffffffffffff, math.MaxUint64}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt128()
+		if got != tc.want {
+			t.Errorf("Uint256(%#066x).SaturatingInt128() = %#034x, want %#034x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint256_SaturatingInt256(t *testing.T) {
+	testCases := []struct {
+		a    Uint256
+		want Int256
+	}{
+		{Uint256{0, 0, 0, 0}, Int256{0, 0, 0, 0}},
+		{Uint256{0, 0, 0, 0x1}, Int256{0, 0, 0, 0x1}},
+		{Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Int256{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{Uint256{0x8000000000000000, 0, 0, 0}, Int256{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt256()
+		if got != tc.want {
+			t.Errorf("Uint256(%#066x).SaturatingInt256() = %#066x, want %#066x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint256_SaturatingInt512(t *testing.T) {
+	testCases := []struct {
+		a    Uint256
+		want Int512
+	}{
+		{Uint256{0, 0, 0, 0}, Int512{0, 0, 0, 0, 0, 0, 0, 0}},
+		{Uint256{0, 0, 0, 0x1}, Int512{0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Int512{0, 0, 0, 0, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{Uint256{0x8000000000000000, 0, 0, 0}, Int512{0, 0, 0, 0, 0x8000000000000000, 0, 0, 0}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt512()
+		if got != tc.want {
+			t.Errorf("Uint256(%#066x).SaturatingInt512() = %#0130x, want %#0130x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint256_SaturatingInt1024(t *testing.T) {
+	testCases := []struct {
+		a    Uint256
+		want Int1024
+	}{
+		{Uint256{0, 0, 0, 0}, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{Uint256{0, 0, 0, 0x1}, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{Uint256{0x8000000000000000, 0, 0, 0}, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x8000000000000000, 0, 0, 0}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt1024()
+		if got != tc.want {
+			t.Errorf("Uint256(%#066x).SaturatingInt1024() = %#0258x, want %#0258x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint512_TryUint8(t *testing.T) {
+	testCases := []struct {
+		a    Uint512
+		want Uint8
+		ok   bool
+		sat  Uint8
+	}{
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0}, 0, true, 0},
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}, 1, true, 1},
+		{Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 255, false, 255},
+		{Uint512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, 0, false, 255},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint8()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint512(%#0130x).TryUint8() = %#04x, %t, want %#04x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint8()
+		if got != tc.sat {
+			t.Errorf("Uint512(%#0130x).SaturatingUint8() = %#04x, want %#04x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint512_TryUint16(t *testing.T) {
+	testCases := []struct {
+		a    Uint512
+		want Uint16
+		ok   bool
+		sat  Uint16
+	}{
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0}, 0, true, 0},
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}, 1, true, 1},
+		{Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 65535, false, 65535},
+		{Uint512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, 0, false, 65535},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint16()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint512(%#0130x).TryUint16() = %#06x, %t, want %#06x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint16()
+		if got != tc.sat {
+			t.Errorf("Uint512(%#0130x).SaturatingUint16() = %#06x, want %#06x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint512_TryUint32(t *testing.T) {
+	testCases := []struct {
+		a    Uint512
+		want Uint32
+		ok   bool
+		sat  Uint32
+	}{
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0}, 0, true, 0},
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}, 1, true, 1},
+		{Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 4294967295, false, 4294967295},
+		{Uint512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, 0, false, 4294967295},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint32()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint512(%#0130x).TryUint32() = %#010x, %t, want %#010x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint32()
+		if got != tc.sat {
+			t.Errorf("Uint512(%#0130x).SaturatingUint32() = %#010x, want %#010x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint512_TryUint64(t *testing.T) {
+	testCases := []struct {
+		a    Uint512
+		want Uint64
+		ok   bool
+		sat  Uint64
+	}{
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0}, 0, true, 0},
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}, 1, true, 1},
+		{Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 18446744073709551615, false, 18446744073709551615},
+		{Uint512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, 0, false, 18446744073709551615},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint64()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint512(%#0130x).TryUint64() = %#018x, %t, want %#018x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint64()
+		if got != tc.sat {
+			t.Errorf("Uint512(%#0130x).SaturatingUint64() = %#018x, want %#018x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint512_TryUint128(t *testing.T) {
+	testCases := []struct {
+		a    Uint512
+		want Uint128
+		ok   bool
+		sat  Uint128
+	}{
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0}, Uint128{0, 0}, true, Uint128{0, 0}},
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}, Uint128{0, 0x1}, true, Uint128{0, 0x1}},
+		{Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint128{math.MaxUint64, math.MaxUint64}, false, Uint128{math.MaxUint64, math.MaxUint64}},
+		{Uint512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, Uint128{0, 0}, false, Uint128{math.MaxUint64, math.MaxUint64}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint128()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint512(%#0130x).TryUint128() = %#034x, %t, want %#034x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint128()
+		if got != tc.sat {
+			t.Errorf("Uint512(%#0130x).SaturatingUint128() = %#034x, want %#034x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint512_TryUint256(t *testing.T) {
+	testCases := []struct {
+		a    Uint512
+		want Uint256
+		ok   bool
+		sat  Uint256
+	}{
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0}, Uint256{0, 0, 0, 0}, true, Uint256{0, 0, 0, 0}},
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}, Uint256{0, 0, 0, 0x1}, true, Uint256{0, 0, 0, 0x1}},
+		{Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false, Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{Uint512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, Uint256{0, 0, 0, 0}, false, Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint256()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint512(%#0130x).TryUint256() = %#066x, %t, want %#066x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint256()
+		if got != tc.sat {
+			t.Errorf("Uint512(%#0130x).SaturatingUint256() = %#066x, want %#066x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint512_TryUint512(t *testing.T) {
+	testCases := []struct {
+		a    Uint512
+		want Uint512
+		ok   bool
+		sat  Uint512
+	}{
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0}, Uint512{0, 0, 0, 0, 0, 0, 0, 0}, true, Uint512{0, 0, 0, 0, 0, 0, 0, 0}},
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}, Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}, true, Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, true, Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{Uint512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, Uint512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, true, Uint512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint512()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint512(%#0130x).TryUint512() = %#0130x, %t, want %#0130x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint512()
+		if got != tc.sat {
+			t.Errorf("Uint512(%#0130x).SaturatingUint512() = %#0130x, want %#0130x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint512_TryUint1024(t *testing.T) {
+	testCases := []struct {
+		a    Uint512
+		want Uint1024
+		ok   bool
+		sat  Uint1024
+	}{
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0}, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, true, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, true, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, true, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{Uint512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, true, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint1024()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint512(%#0130x).TryUint1024() = %#0258x, %t, want %#0258x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint1024()
+		if got != tc.sat {
+			t.Errorf("Uint512(%#0130x).SaturatingUint1024() = %#0258x, want %#0258x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint512_SaturatingInt8(t *testing.T) {
+	testCases := []struct {
+		a    Uint512
+		want Int8
+	}{
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0}, 0},
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}, 1},
+		{Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 127},
+		{Uint512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, 127},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt8()
+		if got != tc.want {
+			t.Errorf("Uint512(%#0130x).SaturatingInt8() = %#04x, want %#04x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint512_SaturatingInt16(t *testing.T) {
+	testCases := []struct {
+		a    Uint512
+		want Int16
+	}{
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0}, 0},
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}, 1},
+		{Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 32767},
+		{Uint512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, 32767},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt16()
+		if got != tc.want {
+			t.Errorf("Uint512(%#0130x).SaturatingInt16() = %#06x, want %#06x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint512_SaturatingInt32(t *testing.T) {
+	testCases := []struct {
+		a    Uint512
+		want Int32
+	}{
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0}, 0},
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}, 1},
+		{Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 2147483647},
+		{Uint512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, 2147483647},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt32()
+		if got != tc.want {
+			t.Errorf("Uint512(%#0130x).SaturatingInt32() = %#010x, want %#010x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint512_SaturatingInt64(t *testing.T) {
+	testCases := []struct {
+		a    Uint512
+		want Int64
+	}{
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0}, 0},
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}, 1},
+		{Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 9223372036854775807},
+		{Uint512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, 9223372036854775807},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt64()
+		if got != tc.want {
+			t.Errorf("Uint512(%#0130x).SaturatingInt64() = %#018x, want %#018x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint512_SaturatingInt128(t *testing.T) {
+	testCases := []struct {
+		a    Uint512
+		want Int128
+	}{
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0}, Int128{0, 0}},
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}, Int128{0, 0x1}},
+		{Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Int128{0x7fffffffffffffff, math.MaxUint64}},
+		{Uint512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, Int128{0x7fffffffffffffff, math.MaxUint64}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt128()
+		if got != tc.want {
+			t.Errorf("Uint512(%#0130x).SaturatingInt128() = %#034x, want %#034x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint512_SaturatingInt256(t *testing.T) {
+	testCases := []struct {
+		a    Uint512
+		want Int256
+	}{
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0}, Int256{0, 0, 0, 0}},
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}, Int256{0, 0, 0, 0x1}},
+		{Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Int256{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{Uint512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, Int256{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt256()
+		if got != tc.want {
+			t.Errorf("Uint512(%#0130x).SaturatingInt256() = %#066x, want %#066x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint512_SaturatingInt512(t *testing.T) {
+	testCases := []struct {
+		a    Uint512
+		want Int512
+	}{
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0}, Int512{0, 0, 0, 0, 0, 0, 0, 0}},
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}, Int512{0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Int512{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{Uint512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, Int512{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt512()
+		if got != tc.want {
+			t.Errorf("Uint512(%#0130x).SaturatingInt512() = %#0130x, want %#0130x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint512_SaturatingInt1024(t *testing.T) {
+	testCases := []struct {
+		a    Uint512
+		want Int1024
+	}{
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0}, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Int1024{0, 0, 0, 0, 0, 0, 0, 0, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{Uint512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt1024()
+		if got != tc.want {
+			t.Errorf("Uint512(%#0130x).SaturatingInt1024() = %#0258x, want %#0258x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint1024_TryUint8(t *testing.T) {
+	testCases := []struct {
+		a    Uint1024
+		want Uint8
+		ok   bool
+		sat  Uint8
+	}{
+		{Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, 0, true, 0},
+		{Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, 1, true, 1},
+		{Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 255, false, 255},
+		{Uint1024{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, 0, false, 255},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint8()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint1024(%#0258x).TryUint8() = %#04x, %t, want %#04x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint8()
+		if got != tc.sat {
+			t.Errorf("Uint1024(%#0258x).SaturatingUint8() = %#04x, want %#04x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint1024_TryUint16(t *testing.T) {
+	testCases := []struct {
+		a    Uint1024
+		want Uint16
+		ok   bool
+		sat  Uint16
+	}{
+		{Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, 0, true, 0},
+		{Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, 1, true, 1},
+		{Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 65535, false, 65535},
+		{Uint1024{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, 0, false, 65535},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint16()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint1024(%#0258x).TryUint16() = %#06x, %t, want %#06x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint16()
+		if got != tc.sat {
+			t.Errorf("Uint1024(%#0258x).SaturatingUint16() = %#06x, want %#06x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint1024_TryUint32(t *testing.T) {
+	testCases := []struct {
+		a    Uint1024
+		want Uint32
+		ok   bool
+		sat  Uint32
+	}{
+		{Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, 0, true, 0},
+		{Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, 1, true, 1},
+		{Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 4294967295, false, 4294967295},
+		{Uint1024{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, 0, false, 4294967295},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint32()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint1024(%#0258x).TryUint32() = %#010x, %t, want %#010x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint32()
+		if got != tc.sat {
+			t.Errorf("Uint1024(%#0258x).SaturatingUint32() = %#010x, want %#010x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint1024_TryUint64(t *testing.T) {
+	testCases := []struct {
+		a    Uint1024
+		want Uint64
+		ok   bool
+		sat  Uint64
+	}{
+		{Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, 0, true, 0},
+		{Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, 1, true, 1},
+		{Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 18446744073709551615, false, 18446744073709551615},
+		{Uint1024{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, 0, false, 18446744073709551615},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint64()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint1024(%#0258x).TryUint64() = %#018x, %t, want %#018x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint64()
+		if got != tc.sat {
+			t.Errorf("Uint1024(%#0258x).SaturatingUint64() = %#018x, want %#018x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint1024_TryUint128(t *testing.T) {
+	testCases := []struct {
+		a    Uint1024
+		want Uint128
+		ok   bool
+		sat  Uint128
+	}{
+		{Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, Uint128{0, 0}, true, Uint128{0, 0}},
+		{Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, Uint128{0, 0x1}, true, Uint128{0, 0x1}},
+		{Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint128{math.MaxUint64, math.MaxUint64}, false, Uint128{math.MaxUint64, math.MaxUint64}},
+		{Uint1024{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, Uint128{0, 0}, false, Uint128{math.MaxUint64, math.MaxUint64}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint128()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint1024(%#0258x).TryUint128() = %#034x, %t, want %#034x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint128()
+		if got != tc.sat {
+			t.Errorf("Uint1024(%#0258x).SaturatingUint128() = %#034x, want %#034x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint1024_TryUint256(t *testing.T) {
+	testCases := []struct {
+		a    Uint1024
+		want Uint256
+		ok   bool
+		sat  Uint256
+	}{
+		{Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, Uint256{0, 0, 0, 0}, true, Uint256{0, 0, 0, 0}},
+		{Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, Uint256{0, 0, 0, 0x1}, true, Uint256{0, 0, 0, 0x1}},
+		{Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false, Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{Uint1024{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, Uint256{0, 0, 0, 0}, false, Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint256()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint1024(%#0258x).TryUint256() = %#066x, %t, want %#066x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint256()
+		if got != tc.sat {
+			t.Errorf("Uint1024(%#0258x).SaturatingUint256() = %#066x, want %#066x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint1024_TryUint512(t *testing.T) {
+	testCases := []struct {
+		a    Uint1024
+		want Uint512
+		ok   bool
+		sat  Uint512
+	}{
+		{Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, Uint512{0, 0, 0, 0, 0, 0, 0, 0}, true, Uint512{0, 0, 0, 0, 0, 0, 0, 0}},
+		{Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}, true, Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false, Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{Uint1024{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, Uint512{0, 0, 0, 0, 0, 0, 0, 0}, false, Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint512()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint1024(%#0258x).TryUint512() = %#0130x, %t, want %#0130x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint512()
+		if got != tc.sat {
+			t.Errorf("Uint1024(%#0258x).SaturatingUint512() = %#0130x, want %#0130x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint1024_TryUint1024(t *testing.T) {
+	testCases := []struct {
+		a    Uint1024
+		want Uint1024
+		ok   bool
+		sat  Uint1024
+	}{
+		{Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, true, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, true, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, true, Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{Uint1024{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, Uint1024{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, true, Uint1024{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+	}
+
+	for _, tc := range testCases {
+		got, ok := tc.a.TryUint1024()
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint1024(%#0258x).TryUint1024() = %#0258x, %t, want %#0258x, %t", tc.a, got, ok, tc.want, tc.ok)
+		}
+
+		got = tc.a.SaturatingUint1024()
+		if got != tc.sat {
+			t.Errorf("Uint1024(%#0258x).SaturatingUint1024() = %#0258x, want %#0258x", tc.a, got, tc.sat)
+		}
+	}
+}
+
+func TestUint1024_SaturatingInt8(t *testing.T) {
+	testCases := []struct {
+		a    Uint1024
+		want Int8
+	}{
+		{Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, 0},
+		{Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, 1},
+		{Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 127},
+		{Uint1024{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, 127},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt8()
+		if got != tc.want {
+			t.Errorf("Uint1024(%#0258x).SaturatingInt8() = %#04x, want %#04x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint1024_SaturatingInt16(t *testing.T) {
+	testCases := []struct {
+		a    Uint1024
+		want Int16
+	}{
+		{Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, 0},
+		{Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, 1},
+		{Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 32767},
+		{Uint1024{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, 32767},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt16()
+		if got != tc.want {
+			t.Errorf("Uint1024(%#0258x).SaturatingInt16() = %#06x, want %#06x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint1024_SaturatingInt32(t *testing.T) {
+	testCases := []struct {
+		a    Uint1024
+		want Int32
+	}{
+		{Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, 0},
+		{Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, 1},
+		{Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 2147483647},
+		{Uint1024{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, 2147483647},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt32()
+		if got != tc.want {
+			t.Errorf("Uint1024(%#0258x).SaturatingInt32() = %#010x, want %#010x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint1024_SaturatingInt64(t *testing.T) {
+	testCases := []struct {
+		a    Uint1024
+		want Int64
+	}{
+		{Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, 0},
+		{Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, 1},
+		{Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 9223372036854775807},
+		{Uint1024{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, 9223372036854775807},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt64()
+		if got != tc.want {
+			t.Errorf("Uint1024(%#0258x).SaturatingInt64() = %#018x, want %#018x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint1024_SaturatingInt128(t *testing.T) {
+	testCases := []struct {
+		a    Uint1024
+		want Int128
+	}{
+		{Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, Int128{0, 0}},
+		{Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, Int128{0, 0x1}},
+		{Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Int128{0x7fffffffffffffff, math.MaxUint64}},
+		{Uint1024{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, Int128{0x7fffffffffffffff, math.MaxUint64}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt128()
+		if got != tc.want {
+			t.Errorf("Uint1024(%#0258x).SaturatingInt128() = %#034x, want %#034x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint1024_SaturatingInt256(t *testing.T) {
+	testCases := []struct {
+		a    Uint1024
+		want Int256
+	}{
+		{Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, Int256{0, 0, 0, 0}},
+		{Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, Int256{0, 0, 0, 0x1}},
+		{Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Int256{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{Uint1024{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, Int256{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt256()
+		if got != tc.want {
+			t.Errorf("Uint1024(%#0258x).SaturatingInt256() = %#066x, want %#066x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint1024_SaturatingInt512(t *testing.T) {
+	testCases := []struct {
+		a    Uint1024
+		want Int512
+	}{
+		{Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, Int512{0, 0, 0, 0, 0, 0, 0, 0}},
+		{Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, Int512{0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Int512{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{Uint1024{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, Int512{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt512()
+		if got != tc.want {
+			t.Errorf("Uint1024(%#0258x).SaturatingInt512() = %#0130x, want %#0130x", tc.a, got, tc.want)
+		}
+	}
+}
+
+func TestUint1024_SaturatingInt1024(t *testing.T) {
+	testCases := []struct {
+		a    Uint1024
+		want Int1024
+	}{
+		{Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Int1024{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{Uint1024{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, Int1024{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.SaturatingInt1024()
+		if got != tc.want {
+			t.Errorf("Uint1024(%#0258x).SaturatingInt1024() = %#0258x, want %#0258x", tc.a, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,303 @@
+package ints
+
+import (
+	"math"
+	"math/bits"
+)
+
+// Divisor128 is a precomputed reciprocal of a 128-bit divisor d.
+// It replaces the division by d with multiplications,
+// which is faster when dividing many values by the same divisor.
+// The zero value is not usable; use [NewDivisor128] to create one.
+type Divisor128 struct {
+	d  Uint128 // the divisor
+	dn Uint128 // d shifted left by sh so that its most significant bit is set
+	v  uint64  // floor((2**128-1)/dn[1]) - 2**64 if d < 2**64, otherwise floor((2**192-1)/dn) - 2**64
+	sh uint    // the normalization shift
+}
+
+// NewDivisor128 returns a precomputed reciprocal of d.
+// It panics if d == 0.
+func NewDivisor128(d Uint128) *Divisor128 {
+	if d.IsZero() {
+		panic("division by zero")
+	}
+
+	if d[0] == 0 {
+		sh := uint(bits.LeadingZeros64(d[1]))
+		dn := d[1] << sh
+		return &Divisor128{
+			d:  d,
+			dn: Uint128{0, dn},
+			v:  reciprocal2by1(dn),
+			sh: sh,
+		}
+	}
+	sh := uint(bits.LeadingZeros64(d[0]))
+	dn := d.Lsh(sh)
+	return &Divisor128{
+		d:  d,
+		dn: dn,
+		v:  reciprocal3by2(dn),
+		sh: sh,
+	}
+}
+
+// Divisor returns the divisor d.
+func (s *Divisor128) Divisor() Uint128 {
+	return s.d
+}
+
+// Div returns the quotient a/d.
+func (s *Divisor128) Div(a Uint128) Uint128 {
+	q, _ := s.DivMod(a)
+	return q
+}
+
+// Mod returns the remainder a%d.
+func (s *Divisor128) Mod(a Uint128) Uint128 {
+	_, r := s.DivMod(a)
+	return r
+}
+
+// DivMod returns the quotient a/d and the remainder a%d.
+func (s *Divisor128) DivMod(a Uint128) (Uint128, Uint128) {
+	// normalize a in the same way as d.
+	// shifts of 64 bits always result in 0, so sh == 0 needs no special handling.
+	n2 := a[0] >> (64 - s.sh)
+	n1 := a[0]<<s.sh | a[1]>>(64-s.sh)
+	n0 := a[1] << s.sh
+
+	if s.d[0] == 0 {
+		q0, r := div2by1(n2, n1, s.dn[1], s.v)
+		q1, r := div2by1(r, n0, s.dn[1], s.v)
+		return Uint128{q0, q1}, Uint128{0, r >> s.sh}
+	}
+
+	// the quotient fits in 64 bits because d >= 2**64.
+	q, r1, r0 := div3by2(n2, n1, n0, s.dn[0], s.dn[1], s.v)
+	return Uint128{0, q}, Uint128{r1 >> s.sh, r0>>s.sh | r1<<(64-s.sh)}
+}
+
+// reciprocal2by1 returns floor((2**128-1)/d) - 2**64 for normalized d (d >= 2**63).
+func reciprocal2by1(d uint64) uint64 {
+	v, _ := bits.Div64(^d, math.MaxUint64, d)
+	return v
+}
+
+// div2by1 returns the quotient and remainder of (u1, u0) divided by d,
+// where d is normalized, v = reciprocal2by1(d) and u1 < d.
+//
+// See Niels Möller and Torbjörn Granlund,
+// “Improved division by invariant integers”, Algorithm 4.
+func div2by1(u1, u0, d, v uint64) (q, r uint64) {
+	q1, q0 := bits.Mul64(v, u1)
+	q0, carry := bits.Add64(q0, u0, 0)
+	q1, _ = bits.Add64(q1, u1, carry)
+	q1++
+	r = u0 - q1*d
+	if r > q0 {
+		q1--
+		r += d
+	}
+	if r >= d {
+		q1++
+		r -= d
+	}
+	return q1, r
+}
+
+// reciprocal3by2 returns floor((2**192-1)/d) - 2**64 for normalized d (d >= 2**127).
+func reciprocal3by2(d Uint128) uint64 {
+	v, _ := d.Div256(Uint128{0, math.MaxUint64}, Uint128{math.MaxUint64, math.MaxUint64})
+	return v[1]
+}
+
+// div3by2 returns the quotient and remainder of (u2, u1, u0) divided by (d1, d0),
+// where (d1, d0) is normalized, v = reciprocal3by2(Uint128{d1, d0}) and (u2, u1) < (d1, d0).
+//
+// See Niels Möller and Torbjörn Granlund,
+// “Improved division by invariant integers”, Algorithm 5.
+func div3by2(u2, u1, u0, d1, d0, v uint64) (q, r1, r0 uint64) {
+	q1, q0 := bits.Mul64(v, u2)
+	q0, carry := bits.Add64(q0, u1, 0)
+	q1, _ = bits.Add64(q1, u2, carry)
+	r1 = u1 - q1*d1
+	t1, t0 := bits.Mul64(d0, q1)
+	var borrow uint64
+	r0, borrow = bits.Sub64(u0, t0, 0)
+	r1, _ = bits.Sub64(r1, t1, borrow)
+	r0, borrow = bits.Sub64(r0, d0, 0)
+	r1, _ = bits.Sub64(r1, d1, borrow)
+	q1++
+	if r1 >= q0 {
+		q1--
+		r0, carry = bits.Add64(r0, d0, 0)
+		r1, _ = bits.Add64(r1, d1, carry)
+	}
+	if r1 > d1 || (r1 == d1 && r0 >= d0) {
+		q1++
+		r0, borrow = bits.Sub64(r0, d0, 0)
+		r1, _ = bits.Sub64(r1, d1, borrow)
+	}
+	return q1, r1, r0
+}
+
+// Divisor256 is a precomputed reciprocal of a 256-bit divisor d.
+// It replaces the division by d with a multiplication and shifts,
+// which is faster when dividing many values by the same divisor.
+// The zero value is not usable; use [NewDivisor256] to create one.
+type Divisor256 struct {
+	d   Uint256 // the divisor
+	m   Uint256 // the magic number floor(2**256 * (2**l - d) / d) + 1, where l = ceil(log2(d))
+	sh1 uint    // min(l, 1)
+	sh2 uint    // max(l-1, 0)
+}
+
+// NewDivisor256 returns a precomputed reciprocal of d.
+// It panics if d == 0.
+func NewDivisor256(d Uint256) *Divisor256 {
+	if d.IsZero() {
+		panic("division by zero")
+	}
+
+	// See Torbjörn Granlund and Peter L. Montgomery,
+	// “Division by invariant integers using multiplication”, Figure 4.1.
+	l := uint(d.Sub(Uint256{0, 0, 0, 1}).BitLen())
+	m, _ := d.Div512(Uint256{0, 0, 0, 1}.Lsh(l).Sub(d), Uint256{})
+	return &Divisor256{
+		d:   d,
+		m:   m.Add(Uint256{0, 0, 0, 1}),
+		sh1: min(l, 1),
+		sh2: max(l, 1) - 1,
+	}
+}
+
+// Divisor returns the divisor d.
+func (s *Divisor256) Divisor() Uint256 {
+	return s.d
+}
+
+// Div returns the quotient a/d.
+func (s *Divisor256) Div(a Uint256) Uint256 {
+	t, _ := s.m.MulFull(a)
+	return a.Sub(t).Rsh(s.sh1).Add(t).Rsh(s.sh2)
+}
+
+// Mod returns the remainder a%d.
+func (s *Divisor256) Mod(a Uint256) Uint256 {
+	_, r := s.DivMod(a)
+	return r
+}
+
+// DivMod returns the quotient a/d and the remainder a%d.
+func (s *Divisor256) DivMod(a Uint256) (Uint256, Uint256) {
+	q := s.Div(a)
+	return q, a.Sub(q.Mul(s.d))
+}
+
+// Divisor512 is a precomputed reciprocal of a 512-bit divisor d.
+// It replaces the division by d with a multiplication and shifts,
+// which is faster when dividing many values by the same divisor.
+// The zero value is not usable; use [NewDivisor512] to create one.
+type Divisor512 struct {
+	d   Uint512 // the divisor
+	m   Uint512 // the magic number floor(2**512 * (2**l - d) / d) + 1, where l = ceil(log2(d))
+	sh1 uint    // min(l, 1)
+	sh2 uint    // max(l-1, 0)
+}
+
+// NewDivisor512 returns a precomputed reciprocal of d.
+// It panics if d == 0.
+func NewDivisor512(d Uint512) *Divisor512 {
+	if d.IsZero() {
+		panic("division by zero")
+	}
+
+	// See Torbjörn Granlund and Peter L. Montgomery,
+	// “Division by invariant integers using multiplication”, Figure 4.1.
+	l := uint(d.Sub(Uint512{0, 0, 0, 0, 0, 0, 0, 1}).BitLen())
+	m, _ := d.Div1024(Uint512{0, 0, 0, 0, 0, 0, 0, 1}.Lsh(l).Sub(d), Uint512{})
+	return &Divisor512{
+		d:   d,
+		m:   m.Add(Uint512{0, 0, 0, 0, 0, 0, 0, 1}),
+		sh1: min(l, 1),
+		sh2: max(l, 1) - 1,
+	}
+}
+
+// Divisor returns the divisor d.
+func (s *Divisor512) Divisor() Uint512 {
+	return s.d
+}
+
+// Div returns the quotient a/d.
+func (s *Divisor512) Div(a Uint512) Uint512 {
+	t, _ := s.m.MulFull(a)
+	return a.Sub(t).Rsh(s.sh1).Add(t).Rsh(s.sh2)
+}
+
+// Mod returns the remainder a%d.
+func (s *Divisor512) Mod(a Uint512) Uint512 {
+	_, r := s.DivMod(a)
+	return r
+}
+
+// DivMod returns the quotient a/d and the remainder a%d.
+func (s *Divisor512) DivMod(a Uint512) (Uint512, Uint512) {
+	q := s.Div(a)
+	return q, a.Sub(q.Mul(s.d))
+}
+
+// Divisor1024 is a precomputed reciprocal of a 1024-bit divisor d.
+// It replaces the division by d with a multiplication and shifts,
+// which is faster when dividing many values by the same divisor.
+// The zero value is not usable; use [NewDivisor1024] to create one.
+type Divisor1024 struct {
+	d   Uint1024 // the divisor
+	m   Uint1024 // the magic number floor(2**1024 * (2**l - d) / d) + 1, where l = ceil(log2(d))
+	sh1 uint     // min(l, 1)
+	sh2 uint     // max(l-1, 0)
+}
+
+// NewDivisor1024 returns a precomputed reciprocal of d.
+// It panics if d == 0.
+func NewDivisor1024(d Uint1024) *Divisor1024 {
+	if d.IsZero() {
+		panic("division by zero")
+	}
+
+	// See Torbjörn Granlund and Peter L. Montgomery,
+	// “Division by invariant integers using multiplication”, Figure 4.1.
+	l := uint(d.Sub(Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1}).BitLen())
+	m, _ := d.Div2048(Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1}.Lsh(l).Sub(d), Uint1024{})
+	return &Divisor1024{
+		d:   d,
+		m:   m.Add(Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1}),
+		sh1: min(l, 1),
+		sh2: max(l, 1) - 1,
+	}
+}
+
+// Divisor returns the divisor d.
+func (s *Divisor1024) Divisor() Uint1024 {
+	return s.d
+}
+
+// Div returns the quotient a/d.
+func (s *Divisor1024) Div(a Uint1024) Uint1024 {
+	t, _ := s.m.MulFull(a)
+	return a.Sub(t).Rsh(s.sh1).Add(t).Rsh(s.sh2)
+}
+
+// Mod returns the remainder a%d.
+func (s *Divisor1024) Mod(a Uint1024) Uint1024 {
+	_, r := s.DivMod(a)
+	return r
+}
+
+// DivMod returns the quotient a/d and the remainder a%d.
+func (s *Divisor1024) DivMod(a Uint1024) (Uint1024, Uint1024) {
+	q := s.Div(a)
+	return q, a.Sub(q.Mul(s.d))
+}
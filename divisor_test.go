@@ -0,0 +1,316 @@
+package ints
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"testing"
+)
+
+func FuzzDivisor128(f *testing.F) {
+	f.Add(
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0x0), uint64(0x1),
+	)
+	f.Add(
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0x0), uint64(0x7),
+	)
+	f.Add(
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0x0), uint64(0xa),
+	)
+	f.Add(
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0x8000000000000000), uint64(0x0),
+	)
+	f.Add(
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+	)
+	f.Add(
+		uint64(0x8000000000000000), uint64(0x0),
+		uint64(0x8000000000000000), uint64(0x1),
+	)
+	f.Add(
+		uint64(0x0), uint64(0x6),
+		uint64(0x0), uint64(0x7),
+	)
+	f.Add(
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0x0), uint64(0xffffffffffffffff),
+	)
+	f.Add(
+		uint64(0x123456789abcdef0), uint64(0xfedcba9876543210),
+		uint64(0x0), uint64(0x3e8),
+	)
+	f.Add(
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0x1), uint64(0x0),
+	)
+	f.Add(
+		uint64(0xffffffffffffffff), uint64(0xfffffffffffffffe),
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+	)
+	f.Add(
+		uint64(0x7fffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0x1), uint64(0x1),
+	)
+	f.Add(
+		uint64(0x3b9aca07), uint64(0x0),
+		uint64(0x3b9aca07), uint64(0xffffffffffffffff),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, v0, v1 uint64) {
+		a := Uint128{u0, u1}
+		d := Uint128{v0, v1}
+		if d.IsZero() {
+			return
+		}
+		s := NewDivisor128(d)
+		x, y := uint128ToBigInt(a), uint128ToBigInt(d)
+		wantQ, wantR := new(big.Int).DivMod(x, y, new(big.Int))
+
+		if got := s.Divisor(); got != d {
+			t.Errorf("NewDivisor128(%d).Divisor() = %d, want %d", d, got, d)
+		}
+		if got := s.Div(a); uint128ToBigInt(got).Cmp(wantQ) != 0 {
+			t.Errorf("NewDivisor128(%d).Div(%d) = %d, want %d", d, a, got, wantQ)
+		}
+		if got := s.Mod(a); uint128ToBigInt(got).Cmp(wantR) != 0 {
+			t.Errorf("NewDivisor128(%d).Mod(%d) = %d, want %d", d, a, got, wantR)
+		}
+		q, r := s.DivMod(a)
+		if uint128ToBigInt(q).Cmp(wantQ) != 0 || uint128ToBigInt(r).Cmp(wantR) != 0 {
+			t.Errorf("NewDivisor128(%d).DivMod(%d) = (%d, %d), want (%d, %d)", d, a, q, r, wantQ, wantR)
+		}
+	})
+}
+
+func TestNewDivisor128_Panic(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("NewDivisor128(0) did not panic")
+		}
+	}()
+	NewDivisor128(Uint128{})
+}
+
+func BenchmarkDivisor128_DivMod(b *testing.B) {
+	x := MaxUint128
+	for _, y := range []Uint128{
+		{0, 1000},
+		{1_000_000_007, math.MaxUint64},
+	} {
+		b.Run(fmt.Sprintf("Divisor128/%d", y), func(b *testing.B) {
+			s := NewDivisor128(y)
+			for b.Loop() {
+				s.DivMod(x)
+			}
+		})
+
+		b.Run(fmt.Sprintf("Uint128/%d", y), func(b *testing.B) {
+			for b.Loop() {
+				x.DivMod(y)
+			}
+		})
+	}
+}
+
+func FuzzDivisor256(f *testing.F) {
+	f.Add(
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x1),
+	)
+	f.Add(
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x7),
+	)
+	f.Add(
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0xa),
+	)
+	f.Add(
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0x8000000000000000), uint64(0x0), uint64(0x0), uint64(0x0),
+	)
+	f.Add(
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+	)
+	f.Add(
+		uint64(0x8000000000000000), uint64(0x0), uint64(0x0), uint64(0x0),
+		uint64(0x8000000000000000), uint64(0x0), uint64(0x0), uint64(0x1),
+	)
+	f.Add(
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x6),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x7),
+	)
+	f.Add(
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0x0), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, v0, v1, v2, v3 uint64) {
+		a := Uint256{u0, u1, u2, u3}
+		d := Uint256{v0, v1, v2, v3}
+		if d.IsZero() {
+			return
+		}
+		s := NewDivisor256(d)
+		x, y := uint256ToBigInt(a), uint256ToBigInt(d)
+		wantQ, wantR := new(big.Int).DivMod(x, y, new(big.Int))
+
+		if got := s.Divisor(); got != d {
+			t.Errorf("NewDivisor256(%d).Divisor() = %d, want %d", d, got, d)
+		}
+		if got := s.Div(a); uint256ToBigInt(got).Cmp(wantQ) != 0 {
+			t.Errorf("NewDivisor256(%d).Div(%d) = %d, want %d", d, a, got, wantQ)
+		}
+		if got := s.Mod(a); uint256ToBigInt(got).Cmp(wantR) != 0 {
+			t.Errorf("NewDivisor256(%d).Mod(%d) = %d, want %d", d, a, got, wantR)
+		}
+		q, r := s.DivMod(a)
+		if uint256ToBigInt(q).Cmp(wantQ) != 0 || uint256ToBigInt(r).Cmp(wantR) != 0 {
+			t.Errorf("NewDivisor256(%d).DivMod(%d) = (%d, %d), want (%d, %d)", d, a, q, r, wantQ, wantR)
+		}
+	})
+}
+
+func BenchmarkDivisor256_DivMod(b *testing.B) {
+	x := MaxUint256
+	y := Uint256{0, 1_000_000_007, math.MaxUint64, math.MaxUint64}
+
+	b.Run("Divisor256", func(b *testing.B) {
+		s := NewDivisor256(y)
+		for b.Loop() {
+			s.DivMod(x)
+		}
+	})
+
+	b.Run("Uint256", func(b *testing.B) {
+		for b.Loop() {
+			x.DivMod(y)
+		}
+	})
+}
+
+func FuzzDivisor512(f *testing.F) {
+	f.Add(
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x1),
+	)
+	f.Add(
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x7),
+	)
+	f.Add(
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0xa),
+	)
+	f.Add(
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0x8000000000000000), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0),
+	)
+	f.Add(
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+	)
+	f.Add(
+		uint64(0x8000000000000000), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0),
+		uint64(0x8000000000000000), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x1),
+	)
+	f.Add(
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x6),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x7),
+	)
+	f.Add(
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0x0), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, u4, u5, u6, u7, v0, v1, v2, v3, v4, v5, v6, v7 uint64) {
+		a := Uint512{u0, u1, u2, u3, u4, u5, u6, u7}
+		d := Uint512{v0, v1, v2, v3, v4, v5, v6, v7}
+		if d.IsZero() {
+			return
+		}
+		s := NewDivisor512(d)
+		x, y := uint512ToBigInt(a), uint512ToBigInt(d)
+		wantQ, wantR := new(big.Int).DivMod(x, y, new(big.Int))
+
+		if got := s.Divisor(); got != d {
+			t.Errorf("NewDivisor512(%d).Divisor() = %d, want %d", d, got, d)
+		}
+		if got := s.Div(a); uint512ToBigInt(got).Cmp(wantQ) != 0 {
+			t.Errorf("NewDivisor512(%d).Div(%d) = %d, want %d", d, a, got, wantQ)
+		}
+		if got := s.Mod(a); uint512ToBigInt(got).Cmp(wantR) != 0 {
+			t.Errorf("NewDivisor512(%d).Mod(%d) = %d, want %d", d, a, got, wantR)
+		}
+		q, r := s.DivMod(a)
+		if uint512ToBigInt(q).Cmp(wantQ) != 0 || uint512ToBigInt(r).Cmp(wantR) != 0 {
+			t.Errorf("NewDivisor512(%d).DivMod(%d) = (%d, %d), want (%d, %d)", d, a, q, r, wantQ, wantR)
+		}
+	})
+}
+
+func FuzzDivisor1024(f *testing.F) {
+	f.Add(
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x1),
+	)
+	f.Add(
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x7),
+	)
+	f.Add(
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0xa),
+	)
+	f.Add(
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0x8000000000000000), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0),
+	)
+	f.Add(
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+	)
+	f.Add(
+		uint64(0x8000000000000000), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0),
+		uint64(0x8000000000000000), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x1),
+	)
+	f.Add(
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x6),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x7),
+	)
+	f.Add(
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0x0), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, u4, u5, u6, u7, u8, u9, u10, u11, u12, u13, u14, u15, v0, v1, v2, v3, v4, v5, v6, v7, v8, v9, v10, v11, v12, v13, v14, v15 uint64) {
+		a := Uint1024{u0, u1, u2, u3, u4, u5, u6, u7, u8, u9, u10, u11, u12, u13, u14, u15}
+		d := Uint1024{v0, v1, v2, v3, v4, v5, v6, v7, v8, v9, v10, v11, v12, v13, v14, v15}
+		if d.IsZero() {
+			return
+		}
+		s := NewDivisor1024(d)
+		x, y := uint1024ToBigInt(a), uint1024ToBigInt(d)
+		wantQ, wantR := new(big.Int).DivMod(x, y, new(big.Int))
+
+		if got := s.Divisor(); got != d {
+			t.Errorf("NewDivisor1024(%d).Divisor() = %d, want %d", d, got, d)
+		}
+		if got := s.Div(a); uint1024ToBigInt(got).Cmp(wantQ) != 0 {
+			t.Errorf("NewDivisor1024(%d).Div(%d) = %d, want %d", d, a, got, wantQ)
+		}
+		if got := s.Mod(a); uint1024ToBigInt(got).Cmp(wantR) != 0 {
+			t.Errorf("NewDivisor1024(%d).Mod(%d) = %d, want %d", d, a, got, wantR)
+		}
+		q, r := s.DivMod(a)
+		if uint1024ToBigInt(q).Cmp(wantQ) != 0 || uint1024ToBigInt(r).Cmp(wantR) != 0 {
+			t.Errorf("NewDivisor1024(%d).DivMod(%d) = (%d, %d), want (%d, %d)", d, a, q, r, wantQ, wantR)
+		}
+	})
+}
@@ -0,0 +1,254 @@
+package ints
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"math/bits"
+)
+
+var (
+	// ErrNaN is returned by the FromFloat64 functions when the argument is NaN.
+	ErrNaN = errors.New("ints: NaN can't be converted to an integer")
+
+	// ErrOverflow is returned by the FromFloat64 and FromBigFloat functions
+	// when the rounded argument is out of the range of the result type.
+	ErrOverflow = errors.New("ints: value out of range")
+)
+
+// float64FromLimbs returns the float64 value nearest to the unsigned integer z, and an indication of any rounding error.
+// z holds the limbs in big-endian order.
+// If the value is too large to be represented by a float64, the result is +Inf.
+func float64FromLimbs(z []uint64) (float64, big.Accuracy) {
+	// find the most significant non-zero limb.
+	i := 0
+	for i < len(z) && z[i] == 0 {
+		i++
+	}
+	if i == len(z) {
+		return 0, big.Exact
+	}
+
+	// n is the number of bits of z.
+	l := uint(bits.Len64(z[i]))
+	n := (len(z)-1-i)*64 + int(l)
+
+	// hi holds the top 64 bits of z, left aligned.
+	hi := z[i] << (64 - l)
+	sticky := false
+	if i+1 < len(z) {
+		hi |= z[i+1] >> l
+		sticky = z[i+1]<<(64-l) != 0
+		for _, v := range z[i+2:] {
+			sticky = sticky || v != 0
+		}
+	}
+
+	// round to 53 bits, half to even.
+	m := hi >> 11
+	half := hi&(1<<10) != 0
+	sticky = sticky || hi&(1<<10-1) != 0
+	acc := big.Exact
+	if half && (sticky || m&1 != 0) {
+		m++
+		acc = big.Above
+	} else if half || sticky {
+		acc = big.Below
+	}
+
+	// math.Ldexp handles the carry of m into the 54th bit and the overflow to +Inf.
+	return math.Ldexp(float64(m), n-53), acc
+}
+
+// roundFloat64 rounds f to an integer value according to mode.
+func roundFloat64(f float64, mode big.RoundingMode) float64 {
+	switch mode {
+	case big.ToNearestEven:
+		return math.RoundToEven(f)
+	case big.ToNearestAway:
+		return math.Round(f)
+	case big.ToZero:
+		return math.Trunc(f)
+	case big.AwayFromZero:
+		if f < 0 {
+			return math.Floor(f)
+		}
+		return math.Ceil(f)
+	case big.ToNegativeInf:
+		return math.Floor(f)
+	case big.ToPositiveInf:
+		return math.Ceil(f)
+	}
+	panic("ints: invalid rounding mode")
+}
+
+// magnitudeFromFloat64 stores |r| into z in big-endian order.
+// r must be an integer value or an infinity.
+// It reports whether |r| fits in z.
+func magnitudeFromFloat64(z []uint64, r float64) bool {
+	clear(z)
+	b := math.Float64bits(r)
+	exp := int(b>>52) & 0x7ff
+	if exp == 0 {
+		// r is zero; subnormal numbers are not integers.
+		return true
+	}
+
+	mant := b&(1<<52-1) | 1<<52
+	shift := exp - 1075
+	if shift < 0 {
+		// r is an integer, so the shifted out bits are zero.
+		mant >>= uint(-shift)
+		shift = 0
+	}
+	if bits.Len64(mant)+shift > 64*len(z) {
+		return false
+	}
+
+	i, s := shift/64, uint(shift%64)
+	z[len(z)-1-i] = mant << s
+	if s > 0 && i+1 < len(z) {
+		z[len(z)-2-i] = mant >> (64 - s)
+	}
+	return true
+}
+
+// accuracyOf returns the accuracy of the rounded value r of f.
+func accuracyOf(r, f float64) big.Accuracy {
+	switch {
+	case r < f:
+		return big.Below
+	case r > f:
+		return big.Above
+	}
+	return big.Exact
+}
+
+// uintFromFloat64 rounds f to an integer according to mode, and stores it into z as a bitSize-bit unsigned integer.
+func uintFromFloat64(z []uint64, bitSize int, f float64, mode big.RoundingMode) (big.Accuracy, error) {
+	if math.IsNaN(f) {
+		clear(z)
+		return big.Exact, ErrNaN
+	}
+
+	r := roundFloat64(f, mode)
+	if r < 0 {
+		clear(z)
+		return big.Above, ErrOverflow
+	}
+	if !magnitudeFromFloat64(z, r) || z[0] > ^uint64(0)>>(64*len(z)-bitSize) {
+		maxUintLimbs(z, bitSize)
+		return big.Below, ErrOverflow
+	}
+	return accuracyOf(r, f), nil
+}
+
+// intFromFloat64 rounds f to an integer according to mode, and stores it into z as a bitSize-bit signed integer.
+func intFromFloat64(z []uint64, bitSize int, f float64, mode big.RoundingMode) (big.Accuracy, error) {
+	if math.IsNaN(f) {
+		clear(z)
+		return big.Exact, ErrNaN
+	}
+
+	r := roundFloat64(f, mode)
+	neg := math.Signbit(r)
+	if !magnitudeFromFloat64(z, r) || !fitsInt(z, bitSize, neg) {
+		if neg {
+			minIntLimbs(z, bitSize)
+			return big.Above, ErrOverflow
+		}
+		maxIntLimbs(z, bitSize)
+		return big.Below, ErrOverflow
+	}
+	if neg {
+		negLimbs(z)
+	}
+	return accuracyOf(r, f), nil
+}
+
+// roundBigFloat rounds x to an integer according to mode.
+// x must be finite.
+func roundBigFloat(x *big.Float, mode big.RoundingMode) (*big.Int, big.Accuracy) {
+	// t is x truncated toward zero.
+	t, acc := x.Int(nil)
+	if acc == big.Exact {
+		return t, acc
+	}
+
+	// The fractional part of x is non-zero. Check whether it is greater than or equal to 0.5.
+	var x2 big.Float
+	x2.SetPrec(x.Prec()+1).Mul(x, big.NewFloat(2))
+	u, uacc := x2.Int(nil)
+	half := u.Bit(0) != 0
+	exactHalf := half && uacc == big.Exact
+
+	var away bool
+	switch mode {
+	case big.ToNearestEven:
+		away = half && (!exactHalf || t.Bit(0) != 0)
+	case big.ToNearestAway:
+		away = half
+	case big.ToZero:
+		away = false
+	case big.AwayFromZero:
+		away = true
+	case big.ToNegativeInf:
+		away = x.Sign() < 0
+	case big.ToPositiveInf:
+		away = x.Sign() > 0
+	default:
+		panic("ints: invalid rounding mode")
+	}
+
+	if away {
+		t.Add(t, big.NewInt(int64(x.Sign())))
+		acc = -acc
+	}
+	return t, acc
+}
+
+// uintFromBigFloat rounds x to an integer according to mode, and stores it into z as a bitSize-bit unsigned integer.
+func uintFromBigFloat(z []uint64, bitSize int, x *big.Float, mode big.RoundingMode) (big.Accuracy, error) {
+	if x.IsInf() {
+		if x.Sign() < 0 {
+			clear(z)
+			return big.Above, ErrOverflow
+		}
+		maxUintLimbs(z, bitSize)
+		return big.Below, ErrOverflow
+	}
+
+	r, acc := roundBigFloat(x, mode)
+	if !uintFromBigInt(z, bitSize, r) {
+		if r.Sign() < 0 {
+			clear(z)
+			return big.Above, ErrOverflow
+		}
+		maxUintLimbs(z, bitSize)
+		return big.Below, ErrOverflow
+	}
+	return acc, nil
+}
+
+// intFromBigFloat rounds x to an integer according to mode, and stores it into z as a bitSize-bit signed integer.
+func intFromBigFloat(z []uint64, bitSize int, x *big.Float, mode big.RoundingMode) (big.Accuracy, error) {
+	if x.IsInf() {
+		if x.Sign() < 0 {
+			minIntLimbs(z, bitSize)
+			return big.Above, ErrOverflow
+		}
+		maxIntLimbs(z, bitSize)
+		return big.Below, ErrOverflow
+	}
+
+	r, acc := roundBigFloat(x, mode)
+	if !intFromBigInt(z, bitSize, r) {
+		if r.Sign() < 0 {
+			minIntLimbs(z, bitSize)
+			return big.Above, ErrOverflow
+		}
+		maxIntLimbs(z, bitSize)
+		return big.Below, ErrOverflow
+	}
+	return acc, nil
+}
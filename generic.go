@@ -0,0 +1,163 @@
+package ints
+
+import "fmt"
+
+// Integer is the set of methods shared by all integer types in this package.
+// T is the integer type itself, so Integer[T] can be used as a constraint
+// such as [T Integer[T]] to write algorithms once over all widths.
+type Integer[T any] interface {
+	fmt.Stringer
+	fmt.Formatter
+
+	IsZero() bool
+	IsInt64() bool
+	IsUint64() bool
+	Sign() int
+	Cmp(b T) int
+
+	Add(b T) T
+	AddOverflow(b T) (T, bool)
+	SaturatingAdd(b T) T
+	Sub(b T) T
+	SubOverflow(b T) (T, bool)
+	SaturatingSub(b T) T
+	Mul(b T) T
+	MulOverflow(b T) (T, bool)
+	SaturatingMul(b T) T
+	Div(b T) T
+	Mod(b T) T
+	DivMod(b T) (T, T)
+	Quo(b T) T
+	Rem(b T) T
+	QuoRem(b T) (T, T)
+	Neg() T
+	NegOverflow() (T, bool)
+
+	And(b T) T
+	AndNot(b T) T
+	Or(b T) T
+	Xor(b T) T
+	Not() T
+	Lsh(i uint) T
+	LshOverflow(i uint) (T, bool)
+	Rsh(i uint) T
+	URsh(i uint) T
+	RshRound(i uint) T
+	RshCeil(i uint) T
+	LeadingZeros() int
+	TrailingZeros() int
+	BitLen() int
+	OnesCount() int
+	Bit(i int) uint
+	Sqrt() T
+	Cbrt() T
+	Root(n uint) T
+	IsPerfectSquare() bool
+
+	Text(base int) string
+	Append(dst []byte, base int) []byte
+	AppendText(dst []byte) ([]byte, error)
+	MarshalText() ([]byte, error)
+	MarshalJSON() ([]byte, error)
+	AppendBinary(dst []byte) ([]byte, error)
+	MarshalBinary() ([]byte, error)
+}
+
+// Unsigned is the set of methods shared by all unsigned integer types in this package.
+type Unsigned[T any] interface {
+	Integer[T]
+
+	AddCarry(b T, carry uint) (sum T, carryOut uint)
+	SubBorrow(b T, borrow uint) (diff T, borrowOut uint)
+	MulFull(b T) (hi, lo T)
+	AddMod(b, m T) T
+	SubMod(b, m T) T
+	MulMod(b, m T) T
+	ExpMod(e, m T) T
+	GCD(b T) T
+	LCM(b T) T
+	ModInverse(m T) (T, bool)
+	RotateLeft(k int) T
+	Reverse() T
+	ReverseBytes() T
+	SetBit(i int, b uint) T
+	ClearBit(i int) T
+	FlipBit(i int) T
+	Extract(lo, width uint) T
+	Insert(v T, lo, width uint) T
+}
+
+// Signed is the set of methods shared by all signed integer types in this package.
+type Signed[T any] interface {
+	Integer[T]
+
+	Abs() T
+	SaturatingAbs() T
+	SaturatingNeg() T
+}
+
+var (
+	_ Unsigned[Uint8]    = Uint8(0)
+	_ Unsigned[Uint16]   = Uint16(0)
+	_ Unsigned[Uint32]   = Uint32(0)
+	_ Unsigned[Uint64]   = Uint64(0)
+	_ Unsigned[Uint128]  = Uint128{}
+	_ Unsigned[Uint256]  = Uint256{}
+	_ Unsigned[Uint512]  = Uint512{}
+	_ Unsigned[Uint1024] = Uint1024{}
+
+	_ Signed[Int8]    = Int8(0)
+	_ Signed[Int16]   = Int16(0)
+	_ Signed[Int32]   = Int32(0)
+	_ Signed[Int64]   = Int64(0)
+	_ Signed[Int128]  = Int128{}
+	_ Signed[Int256]  = Int256{}
+	_ Signed[Int512]  = Int512{}
+	_ Signed[Int1024] = Int1024{}
+)
+
+// Min returns the smallest value of x and ys.
+func Min[T Integer[T]](x T, ys ...T) T {
+	for _, y := range ys {
+		if y.Cmp(x) < 0 {
+			x = y
+		}
+	}
+	return x
+}
+
+// Max returns the largest value of x and ys.
+func Max[T Integer[T]](x T, ys ...T) T {
+	for _, y := range ys {
+		if y.Cmp(x) > 0 {
+			x = y
+		}
+	}
+	return x
+}
+
+// Sum returns the sum of xs.
+// It wraps around on overflow in the same way as [Integer.Add].
+// Sum returns zero if xs is empty.
+func Sum[T Integer[T]](xs ...T) T {
+	var sum T
+	for _, x := range xs {
+		sum = sum.Add(x)
+	}
+	return sum
+}
+
+// Clamp returns x clamped to the range [lo, hi].
+// It panics if lo > hi.
+func Clamp[T Integer[T]](x, lo, hi T) T {
+	if lo.Cmp(hi) > 0 {
+		panic("ints: Clamp: lo > hi")
+	}
+	if x.Cmp(lo) < 0 {
+		return lo
+	}
+	if x.Cmp(hi) > 0 {
+		return hi
+	}
+	return x
+}
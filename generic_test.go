@@ -0,0 +1,127 @@
+package ints
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func TestMin(t *testing.T) {
+	if got, want := Min(Int8(3)), Int8(3); got != want {
+		t.Errorf("Min(3) = %d, want %d", got, want)
+	}
+	if got, want := Min(Int8(3), -1, 5, math.MinInt8), Int8(math.MinInt8); got != want {
+		t.Errorf("Min(3, -1, 5, -128) = %d, want %d", got, want)
+	}
+	if got, want := Min(Int256{0, 0, 0, 1}, MaxInt256, MinInt256), MinInt256; got != want {
+		t.Errorf("Min(1, MaxInt256, MinInt256) = %d, want %d", got, want)
+	}
+	if got, want := Min(MaxUint128, Uint128{1, 0}, Uint128{0, math.MaxUint64}), (Uint128{0, math.MaxUint64}); got != want {
+		t.Errorf("Min(MaxUint128, 2**64, 2**64-1) = %d, want %d", got, want)
+	}
+}
+
+func TestMax(t *testing.T) {
+	if got, want := Max(Uint8(3)), Uint8(3); got != want {
+		t.Errorf("Max(3) = %d, want %d", got, want)
+	}
+	if got, want := Max(Int8(3), -1, 5, math.MinInt8), Int8(5); got != want {
+		t.Errorf("Max(3, -1, 5, -128) = %d, want %d", got, want)
+	}
+	if got, want := Max(Int256{0, 0, 0, 1}, MaxInt256, MinInt256), MaxInt256; got != want {
+		t.Errorf("Max(1, MaxInt256, MinInt256) = %d, want %d", got, want)
+	}
+	if got, want := Max(Uint128{0, math.MaxUint64}, Uint128{1, 0}), (Uint128{1, 0}); got != want {
+		t.Errorf("Max(2**64-1, 2**64) = %d, want %d", got, want)
+	}
+}
+
+func TestSum(t *testing.T) {
+	if got, want := Sum[Int64](), Int64(0); got != want {
+		t.Errorf("Sum() = %d, want %d", got, want)
+	}
+	if got, want := Sum(Int16(1), 2, -3, 4), Int16(4); got != want {
+		t.Errorf("Sum(1, 2, -3, 4) = %d, want %d", got, want)
+	}
+	if got, want := Sum(Uint8(200), 100), Uint8(44); got != want {
+		t.Errorf("Sum(200, 100) = %d, want %d", got, want)
+	}
+	if got, want := Sum(Uint128{0, math.MaxUint64}, Uint128{0, 1}, Uint128{0, 2}), (Uint128{1, 2}); got != want {
+		t.Errorf("Sum(2**64-1, 1, 2) = %d, want %d", got, want)
+	}
+	if got, want := Sum(MaxInt512, Int512FromInt64(1)), MinInt512; got != want {
+		t.Errorf("Sum(MaxInt512, 1) = %d, want %d", got, want)
+	}
+}
+
+func TestClamp(t *testing.T) {
+	testCases := []struct {
+		x, lo, hi Int128
+		want      Int128
+	}{
+		{Int128FromInt64(5), Int128FromInt64(0), Int128FromInt64(10), Int128FromInt64(5)},
+		{Int128FromInt64(-5), Int128FromInt64(0), Int128FromInt64(10), Int128FromInt64(0)},
+		{Int128FromInt64(15), Int128FromInt64(0), Int128FromInt64(10), Int128FromInt64(10)},
+		{MinInt128, Int128FromInt64(-1), Int128FromInt64(-1), Int128FromInt64(-1)},
+		{MaxInt128, MinInt128, MaxInt128, MaxInt128},
+	}
+
+	for _, tc := range testCases {
+		got := Clamp(tc.x, tc.lo, tc.hi)
+		if got != tc.want {
+			t.Errorf("Clamp(%d, %d, %d) = %d, want %d", tc.x, tc.lo, tc.hi, got, tc.want)
+		}
+	}
+}
+
+func TestClamp_Panic(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Clamp(0, 2, 1) did not panic")
+		}
+	}()
+	Clamp(Uint256{}, Uint256FromUint64(2), Uint256FromUint64(1))
+}
+
+// inc and incJSON are generic over Integer[T] only,
+// to check that One, Zero and JSONString accept any such T.
+func inc[T Integer[T]](x T) T {
+	return x.Add(One[T]())
+}
+
+func incJSON[T Integer[T]](x T) ([]byte, error) {
+	if x.Cmp(Zero[T]()) == 0 {
+		x = inc(x)
+	}
+	return json.Marshal(JSONString[T]{inc(x)})
+}
+
+func TestIntegerGeneric(t *testing.T) {
+	if got, want := inc(Uint8(41)), Uint8(42); got != want {
+		t.Errorf("inc(Uint8(41)) = %d, want %d", got, want)
+	}
+	if got, want := inc(Int64(-1)), Int64(0); got != want {
+		t.Errorf("inc(Int64(-1)) = %d, want %d", got, want)
+	}
+	if got, want := inc(Uint128{0, math.MaxUint64}), (Uint128{1, 0}); got != want {
+		t.Errorf("inc(Uint128{0, MaxUint64}) = %d, want %d", got, want)
+	}
+	if got, want := inc(Int1024{}), Int1024FromInt64(1); got != want {
+		t.Errorf("inc(Int1024{}) = %d, want %d", got, want)
+	}
+
+	got, err := incJSON(Int256{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `"2"`; string(got) != want {
+		t.Errorf("incJSON(Int256{}) = %s, want %s", got, want)
+	}
+	got, err = incJSON(Uint16(9))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `"10"`; string(got) != want {
+		t.Errorf("incJSON(Uint16(9)) = %s, want %s", got, want)
+	}
+}
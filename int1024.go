@@ -2,19 +2,72 @@ package ints
 
 import (
 	"cmp"
+	"encoding/binary"
 	"fmt"
+	"math"
+	"math/big"
 	"math/bits"
 )
 
 // Int1024 is a type that represents an 1024-bit signed integer.
 type Int1024 [16]uint64
 
+var (
+	// MaxInt1024 is the maximum value of Int1024.
+	MaxInt1024 = Int1024{math.MaxInt64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+
+	// MinInt1024 is the minimum value of Int1024.
+	MinInt1024 = Int1024{1 << 63, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}
+)
+
+// Int1024FromInt64 returns v as an Int1024.
+func Int1024FromInt64(v int64) Int1024 {
+	s := uint64(v >> 63) // sign extension
+	return Int1024{s, s, s, s, s, s, s, s, s, s, s, s, s, s, s, uint64(v)}
+}
+
+// Int1024Of returns v as an Int1024.
+// v is converted in the same way as Go's integer conversions:
+// it is sign-extended if T is a signed type, and zero-extended otherwise.
+func Int1024Of[T builtinInteger](v T) Int1024 {
+	if isSigned[T]() {
+		return Int64(v).Int1024()
+	}
+	return Uint64(v).Int1024()
+}
+
 // IsZero returns true if a is zero.
 func (a Int1024) IsZero() bool {
 	var zero Int1024
 	return a == zero
 }
 
+// IsInt64 reports whether a can be represented as an int64.
+func (a Int1024) IsInt64() bool {
+	_, ok := a.TryInt64()
+	return ok
+}
+
+// IsUint64 reports whether a can be represented as a uint64.
+func (a Int1024) IsUint64() bool {
+	_, ok := a.TryUint64()
+	return ok
+}
+
+// Int returns a as an int and reports whether a can be represented as an int.
+// If it can't, the result is a truncated to the size of int.
+func (a Int1024) Int() (int, bool) {
+	v, ok := a.TryInt64()
+	return int(v), ok && int64(int(v)) == int64(v)
+}
+
+// Uint returns a as a uint and reports whether a can be represented as a uint.
+// If it can't, the result is a truncated to the size of uint.
+func (a Int1024) Uint() (uint, bool) {
+	v, ok := a.TryUint64()
+	return uint(v), ok && uint64(uint(v)) == uint64(v)
+}
+
 // Add returns the sum a+b.
 //
 // This function's execution time does not depend on the inputs.
@@ -38,6 +91,27 @@ func (a Int1024) Add(b Int1024) Int1024 {
 	return Int1024{u0, u1, u2, u3, u4, u5, u6, u7, u8, u9, u10, u11, u12, u13, u14, u15}
 }
 
+// AddOverflow returns the sum a+b and reports whether the addition overflowed.
+//
+// This function's execution time does not depend on the inputs.
+func (a Int1024) AddOverflow(b Int1024) (Int1024, bool) {
+	c := a.Add(b)
+	return c, ((a[0]^c[0])&(b[0]^c[0]))>>63 != 0
+}
+
+// SaturatingAdd returns the sum a+b.
+// If the result overflows, it is clamped to the minimum or maximum value.
+func (a Int1024) SaturatingAdd(b Int1024) Int1024 {
+	c, overflow := a.AddOverflow(b)
+	if overflow {
+		if int64(a[0]) < 0 {
+			return Int1024{1 << 63, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}
+		}
+		return Int1024{math.MaxInt64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return c
+}
+
 // Sub returns the difference a-b.
 //
 // This function's execution time does not depend on the inputs.
@@ -61,6 +135,27 @@ func (a Int1024) Sub(b Int1024) Int1024 {
 	return Int1024{u0, u1, u2, u3, u4, u5, u6, u7, u8, u9, u10, u11, u12, u13, u14, u15}
 }
 
+// SubOverflow returns the difference a-b and reports whether the subtraction overflowed.
+//
+// This function's execution time does not depend on the inputs.
+func (a Int1024) SubOverflow(b Int1024) (Int1024, bool) {
+	c := a.Sub(b)
+	return c, ((a[0]^b[0])&(a[0]^c[0]))>>63 != 0
+}
+
+// SaturatingSub returns the difference a-b.
+// If the result overflows, it is clamped to the minimum or maximum value.
+func (a Int1024) SaturatingSub(b Int1024) Int1024 {
+	c, overflow := a.SubOverflow(b)
+	if overflow {
+		if int64(a[0]) < 0 {
+			return Int1024{1 << 63, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}
+		}
+		return Int1024{math.MaxInt64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return c
+}
+
 // Mul returns the product a*b.
 func (a Int1024) Mul(b Int1024) Int1024 {
 	neg := false
@@ -81,6 +176,45 @@ func (a Int1024) Mul(b Int1024) Int1024 {
 	return c
 }
 
+// MulOverflow returns the product a*b and reports whether the multiplication overflowed.
+func (a Int1024) MulOverflow(b Int1024) (Int1024, bool) {
+	neg := false
+	if a.Sign() < 0 {
+		neg = !neg
+		a = a.Neg()
+	}
+	if b.Sign() < 0 {
+		neg = !neg
+		b = b.Neg()
+	}
+
+	c, overflow := Uint1024(a).MulOverflow(Uint1024(b))
+
+	// The magnitude of the result must be less than 2**1023,
+	// or equal to 2**1023 if the result is negative.
+	limit := Uint1024{1 << 63, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}
+	if neg {
+		overflow = overflow || c.Cmp(limit) > 0
+		c = c.Neg()
+	} else {
+		overflow = overflow || c.Cmp(limit) >= 0
+	}
+	return Int1024(c), overflow
+}
+
+// SaturatingMul returns the product a*b.
+// If the result overflows, it is clamped to the minimum or maximum value.
+func (a Int1024) SaturatingMul(b Int1024) Int1024 {
+	c, overflow := a.MulOverflow(b)
+	if overflow {
+		if (int64(a[0]) < 0) != (int64(b[0]) < 0) {
+			return Int1024{1 << 63, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}
+		}
+		return Int1024{math.MaxInt64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return c
+}
+
 // Div returns the quotient a/b for b != 0.
 // If b == 0, a division-by-zero run-time panic occurs.
 // Div implements Euclidean division (unlike Go); see [Int1024.DivMod] for more details.
@@ -356,6 +490,13 @@ func (a Int1024) Lsh(i uint) Int1024 {
 	}
 }
 
+// LshOverflow returns the left shift a<<i and reports whether the shift overflowed,
+// i.e. whether a<<i differs from a multiplied by 2**i.
+func (a Int1024) LshOverflow(i uint) (Int1024, bool) {
+	c := a.Lsh(i)
+	return c, c.Rsh(i) != a
+}
+
 // Rsh returns the arithmetic right shift a>>i, preserving the sign bit.
 //
 // This function's execution time does not depend on the inputs.
@@ -451,6 +592,77 @@ func (a Int1024) Rsh(i uint) Int1024 {
 	}
 }
 
+// URsh returns the logical right shift a>>i, treating a as an unsigned integer.
+// The vacated upper bits are filled with zeros regardless of the sign of a.
+func (a Int1024) URsh(i uint) Int1024 {
+	return Int1024(Uint1024(a).Rsh(i))
+}
+
+// RshRound returns a/2**i rounded to the nearest integer, with ties rounded to even.
+func (a Int1024) RshRound(i uint) Int1024 {
+	if i == 0 {
+		return a
+	}
+	q := a.Rsh(i)
+	half := a.Bit(int(min(i-1, 1024)))
+	sticky := !Uint1024(a).Extract(0, i-1).IsZero()
+	if half == 1 && (sticky || q.Bit(0) == 1) {
+		q = q.Add(Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1})
+	}
+	return q
+}
+
+// RshCeil returns a/2**i rounded toward positive infinity.
+func (a Int1024) RshCeil(i uint) Int1024 {
+	q := a.Rsh(i)
+	if !Uint1024(a).Extract(0, i).IsZero() {
+		q = q.Add(Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1})
+	}
+	return q
+}
+
+// LeadingZeros returns the number of leading zero bits in the two's complement representation of a;
+// the result is 0 for a < 0, and 1024 for a == 0.
+func (a Int1024) LeadingZeros() int {
+	return Uint1024(a).LeadingZeros()
+}
+
+// TrailingZeros returns the number of trailing zero bits in a; the result is 1024 for a == 0.
+// a and -a have the same number of trailing zero bits.
+func (a Int1024) TrailingZeros() int {
+	return Uint1024(a).TrailingZeros()
+}
+
+// BitLen returns the number of bits required to represent the absolute value of a in binary;
+// the result is 0 for a == 0.
+// It is the same as [big.Int.BitLen].
+func (a Int1024) BitLen() int {
+	if int64(a[0]) < 0 {
+		// the negation of the minimum value is itself,
+		// but it is correctly interpreted as 2**1023 in unsigned.
+		a = a.Neg()
+	}
+	return Uint1024(a).BitLen()
+}
+
+// OnesCount returns the number of one bits ("population count") in the two's complement representation of a.
+func (a Int1024) OnesCount() int {
+	return Uint1024(a).OnesCount()
+}
+
+// Bit returns the value of the i'th bit of the two's complement representation of a.
+// That is, it returns (a>>i)&1, and the sign bit for i >= 1024, like [big.Int.Bit].
+// The bit index i must be >= 0.
+func (a Int1024) Bit(i int) uint {
+	if i < 0 {
+		panic("ints: negative bit index")
+	}
+	if i >= 1024 {
+		return uint(a[0] >> 63)
+	}
+	return uint(a[15-i/64]>>(uint(i)%64)) & 1
+}
+
 // Sign returns the sign of a.
 // It returns 1 if a > 0, -1 if a < 0, and 0 if a == 0.
 func (a Int1024) Sign() int {
@@ -488,6 +700,73 @@ func (a Int1024) Neg() Int1024 {
 	return Int1024{u0, u1, u2, u3, u4, u5, u6, u7, u8, u9, u10, u11, u12, u13, u14, u15}
 }
 
+// NegOverflow returns the negation of a and reports whether the negation overflowed,
+// which happens only if a is the minimum value.
+//
+// This function's execution time does not depend on the inputs.
+func (a Int1024) NegOverflow() (Int1024, bool) {
+	c := a.Neg()
+	return c, (a[0]&c[0])>>63 != 0
+}
+
+// SaturatingNeg returns the negation of a.
+// If a is the minimum value, it returns the maximum value.
+func (a Int1024) SaturatingNeg() Int1024 {
+	c, overflow := a.NegOverflow()
+	if overflow {
+		return Int1024{math.MaxInt64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return c
+}
+
+// Abs returns the absolute value of a.
+// If a is the minimum value, Abs returns a itself because its absolute value can't be represented.
+func (a Int1024) Abs() Int1024 {
+	if int64(a[0]) < 0 {
+		return a.Neg()
+	}
+	return a
+}
+
+// SaturatingAbs returns the absolute value of a.
+// If a is the minimum value, it returns the maximum value.
+func (a Int1024) SaturatingAbs() Int1024 {
+	if int64(a[0]) < 0 {
+		return a.SaturatingNeg()
+	}
+	return a
+}
+
+// Sqrt returns the floor square root of a, ⌊√a⌋.
+// It panics if a < 0.
+func (a Int1024) Sqrt() Int1024 {
+	if a.Sign() < 0 {
+		panic("ints: square root of negative number")
+	}
+	return Int1024(Uint1024(a).Sqrt())
+}
+
+// Cbrt returns the floor cube root of a, ⌊∛a⌋.
+// It panics if a < 0.
+func (a Int1024) Cbrt() Int1024 {
+	return a.Root(3)
+}
+
+// Root returns the floor n-th root of a, ⌊a**(1/n)⌋.
+// It panics if a < 0 or n == 0.
+func (a Int1024) Root(n uint) Int1024 {
+	if a.Sign() < 0 {
+		panic("ints: root of negative number")
+	}
+	return Int1024(Uint1024(a).Root(n))
+}
+
+// IsPerfectSquare reports whether a is the square of an integer.
+// Negative numbers are never perfect squares.
+func (a Int1024) IsPerfectSquare() bool {
+	return a.Sign() >= 0 && Uint1024(a).IsPerfectSquare()
+}
+
 // Cmp returns the comparison result of a and b.
 // It returns -1 if a < b, 0 if a == b, and 1 if a > b.
 func (a Int1024) Cmp(b Int1024) int {
@@ -575,3 +854,254 @@ func (a Int1024) Format(s fmt.State, verb rune) {
 	}
 	format(s, verb, sign, b)
 }
+
+// ParseInt1024 interprets a string s in the given base (0, 2 to 62) and
+// returns the corresponding value.
+//
+// The string may begin with a leading sign: "+" or "-".
+//
+// If the base argument is 0, the true base is implied by the string's
+// prefix following the sign (if present): 2 for "0b", 8 for "0" or "0o",
+// 16 for "0x", and 10 otherwise. Also, for argument base 0 only,
+// underscore characters are permitted as defined by the Go syntax for
+// [integer literals].
+// For bases <= 36, lower and upper case letters are considered the same:
+// The letters 'a' to 'z' and 'A' to 'Z' represent digit values 10 to 35.
+// For bases > 36, the upper case letters 'A' to 'Z' represent the digit values 36 to 61,
+// as generated by [Int1024.Text].
+//
+// The errors that ParseInt1024 returns have concrete type [*strconv.NumError]
+// and include err.Num = s. If s is empty or contains invalid
+// digits, err.Err = [strconv.ErrSyntax] and the returned value is 0;
+// if the value corresponding to s cannot be represented by an Int1024,
+// err.Err = [strconv.ErrRange] and the returned value is the maximum magnitude integer
+// of the appropriate sign.
+//
+// [integer literals]: https://go.dev/ref/spec#Integer_literals
+func ParseInt1024(s string, base int) (Int1024, error) {
+	var z Int1024
+	err := parseInt("ParseInt1024", s, base, 1024, z[:])
+	return z, err
+}
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+// The result is the decimal representation of a.
+func (a Int1024) MarshalText() ([]byte, error) {
+	return a.AppendText(nil)
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+// It accepts the same syntax as Go integer literals, such as "0x" prefixes and underscores.
+// See [ParseInt1024] for more details.
+func (a *Int1024) UnmarshalText(text []byte) error {
+	v, err := ParseInt1024(string(text), 0)
+	if err != nil {
+		return err
+	}
+	*a = v
+	return nil
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+// The result is a JSON number. Use [JSONString] to encode a as a JSON string.
+func (a Int1024) MarshalJSON() ([]byte, error) {
+	return a.AppendText(nil)
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+// It accepts both a JSON number such as 123 and a JSON string such as "123" or "0x7b".
+// JSON strings are parsed in the same way as [Int1024.UnmarshalText].
+func (a *Int1024) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(a, data, ParseInt1024)
+}
+
+// BytesBE returns the two's complement big-endian representation of a.
+func (a Int1024) BytesBE() [128]byte {
+	var b [128]byte
+	a.PutBytesBE(b[:])
+	return b
+}
+
+// BytesLE returns the two's complement little-endian representation of a.
+func (a Int1024) BytesLE() [128]byte {
+	var b [128]byte
+	a.PutBytesLE(b[:])
+	return b
+}
+
+// PutBytesBE stores the two's complement big-endian representation of a into b.
+// It panics if len(b) < 128.
+func (a Int1024) PutBytesBE(b []byte) {
+	_ = b[127] // bounds check hint to compiler; see golang.org/issue/14808
+	binary.BigEndian.PutUint64(b[0:], a[0])
+	binary.BigEndian.PutUint64(b[8:], a[1])
+	binary.BigEndian.PutUint64(b[16:], a[2])
+	binary.BigEndian.PutUint64(b[24:], a[3])
+	binary.BigEndian.PutUint64(b[32:], a[4])
+	binary.BigEndian.PutUint64(b[40:], a[5])
+	binary.BigEndian.PutUint64(b[48:], a[6])
+	binary.BigEndian.PutUint64(b[56:], a[7])
+	binary.BigEndian.PutUint64(b[64:], a[8])
+	binary.BigEndian.PutUint64(b[72:], a[9])
+	binary.BigEndian.PutUint64(b[80:], a[10])
+	binary.BigEndian.PutUint64(b[88:], a[11])
+	binary.BigEndian.PutUint64(b[96:], a[12])
+	binary.BigEndian.PutUint64(b[104:], a[13])
+	binary.BigEndian.PutUint64(b[112:], a[14])
+	binary.BigEndian.PutUint64(b[120:], a[15])
+}
+
+// PutBytesLE stores the two's complement little-endian representation of a into b.
+// It panics if len(b) < 128.
+func (a Int1024) PutBytesLE(b []byte) {
+	_ = b[127] // bounds check hint to compiler; see golang.org/issue/14808
+	binary.LittleEndian.PutUint64(b[0:], a[15])
+	binary.LittleEndian.PutUint64(b[8:], a[14])
+	binary.LittleEndian.PutUint64(b[16:], a[13])
+	binary.LittleEndian.PutUint64(b[24:], a[12])
+	binary.LittleEndian.PutUint64(b[32:], a[11])
+	binary.LittleEndian.PutUint64(b[40:], a[10])
+	binary.LittleEndian.PutUint64(b[48:], a[9])
+	binary.LittleEndian.PutUint64(b[56:], a[8])
+	binary.LittleEndian.PutUint64(b[64:], a[7])
+	binary.LittleEndian.PutUint64(b[72:], a[6])
+	binary.LittleEndian.PutUint64(b[80:], a[5])
+	binary.LittleEndian.PutUint64(b[88:], a[4])
+	binary.LittleEndian.PutUint64(b[96:], a[3])
+	binary.LittleEndian.PutUint64(b[104:], a[2])
+	binary.LittleEndian.PutUint64(b[112:], a[1])
+	binary.LittleEndian.PutUint64(b[120:], a[0])
+}
+
+// Int1024FromBytesBE returns the Int1024 represented by the two's complement big-endian bytes b[:128].
+// It panics if len(b) < 128.
+func Int1024FromBytesBE(b []byte) Int1024 {
+	_ = b[127] // bounds check hint to compiler; see golang.org/issue/14808
+	return Int1024{
+		binary.BigEndian.Uint64(b[0:]),
+		binary.BigEndian.Uint64(b[8:]),
+		binary.BigEndian.Uint64(b[16:]),
+		binary.BigEndian.Uint64(b[24:]),
+		binary.BigEndian.Uint64(b[32:]),
+		binary.BigEndian.Uint64(b[40:]),
+		binary.BigEndian.Uint64(b[48:]),
+		binary.BigEndian.Uint64(b[56:]),
+		binary.BigEndian.Uint64(b[64:]),
+		binary.BigEndian.Uint64(b[72:]),
+		binary.BigEndian.Uint64(b[80:]),
+		binary.BigEndian.Uint64(b[88:]),
+		binary.BigEndian.Uint64(b[96:]),
+		binary.BigEndian.Uint64(b[104:]),
+		binary.BigEndian.Uint64(b[112:]),
+		binary.BigEndian.Uint64(b[120:]),
+	}
+}
+
+// Int1024FromBytesLE returns the Int1024 represented by the two's complement little-endian bytes b[:128].
+// It panics if len(b) < 128.
+func Int1024FromBytesLE(b []byte) Int1024 {
+	_ = b[127] // bounds check hint to compiler; see golang.org/issue/14808
+	return Int1024{
+		binary.LittleEndian.Uint64(b[120:]),
+		binary.LittleEndian.Uint64(b[112:]),
+		binary.LittleEndian.Uint64(b[104:]),
+		binary.LittleEndian.Uint64(b[96:]),
+		binary.LittleEndian.Uint64(b[88:]),
+		binary.LittleEndian.Uint64(b[80:]),
+		binary.LittleEndian.Uint64(b[72:]),
+		binary.LittleEndian.Uint64(b[64:]),
+		binary.LittleEndian.Uint64(b[56:]),
+		binary.LittleEndian.Uint64(b[48:]),
+		binary.LittleEndian.Uint64(b[40:]),
+		binary.LittleEndian.Uint64(b[32:]),
+		binary.LittleEndian.Uint64(b[24:]),
+		binary.LittleEndian.Uint64(b[16:]),
+		binary.LittleEndian.Uint64(b[8:]),
+		binary.LittleEndian.Uint64(b[0:]),
+	}
+}
+
+// AppendBinary implements the [encoding.BinaryAppender] interface.
+// It appends the two's complement big-endian representation of a to dst.
+func (a Int1024) AppendBinary(dst []byte) ([]byte, error) {
+	b := a.BytesBE()
+	return append(dst, b[:]...), nil
+}
+
+// MarshalBinary implements the [encoding.BinaryMarshaler] interface.
+// The result is the 128-byte two's complement big-endian representation of a.
+func (a Int1024) MarshalBinary() ([]byte, error) {
+	return a.AppendBinary(make([]byte, 0, 128))
+}
+
+// UnmarshalBinary implements the [encoding.BinaryUnmarshaler] interface.
+// data must be exactly 128 bytes of the two's complement big-endian representation, as generated by [Int1024.MarshalBinary].
+func (a *Int1024) UnmarshalBinary(data []byte) error {
+	if len(data) != 128 {
+		return fmt.Errorf("ints: Int1024.UnmarshalBinary: invalid length %d, want 128", len(data))
+	}
+	*a = Int1024FromBytesBE(data)
+	return nil
+}
+
+// BigInt returns a as a new [*big.Int].
+func (a Int1024) BigInt() *big.Int {
+	if a.Sign() < 0 {
+		a = a.Neg()
+		var w [1024 / bits.UintSize]big.Word
+		z := new(big.Int).SetBits(wordsFromLimbs(w[:], a[:]))
+		return z.Neg(z)
+	}
+	var w [1024 / bits.UintSize]big.Word
+	return new(big.Int).SetBits(wordsFromLimbs(w[:], a[:]))
+}
+
+// Int1024FromBigInt returns x as an Int1024.
+// If x is not representable as an Int1024, the result is the lower 1024 bits of x in two's complement,
+// and ok is false.
+func Int1024FromBigInt(x *big.Int) (Int1024, bool) {
+	var z Int1024
+	ok := intFromBigInt(z[:], 1024, x)
+	return z, ok
+}
+
+// Float64 returns the float64 value nearest to a, rounding ties to even, and an indication of any rounding error.
+// If a is too large to be represented by a float64, the result is ±Inf.
+func (a Int1024) Float64() (float64, big.Accuracy) {
+	if a.Sign() < 0 {
+		b := Uint1024(a).Neg()
+		f, acc := float64FromLimbs(b[:])
+		return -f, -acc
+	}
+	return float64FromLimbs(a[:])
+}
+
+// Int1024FromFloat64 returns the integer value of f rounded according to mode,
+// and the accuracy of the result: [big.Below] if the result is less than f, [big.Above] if it is greater than f.
+// Use [big.ToZero] to truncate f like the Go conversion, and [big.ToNearestEven] to round f to the nearest integer.
+//
+// If f is NaN, the result is 0 and the error is [ErrNaN].
+// If the rounded value of f is not representable as an Int1024, including ±Inf,
+// the result is clamped to the minimum or maximum value of Int1024, and the error is [ErrOverflow].
+func Int1024FromFloat64(f float64, mode big.RoundingMode) (Int1024, big.Accuracy, error) {
+	var z Int1024
+	acc, err := intFromFloat64(z[:], 1024, f, mode)
+	return z, acc, err
+}
+
+// BigFloat returns a as a new [*big.Float].
+// The precision of the result is 1024 bits, so the conversion is always exact.
+func (a Int1024) BigFloat() *big.Float {
+	return new(big.Float).SetPrec(1024).SetInt(a.BigInt())
+}
+
+// Int1024FromBigFloat returns the integer value of x rounded according to mode,
+// and the accuracy of the result: [big.Below] if the result is less than x, [big.Above] if it is greater than x.
+//
+// If the rounded value of x is not representable as an Int1024, including ±Inf,
+// the result is clamped to the minimum or maximum value of Int1024, and the error is [ErrOverflow].
+func Int1024FromBigFloat(x *big.Float, mode big.RoundingMode) (Int1024, big.Accuracy, error) {
+	var z Int1024
+	acc, err := intFromBigFloat(z[:], 1024, x, mode)
+	return z, acc, err
+}
@@ -2,19 +2,72 @@ package ints
 
 import (
 	"cmp"
+	"encoding/binary"
 	"fmt"
+	"math"
+	"math/big"
 	"math/bits"
 )
 
 // Int128 is a type that represents an 128-bit signed integer.
 type Int128 [2]uint64
 
+var (
+	// MaxInt128 is the maximum value of Int128.
+	MaxInt128 = Int128{math.MaxInt64, math.MaxUint64}
+
+	// MinInt128 is the minimum value of Int128.
+	MinInt128 = Int128{1 << 63, 0}
+)
+
+// Int128FromInt64 returns v as an Int128.
+func Int128FromInt64(v int64) Int128 {
+	s := uint64(v >> 63) // sign extension
+	return Int128{s, uint64(v)}
+}
+
+// Int128Of returns v as an Int128.
+// v is converted in the same way as Go's integer conversions:
+// it is sign-extended if T is a signed type, and zero-extended otherwise.
+func Int128Of[T builtinInteger](v T) Int128 {
+	if isSigned[T]() {
+		return Int64(v).Int128()
+	}
+	return Uint64(v).Int128()
+}
+
 // IsZero returns true if a is zero.
 func (a Int128) IsZero() bool {
 	var zero Int128
 	return a == zero
 }
 
+// IsInt64 reports whether a can be represented as an int64.
+func (a Int128) IsInt64() bool {
+	_, ok := a.TryInt64()
+	return ok
+}
+
+// IsUint64 reports whether a can be represented as a uint64.
+func (a Int128) IsUint64() bool {
+	_, ok := a.TryUint64()
+	return ok
+}
+
+// Int returns a as an int and reports whether a can be represented as an int.
+// If it can't, the result is a truncated to the size of int.
+func (a Int128) Int() (int, bool) {
+	v, ok := a.TryInt64()
+	return int(v), ok && int64(int(v)) == int64(v)
+}
+
+// Uint returns a as a uint and reports whether a can be represented as a uint.
+// If it can't, the result is a truncated to the size of uint.
+func (a Int128) Uint() (uint, bool) {
+	v, ok := a.TryUint64()
+	return uint(v), ok && uint64(uint(v)) == uint64(v)
+}
+
 // Add returns the sum a+b.
 //
 // This function's execution time does not depend on the inputs.
@@ -24,6 +77,27 @@ func (a Int128) Add(b Int128) Int128 {
 	return Int128{u0, u1}
 }
 
+// AddOverflow returns the sum a+b and reports whether the addition overflowed.
+//
+// This function's execution time does not depend on the inputs.
+func (a Int128) AddOverflow(b Int128) (Int128, bool) {
+	c := a.Add(b)
+	return c, ((a[0]^c[0])&(b[0]^c[0]))>>63 != 0
+}
+
+// SaturatingAdd returns the sum a+b.
+// If the result overflows, it is clamped to the minimum or maximum value.
+func (a Int128) SaturatingAdd(b Int128) Int128 {
+	c, overflow := a.AddOverflow(b)
+	if overflow {
+		if int64(a[0]) < 0 {
+			return Int128{1 << 63, 0}
+		}
+		return Int128{math.MaxInt64, math.MaxUint64}
+	}
+	return c
+}
+
 // Sub returns the difference a-b.
 //
 // This function's execution time does not depend on the inputs.
@@ -33,6 +107,27 @@ func (a Int128) Sub(b Int128) Int128 {
 	return Int128{u0, u1}
 }
 
+// SubOverflow returns the difference a-b and reports whether the subtraction overflowed.
+//
+// This function's execution time does not depend on the inputs.
+func (a Int128) SubOverflow(b Int128) (Int128, bool) {
+	c := a.Sub(b)
+	return c, ((a[0]^b[0])&(a[0]^c[0]))>>63 != 0
+}
+
+// SaturatingSub returns the difference a-b.
+// If the result overflows, it is clamped to the minimum or maximum value.
+func (a Int128) SaturatingSub(b Int128) Int128 {
+	c, overflow := a.SubOverflow(b)
+	if overflow {
+		if int64(a[0]) < 0 {
+			return Int128{1 << 63, 0}
+		}
+		return Int128{math.MaxInt64, math.MaxUint64}
+	}
+	return c
+}
+
 // Mul returns the product a*b.
 func (a Int128) Mul(b Int128) Int128 {
 	neg := false
@@ -53,6 +148,64 @@ func (a Int128) Mul(b Int128) Int128 {
 	return c
 }
 
+// MulOverflow returns the product a*b and reports whether the multiplication overflowed.
+func (a Int128) MulOverflow(b Int128) (Int128, bool) {
+	neg := false
+	if a.Sign() < 0 {
+		neg = !neg
+		a = a.Neg()
+	}
+	if b.Sign() < 0 {
+		neg = !neg
+		b = b.Neg()
+	}
+
+	c, overflow := Uint128(a).MulOverflow(Uint128(b))
+
+	// The magnitude of the result must be less than 2**127,
+	// or equal to 2**127 if the result is negative.
+	limit := Uint128{1 << 63, 0}
+	if neg {
+		overflow = overflow || c.Cmp(limit) > 0
+		c = c.Neg()
+	} else {
+		overflow = overflow || c.Cmp(limit) >= 0
+	}
+	return Int128(c), overflow
+}
+
+// SaturatingMul returns the product a*b.
+// If the result overflows, it is clamped to the minimum or maximum value.
+func (a Int128) SaturatingMul(b Int128) Int128 {
+	c, overflow := a.MulOverflow(b)
+	if overflow {
+		if (int64(a[0]) < 0) != (int64(b[0]) < 0) {
+			return Int128{1 << 63, 0}
+		}
+		return Int128{math.MaxInt64, math.MaxUint64}
+	}
+	return c
+}
+
+// Mul256 returns the product a*b, the result is a 256-bit integer.
+func (a Int128) Mul256(b Int128) Int256 {
+	neg := false
+	if a.Sign() < 0 {
+		a = a.Neg()
+		neg = true
+	}
+	if b.Sign() < 0 {
+		b = b.Neg()
+		neg = !neg
+	}
+
+	c := Int256(Uint128(a).Mul256(Uint128(b)))
+	if neg {
+		c = c.Neg()
+	}
+	return c
+}
+
 // Div returns the quotient a/b for b != 0.
 // If b == 0, a division-by-zero run-time panic occurs.
 // Div implements Euclidean division (unlike Go); see [Int128.DivMod] for more details.
@@ -180,6 +333,13 @@ func (a Int128) Lsh(i uint) Int128 {
 	}
 }
 
+// LshOverflow returns the left shift a<<i and reports whether the shift overflowed,
+// i.e. whether a<<i differs from a multiplied by 2**i.
+func (a Int128) LshOverflow(i uint) (Int128, bool) {
+	c := a.Lsh(i)
+	return c, c.Rsh(i) != a
+}
+
 // Rsh returns the arithmetic right shift a>>i, preserving the sign bit.
 //
 // This function's execution time does not depend on the inputs.
@@ -197,6 +357,77 @@ func (a Int128) Rsh(i uint) Int128 {
 	}
 }
 
+// URsh returns the logical right shift a>>i, treating a as an unsigned integer.
+// The vacated upper bits are filled with zeros regardless of the sign of a.
+func (a Int128) URsh(i uint) Int128 {
+	return Int128(Uint128(a).Rsh(i))
+}
+
+// RshRound returns a/2**i rounded to the nearest integer, with ties rounded to even.
+func (a Int128) RshRound(i uint) Int128 {
+	if i == 0 {
+		return a
+	}
+	q := a.Rsh(i)
+	half := a.Bit(int(min(i-1, 128)))
+	sticky := !Uint128(a).Extract(0, i-1).IsZero()
+	if half == 1 && (sticky || q.Bit(0) == 1) {
+		q = q.Add(Int128{0, 1})
+	}
+	return q
+}
+
+// RshCeil returns a/2**i rounded toward positive infinity.
+func (a Int128) RshCeil(i uint) Int128 {
+	q := a.Rsh(i)
+	if !Uint128(a).Extract(0, i).IsZero() {
+		q = q.Add(Int128{0, 1})
+	}
+	return q
+}
+
+// LeadingZeros returns the number of leading zero bits in the two's complement representation of a;
+// the result is 0 for a < 0, and 128 for a == 0.
+func (a Int128) LeadingZeros() int {
+	return Uint128(a).LeadingZeros()
+}
+
+// TrailingZeros returns the number of trailing zero bits in a; the result is 128 for a == 0.
+// a and -a have the same number of trailing zero bits.
+func (a Int128) TrailingZeros() int {
+	return Uint128(a).TrailingZeros()
+}
+
+// BitLen returns the number of bits required to represent the absolute value of a in binary;
+// the result is 0 for a == 0.
+// It is the same as [big.Int.BitLen].
+func (a Int128) BitLen() int {
+	if int64(a[0]) < 0 {
+		// the negation of the minimum value is itself,
+		// but it is correctly interpreted as 2**127 in unsigned.
+		a = a.Neg()
+	}
+	return Uint128(a).BitLen()
+}
+
+// OnesCount returns the number of one bits ("population count") in the two's complement representation of a.
+func (a Int128) OnesCount() int {
+	return Uint128(a).OnesCount()
+}
+
+// Bit returns the value of the i'th bit of the two's complement representation of a.
+// That is, it returns (a>>i)&1, and the sign bit for i >= 128, like [big.Int.Bit].
+// The bit index i must be >= 0.
+func (a Int128) Bit(i int) uint {
+	if i < 0 {
+		panic("ints: negative bit index")
+	}
+	if i >= 128 {
+		return uint(a[0] >> 63)
+	}
+	return uint(a[1-i/64]>>(uint(i)%64)) & 1
+}
+
 // Sign returns the sign of a.
 // It returns 1 if a > 0, -1 if a < 0, and 0 if a == 0.
 func (a Int128) Sign() int {
@@ -220,6 +451,73 @@ func (a Int128) Neg() Int128 {
 	return Int128{u0, u1}
 }
 
+// NegOverflow returns the negation of a and reports whether the negation overflowed,
+// which happens only if a is the minimum value.
+//
+// This function's execution time does not depend on the inputs.
+func (a Int128) NegOverflow() (Int128, bool) {
+	c := a.Neg()
+	return c, (a[0]&c[0])>>63 != 0
+}
+
+// SaturatingNeg returns the negation of a.
+// If a is the minimum value, it returns the maximum value.
+func (a Int128) SaturatingNeg() Int128 {
+	c, overflow := a.NegOverflow()
+	if overflow {
+		return Int128{math.MaxInt64, math.MaxUint64}
+	}
+	return c
+}
+
+// Abs returns the absolute value of a.
+// If a is the minimum value, Abs returns a itself because its absolute value can't be represented.
+func (a Int128) Abs() Int128 {
+	if int64(a[0]) < 0 {
+		return a.Neg()
+	}
+	return a
+}
+
+// SaturatingAbs returns the absolute value of a.
+// If a is the minimum value, it returns the maximum value.
+func (a Int128) SaturatingAbs() Int128 {
+	if int64(a[0]) < 0 {
+		return a.SaturatingNeg()
+	}
+	return a
+}
+
+// Sqrt returns the floor square root of a, ⌊√a⌋.
+// It panics if a < 0.
+func (a Int128) Sqrt() Int128 {
+	if a.Sign() < 0 {
+		panic("ints: square root of negative number")
+	}
+	return Int128(Uint128(a).Sqrt())
+}
+
+// Cbrt returns the floor cube root of a, ⌊∛a⌋.
+// It panics if a < 0.
+func (a Int128) Cbrt() Int128 {
+	return a.Root(3)
+}
+
+// Root returns the floor n-th root of a, ⌊a**(1/n)⌋.
+// It panics if a < 0 or n == 0.
+func (a Int128) Root(n uint) Int128 {
+	if a.Sign() < 0 {
+		panic("ints: root of negative number")
+	}
+	return Int128(Uint128(a).Root(n))
+}
+
+// IsPerfectSquare reports whether a is the square of an integer.
+// Negative numbers are never perfect squares.
+func (a Int128) IsPerfectSquare() bool {
+	return a.Sign() >= 0 && Uint128(a).IsPerfectSquare()
+}
+
 // Cmp returns the comparison result of a and b.
 // It returns -1 if a < b, 0 if a == b, and 1 if a > b.
 func (a Int128) Cmp(b Int128) int {
@@ -265,3 +563,198 @@ func (a Int128) Format(s fmt.State, verb rune) {
 	}
 	format(s, verb, sign, b)
 }
+
+// ParseInt128 interprets a string s in the given base (0, 2 to 62) and
+// returns the corresponding value.
+//
+// The string may begin with a leading sign: "+" or "-".
+//
+// If the base argument is 0, the true base is implied by the string's
+// prefix following the sign (if present): 2 for "0b", 8 for "0" or "0o",
+// 16 for "0x", and 10 otherwise. Also, for argument base 0 only,
+// underscore characters are permitted as defined by the Go syntax for
+// [integer literals].
+// For bases <= 36, lower and upper case letters are considered the same:
+// The letters 'a' to 'z' and 'A' to 'Z' represent digit values 10 to 35.
+// For bases > 36, the upper case letters 'A' to 'Z' represent the digit values 36 to 61,
+// as generated by [Int128.Text].
+//
+// The errors that ParseInt128 returns have concrete type [*strconv.NumError]
+// and include err.Num = s. If s is empty or contains invalid
+// digits, err.Err = [strconv.ErrSyntax] and the returned value is 0;
+// if the value corresponding to s cannot be represented by an Int128,
+// err.Err = [strconv.ErrRange] and the returned value is the maximum magnitude integer
+// of the appropriate sign.
+//
+// [integer literals]: https://go.dev/ref/spec#Integer_literals
+func ParseInt128(s string, base int) (Int128, error) {
+	var z Int128
+	err := parseInt("ParseInt128", s, base, 128, z[:])
+	return z, err
+}
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+// The result is the decimal representation of a.
+func (a Int128) MarshalText() ([]byte, error) {
+	return a.AppendText(nil)
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+// It accepts the same syntax as Go integer literals, such as "0x" prefixes and underscores.
+// See [ParseInt128] for more details.
+func (a *Int128) UnmarshalText(text []byte) error {
+	v, err := ParseInt128(string(text), 0)
+	if err != nil {
+		return err
+	}
+	*a = v
+	return nil
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+// The result is a JSON number. Use [JSONString] to encode a as a JSON string.
+func (a Int128) MarshalJSON() ([]byte, error) {
+	return a.AppendText(nil)
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+// It accepts both a JSON number such as 123 and a JSON string such as "123" or "0x7b".
+// JSON strings are parsed in the same way as [Int128.UnmarshalText].
+func (a *Int128) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(a, data, ParseInt128)
+}
+
+// BytesBE returns the two's complement big-endian representation of a.
+func (a Int128) BytesBE() [16]byte {
+	var b [16]byte
+	a.PutBytesBE(b[:])
+	return b
+}
+
+// BytesLE returns the two's complement little-endian representation of a.
+func (a Int128) BytesLE() [16]byte {
+	var b [16]byte
+	a.PutBytesLE(b[:])
+	return b
+}
+
+// PutBytesBE stores the two's complement big-endian representation of a into b.
+// It panics if len(b) < 16.
+func (a Int128) PutBytesBE(b []byte) {
+	_ = b[15] // bounds check hint to compiler; see golang.org/issue/14808
+	binary.BigEndian.PutUint64(b[0:], a[0])
+	binary.BigEndian.PutUint64(b[8:], a[1])
+}
+
+// PutBytesLE stores the two's complement little-endian representation of a into b.
+// It panics if len(b) < 16.
+func (a Int128) PutBytesLE(b []byte) {
+	_ = b[15] // bounds check hint to compiler; see golang.org/issue/14808
+	binary.LittleEndian.PutUint64(b[0:], a[1])
+	binary.LittleEndian.PutUint64(b[8:], a[0])
+}
+
+// Int128FromBytesBE returns the Int128 represented by the two's complement big-endian bytes b[:16].
+// It panics if len(b) < 16.
+func Int128FromBytesBE(b []byte) Int128 {
+	_ = b[15] // bounds check hint to compiler; see golang.org/issue/14808
+	return Int128{
+		binary.BigEndian.Uint64(b[0:]),
+		binary.BigEndian.Uint64(b[8:]),
+	}
+}
+
+// Int128FromBytesLE returns the Int128 represented by the two's complement little-endian bytes b[:16].
+// It panics if len(b) < 16.
+func Int128FromBytesLE(b []byte) Int128 {
+	_ = b[15] // bounds check hint to compiler; see golang.org/issue/14808
+	return Int128{
+		binary.LittleEndian.Uint64(b[8:]),
+		binary.LittleEndian.Uint64(b[0:]),
+	}
+}
+
+// AppendBinary implements the [encoding.BinaryAppender] interface.
+// It appends the two's complement big-endian representation of a to dst.
+func (a Int128) AppendBinary(dst []byte) ([]byte, error) {
+	b := a.BytesBE()
+	return append(dst, b[:]...), nil
+}
+
+// MarshalBinary implements the [encoding.BinaryMarshaler] interface.
+// The result is the 16-byte two's complement big-endian representation of a.
+func (a Int128) MarshalBinary() ([]byte, error) {
+	return a.AppendBinary(make([]byte, 0, 16))
+}
+
+// UnmarshalBinary implements the [encoding.BinaryUnmarshaler] interface.
+// data must be exactly 16 bytes of the two's complement big-endian representation, as generated by [Int128.MarshalBinary].
+func (a *Int128) UnmarshalBinary(data []byte) error {
+	if len(data) != 16 {
+		return fmt.Errorf("ints: Int128.UnmarshalBinary: invalid length %d, want 16", len(data))
+	}
+	*a = Int128FromBytesBE(data)
+	return nil
+}
+
+// BigInt returns a as a new [*big.Int].
+func (a Int128) BigInt() *big.Int {
+	if a.Sign() < 0 {
+		a = a.Neg()
+		var w [128 / bits.UintSize]big.Word
+		z := new(big.Int).SetBits(wordsFromLimbs(w[:], a[:]))
+		return z.Neg(z)
+	}
+	var w [128 / bits.UintSize]big.Word
+	return new(big.Int).SetBits(wordsFromLimbs(w[:], a[:]))
+}
+
+// Int128FromBigInt returns x as an Int128.
+// If x is not representable as an Int128, the result is the lower 128 bits of x in two's complement,
+// and ok is false.
+func Int128FromBigInt(x *big.Int) (Int128, bool) {
+	var z Int128
+	ok := intFromBigInt(z[:], 128, x)
+	return z, ok
+}
+
+// Float64 returns the float64 value nearest to a, rounding ties to even, and an indication of any rounding error.
+// If a is too large to be represented by a float64, the result is ±Inf.
+func (a Int128) Float64() (float64, big.Accuracy) {
+	if a.Sign() < 0 {
+		b := Uint128(a).Neg()
+		f, acc := float64FromLimbs(b[:])
+		return -f, -acc
+	}
+	return float64FromLimbs(a[:])
+}
+
+// Int128FromFloat64 returns the integer value of f rounded according to mode,
+// and the accuracy of the result: [big.Below] if the result is less than f, [big.Above] if it is greater than f.
+// Use [big.ToZero] to truncate f like the Go conversion, and [big.ToNearestEven] to round f to the nearest integer.
+//
+// If f is NaN, the result is 0 and the error is [ErrNaN].
+// If the rounded value of f is not representable as an Int128, including ±Inf,
+// the result is clamped to the minimum or maximum value of Int128, and the error is [ErrOverflow].
+func Int128FromFloat64(f float64, mode big.RoundingMode) (Int128, big.Accuracy, error) {
+	var z Int128
+	acc, err := intFromFloat64(z[:], 128, f, mode)
+	return z, acc, err
+}
+
+// BigFloat returns a as a new [*big.Float].
+// The precision of the result is 128 bits, so the conversion is always exact.
+func (a Int128) BigFloat() *big.Float {
+	return new(big.Float).SetPrec(128).SetInt(a.BigInt())
+}
+
+// Int128FromBigFloat returns the integer value of x rounded according to mode,
+// and the accuracy of the result: [big.Below] if the result is less than x, [big.Above] if it is greater than x.
+//
+// If the rounded value of x is not representable as an Int128, including ±Inf,
+// the result is clamped to the minimum or maximum value of Int128, and the error is [ErrOverflow].
+func Int128FromBigFloat(x *big.Float, mode big.RoundingMode) (Int128, big.Accuracy, error) {
+	var z Int128
+	acc, err := intFromBigFloat(z[:], 128, x, mode)
+	return z, acc, err
+}
@@ -1,10 +1,15 @@
 package ints
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
 	"math/big"
 	"runtime"
+	"slices"
+	"strconv"
 	"testing"
 )
 
@@ -586,3 +591,958 @@ func TestInt128_Format(t *testing.T) {
 		}
 	}
 }
+
+func TestParseInt128(t *testing.T) {
+	testCases := []struct {
+		s    string
+		base int
+		want Int128
+		err  error
+	}{
+		{"0", 10, Int128{0, 0}, nil},
+		{"1", 10, Int128{0, 0x1}, nil},
+		{"170141183460469231731687303715884105727", 10, Int128{0x7fffffffffffffff, math.MaxUint64}, nil},
+		{"170141183460469231731687303715884105728", 10, Int128{0x7fffffffffffffff, math.MaxUint64}, strconv.ErrRange},
+		{"0x7fffffffffffffffffffffffffffffff", 0, Int128{0x7fffffffffffffff, math.MaxUint64}, nil},
+		{"0b101", 0, Int128{0, 0x5}, nil},
+		{"0o17", 0, Int128{0, 0xf}, nil},
+		{"017", 0, Int128{0, 0xf}, nil},
+		{"1_000", 0, Int128{0, 0x3e8}, nil},
+		{"1_000", 10, Int128{0, 0}, strconv.ErrSyntax},
+		{"_1", 0, Int128{0, 0}, strconv.ErrSyntax},
+		{"Z", 62, Int128{0, 0x3d}, nil},
+		{"z", 36, Int128{0, 0x23}, nil},
+		{"Z", 36, Int128{0, 0x23}, nil},
+		{"", 10, Int128{0, 0}, strconv.ErrSyntax},
+		{"12a", 10, Int128{0, 0}, strconv.ErrSyntax},
+		{"-1", 10, Int128{math.MaxUint64, math.MaxUint64}, nil},
+		{"+1", 10, Int128{0, 0x1}, nil},
+		{"-170141183460469231731687303715884105728", 10, Int128{0x8000000000000000, 0}, nil},
+		{"-170141183460469231731687303715884105729", 10, Int128{0x8000000000000000, 0}, strconv.ErrRange},
+		{"-0x80000000000000000000000000000000", 0, Int128{0x8000000000000000, 0}, nil},
+		{"-", 10, Int128{0, 0}, strconv.ErrSyntax},
+	}
+
+	for _, tc := range testCases {
+		got, err := ParseInt128(tc.s, tc.base)
+		if got != tc.want {
+			t.Errorf("ParseInt128(%q, %d) = %d, want %d", tc.s, tc.base, got, tc.want)
+		}
+		if !errors.Is(err, tc.err) {
+			t.Errorf("ParseInt128(%q, %d) error = %v, want %v", tc.s, tc.base, err, tc.err)
+		}
+		if err != nil {
+			var numErr *strconv.NumError
+			if !errors.As(err, &numErr) || numErr.Func != "ParseInt128" || numErr.Num != tc.s {
+				t.Errorf("ParseInt128(%q, %d) error = %#v, want *strconv.NumError", tc.s, tc.base, err)
+			}
+		}
+	}
+
+	if _, err := ParseInt128("1", 63); err == nil {
+		t.Errorf("ParseInt128(%q, %d) should fail", "1", 63)
+	}
+}
+
+func FuzzParseInt128(f *testing.F) {
+	f.Add(uint64(0), uint64(0), 10)
+	f.Add(uint64(math.MaxUint64), uint64(math.MaxUint64), 16)
+	f.Add(uint64(math.MaxUint64), uint64(math.MaxUint64), 62)
+
+	f.Fuzz(func(t *testing.T, u0, u1 uint64, base int) {
+		if base < 2 || base > 62 {
+			return
+		}
+		a := Int128{u0, u1}
+		s := int128ToBigInt(a).Text(base)
+		got, err := ParseInt128(s, base)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != a {
+			t.Errorf("ParseInt128(%q, %d) = %d, want %d", s, base, got, a)
+		}
+	})
+}
+
+func TestInt128_MarshalText(t *testing.T) {
+	testCases := []struct {
+		x    Int128
+		want string
+	}{
+		{Int128{0, 0}, "0"},
+		{Int128{0, 0x1}, "1"},
+		{Int128{0x7fffffffffffffff, math.MaxUint64}, "170141183460469231731687303715884105727"},
+		{Int128{math.MaxUint64, math.MaxUint64}, "-1"},
+		{Int128{0x8000000000000000, 0}, "-170141183460469231731687303715884105728"},
+	}
+
+	for _, tc := range testCases {
+		got, err := tc.x.MarshalText()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != tc.want {
+			t.Errorf("Int128(%d).MarshalText() = %q, want %q", tc.x, got, tc.want)
+		}
+	}
+}
+
+func TestInt128_UnmarshalText(t *testing.T) {
+	testCases := []struct {
+		s    string
+		want Int128
+		err  error
+	}{
+		{"0", Int128{0, 0}, nil},
+		{"0x10", Int128{0, 0x10}, nil},
+		{"0b1_0", Int128{0, 0x2}, nil},
+		{"010", Int128{0, 0x8}, nil},
+		{"170141183460469231731687303715884105727", Int128{0x7fffffffffffffff, math.MaxUint64}, nil},
+		{"170141183460469231731687303715884105728", Int128{0, 0}, strconv.ErrRange},
+		{"1.0", Int128{0, 0}, strconv.ErrSyntax},
+		{"-0x10", Int128{math.MaxUint64, 0xfffffffffffffff0}, nil},
+		{"-170141183460469231731687303715884105729", Int128{0, 0}, strconv.ErrRange},
+	}
+
+	for _, tc := range testCases {
+		var got Int128
+		err := got.UnmarshalText([]byte(tc.s))
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Int128.UnmarshalText(%q) error = %v, want %v", tc.s, err, tc.err)
+		}
+		if got != tc.want {
+			t.Errorf("Int128.UnmarshalText(%q) = %d, want %d", tc.s, got, tc.want)
+		}
+	}
+}
+
+func TestInt128_MarshalJSON(t *testing.T) {
+	testCases := []struct {
+		x    Int128
+		want string
+	}{
+		{Int128{0, 0}, "0"},
+		{Int128{0, 0x1}, "1"},
+		{Int128{0x7fffffffffffffff, math.MaxUint64}, "170141183460469231731687303715884105727"},
+		{Int128{math.MaxUint64, math.MaxUint64}, "-1"},
+		{Int128{0x8000000000000000, 0}, "-170141183460469231731687303715884105728"},
+	}
+
+	for _, tc := range testCases {
+		got, err := json.Marshal(tc.x)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != tc.want {
+			t.Errorf("json.Marshal(Int128(%d)) = %s, want %s", tc.x, got, tc.want)
+		}
+	}
+}
+
+func TestInt128_UnmarshalJSON(t *testing.T) {
+	testCases := []struct {
+		s       string
+		want    Int128
+		wantErr bool
+	}{
+		{"0", Int128{0, 0}, false},
+		{"123", Int128{0, 0x7b}, false},
+		{"\"123\"", Int128{0, 0x7b}, false},
+		{"\"0x7f\"", Int128{0, 0x7f}, false},
+		{"170141183460469231731687303715884105727", Int128{0x7fffffffffffffff, math.MaxUint64}, false},
+		{"\"170141183460469231731687303715884105727\"", Int128{0x7fffffffffffffff, math.MaxUint64}, false},
+		{"170141183460469231731687303715884105728", Int128{0, 0}, true},
+		{"0x7f", Int128{0, 0}, true},
+		{"\"abc\"", Int128{0, 0}, true},
+		{"1.5", Int128{0, 0}, true},
+		{"null", Int128{0, 0}, false},
+		{"-1", Int128{math.MaxUint64, math.MaxUint64}, false},
+		{"\"-1\"", Int128{math.MaxUint64, math.MaxUint64}, false},
+		{"-170141183460469231731687303715884105728", Int128{0x8000000000000000, 0}, false},
+	}
+
+	for _, tc := range testCases {
+		var got Int128
+		err := json.Unmarshal([]byte(tc.s), &got)
+		if (err != nil) != tc.wantErr {
+			t.Errorf("json.Unmarshal(%s) error = %v, wantErr %t", tc.s, err, tc.wantErr)
+		}
+		if got != tc.want {
+			t.Errorf("json.Unmarshal(%s) = %d, want %d", tc.s, got, tc.want)
+		}
+	}
+}
+
+func TestInt128_Bytes(t *testing.T) {
+	testCases := []struct {
+		x  Int128
+		be [16]byte
+	}{
+		{
+			Int128{0x102030405060708, 0x90a0b0c0d0e0f10},
+			[16]byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10},
+		},
+		{
+			Int128{math.MaxUint64, 0xfffffffffffffffe},
+			[16]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xfe},
+		},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.x.BytesBE(); got != tc.be {
+			t.Errorf("Int128(%d).BytesBE() = %x, want %x", tc.x, got, tc.be)
+		}
+		if got := Int128FromBytesBE(tc.be[:]); got != tc.x {
+			t.Errorf("Int128FromBytesBE(%x) = %d, want %d", tc.be, got, tc.x)
+		}
+
+		le := tc.be
+		slices.Reverse(le[:])
+		if got := tc.x.BytesLE(); got != le {
+			t.Errorf("Int128(%d).BytesLE() = %x, want %x", tc.x, got, le)
+		}
+		if got := Int128FromBytesLE(le[:]); got != tc.x {
+			t.Errorf("Int128FromBytesLE(%x) = %d, want %d", le, got, tc.x)
+		}
+
+		data, err := tc.x.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(data, tc.be[:]) {
+			t.Errorf("Int128(%d).MarshalBinary() = %x, want %x", tc.x, data, tc.be)
+		}
+		var got Int128
+		if err := got.UnmarshalBinary(data); err != nil {
+			t.Fatal(err)
+		}
+		if got != tc.x {
+			t.Errorf("Int128.UnmarshalBinary(%x) = %d, want %d", data, got, tc.x)
+		}
+	}
+}
+
+func TestInt128_UnmarshalBinary_InvalidLength(t *testing.T) {
+	var a Int128
+	if err := a.UnmarshalBinary(make([]byte, 15)); err == nil {
+		t.Error("want error, got nil")
+	}
+	if err := a.UnmarshalBinary(make([]byte, 17)); err == nil {
+		t.Error("want error, got nil")
+	}
+}
+
+func TestInt128FromBigInt(t *testing.T) {
+	testCases := []struct {
+		x    *big.Int
+		want Int128
+		ok   bool
+	}{
+		{big.NewInt(0), Int128{0, 0}, true},
+		{big.NewInt(1), Int128{0, 0x1}, true},
+		{bigIntFromString("170141183460469231731687303715884105727"), Int128{0x7fffffffffffffff, math.MaxUint64}, true},
+		{bigIntFromString("170141183460469231731687303715884105728"), Int128{0x8000000000000000, 0}, false},
+		{bigIntFromString("340282366920938463463374607431768211456"), Int128{0, 0}, false},
+		{big.NewInt(-1), Int128{math.MaxUint64, math.MaxUint64}, true},
+		{bigIntFromString("-170141183460469231731687303715884105728"), Int128{0x8000000000000000, 0}, true},
+		{bigIntFromString("-170141183460469231731687303715884105729"), Int128{0x7fffffffffffffff, math.MaxUint64}, false},
+	}
+
+	for _, tc := range testCases {
+		got, ok := Int128FromBigInt(tc.x)
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int128FromBigInt(%s) = %d, %t, want %d, %t", tc.x, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func FuzzInt128_BigInt(f *testing.F) {
+	f.Add(uint64(0), uint64(0))
+	f.Add(uint64(math.MaxUint64), uint64(math.MaxUint64))
+	f.Add(uint64(1), uint64(0))
+
+	f.Fuzz(func(t *testing.T, u0, u1 uint64) {
+		a := Int128{u0, u1}
+		got := a.BigInt()
+		want := int128ToBigInt(a)
+		if got.Cmp(want) != 0 {
+			t.Errorf("Int128(%d).BigInt() = %s, want %s", a, got, want)
+		}
+		if b, ok := Int128FromBigInt(got); b != a || !ok {
+			t.Errorf("Int128FromBigInt(%s) = %d, %t, want %d, true", got, b, ok, a)
+		}
+	})
+}
+
+func TestInt128FromFloat64(t *testing.T) {
+	testCases := []struct {
+		f    float64
+		mode big.RoundingMode
+		want Int128
+		acc  big.Accuracy
+		err  error
+	}{
+		{0, big.ToNearestEven, Int128{0, 0}, big.Exact, nil},
+		{2.5, big.ToNearestEven, Int128{0, 0x2}, big.Below, nil},
+		{3.5, big.ToNearestEven, Int128{0, 0x4}, big.Above, nil},
+		{2.5, big.ToNearestAway, Int128{0, 0x3}, big.Above, nil},
+		{2.5, big.ToZero, Int128{0, 0x2}, big.Below, nil},
+		{2.5, big.AwayFromZero, Int128{0, 0x3}, big.Above, nil},
+		{2.5, big.ToNegativeInf, Int128{0, 0x2}, big.Below, nil},
+		{2.5, big.ToPositiveInf, Int128{0, 0x3}, big.Above, nil},
+		{math.NaN(), big.ToNearestEven, Int128{0, 0}, big.Exact, ErrNaN},
+		{math.Inf(1), big.ToNearestEven, Int128{0x7fffffffffffffff, math.MaxUint64}, big.Below, ErrOverflow},
+		{math.Inf(-1), big.ToNearestEven, Int128{0x8000000000000000, 0}, big.Above, ErrOverflow},
+		{0x1p128, big.ToNearestEven, Int128{0x7fffffffffffffff, math.MaxUint64}, big.Below, ErrOverflow},
+		{0x1p127, big.ToNearestEven, Int128{0x7fffffffffffffff, math.MaxUint64}, big.Below, ErrOverflow},
+		{-2.5, big.ToNearestEven, Int128{math.MaxUint64, 0xfffffffffffffffe}, big.Above, nil},
+		{-2.5, big.ToZero, Int128{math.MaxUint64, 0xfffffffffffffffe}, big.Above, nil},
+		{-2.5, big.AwayFromZero, Int128{math.MaxUint64, 0xfffffffffffffffd}, big.Below, nil},
+		{-2.5, big.ToNegativeInf, Int128{math.MaxUint64, 0xfffffffffffffffd}, big.Below, nil},
+		{-0x1p127, big.ToNearestEven, Int128{0x8000000000000000, 0}, big.Exact, nil},
+	}
+
+	for _, tc := range testCases {
+		got, acc, err := Int128FromFloat64(tc.f, tc.mode)
+		if got != tc.want || acc != tc.acc || err != tc.err {
+			t.Errorf("Int128FromFloat64(%v, %s) = %d, %s, %v, want %d, %s, %v", tc.f, tc.mode, got, acc, err, tc.want, tc.acc, tc.err)
+		}
+
+		if math.IsNaN(tc.f) {
+			continue
+		}
+		got, acc, err = Int128FromBigFloat(big.NewFloat(tc.f), tc.mode)
+		if got != tc.want || acc != tc.acc || err != tc.err {
+			t.Errorf("Int128FromBigFloat(%v, %s) = %d, %s, %v, want %d, %s, %v", tc.f, tc.mode, got, acc, err, tc.want, tc.acc, tc.err)
+		}
+	}
+}
+
+func FuzzInt128_Float64(f *testing.F) {
+	f.Add(uint64(0), uint64(0))
+	f.Add(uint64(math.MaxUint64), uint64(math.MaxUint64))
+	f.Add(uint64(0), uint64(1<<53+1))
+	f.Add(uint64(1), uint64(1<<11|1<<10))
+
+	f.Fuzz(func(t *testing.T, u0, u1 uint64) {
+		a := Int128{u0, u1}
+		b := int128ToBigInt(a)
+		got, acc := a.Float64()
+		want, wantAcc := new(big.Float).SetInt(b).Float64()
+		if got != want || acc != wantAcc {
+			t.Errorf("Int128(%d).Float64() = %v, %s, want %v, %s", a, got, acc, want, wantAcc)
+		}
+
+		bf := a.BigFloat()
+		if c, _ := bf.Int(nil); c.Cmp(b) != 0 {
+			t.Errorf("Int128(%d).BigFloat() = %v, want %v", a, bf, b)
+		}
+		if c, acc, err := Int128FromBigFloat(bf, big.ToNearestEven); c != a || acc != big.Exact || err != nil {
+			t.Errorf("Int128FromBigFloat(%v) = %d, %s, %v, want %d, %s, nil", bf, c, acc, err, a, big.Exact)
+		}
+	})
+}
+
+func FuzzInt128FromFloat64(f *testing.F) {
+	f.Add(0.0, uint8(big.ToNearestEven))
+	f.Add(-2.5, uint8(big.ToNearestEven))
+	f.Add(0x1p127, uint8(big.ToZero))
+	f.Add(0x1.fffffffffffffp127, uint8(big.ToPositiveInf))
+
+	f.Fuzz(func(t *testing.T, x float64, mode uint8) {
+		if math.IsNaN(x) || mode > uint8(big.ToPositiveInf) {
+			return
+		}
+		m := big.RoundingMode(mode)
+		got, acc, err := Int128FromFloat64(x, m)
+		want, wantAcc, wantErr := Int128FromBigFloat(big.NewFloat(x), m)
+		if got != want || acc != wantAcc || err != wantErr {
+			t.Errorf("Int128FromFloat64(%v, %s) = %d, %s, %v, want %d, %s, %v", x, m, got, acc, err, want, wantAcc, wantErr)
+		}
+	})
+}
+
+func FuzzInt128_AddOverflow(f *testing.F) {
+	f.Add(
+		uint64(0), uint64(0),
+		uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(0), uint64(1),
+	)
+	f.Add(
+		uint64(0), uint64(1),
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(1), uint64(0),
+		uint64(1), uint64(0),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0),
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, v0, v1 uint64) {
+		a := Int128{u0, u1}
+		b := Int128{v0, v1}
+		got, overflow := a.AddOverflow(b)
+
+		want, ok := Int128FromBigInt(new(big.Int).Add(int128ToBigInt(a), int128ToBigInt(b)))
+		if got != want || overflow == ok {
+			t.Errorf("Int128(%d).AddOverflow(%d) = %d, %t, want %d, %t", a, b, got, overflow, want, !ok)
+		}
+	})
+}
+
+func FuzzInt128_SubOverflow(f *testing.F) {
+	f.Add(
+		uint64(0), uint64(0),
+		uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(0), uint64(1),
+	)
+	f.Add(
+		uint64(0), uint64(1),
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(1), uint64(0),
+		uint64(1), uint64(0),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0),
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, v0, v1 uint64) {
+		a := Int128{u0, u1}
+		b := Int128{v0, v1}
+		got, overflow := a.SubOverflow(b)
+
+		want, ok := Int128FromBigInt(new(big.Int).Sub(int128ToBigInt(a), int128ToBigInt(b)))
+		if got != want || overflow == ok {
+			t.Errorf("Int128(%d).SubOverflow(%d) = %d, %t, want %d, %t", a, b, got, overflow, want, !ok)
+		}
+	})
+}
+
+func FuzzInt128_MulOverflow(f *testing.F) {
+	f.Add(
+		uint64(0), uint64(0),
+		uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(0), uint64(1),
+	)
+	f.Add(
+		uint64(0), uint64(1),
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(1), uint64(0),
+		uint64(1), uint64(0),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0),
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, v0, v1 uint64) {
+		a := Int128{u0, u1}
+		b := Int128{v0, v1}
+		got, overflow := a.MulOverflow(b)
+
+		want, ok := Int128FromBigInt(new(big.Int).Mul(int128ToBigInt(a), int128ToBigInt(b)))
+		if got != want || overflow == ok {
+			t.Errorf("Int128(%d).MulOverflow(%d) = %d, %t, want %d, %t", a, b, got, overflow, want, !ok)
+		}
+	})
+}
+
+func TestInt128_NegOverflow(t *testing.T) {
+	testCases := []struct {
+		x        Int128
+		want     Int128
+		overflow bool
+	}{
+		{Int128{0, 0}, Int128{0, 0}, false},
+		{Int128{0, 0x1}, Int128{math.MaxUint64, math.MaxUint64}, false},
+		{Int128{0x7fffffffffffffff, math.MaxUint64}, Int128{0x8000000000000000, 0x1}, false},
+		{Int128{math.MaxUint64, math.MaxUint64}, Int128{0, 0x1}, false},
+		{Int128{0x8000000000000000, 0}, Int128{0x8000000000000000, 0}, true},
+	}
+
+	for _, tc := range testCases {
+		got, overflow := tc.x.NegOverflow()
+		if got != tc.want || overflow != tc.overflow {
+			t.Errorf("Int128(%d).NegOverflow() = %d, %t, want %d, %t", tc.x, got, overflow, tc.want, tc.overflow)
+		}
+	}
+}
+
+func TestInt128_LshOverflow(t *testing.T) {
+	testCases := []struct {
+		x        Int128
+		i        uint
+		want     Int128
+		overflow bool
+	}{
+		{Int128{0, 0x1}, 0, Int128{0, 0x1}, false},
+		{Int128{0, 0x1}, 126, Int128{0x4000000000000000, 0}, false},
+		{Int128{0, 0x1}, 127, Int128{0x8000000000000000, 0}, true},
+		{Int128{0, 0x1}, 128, Int128{0, 0}, true},
+		{Int128{0, 0x3}, 126, Int128{0xc000000000000000, 0}, true},
+		{Int128{0x7fffffffffffffff, math.MaxUint64}, 1, Int128{math.MaxUint64, 0xfffffffffffffffe}, true},
+		{Int128{0, 0}, 138, Int128{0, 0}, false},
+		{Int128{math.MaxUint64, math.MaxUint64}, 127, Int128{0x8000000000000000, 0}, false},
+		{Int128{math.MaxUint64, math.MaxUint64}, 128, Int128{0, 0}, true},
+		{Int128{math.MaxUint64, 0xfffffffffffffffe}, 126, Int128{0x8000000000000000, 0}, false},
+		{Int128{math.MaxUint64, 0xfffffffffffffffd}, 126, Int128{0x4000000000000000, 0}, true},
+	}
+
+	for _, tc := range testCases {
+		got, overflow := tc.x.LshOverflow(tc.i)
+		if got != tc.want || overflow != tc.overflow {
+			t.Errorf("Int128(%d).LshOverflow(%d) = %d, %t, want %d, %t", tc.x, tc.i, got, overflow, tc.want, tc.overflow)
+		}
+	}
+}
+
+func FuzzInt128_SaturatingAdd(f *testing.F) {
+	f.Add(
+		uint64(0), uint64(0),
+		uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(0), uint64(1),
+	)
+	f.Add(
+		uint64(0), uint64(1),
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(1), uint64(0),
+		uint64(1), uint64(0),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0),
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0),
+		uint64(1<<63), uint64(0),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, v0, v1 uint64) {
+		a := Int128{u0, u1}
+		b := Int128{v0, v1}
+		got := a.SaturatingAdd(b)
+
+		want := saturateBigInt(new(big.Int).Add(int128ToBigInt(a), int128ToBigInt(b)), 128, true)
+		if int128ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("Int128(%d).SaturatingAdd(%d) = %d, want %d", a, b, got, want)
+		}
+	})
+}
+
+func FuzzInt128_SaturatingSub(f *testing.F) {
+	f.Add(
+		uint64(0), uint64(0),
+		uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(0), uint64(1),
+	)
+	f.Add(
+		uint64(0), uint64(1),
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(1), uint64(0),
+		uint64(1), uint64(0),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0),
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0),
+		uint64(1<<63), uint64(0),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, v0, v1 uint64) {
+		a := Int128{u0, u1}
+		b := Int128{v0, v1}
+		got := a.SaturatingSub(b)
+
+		want := saturateBigInt(new(big.Int).Sub(int128ToBigInt(a), int128ToBigInt(b)), 128, true)
+		if int128ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("Int128(%d).SaturatingSub(%d) = %d, want %d", a, b, got, want)
+		}
+	})
+}
+
+func FuzzInt128_SaturatingMul(f *testing.F) {
+	f.Add(
+		uint64(0), uint64(0),
+		uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(0), uint64(1),
+	)
+	f.Add(
+		uint64(0), uint64(1),
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(1), uint64(0),
+		uint64(1), uint64(0),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0),
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0),
+		uint64(1<<63), uint64(0),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, v0, v1 uint64) {
+		a := Int128{u0, u1}
+		b := Int128{v0, v1}
+		got := a.SaturatingMul(b)
+
+		want := saturateBigInt(new(big.Int).Mul(int128ToBigInt(a), int128ToBigInt(b)), 128, true)
+		if int128ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("Int128(%d).SaturatingMul(%d) = %d, want %d", a, b, got, want)
+		}
+	})
+}
+
+func FuzzInt128_Abs(f *testing.F) {
+	f.Add(uint64(0), uint64(0))
+	f.Add(uint64(0), uint64(1))
+	f.Add(uint64(math.MaxUint64), uint64(math.MaxUint64))
+	f.Add(uint64(1<<63), uint64(0))
+
+	f.Fuzz(func(t *testing.T, u0, u1 uint64) {
+		a := Int128{u0, u1}
+
+		abs := new(big.Int).Abs(int128ToBigInt(a))
+		// Abs wraps around if a is the minimum value.
+		if got, want := a.Abs(), wrapInt128(abs); got != want {
+			t.Errorf("Int128(%d).Abs() = %d, want %d", a, got, want)
+		}
+		if got, want := a.SaturatingAbs(), saturateBigInt(abs, 128, true); int128ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("Int128(%d).SaturatingAbs() = %d, want %d", a, got, want)
+		}
+
+		neg := new(big.Int).Neg(int128ToBigInt(a))
+		if got, want := a.SaturatingNeg(), saturateBigInt(neg, 128, true); int128ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("Int128(%d).SaturatingNeg() = %d, want %d", a, got, want)
+		}
+	})
+}
+
+func wrapInt128(x *big.Int) Int128 {
+	v, _ := Int128FromBigInt(x)
+	return v
+}
+
+func TestMinMaxInt128(t *testing.T) {
+	max := new(big.Int).Lsh(big.NewInt(1), 127)
+	min := new(big.Int).Neg(max)
+	max.Sub(max, big.NewInt(1))
+	if got := int128ToBigInt(MaxInt128); got.Cmp(max) != 0 {
+		t.Errorf("MaxInt128 = %d, want %d", got, max)
+	}
+	if got := int128ToBigInt(MinInt128); got.Cmp(min) != 0 {
+		t.Errorf("MinInt128 = %d, want %d", got, min)
+	}
+}
+
+func TestInt128FromInt64(t *testing.T) {
+	testCases := []struct {
+		v    int64
+		want Int128
+	}{
+		{0, Int128{0, 0}},
+		{1, Int128{0, 0x1}},
+		{-1, Int128{math.MaxUint64, math.MaxUint64}},
+		{math.MaxInt64, Int128{0, 0x7fffffffffffffff}},
+		{math.MinInt64, Int128{math.MaxUint64, 0x8000000000000000}},
+	}
+
+	for _, tc := range testCases {
+		got := Int128FromInt64(tc.v)
+		if got != tc.want {
+			t.Errorf("Int128FromInt64(%d) = %d, want %d", tc.v, got, tc.want)
+		}
+	}
+}
+
+func TestInt128Of(t *testing.T) {
+	testCases := []struct {
+		v    string
+		got  Int128
+		want Int128
+	}{
+		{"int8(-1)", Int128Of(int8(-1)), Int128{math.MaxUint64, math.MaxUint64}},
+		{"int(42)", Int128Of(int(42)), Int128{0, 0x2a}},
+		{"uint64(math.MaxUint64)", Int128Of(uint64(math.MaxUint64)), Int128{0, math.MaxUint64}},
+		{"int64(math.MinInt64)", Int128Of(int64(math.MinInt64)), Int128{math.MaxUint64, 0x8000000000000000}},
+		{"uintptr(7)", Int128Of(uintptr(7)), Int128{0, 0x7}},
+	}
+
+	for _, tc := range testCases {
+		if tc.got != tc.want {
+			t.Errorf("Int128Of(%s) = %d, want %d", tc.v, tc.got, tc.want)
+		}
+	}
+}
+
+func TestInt128_IsInt64(t *testing.T) {
+	testCases := []struct {
+		a        Int128
+		isInt64  bool
+		isUint64 bool
+		i64      int64
+		u64      uint64
+	}{
+		{Int128{0x8000000000000000, 0}, false, false, 0, 0},
+		{Int128{math.MaxUint64, 0x7fffffffffffffff}, false, false, 9223372036854775807, 9223372036854775807},
+		{Int128{math.MaxUint64, 0x8000000000000000}, true, false, math.MinInt64, 9223372036854775808},
+		{Int128{math.MaxUint64, math.MaxUint64}, true, false, -1, math.MaxUint64},
+		{Int128{0, 0}, true, true, 0, 0},
+		{Int128{0, 0x1}, true, true, 1, 1},
+		{Int128{0, 0x7fffffffffffffff}, true, true, 9223372036854775807, 9223372036854775807},
+		{Int128{0, 0x8000000000000000}, false, true, math.MinInt64, 9223372036854775808},
+		{Int128{0, math.MaxUint64}, false, true, -1, math.MaxUint64},
+		{Int128{0x1, 0}, false, false, 0, 0},
+		{Int128{0x7fffffffffffffff, math.MaxUint64}, false, false, -1, math.MaxUint64},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.a.IsInt64(); got != tc.isInt64 {
+			t.Errorf("Int128(%d).IsInt64() = %t, want %t", tc.a, got, tc.isInt64)
+		}
+		if got := tc.a.IsUint64(); got != tc.isUint64 {
+			t.Errorf("Int128(%d).IsUint64() = %t, want %t", tc.a, got, tc.isUint64)
+		}
+
+		got, ok := tc.a.Int()
+		want, wantOK := int(tc.i64), tc.isInt64 && int64(int(tc.i64)) == tc.i64
+		if got != want || ok != wantOK {
+			t.Errorf("Int128(%d).Int() = %d, %t, want %d, %t", tc.a, got, ok, want, wantOK)
+		}
+
+		gotU, ok := tc.a.Uint()
+		wantU, wantOK := uint(tc.u64), tc.isUint64 && uint64(uint(tc.u64)) == tc.u64
+		if gotU != wantU || ok != wantOK {
+			t.Errorf("Int128(%d).Uint() = %d, %t, want %d, %t", tc.a, gotU, ok, wantU, wantOK)
+		}
+	}
+}
+
+func TestInt128_Bits(t *testing.T) {
+	testCases := []struct {
+		x             Int128
+		leadingZeros  int
+		trailingZeros int
+		bitLen        int
+		onesCount     int
+	}{
+		{Int128{0, 0}, 128, 128, 0, 0},
+		{Int128{0, 0x1}, 127, 0, 1, 1},
+		{Int128{math.MaxUint64, math.MaxUint64}, 0, 0, 1, 128},
+		{Int128{0, 0x2}, 126, 1, 2, 1},
+		{Int128{math.MaxUint64, 0xfffffffffffffffe}, 0, 1, 2, 127},
+		{Int128{0x7fffffffffffffff, math.MaxUint64}, 1, 0, 127, 127},
+		{Int128{0x8000000000000000, 0}, 0, 127, 128, 1},
+		{Int128{0x8000000000000000, 0x1}, 0, 0, 127, 2},
+		{Int128{0x1, 0}, 63, 64, 65, 1},
+		{Int128{math.MaxUint64, 0}, 0, 64, 65, 64},
+		{Int128{0, 0x6}, 125, 1, 3, 2},
+		{Int128{math.MaxUint64, 0xfffffffffffffffa}, 0, 1, 3, 126},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.x.LeadingZeros(); got != tc.leadingZeros {
+			t.Errorf("Int128(%d).LeadingZeros() = %d, want %d", tc.x, got, tc.leadingZeros)
+		}
+		if got := tc.x.TrailingZeros(); got != tc.trailingZeros {
+			t.Errorf("Int128(%d).TrailingZeros() = %d, want %d", tc.x, got, tc.trailingZeros)
+		}
+		if got := tc.x.BitLen(); got != tc.bitLen {
+			t.Errorf("Int128(%d).BitLen() = %d, want %d", tc.x, got, tc.bitLen)
+		}
+		if got := tc.x.OnesCount(); got != tc.onesCount {
+			t.Errorf("Int128(%d).OnesCount() = %d, want %d", tc.x, got, tc.onesCount)
+		}
+	}
+}
+
+func FuzzInt128_Bit(f *testing.F) {
+	f.Add(uint64(0), uint64(0))
+	f.Add(uint64(0), uint64(1))
+	f.Add(uint64(math.MaxUint64), uint64(math.MaxUint64))
+	f.Add(uint64(1<<63), uint64(0))
+
+	f.Fuzz(func(t *testing.T, u0, u1 uint64) {
+		a := Int128{u0, u1}
+		x := int128ToBigInt(a)
+		for i := range 138 {
+			if got, want := a.Bit(i), x.Bit(i); got != want {
+				t.Errorf("Int128(%d).Bit(%d) = %d, want %d", a, i, got, want)
+			}
+		}
+	})
+}
+
+func TestInt128_RshRound(t *testing.T) {
+	values := []Int128{
+		Int128{0, 0},
+		Int128{0, 0x1},
+		Int128{0, 0x2},
+		Int128{0, 0x3},
+		Int128{0, 0x5},
+		Int128{0, 0x6},
+		Int128{0, math.MaxUint64},
+		Int128{0x1, 0x8000000000000000},
+		Int128{0x1, 0x8000000000000001},
+		Int128{0x7fffffffffffffff, math.MaxUint64},
+		Int128{0x8000000000000000, 0},
+		Int128{0x8000000000000000, 0x1},
+		Int128{0xb000000000000000, 0},
+		Int128{0xc000000000000001, 0},
+		Int128{math.MaxUint64, math.MaxUint64},
+		Int128{0x3fffffffffffffff, 0},
+		Int128{0x5000000000000000, 0},
+		Int128{0xfffffffffffffffe, 0x7fffffffffffffff},
+		Int128{0xfffffffffffffffe, 0x8000000000000000},
+		Int128{math.MaxUint64, 0x1},
+		Int128{math.MaxUint64, 0xfffffffffffffffa},
+		Int128{math.MaxUint64, 0xfffffffffffffffb},
+		Int128{math.MaxUint64, 0xfffffffffffffffd},
+		Int128{math.MaxUint64, 0xfffffffffffffffe},
+	}
+	shifts := []uint{0, 1, 2, 3, 63, 64, 65, 127, 128, 129, 192}
+
+	for _, a := range values {
+		x := int128ToBigInt(a)
+		for _, i := range shifts {
+			if got, want := a.RshRound(i), rshRoundBigInt(x, i); int128ToBigInt(got).Cmp(want) != 0 {
+				t.Errorf("Int128(%d).RshRound(%d) = %d, want %d", a, i, got, want)
+			}
+			if got, want := a.RshCeil(i), rshCeilBigInt(x, i); int128ToBigInt(got).Cmp(want) != 0 {
+				t.Errorf("Int128(%d).RshCeil(%d) = %d, want %d", a, i, got, want)
+			}
+			if got, want := a.URsh(i), new(big.Int).Rsh(uint128ToBigInt(Uint128(a)), i); uint128ToBigInt(Uint128(got)).Cmp(want) != 0 {
+				t.Errorf("Int128(%d).URsh(%d) = %d, want %d", a, i, got, want)
+			}
+		}
+	}
+}
+
+func FuzzInt128_Mul256(f *testing.F) {
+	f.Add(
+		uint64(0), uint64(0),
+		uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(0), uint64(1),
+	)
+	f.Add(
+		uint64(0), uint64(1),
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0),
+		uint64(1<<63), uint64(0),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0),
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, v0, v1 uint64) {
+		a := Int128{u0, u1}
+		b := Int128{v0, v1}
+		got := int256ToBigInt(a.Mul256(b))
+		want := new(big.Int).Mul(int128ToBigInt(a), int128ToBigInt(b))
+		if got.Cmp(want) != 0 {
+			t.Errorf("Int128(%d).Mul256(%d) = %d, want %d", a, b, got, want)
+		}
+	})
+}
+
+func FuzzInt128_Sqrt(f *testing.F) {
+	f.Add(uint64(0x0), uint64(0x0), uint8(2))
+	f.Add(uint64(0x0), uint64(0x1), uint8(3))
+	f.Add(uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint8(2))
+	f.Add(uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint8(3))
+	f.Add(uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint8(17))
+	f.Add(uint64(0x7fffffffffffffff), uint64(0xffffffffffffffff), uint8(7))
+	f.Add(uint64(0x0), uint64(0xffffffffffffffff), uint8(4))
+	f.Add(uint64(0x1), uint64(0x0), uint8(63))
+
+	f.Fuzz(func(t *testing.T, u0, u1 uint64, k uint8) {
+		a := Int128{u0, u1}
+		if a.Sign() < 0 {
+			if a.IsPerfectSquare() {
+				t.Errorf("Int128(%d).IsPerfectSquare() = true, want false", a)
+			}
+			return
+		}
+		x := int128ToBigInt(a)
+
+		want := new(big.Int).Sqrt(x)
+		if got := a.Sqrt(); int128ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("Int128(%d).Sqrt() = %d, want %d", a, got, want)
+		}
+		isSquare := new(big.Int).Mul(want, want).Cmp(x) == 0
+		if got := a.IsPerfectSquare(); got != isSquare {
+			t.Errorf("Int128(%d).IsPerfectSquare() = %t, want %t", a, got, isSquare)
+		}
+
+		// r is the floor n-th root of a if r**n <= a < (r+1)**n.
+		for _, n := range []uint{3, uint(k)%128 + 1} {
+			got := a.Root(n)
+			r := int128ToBigInt(got)
+			lo := new(big.Int).Exp(r, big.NewInt(int64(n)), nil)
+			hi := new(big.Int).Exp(r.Add(r, big.NewInt(1)), big.NewInt(int64(n)), nil)
+			if lo.Cmp(x) > 0 || hi.Cmp(x) <= 0 {
+				t.Errorf("Int128(%d).Root(%d) = %d", a, n, got)
+			}
+		}
+		if got, want := a.Cbrt(), a.Root(3); got != want {
+			t.Errorf("Int128(%d).Cbrt() = %d, want %d", a, got, want)
+		}
+	})
+}
@@ -1,9 +1,13 @@
 package ints
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
 	"math/big"
+	"slices"
 	"strconv"
 	"testing"
 )
@@ -422,3 +426,792 @@ func TestInt16_Format(t *testing.T) {
 		}
 	}
 }
+
+func TestParseInt16(t *testing.T) {
+	testCases := []struct {
+		s    string
+		base int
+		want Int16
+		err  error
+	}{
+		{"0", 10, 0, nil},
+		{"1", 10, 1, nil},
+		{"32767", 10, 32767, nil},
+		{"32768", 10, 32767, strconv.ErrRange},
+		{"0x7fff", 0, 32767, nil},
+		{"0b101", 0, 5, nil},
+		{"0o17", 0, 15, nil},
+		{"017", 0, 15, nil},
+		{"1_000", 0, 1000, nil},
+		{"1_000", 10, 0, strconv.ErrSyntax},
+		{"_1", 0, 0, strconv.ErrSyntax},
+		{"Z", 62, 61, nil},
+		{"z", 36, 35, nil},
+		{"Z", 36, 35, nil},
+		{"", 10, 0, strconv.ErrSyntax},
+		{"12a", 10, 0, strconv.ErrSyntax},
+		{"-1", 10, -1, nil},
+		{"+1", 10, 1, nil},
+		{"-32768", 10, -32768, nil},
+		{"-32769", 10, -32768, strconv.ErrRange},
+		{"-0x8000", 0, -32768, nil},
+		{"-", 10, 0, strconv.ErrSyntax},
+	}
+
+	for _, tc := range testCases {
+		got, err := ParseInt16(tc.s, tc.base)
+		if got != tc.want {
+			t.Errorf("ParseInt16(%q, %d) = %d, want %d", tc.s, tc.base, got, tc.want)
+		}
+		if !errors.Is(err, tc.err) {
+			t.Errorf("ParseInt16(%q, %d) error = %v, want %v", tc.s, tc.base, err, tc.err)
+		}
+		if err != nil {
+			var numErr *strconv.NumError
+			if !errors.As(err, &numErr) || numErr.Func != "ParseInt16" || numErr.Num != tc.s {
+				t.Errorf("ParseInt16(%q, %d) error = %#v, want *strconv.NumError", tc.s, tc.base, err)
+			}
+		}
+	}
+
+	if _, err := ParseInt16("1", 63); err == nil {
+		t.Errorf("ParseInt16(%q, %d) should fail", "1", 63)
+	}
+}
+
+func TestInt16_MarshalText(t *testing.T) {
+	testCases := []struct {
+		x    Int16
+		want string
+	}{
+		{0, "0"},
+		{1, "1"},
+		{32767, "32767"},
+		{-1, "-1"},
+		{-32768, "-32768"},
+	}
+
+	for _, tc := range testCases {
+		got, err := tc.x.MarshalText()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != tc.want {
+			t.Errorf("Int16(%d).MarshalText() = %q, want %q", tc.x, got, tc.want)
+		}
+	}
+}
+
+func TestInt16_UnmarshalText(t *testing.T) {
+	testCases := []struct {
+		s    string
+		want Int16
+		err  error
+	}{
+		{"0", 0, nil},
+		{"0x10", 16, nil},
+		{"0b1_0", 2, nil},
+		{"010", 8, nil},
+		{"32767", 32767, nil},
+		{"32768", 0, strconv.ErrRange},
+		{"1.0", 0, strconv.ErrSyntax},
+		{"-0x10", -16, nil},
+		{"-32769", 0, strconv.ErrRange},
+	}
+
+	for _, tc := range testCases {
+		var got Int16
+		err := got.UnmarshalText([]byte(tc.s))
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Int16.UnmarshalText(%q) error = %v, want %v", tc.s, err, tc.err)
+		}
+		if got != tc.want {
+			t.Errorf("Int16.UnmarshalText(%q) = %d, want %d", tc.s, got, tc.want)
+		}
+	}
+}
+
+func TestInt16_MarshalJSON(t *testing.T) {
+	testCases := []struct {
+		x    Int16
+		want string
+	}{
+		{0, "0"},
+		{1, "1"},
+		{32767, "32767"},
+		{-1, "-1"},
+		{-32768, "-32768"},
+	}
+
+	for _, tc := range testCases {
+		got, err := json.Marshal(tc.x)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != tc.want {
+			t.Errorf("json.Marshal(Int16(%d)) = %s, want %s", tc.x, got, tc.want)
+		}
+	}
+}
+
+func TestInt16_UnmarshalJSON(t *testing.T) {
+	testCases := []struct {
+		s       string
+		want    Int16
+		wantErr bool
+	}{
+		{"0", 0, false},
+		{"123", 123, false},
+		{"\"123\"", 123, false},
+		{"\"0x7f\"", 127, false},
+		{"32767", 32767, false},
+		{"\"32767\"", 32767, false},
+		{"32768", 0, true},
+		{"0x7f", 0, true},
+		{"\"abc\"", 0, true},
+		{"1.5", 0, true},
+		{"null", 0, false},
+		{"-1", -1, false},
+		{"\"-1\"", -1, false},
+		{"-32768", -32768, false},
+	}
+
+	for _, tc := range testCases {
+		var got Int16
+		err := json.Unmarshal([]byte(tc.s), &got)
+		if (err != nil) != tc.wantErr {
+			t.Errorf("json.Unmarshal(%s) error = %v, wantErr %t", tc.s, err, tc.wantErr)
+		}
+		if got != tc.want {
+			t.Errorf("json.Unmarshal(%s) = %d, want %d", tc.s, got, tc.want)
+		}
+	}
+}
+
+func TestInt16_Bytes(t *testing.T) {
+	testCases := []struct {
+		x  Int16
+		be [2]byte
+	}{
+		{
+			258,
+			[2]byte{0x01, 0x02},
+		},
+		{
+			-2,
+			[2]byte{0xff, 0xfe},
+		},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.x.BytesBE(); got != tc.be {
+			t.Errorf("Int16(%d).BytesBE() = %x, want %x", tc.x, got, tc.be)
+		}
+		if got := Int16FromBytesBE(tc.be[:]); got != tc.x {
+			t.Errorf("Int16FromBytesBE(%x) = %d, want %d", tc.be, got, tc.x)
+		}
+
+		le := tc.be
+		slices.Reverse(le[:])
+		if got := tc.x.BytesLE(); got != le {
+			t.Errorf("Int16(%d).BytesLE() = %x, want %x", tc.x, got, le)
+		}
+		if got := Int16FromBytesLE(le[:]); got != tc.x {
+			t.Errorf("Int16FromBytesLE(%x) = %d, want %d", le, got, tc.x)
+		}
+
+		data, err := tc.x.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(data, tc.be[:]) {
+			t.Errorf("Int16(%d).MarshalBinary() = %x, want %x", tc.x, data, tc.be)
+		}
+		var got Int16
+		if err := got.UnmarshalBinary(data); err != nil {
+			t.Fatal(err)
+		}
+		if got != tc.x {
+			t.Errorf("Int16.UnmarshalBinary(%x) = %d, want %d", data, got, tc.x)
+		}
+	}
+}
+
+func TestInt16_UnmarshalBinary_InvalidLength(t *testing.T) {
+	var a Int16
+	if err := a.UnmarshalBinary(make([]byte, 1)); err == nil {
+		t.Error("want error, got nil")
+	}
+	if err := a.UnmarshalBinary(make([]byte, 3)); err == nil {
+		t.Error("want error, got nil")
+	}
+}
+
+func TestInt16FromBigInt(t *testing.T) {
+	testCases := []struct {
+		x    *big.Int
+		want Int16
+		ok   bool
+	}{
+		{big.NewInt(0), 0, true},
+		{big.NewInt(1), 1, true},
+		{big.NewInt(32767), 32767, true},
+		{big.NewInt(32768), -32768, false},
+		{big.NewInt(65536), 0, false},
+		{big.NewInt(-1), -1, true},
+		{big.NewInt(-32768), -32768, true},
+		{big.NewInt(-32769), 32767, false},
+	}
+
+	for _, tc := range testCases {
+		got, ok := Int16FromBigInt(tc.x)
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int16FromBigInt(%s) = %d, %t, want %d, %t", tc.x, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt16_BigInt(t *testing.T) {
+	testCases := []Int16{0, 1, 32767, -1, -32768}
+
+	for _, a := range testCases {
+		got := a.BigInt()
+		if got.String() != a.String() {
+			t.Errorf("Int16(%d).BigInt() = %s, want %s", a, got, a)
+		}
+		if b, ok := Int16FromBigInt(got); b != a || !ok {
+			t.Errorf("Int16FromBigInt(%s) = %d, %t, want %d, true", got, b, ok, a)
+		}
+	}
+}
+
+func TestInt16FromFloat64(t *testing.T) {
+	testCases := []struct {
+		f    float64
+		mode big.RoundingMode
+		want Int16
+		acc  big.Accuracy
+		err  error
+	}{
+		{0, big.ToNearestEven, 0, big.Exact, nil},
+		{2.5, big.ToNearestEven, 2, big.Below, nil},
+		{3.5, big.ToNearestEven, 4, big.Above, nil},
+		{2.5, big.ToNearestAway, 3, big.Above, nil},
+		{2.5, big.ToZero, 2, big.Below, nil},
+		{2.5, big.AwayFromZero, 3, big.Above, nil},
+		{2.5, big.ToNegativeInf, 2, big.Below, nil},
+		{2.5, big.ToPositiveInf, 3, big.Above, nil},
+		{math.NaN(), big.ToNearestEven, 0, big.Exact, ErrNaN},
+		{math.Inf(1), big.ToNearestEven, 32767, big.Below, ErrOverflow},
+		{math.Inf(-1), big.ToNearestEven, -32768, big.Above, ErrOverflow},
+		{0x1p16, big.ToNearestEven, 32767, big.Below, ErrOverflow},
+		{0x1p15, big.ToNearestEven, 32767, big.Below, ErrOverflow},
+		{-2.5, big.ToNearestEven, -2, big.Above, nil},
+		{-2.5, big.ToZero, -2, big.Above, nil},
+		{-2.5, big.AwayFromZero, -3, big.Below, nil},
+		{-2.5, big.ToNegativeInf, -3, big.Below, nil},
+		{-0x1p15, big.ToNearestEven, -32768, big.Exact, nil},
+	}
+
+	for _, tc := range testCases {
+		got, acc, err := Int16FromFloat64(tc.f, tc.mode)
+		if got != tc.want || acc != tc.acc || err != tc.err {
+			t.Errorf("Int16FromFloat64(%v, %s) = %d, %s, %v, want %d, %s, %v", tc.f, tc.mode, got, acc, err, tc.want, tc.acc, tc.err)
+		}
+
+		if math.IsNaN(tc.f) {
+			continue
+		}
+		got, acc, err = Int16FromBigFloat(big.NewFloat(tc.f), tc.mode)
+		if got != tc.want || acc != tc.acc || err != tc.err {
+			t.Errorf("Int16FromBigFloat(%v, %s) = %d, %s, %v, want %d, %s, %v", tc.f, tc.mode, got, acc, err, tc.want, tc.acc, tc.err)
+		}
+	}
+}
+
+func TestInt16_Float64(t *testing.T) {
+	testCases := []Int16{0, 1, 32767, -1, -32768}
+
+	for _, a := range testCases {
+		b := a.BigInt()
+		got, acc := a.Float64()
+		want, wantAcc := new(big.Float).SetInt(b).Float64()
+		if got != want || acc != wantAcc {
+			t.Errorf("Int16(%d).Float64() = %v, %s, want %v, %s", a, got, acc, want, wantAcc)
+		}
+		if c, acc, err := Int16FromBigFloat(a.BigFloat(), big.ToNearestEven); c != a || acc != big.Exact || err != nil {
+			t.Errorf("Int16FromBigFloat(%v) = %d, %s, %v, want %d, %s, nil", a.BigFloat(), c, acc, err, a, big.Exact)
+		}
+	}
+}
+
+func TestInt16_AddOverflow(t *testing.T) {
+	testCases := []struct {
+		x, y     Int16
+		want     Int16
+		overflow bool
+	}{
+		{0, 0, 0, false},
+		{1, 1, 2, false},
+		{32767, 1, -32768, true},
+		{32767, 32767, -2, true},
+		{-32768, 1, -32767, false},
+		{2, 16383, 16385, false},
+		{2, 16384, 16386, false},
+		{-1, -32768, 32767, true},
+		{-32768, -1, 32767, true},
+		{-32768, -32768, 0, true},
+		{-1, -1, -2, false},
+	}
+
+	for _, tc := range testCases {
+		got, overflow := tc.x.AddOverflow(tc.y)
+		if got != tc.want || overflow != tc.overflow {
+			t.Errorf("Int16(%d).AddOverflow(%d) = %d, %t, want %d, %t", tc.x, tc.y, got, overflow, tc.want, tc.overflow)
+		}
+	}
+}
+
+func TestInt16_SubOverflow(t *testing.T) {
+	testCases := []struct {
+		x, y     Int16
+		want     Int16
+		overflow bool
+	}{
+		{0, 0, 0, false},
+		{1, 1, 0, false},
+		{32767, 1, 32766, false},
+		{32767, 32767, 0, false},
+		{-32768, 1, 32767, true},
+		{2, 16383, -16381, false},
+		{2, 16384, -16382, false},
+		{-1, -32768, 32767, false},
+		{-32768, -1, -32767, false},
+		{-32768, -32768, 0, false},
+		{-1, -1, 0, false},
+	}
+
+	for _, tc := range testCases {
+		got, overflow := tc.x.SubOverflow(tc.y)
+		if got != tc.want || overflow != tc.overflow {
+			t.Errorf("Int16(%d).SubOverflow(%d) = %d, %t, want %d, %t", tc.x, tc.y, got, overflow, tc.want, tc.overflow)
+		}
+	}
+}
+
+func TestInt16_MulOverflow(t *testing.T) {
+	testCases := []struct {
+		x, y     Int16
+		want     Int16
+		overflow bool
+	}{
+		{0, 0, 0, false},
+		{1, 1, 1, false},
+		{32767, 1, 32767, false},
+		{32767, 32767, 1, true},
+		{-32768, 1, -32768, false},
+		{2, 16383, 32766, false},
+		{2, 16384, -32768, true},
+		{-1, -32768, -32768, true},
+		{-32768, -1, -32768, true},
+		{-32768, -32768, 0, true},
+		{-1, -1, 1, false},
+	}
+
+	for _, tc := range testCases {
+		got, overflow := tc.x.MulOverflow(tc.y)
+		if got != tc.want || overflow != tc.overflow {
+			t.Errorf("Int16(%d).MulOverflow(%d) = %d, %t, want %d, %t", tc.x, tc.y, got, overflow, tc.want, tc.overflow)
+		}
+	}
+}
+
+func TestInt16_NegOverflow(t *testing.T) {
+	testCases := []struct {
+		x        Int16
+		want     Int16
+		overflow bool
+	}{
+		{0, 0, false},
+		{1, -1, false},
+		{32767, -32767, false},
+		{-1, 1, false},
+		{-32768, -32768, true},
+	}
+
+	for _, tc := range testCases {
+		got, overflow := tc.x.NegOverflow()
+		if got != tc.want || overflow != tc.overflow {
+			t.Errorf("Int16(%d).NegOverflow() = %d, %t, want %d, %t", tc.x, got, overflow, tc.want, tc.overflow)
+		}
+	}
+}
+
+func TestInt16_LshOverflow(t *testing.T) {
+	testCases := []struct {
+		x        Int16
+		i        uint
+		want     Int16
+		overflow bool
+	}{
+		{1, 0, 1, false},
+		{1, 14, 16384, false},
+		{1, 15, -32768, true},
+		{1, 16, 0, true},
+		{3, 14, -16384, true},
+		{32767, 1, -2, true},
+		{0, 26, 0, false},
+		{-1, 15, -32768, false},
+		{-1, 16, 0, true},
+		{-2, 14, -32768, false},
+		{-3, 14, 16384, true},
+	}
+
+	for _, tc := range testCases {
+		got, overflow := tc.x.LshOverflow(tc.i)
+		if got != tc.want || overflow != tc.overflow {
+			t.Errorf("Int16(%d).LshOverflow(%d) = %d, %t, want %d, %t", tc.x, tc.i, got, overflow, tc.want, tc.overflow)
+		}
+	}
+}
+
+func TestInt16_SaturatingAdd(t *testing.T) {
+	testCases := []struct {
+		x, y Int16
+		want Int16
+	}{
+		{0, 0, 0},
+		{1, 2, 3},
+		{32767, 1, 32767},
+		{32767, 32767, 32767},
+		{-32768, 1, -32767},
+		{2, 16384, 16386},
+		{3, 2, 5},
+		{-32768, -1, -32768},
+		{-1, -32768, -32768},
+		{-32768, -32768, -32768},
+		{32767, -1, 32766},
+		{-2, 16384, 16382},
+	}
+
+	for _, tc := range testCases {
+		got := tc.x.SaturatingAdd(tc.y)
+		if got != tc.want {
+			t.Errorf("Int16(%d).SaturatingAdd(%d) = %d, want %d", tc.x, tc.y, got, tc.want)
+		}
+	}
+}
+
+func TestInt16_SaturatingSub(t *testing.T) {
+	testCases := []struct {
+		x, y Int16
+		want Int16
+	}{
+		{0, 0, 0},
+		{1, 2, -1},
+		{32767, 1, 32766},
+		{32767, 32767, 0},
+		{-32768, 1, -32768},
+		{2, 16384, -16382},
+		{3, 2, 1},
+		{-32768, -1, -32767},
+		{-1, -32768, 32767},
+		{-32768, -32768, 0},
+		{32767, -1, 32767},
+		{-2, 16384, -16386},
+	}
+
+	for _, tc := range testCases {
+		got := tc.x.SaturatingSub(tc.y)
+		if got != tc.want {
+			t.Errorf("Int16(%d).SaturatingSub(%d) = %d, want %d", tc.x, tc.y, got, tc.want)
+		}
+	}
+}
+
+func TestInt16_SaturatingMul(t *testing.T) {
+	testCases := []struct {
+		x, y Int16
+		want Int16
+	}{
+		{0, 0, 0},
+		{1, 2, 2},
+		{32767, 1, 32767},
+		{32767, 32767, 32767},
+		{-32768, 1, -32768},
+		{2, 16384, 32767},
+		{3, 2, 6},
+		{-32768, -1, 32767},
+		{-1, -32768, 32767},
+		{-32768, -32768, 32767},
+		{32767, -1, -32767},
+		{-2, 16384, -32768},
+	}
+
+	for _, tc := range testCases {
+		got := tc.x.SaturatingMul(tc.y)
+		if got != tc.want {
+			t.Errorf("Int16(%d).SaturatingMul(%d) = %d, want %d", tc.x, tc.y, got, tc.want)
+		}
+	}
+}
+
+func TestInt16_Abs(t *testing.T) {
+	testCases := []struct {
+		x      Int16
+		abs    Int16
+		satAbs Int16
+		satNeg Int16
+	}{
+		{0, 0, 0, 0},
+		{1, 1, 1, -1},
+		{-1, 1, 1, 1},
+		{32767, 32767, 32767, -32767},
+		{-32768, -32768, 32767, 32767},
+		{-32767, 32767, 32767, 32767},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.x.Abs(); got != tc.abs {
+			t.Errorf("Int16(%d).Abs() = %d, want %d", tc.x, got, tc.abs)
+		}
+		if got := tc.x.SaturatingAbs(); got != tc.satAbs {
+			t.Errorf("Int16(%d).SaturatingAbs() = %d, want %d", tc.x, got, tc.satAbs)
+		}
+		if got := tc.x.SaturatingNeg(); got != tc.satNeg {
+			t.Errorf("Int16(%d).SaturatingNeg() = %d, want %d", tc.x, got, tc.satNeg)
+		}
+	}
+}
+
+func TestInt16Of(t *testing.T) {
+	testCases := []struct {
+		v    string
+		got  Int16
+		want Int16
+	}{
+		{"int8(-1)", Int16Of(int8(-1)), -1},
+		{"int(42)", Int16Of(int(42)), 42},
+		{"uint64(math.MaxUint64)", Int16Of(uint64(math.MaxUint64)), -1},
+		{"int64(math.MinInt64)", Int16Of(int64(math.MinInt64)), 0},
+		{"uintptr(7)", Int16Of(uintptr(7)), 7},
+	}
+
+	for _, tc := range testCases {
+		if tc.got != tc.want {
+			t.Errorf("Int16Of(%s) = %d, want %d", tc.v, tc.got, tc.want)
+		}
+	}
+}
+
+func TestInt16_IsInt64(t *testing.T) {
+	testCases := []struct {
+		a        Int16
+		isInt64  bool
+		isUint64 bool
+		i64      int64
+		u64      uint64
+	}{
+		{-32768, true, false, -32768, 18446744073709518848},
+		{-1, true, false, -1, math.MaxUint64},
+		{0, true, true, 0, 0},
+		{1, true, true, 1, 1},
+		{32767, true, true, 32767, 32767},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.a.IsInt64(); got != tc.isInt64 {
+			t.Errorf("Int16(%d).IsInt64() = %t, want %t", tc.a, got, tc.isInt64)
+		}
+		if got := tc.a.IsUint64(); got != tc.isUint64 {
+			t.Errorf("Int16(%d).IsUint64() = %t, want %t", tc.a, got, tc.isUint64)
+		}
+
+		got, ok := tc.a.Int()
+		want, wantOK := int(tc.i64), tc.isInt64 && int64(int(tc.i64)) == tc.i64
+		if got != want || ok != wantOK {
+			t.Errorf("Int16(%d).Int() = %d, %t, want %d, %t", tc.a, got, ok, want, wantOK)
+		}
+
+		gotU, ok := tc.a.Uint()
+		wantU, wantOK := uint(tc.u64), tc.isUint64 && uint64(uint(tc.u64)) == tc.u64
+		if gotU != wantU || ok != wantOK {
+			t.Errorf("Int16(%d).Uint() = %d, %t, want %d, %t", tc.a, gotU, ok, wantU, wantOK)
+		}
+	}
+}
+
+func TestInt16_Bits(t *testing.T) {
+	testCases := []struct {
+		x             Int16
+		leadingZeros  int
+		trailingZeros int
+		bitLen        int
+		onesCount     int
+	}{
+		{0, 16, 16, 0, 0},
+		{1, 15, 0, 1, 1},
+		{-1, 0, 0, 1, 16},
+		{2, 14, 1, 2, 1},
+		{-2, 0, 1, 2, 15},
+		{32767, 1, 0, 15, 15},
+		{-32768, 0, 15, 16, 1},
+		{-32767, 0, 0, 15, 2},
+		{256, 7, 8, 9, 1},
+		{-256, 0, 8, 9, 8},
+		{6, 13, 1, 3, 2},
+		{-6, 0, 1, 3, 14},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.x.LeadingZeros(); got != tc.leadingZeros {
+			t.Errorf("Int16(%d).LeadingZeros() = %d, want %d", tc.x, got, tc.leadingZeros)
+		}
+		if got := tc.x.TrailingZeros(); got != tc.trailingZeros {
+			t.Errorf("Int16(%d).TrailingZeros() = %d, want %d", tc.x, got, tc.trailingZeros)
+		}
+		if got := tc.x.BitLen(); got != tc.bitLen {
+			t.Errorf("Int16(%d).BitLen() = %d, want %d", tc.x, got, tc.bitLen)
+		}
+		if got := tc.x.OnesCount(); got != tc.onesCount {
+			t.Errorf("Int16(%d).OnesCount() = %d, want %d", tc.x, got, tc.onesCount)
+		}
+	}
+}
+
+func TestInt16_Bit(t *testing.T) {
+	testCases := []Int16{
+		0,
+		1,
+		-1,
+		5,
+		-6,
+		-32768,
+		32767,
+	}
+
+	for _, a := range testCases {
+		x := big.NewInt(int64(a))
+		for i := range 26 {
+			if got, want := a.Bit(i), x.Bit(i); got != want {
+				t.Errorf("Int16(%d).Bit(%d) = %d, want %d", a, i, got, want)
+			}
+		}
+	}
+}
+
+func TestInt16_RshRound(t *testing.T) {
+	values := []Int16{
+		0,
+		1,
+		2,
+		3,
+		5,
+		6,
+		128,
+		192,
+		32767,
+		-32768,
+		-32767,
+		-20480,
+		-1,
+		-128,
+		-192,
+		-2,
+		-3,
+		-5,
+		-6,
+		20480,
+	}
+	shifts := []uint{0, 1, 2, 3, 7, 8, 9, 15, 16, 17, 80}
+
+	for _, a := range values {
+		x := big.NewInt(int64(a))
+		for _, i := range shifts {
+			if got, want := a.RshRound(i), rshRoundBigInt(x, i); got.BigInt().Cmp(want) != 0 {
+				t.Errorf("Int16(%d).RshRound(%d) = %d, want %d", a, i, got, want)
+			}
+			if got, want := a.RshCeil(i), rshCeilBigInt(x, i); got.BigInt().Cmp(want) != 0 {
+				t.Errorf("Int16(%d).RshCeil(%d) = %d, want %d", a, i, got, want)
+			}
+			if got, want := a.URsh(i), new(big.Int).Rsh(new(big.Int).SetUint64(uint64(uint16(a))), i); Uint16(got).BigInt().Cmp(want) != 0 {
+				t.Errorf("Int16(%d).URsh(%d) = %d, want %d", a, i, got, want)
+			}
+		}
+	}
+}
+
+func TestInt16_Mul32(t *testing.T) {
+	testCases := []struct {
+		a, b Int16
+		want Int32
+	}{
+		{0, 0, 0},
+		{1, -1, -1},
+		{32767, 32767, 1073676289},
+		{-32768, -32768, 1073741824},
+		{-32768, 32767, -1073709056},
+		{-1, -32768, 32768},
+		{12345, -678, -8369910},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Mul32(tc.b)
+		if got != tc.want {
+			t.Errorf("Int16(%d).Mul32(%d) = %d, want %d", tc.a, tc.b, got, tc.want)
+		}
+	}
+}
+
+func TestInt16_Sqrt(t *testing.T) {
+	testCases := []struct {
+		a        Int16
+		sqrt     Int16
+		cbrt     Int16
+		root5    Int16
+		isSquare bool
+	}{
+		{0, 0, 0, 0, true},
+		{1, 1, 1, 1, true},
+		{2, 1, 1, 1, false},
+		{3, 1, 1, 1, false},
+		{4, 2, 1, 1, true},
+		{15, 3, 2, 1, false},
+		{16, 4, 2, 1, true},
+		{17, 4, 2, 1, false},
+		{26, 5, 2, 1, false},
+		{27, 5, 3, 1, false},
+		{28, 5, 3, 1, false},
+		{81, 9, 4, 2, true},
+		{1000, 31, 10, 3, false},
+		{1024, 32, 10, 4, true},
+		{16383, 127, 25, 6, false},
+		{24910, 157, 29, 7, false},
+		{32766, 181, 31, 7, false},
+		{32767, 181, 31, 7, false},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.a.Sqrt(); got != tc.sqrt {
+			t.Errorf("Int16(%d).Sqrt() = %d, want %d", tc.a, got, tc.sqrt)
+		}
+		if got := tc.a.Cbrt(); got != tc.cbrt {
+			t.Errorf("Int16(%d).Cbrt() = %d, want %d", tc.a, got, tc.cbrt)
+		}
+		if got := tc.a.Root(5); got != tc.root5 {
+			t.Errorf("Int16(%d).Root(5) = %d, want %d", tc.a, got, tc.root5)
+		}
+		if got := tc.a.IsPerfectSquare(); got != tc.isSquare {
+			t.Errorf("Int16(%d).IsPerfectSquare() = %t, want %t", tc.a, got, tc.isSquare)
+		}
+	}
+}
+
+func TestInt16_Sqrt_Panic(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Int16(-4).Sqrt() did not panic")
+		}
+	}()
+	Int16(-4).Sqrt()
+}
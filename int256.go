@@ -2,19 +2,72 @@ package ints
 
 import (
 	"cmp"
+	"encoding/binary"
 	"fmt"
+	"math"
+	"math/big"
 	"math/bits"
 )
 
 // Int256 is a type that represents an 256-bit signed integer.
 type Int256 [4]uint64
 
+var (
+	// MaxInt256 is the maximum value of Int256.
+	MaxInt256 = Int256{math.MaxInt64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+
+	// MinInt256 is the minimum value of Int256.
+	MinInt256 = Int256{1 << 63, 0, 0, 0}
+)
+
+// Int256FromInt64 returns v as an Int256.
+func Int256FromInt64(v int64) Int256 {
+	s := uint64(v >> 63) // sign extension
+	return Int256{s, s, s, uint64(v)}
+}
+
+// Int256Of returns v as an Int256.
+// v is converted in the same way as Go's integer conversions:
+// it is sign-extended if T is a signed type, and zero-extended otherwise.
+func Int256Of[T builtinInteger](v T) Int256 {
+	if isSigned[T]() {
+		return Int64(v).Int256()
+	}
+	return Uint64(v).Int256()
+}
+
 // IsZero returns true if a is zero.
 func (a Int256) IsZero() bool {
 	var zero Int256
 	return a == zero
 }
 
+// IsInt64 reports whether a can be represented as an int64.
+func (a Int256) IsInt64() bool {
+	_, ok := a.TryInt64()
+	return ok
+}
+
+// IsUint64 reports whether a can be represented as a uint64.
+func (a Int256) IsUint64() bool {
+	_, ok := a.TryUint64()
+	return ok
+}
+
+// Int returns a as an int and reports whether a can be represented as an int.
+// If it can't, the result is a truncated to the size of int.
+func (a Int256) Int() (int, bool) {
+	v, ok := a.TryInt64()
+	return int(v), ok && int64(int(v)) == int64(v)
+}
+
+// Uint returns a as a uint and reports whether a can be represented as a uint.
+// If it can't, the result is a truncated to the size of uint.
+func (a Int256) Uint() (uint, bool) {
+	v, ok := a.TryUint64()
+	return uint(v), ok && uint64(uint(v)) == uint64(v)
+}
+
 // Add returns the sum a+b.
 //
 // This function's execution time does not depend on the inputs.
@@ -26,6 +79,27 @@ func (a Int256) Add(b Int256) Int256 {
 	return Int256{u0, u1, u2, u3}
 }
 
+// AddOverflow returns the sum a+b and reports whether the addition overflowed.
+//
+// This function's execution time does not depend on the inputs.
+func (a Int256) AddOverflow(b Int256) (Int256, bool) {
+	c := a.Add(b)
+	return c, ((a[0]^c[0])&(b[0]^c[0]))>>63 != 0
+}
+
+// SaturatingAdd returns the sum a+b.
+// If the result overflows, it is clamped to the minimum or maximum value.
+func (a Int256) SaturatingAdd(b Int256) Int256 {
+	c, overflow := a.AddOverflow(b)
+	if overflow {
+		if int64(a[0]) < 0 {
+			return Int256{1 << 63, 0, 0, 0}
+		}
+		return Int256{math.MaxInt64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return c
+}
+
 // Sub returns the difference a-b.
 //
 // This function's execution time does not depend on the inputs.
@@ -37,6 +111,27 @@ func (a Int256) Sub(b Int256) Int256 {
 	return Int256{u0, u1, u2, u3}
 }
 
+// SubOverflow returns the difference a-b and reports whether the subtraction overflowed.
+//
+// This function's execution time does not depend on the inputs.
+func (a Int256) SubOverflow(b Int256) (Int256, bool) {
+	c := a.Sub(b)
+	return c, ((a[0]^b[0])&(a[0]^c[0]))>>63 != 0
+}
+
+// SaturatingSub returns the difference a-b.
+// If the result overflows, it is clamped to the minimum or maximum value.
+func (a Int256) SaturatingSub(b Int256) Int256 {
+	c, overflow := a.SubOverflow(b)
+	if overflow {
+		if int64(a[0]) < 0 {
+			return Int256{1 << 63, 0, 0, 0}
+		}
+		return Int256{math.MaxInt64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return c
+}
+
 // Mul returns the product a*b.
 func (a Int256) Mul(b Int256) Int256 {
 	neg := false
@@ -57,6 +152,64 @@ func (a Int256) Mul(b Int256) Int256 {
 	return c
 }
 
+// MulOverflow returns the product a*b and reports whether the multiplication overflowed.
+func (a Int256) MulOverflow(b Int256) (Int256, bool) {
+	neg := false
+	if a.Sign() < 0 {
+		neg = !neg
+		a = a.Neg()
+	}
+	if b.Sign() < 0 {
+		neg = !neg
+		b = b.Neg()
+	}
+
+	c, overflow := Uint256(a).MulOverflow(Uint256(b))
+
+	// The magnitude of the result must be less than 2**255,
+	// or equal to 2**255 if the result is negative.
+	limit := Uint256{1 << 63, 0, 0, 0}
+	if neg {
+		overflow = overflow || c.Cmp(limit) > 0
+		c = c.Neg()
+	} else {
+		overflow = overflow || c.Cmp(limit) >= 0
+	}
+	return Int256(c), overflow
+}
+
+// SaturatingMul returns the product a*b.
+// If the result overflows, it is clamped to the minimum or maximum value.
+func (a Int256) SaturatingMul(b Int256) Int256 {
+	c, overflow := a.MulOverflow(b)
+	if overflow {
+		if (int64(a[0]) < 0) != (int64(b[0]) < 0) {
+			return Int256{1 << 63, 0, 0, 0}
+		}
+		return Int256{math.MaxInt64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return c
+}
+
+// Mul512 returns the product a*b, the result is a 512-bit integer.
+func (a Int256) Mul512(b Int256) Int512 {
+	neg := false
+	if a.Sign() < 0 {
+		a = a.Neg()
+		neg = true
+	}
+	if b.Sign() < 0 {
+		b = b.Neg()
+		neg = !neg
+	}
+
+	c := Int512(Uint256(a).Mul512(Uint256(b)))
+	if neg {
+		c = c.Neg()
+	}
+	return c
+}
+
 // Div returns the quotient a/b for b != 0.
 // If b == 0, a division-by-zero run-time panic occurs.
 // Div implements Euclidean division (unlike Go); see [Int256.DivMod] for more details.
@@ -215,6 +368,13 @@ func (a Int256) Lsh(i uint) Int256 {
 	}
 }
 
+// LshOverflow returns the left shift a<<i and reports whether the shift overflowed,
+// i.e. whether a<<i differs from a multiplied by 2**i.
+func (a Int256) LshOverflow(i uint) (Int256, bool) {
+	c := a.Lsh(i)
+	return c, c.Rsh(i) != a
+}
+
 // Rsh returns the arithmetic right shift a>>i, preserving the sign bit.
 //
 // This function's execution time does not depend on the inputs.
@@ -241,6 +401,77 @@ func (a Int256) Rsh(i uint) Int256 {
 	}
 }
 
+// URsh returns the logical right shift a>>i, treating a as an unsigned integer.
+// The vacated upper bits are filled with zeros regardless of the sign of a.
+func (a Int256) URsh(i uint) Int256 {
+	return Int256(Uint256(a).Rsh(i))
+}
+
+// RshRound returns a/2**i rounded to the nearest integer, with ties rounded to even.
+func (a Int256) RshRound(i uint) Int256 {
+	if i == 0 {
+		return a
+	}
+	q := a.Rsh(i)
+	half := a.Bit(int(min(i-1, 256)))
+	sticky := !Uint256(a).Extract(0, i-1).IsZero()
+	if half == 1 && (sticky || q.Bit(0) == 1) {
+		q = q.Add(Int256{0, 0, 0, 1})
+	}
+	return q
+}
+
+// RshCeil returns a/2**i rounded toward positive infinity.
+func (a Int256) RshCeil(i uint) Int256 {
+	q := a.Rsh(i)
+	if !Uint256(a).Extract(0, i).IsZero() {
+		q = q.Add(Int256{0, 0, 0, 1})
+	}
+	return q
+}
+
+// LeadingZeros returns the number of leading zero bits in the two's complement representation of a;
+// the result is 0 for a < 0, and 256 for a == 0.
+func (a Int256) LeadingZeros() int {
+	return Uint256(a).LeadingZeros()
+}
+
+// TrailingZeros returns the number of trailing zero bits in a; the result is 256 for a == 0.
+// a and -a have the same number of trailing zero bits.
+func (a Int256) TrailingZeros() int {
+	return Uint256(a).TrailingZeros()
+}
+
+// BitLen returns the number of bits required to represent the absolute value of a in binary;
+// the result is 0 for a == 0.
+// It is the same as [big.Int.BitLen].
+func (a Int256) BitLen() int {
+	if int64(a[0]) < 0 {
+		// the negation of the minimum value is itself,
+		// but it is correctly interpreted as 2**255 in unsigned.
+		a = a.Neg()
+	}
+	return Uint256(a).BitLen()
+}
+
+// OnesCount returns the number of one bits ("population count") in the two's complement representation of a.
+func (a Int256) OnesCount() int {
+	return Uint256(a).OnesCount()
+}
+
+// Bit returns the value of the i'th bit of the two's complement representation of a.
+// That is, it returns (a>>i)&1, and the sign bit for i >= 256, like [big.Int.Bit].
+// The bit index i must be >= 0.
+func (a Int256) Bit(i int) uint {
+	if i < 0 {
+		panic("ints: negative bit index")
+	}
+	if i >= 256 {
+		return uint(a[0] >> 63)
+	}
+	return uint(a[3-i/64]>>(uint(i)%64)) & 1
+}
+
 // Sign returns the sign of a.
 // It returns 1 if a > 0, -1 if a < 0, and 0 if a == 0.
 func (a Int256) Sign() int {
@@ -266,6 +497,73 @@ func (a Int256) Neg() Int256 {
 	return Int256{u0, u1, u2, u3}
 }
 
+// NegOverflow returns the negation of a and reports whether the negation overflowed,
+// which happens only if a is the minimum value.
+//
+// This function's execution time does not depend on the inputs.
+func (a Int256) NegOverflow() (Int256, bool) {
+	c := a.Neg()
+	return c, (a[0]&c[0])>>63 != 0
+}
+
+// SaturatingNeg returns the negation of a.
+// If a is the minimum value, it returns the maximum value.
+func (a Int256) SaturatingNeg() Int256 {
+	c, overflow := a.NegOverflow()
+	if overflow {
+		return Int256{math.MaxInt64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return c
+}
+
+// Abs returns the absolute value of a.
+// If a is the minimum value, Abs returns a itself because its absolute value can't be represented.
+func (a Int256) Abs() Int256 {
+	if int64(a[0]) < 0 {
+		return a.Neg()
+	}
+	return a
+}
+
+// SaturatingAbs returns the absolute value of a.
+// If a is the minimum value, it returns the maximum value.
+func (a Int256) SaturatingAbs() Int256 {
+	if int64(a[0]) < 0 {
+		return a.SaturatingNeg()
+	}
+	return a
+}
+
+// Sqrt returns the floor square root of a, ⌊√a⌋.
+// It panics if a < 0.
+func (a Int256) Sqrt() Int256 {
+	if a.Sign() < 0 {
+		panic("ints: square root of negative number")
+	}
+	return Int256(Uint256(a).Sqrt())
+}
+
+// Cbrt returns the floor cube root of a, ⌊∛a⌋.
+// It panics if a < 0.
+func (a Int256) Cbrt() Int256 {
+	return a.Root(3)
+}
+
+// Root returns the floor n-th root of a, ⌊a**(1/n)⌋.
+// It panics if a < 0 or n == 0.
+func (a Int256) Root(n uint) Int256 {
+	if a.Sign() < 0 {
+		panic("ints: root of negative number")
+	}
+	return Int256(Uint256(a).Root(n))
+}
+
+// IsPerfectSquare reports whether a is the square of an integer.
+// Negative numbers are never perfect squares.
+func (a Int256) IsPerfectSquare() bool {
+	return a.Sign() >= 0 && Uint256(a).IsPerfectSquare()
+}
+
 func (a Int256) Cmp(b Int256) int {
 	if ret := cmp.Compare(int64(a[0]), int64(b[0])); ret != 0 {
 		return ret
@@ -315,3 +613,206 @@ func (a Int256) Format(s fmt.State, verb rune) {
 	}
 	format(s, verb, sign, b)
 }
+
+// ParseInt256 interprets a string s in the given base (0, 2 to 62) and
+// returns the corresponding value.
+//
+// The string may begin with a leading sign: "+" or "-".
+//
+// If the base argument is 0, the true base is implied by the string's
+// prefix following the sign (if present): 2 for "0b", 8 for "0" or "0o",
+// 16 for "0x", and 10 otherwise. Also, for argument base 0 only,
+// underscore characters are permitted as defined by the Go syntax for
+// [integer literals].
+// For bases <= 36, lower and upper case letters are considered the same:
+// The letters 'a' to 'z' and 'A' to 'Z' represent digit values 10 to 35.
+// For bases > 36, the upper case letters 'A' to 'Z' represent the digit values 36 to 61,
+// as generated by [Int256.Text].
+//
+// The errors that ParseInt256 returns have concrete type [*strconv.NumError]
+// and include err.Num = s. If s is empty or contains invalid
+// digits, err.Err = [strconv.ErrSyntax] and the returned value is 0;
+// if the value corresponding to s cannot be represented by an Int256,
+// err.Err = [strconv.ErrRange] and the returned value is the maximum magnitude integer
+// of the appropriate sign.
+//
+// [integer literals]: https://go.dev/ref/spec#Integer_literals
+func ParseInt256(s string, base int) (Int256, error) {
+	var z Int256
+	err := parseInt("ParseInt256", s, base, 256, z[:])
+	return z, err
+}
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+// The result is the decimal representation of a.
+func (a Int256) MarshalText() ([]byte, error) {
+	return a.AppendText(nil)
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+// It accepts the same syntax as Go integer literals, such as "0x" prefixes and underscores.
+// See [ParseInt256] for more details.
+func (a *Int256) UnmarshalText(text []byte) error {
+	v, err := ParseInt256(string(text), 0)
+	if err != nil {
+		return err
+	}
+	*a = v
+	return nil
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+// The result is a JSON number. Use [JSONString] to encode a as a JSON string.
+func (a Int256) MarshalJSON() ([]byte, error) {
+	return a.AppendText(nil)
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+// It accepts both a JSON number such as 123 and a JSON string such as "123" or "0x7b".
+// JSON strings are parsed in the same way as [Int256.UnmarshalText].
+func (a *Int256) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(a, data, ParseInt256)
+}
+
+// BytesBE returns the two's complement big-endian representation of a.
+func (a Int256) BytesBE() [32]byte {
+	var b [32]byte
+	a.PutBytesBE(b[:])
+	return b
+}
+
+// BytesLE returns the two's complement little-endian representation of a.
+func (a Int256) BytesLE() [32]byte {
+	var b [32]byte
+	a.PutBytesLE(b[:])
+	return b
+}
+
+// PutBytesBE stores the two's complement big-endian representation of a into b.
+// It panics if len(b) < 32.
+func (a Int256) PutBytesBE(b []byte) {
+	_ = b[31] // bounds check hint to compiler; see golang.org/issue/14808
+	binary.BigEndian.PutUint64(b[0:], a[0])
+	binary.BigEndian.PutUint64(b[8:], a[1])
+	binary.BigEndian.PutUint64(b[16:], a[2])
+	binary.BigEndian.PutUint64(b[24:], a[3])
+}
+
+// PutBytesLE stores the two's complement little-endian representation of a into b.
+// It panics if len(b) < 32.
+func (a Int256) PutBytesLE(b []byte) {
+	_ = b[31] // bounds check hint to compiler; see golang.org/issue/14808
+	binary.LittleEndian.PutUint64(b[0:], a[3])
+	binary.LittleEndian.PutUint64(b[8:], a[2])
+	binary.LittleEndian.PutUint64(b[16:], a[1])
+	binary.LittleEndian.PutUint64(b[24:], a[0])
+}
+
+// Int256FromBytesBE returns the Int256 represented by the two's complement big-endian bytes b[:32].
+// It panics if len(b) < 32.
+func Int256FromBytesBE(b []byte) Int256 {
+	_ = b[31] // bounds check hint to compiler; see golang.org/issue/14808
+	return Int256{
+		binary.BigEndian.Uint64(b[0:]),
+		binary.BigEndian.Uint64(b[8:]),
+		binary.BigEndian.Uint64(b[16:]),
+		binary.BigEndian.Uint64(b[24:]),
+	}
+}
+
+// Int256FromBytesLE returns the Int256 represented by the two's complement little-endian bytes b[:32].
+// It panics if len(b) < 32.
+func Int256FromBytesLE(b []byte) Int256 {
+	_ = b[31] // bounds check hint to compiler; see golang.org/issue/14808
+	return Int256{
+		binary.LittleEndian.Uint64(b[24:]),
+		binary.LittleEndian.Uint64(b[16:]),
+		binary.LittleEndian.Uint64(b[8:]),
+		binary.LittleEndian.Uint64(b[0:]),
+	}
+}
+
+// AppendBinary implements the [encoding.BinaryAppender] interface.
+// It appends the two's complement big-endian representation of a to dst.
+func (a Int256) AppendBinary(dst []byte) ([]byte, error) {
+	b := a.BytesBE()
+	return append(dst, b[:]...), nil
+}
+
+// MarshalBinary implements the [encoding.BinaryMarshaler] interface.
+// The result is the 32-byte two's complement big-endian representation of a.
+func (a Int256) MarshalBinary() ([]byte, error) {
+	return a.AppendBinary(make([]byte, 0, 32))
+}
+
+// UnmarshalBinary implements the [encoding.BinaryUnmarshaler] interface.
+// data must be exactly 32 bytes of the two's complement big-endian representation, as generated by [Int256.MarshalBinary].
+func (a *Int256) UnmarshalBinary(data []byte) error {
+	if len(data) != 32 {
+		return fmt.Errorf("ints: Int256.UnmarshalBinary: invalid length %d, want 32", len(data))
+	}
+	*a = Int256FromBytesBE(data)
+	return nil
+}
+
+// BigInt returns a as a new [*big.Int].
+func (a Int256) BigInt() *big.Int {
+	if a.Sign() < 0 {
+		a = a.Neg()
+		var w [256 / bits.UintSize]big.Word
+		z := new(big.Int).SetBits(wordsFromLimbs(w[:], a[:]))
+		return z.Neg(z)
+	}
+	var w [256 / bits.UintSize]big.Word
+	return new(big.Int).SetBits(wordsFromLimbs(w[:], a[:]))
+}
+
+// Int256FromBigInt returns x as an Int256.
+// If x is not representable as an Int256, the result is the lower 256 bits of x in two's complement,
+// and ok is false.
+func Int256FromBigInt(x *big.Int) (Int256, bool) {
+	var z Int256
+	ok := intFromBigInt(z[:], 256, x)
+	return z, ok
+}
+
+// Float64 returns the float64 value nearest to a, rounding ties to even, and an indication of any rounding error.
+// If a is too large to be represented by a float64, the result is ±Inf.
+func (a Int256) Float64() (float64, big.Accuracy) {
+	if a.Sign() < 0 {
+		b := Uint256(a).Neg()
+		f, acc := float64FromLimbs(b[:])
+		return -f, -acc
+	}
+	return float64FromLimbs(a[:])
+}
+
+// Int256FromFloat64 returns the integer value of f rounded according to mode,
+// and the accuracy of the result: [big.Below] if the result is less than f, [big.Above] if it is greater than f.
+// Use [big.ToZero] to truncate f like the Go conversion, and [big.ToNearestEven] to round f to the nearest integer.
+//
+// If f is NaN, the result is 0 and the error is [ErrNaN].
+// If the rounded value of f is not representable as an Int256, including ±Inf,
+// the result is clamped to the minimum or maximum value of Int256, and the error is [ErrOverflow].
+func Int256FromFloat64(f float64, mode big.RoundingMode) (Int256, big.Accuracy, error) {
+	var z Int256
+	acc, err := intFromFloat64(z[:], 256, f, mode)
+	return z, acc, err
+}
+
+// BigFloat returns a as a new [*big.Float].
+// The precision of the result is 256 bits, so the conversion is always exact.
+func (a Int256) BigFloat() *big.Float {
+	return new(big.Float).SetPrec(256).SetInt(a.BigInt())
+}
+
+// Int256FromBigFloat returns the integer value of x rounded according to mode,
+// and the accuracy of the result: [big.Below] if the result is less than x, [big.Above] if it is greater than x.
+//
+// If the rounded value of x is not representable as an Int256, including ±Inf,
+// the result is clamped to the minimum or maximum value of Int256, and the error is [ErrOverflow].
+func Int256FromBigFloat(x *big.Float, mode big.RoundingMode) (Int256, big.Accuracy, error) {
+	var z Int256
+	acc, err := intFromBigFloat(z[:], 256, x, mode)
+	return z, acc, err
+}
@@ -1,10 +1,15 @@
 package ints
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
 	"math/big"
 	"runtime"
+	"slices"
+	"strconv"
 	"testing"
 )
 
@@ -609,3 +614,958 @@ func TestInt256_Format(t *testing.T) {
 		}
 	}
 }
+
+func TestParseInt256(t *testing.T) {
+	testCases := []struct {
+		s    string
+		base int
+		want Int256
+		err  error
+	}{
+		{"0", 10, Int256{0, 0, 0, 0}, nil},
+		{"1", 10, Int256{0, 0, 0, 0x1}, nil},
+		{"57896044618658097711785492504343953926634992332820282019728792003956564819967", 10, Int256{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}, nil},
+		{"57896044618658097711785492504343953926634992332820282019728792003956564819968", 10, Int256{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}, strconv.ErrRange},
+		{"0x7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", 0, Int256{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}, nil},
+		{"0b101", 0, Int256{0, 0, 0, 0x5}, nil},
+		{"0o17", 0, Int256{0, 0, 0, 0xf}, nil},
+		{"017", 0, Int256{0, 0, 0, 0xf}, nil},
+		{"1_000", 0, Int256{0, 0, 0, 0x3e8}, nil},
+		{"1_000", 10, Int256{0, 0, 0, 0}, strconv.ErrSyntax},
+		{"_1", 0, Int256{0, 0, 0, 0}, strconv.ErrSyntax},
+		{"Z", 62, Int256{0, 0, 0, 0x3d}, nil},
+		{"z", 36, Int256{0, 0, 0, 0x23}, nil},
+		{"Z", 36, Int256{0, 0, 0, 0x23}, nil},
+		{"", 10, Int256{0, 0, 0, 0}, strconv.ErrSyntax},
+		{"12a", 10, Int256{0, 0, 0, 0}, strconv.ErrSyntax},
+		{"-1", 10, Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, nil},
+		{"+1", 10, Int256{0, 0, 0, 0x1}, nil},
+		{"-57896044618658097711785492504343953926634992332820282019728792003956564819968", 10, Int256{0x8000000000000000, 0, 0, 0}, nil},
+		{"-57896044618658097711785492504343953926634992332820282019728792003956564819969", 10, Int256{0x8000000000000000, 0, 0, 0}, strconv.ErrRange},
+		{"-0x8000000000000000000000000000000000000000000000000000000000000000", 0, Int256{0x8000000000000000, 0, 0, 0}, nil},
+		{"-", 10, Int256{0, 0, 0, 0}, strconv.ErrSyntax},
+	}
+
+	for _, tc := range testCases {
+		got, err := ParseInt256(tc.s, tc.base)
+		if got != tc.want {
+			t.Errorf("ParseInt256(%q, %d) = %d, want %d", tc.s, tc.base, got, tc.want)
+		}
+		if !errors.Is(err, tc.err) {
+			t.Errorf("ParseInt256(%q, %d) error = %v, want %v", tc.s, tc.base, err, tc.err)
+		}
+		if err != nil {
+			var numErr *strconv.NumError
+			if !errors.As(err, &numErr) || numErr.Func != "ParseInt256" || numErr.Num != tc.s {
+				t.Errorf("ParseInt256(%q, %d) error = %#v, want *strconv.NumError", tc.s, tc.base, err)
+			}
+		}
+	}
+
+	if _, err := ParseInt256("1", 63); err == nil {
+		t.Errorf("ParseInt256(%q, %d) should fail", "1", 63)
+	}
+}
+
+func FuzzParseInt256(f *testing.F) {
+	f.Add(uint64(0), uint64(0), uint64(0), uint64(0), 10)
+	f.Add(uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), 16)
+	f.Add(uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), 62)
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3 uint64, base int) {
+		if base < 2 || base > 62 {
+			return
+		}
+		a := Int256{u0, u1, u2, u3}
+		s := int256ToBigInt(a).Text(base)
+		got, err := ParseInt256(s, base)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != a {
+			t.Errorf("ParseInt256(%q, %d) = %d, want %d", s, base, got, a)
+		}
+	})
+}
+
+func TestInt256_MarshalText(t *testing.T) {
+	testCases := []struct {
+		x    Int256
+		want string
+	}{
+		{Int256{0, 0, 0, 0}, "0"},
+		{Int256{0, 0, 0, 0x1}, "1"},
+		{Int256{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}, "57896044618658097711785492504343953926634992332820282019728792003956564819967"},
+		{Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, "-1"},
+		{Int256{0x8000000000000000, 0, 0, 0}, "-57896044618658097711785492504343953926634992332820282019728792003956564819968"},
+	}
+
+	for _, tc := range testCases {
+		got, err := tc.x.MarshalText()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != tc.want {
+			t.Errorf("Int256(%d).MarshalText() = %q, want %q", tc.x, got, tc.want)
+		}
+	}
+}
+
+func TestInt256_UnmarshalText(t *testing.T) {
+	testCases := []struct {
+		s    string
+		want Int256
+		err  error
+	}{
+		{"0", Int256{0, 0, 0, 0}, nil},
+		{"0x10", Int256{0, 0, 0, 0x10}, nil},
+		{"0b1_0", Int256{0, 0, 0, 0x2}, nil},
+		{"010", Int256{0, 0, 0, 0x8}, nil},
+		{"57896044618658097711785492504343953926634992332820282019728792003956564819967", Int256{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}, nil},
+		{"57896044618658097711785492504343953926634992332820282019728792003956564819968", Int256{0, 0, 0, 0}, strconv.ErrRange},
+		{"1.0", Int256{0, 0, 0, 0}, strconv.ErrSyntax},
+		{"-0x10", Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xfffffffffffffff0}, nil},
+		{"-57896044618658097711785492504343953926634992332820282019728792003956564819969", Int256{0, 0, 0, 0}, strconv.ErrRange},
+	}
+
+	for _, tc := range testCases {
+		var got Int256
+		err := got.UnmarshalText([]byte(tc.s))
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Int256.UnmarshalText(%q) error = %v, want %v", tc.s, err, tc.err)
+		}
+		if got != tc.want {
+			t.Errorf("Int256.UnmarshalText(%q) = %d, want %d", tc.s, got, tc.want)
+		}
+	}
+}
+
+func TestInt256_MarshalJSON(t *testing.T) {
+	testCases := []struct {
+		x    Int256
+		want string
+	}{
+		{Int256{0, 0, 0, 0}, "0"},
+		{Int256{0, 0, 0, 0x1}, "1"},
+		{Int256{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}, "57896044618658097711785492504343953926634992332820282019728792003956564819967"},
+		{Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, "-1"},
+		{Int256{0x8000000000000000, 0, 0, 0}, "-57896044618658097711785492504343953926634992332820282019728792003956564819968"},
+	}
+
+	for _, tc := range testCases {
+		got, err := json.Marshal(tc.x)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != tc.want {
+			t.Errorf("json.Marshal(Int256(%d)) = %s, want %s", tc.x, got, tc.want)
+		}
+	}
+}
+
+func TestInt256_UnmarshalJSON(t *testing.T) {
+	testCases := []struct {
+		s       string
+		want    Int256
+		wantErr bool
+	}{
+		{"0", Int256{0, 0, 0, 0}, false},
+		{"123", Int256{0, 0, 0, 0x7b}, false},
+		{"\"123\"", Int256{0, 0, 0, 0x7b}, false},
+		{"\"0x7f\"", Int256{0, 0, 0, 0x7f}, false},
+		{"57896044618658097711785492504343953926634992332820282019728792003956564819967", Int256{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false},
+		{"\"57896044618658097711785492504343953926634992332820282019728792003956564819967\"", Int256{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false},
+		{"57896044618658097711785492504343953926634992332820282019728792003956564819968", Int256{0, 0, 0, 0}, true},
+		{"0x7f", Int256{0, 0, 0, 0}, true},
+		{"\"abc\"", Int256{0, 0, 0, 0}, true},
+		{"1.5", Int256{0, 0, 0, 0}, true},
+		{"null", Int256{0, 0, 0, 0}, false},
+		{"-1", Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false},
+		{"\"-1\"", Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false},
+		{"-57896044618658097711785492504343953926634992332820282019728792003956564819968", Int256{0x8000000000000000, 0, 0, 0}, false},
+	}
+
+	for _, tc := range testCases {
+		var got Int256
+		err := json.Unmarshal([]byte(tc.s), &got)
+		if (err != nil) != tc.wantErr {
+			t.Errorf("json.Unmarshal(%s) error = %v, wantErr %t", tc.s, err, tc.wantErr)
+		}
+		if got != tc.want {
+			t.Errorf("json.Unmarshal(%s) = %d, want %d", tc.s, got, tc.want)
+		}
+	}
+}
+
+func TestInt256_Bytes(t *testing.T) {
+	testCases := []struct {
+		x  Int256
+		be [32]byte
+	}{
+		{
+			Int256{0x102030405060708, 0x90a0b0c0d0e0f10, 0x1112131415161718, 0x191a1b1c1d1e1f20},
+			[32]byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10, 0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18, 0x19, 0x1a, 0x1b, 0x1c, 0x1d, 0x1e, 0x1f, 0x20},
+		},
+		{
+			Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xfffffffffffffffe},
+			[32]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xfe},
+		},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.x.BytesBE(); got != tc.be {
+			t.Errorf("Int256(%d).BytesBE() = %x, want %x", tc.x, got, tc.be)
+		}
+		if got := Int256FromBytesBE(tc.be[:]); got != tc.x {
+			t.Errorf("Int256FromBytesBE(%x) = %d, want %d", tc.be, got, tc.x)
+		}
+
+		le := tc.be
+		slices.Reverse(le[:])
+		if got := tc.x.BytesLE(); got != le {
+			t.Errorf("Int256(%d).BytesLE() = %x, want %x", tc.x, got, le)
+		}
+		if got := Int256FromBytesLE(le[:]); got != tc.x {
+			t.Errorf("Int256FromBytesLE(%x) = %d, want %d", le, got, tc.x)
+		}
+
+		data, err := tc.x.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(data, tc.be[:]) {
+			t.Errorf("Int256(%d).MarshalBinary() = %x, want %x", tc.x, data, tc.be)
+		}
+		var got Int256
+		if err := got.UnmarshalBinary(data); err != nil {
+			t.Fatal(err)
+		}
+		if got != tc.x {
+			t.Errorf("Int256.UnmarshalBinary(%x) = %d, want %d", data, got, tc.x)
+		}
+	}
+}
+
+func TestInt256_UnmarshalBinary_InvalidLength(t *testing.T) {
+	var a Int256
+	if err := a.UnmarshalBinary(make([]byte, 31)); err == nil {
+		t.Error("want error, got nil")
+	}
+	if err := a.UnmarshalBinary(make([]byte, 33)); err == nil {
+		t.Error("want error, got nil")
+	}
+}
+
+func TestInt256FromBigInt(t *testing.T) {
+	testCases := []struct {
+		x    *big.Int
+		want Int256
+		ok   bool
+	}{
+		{big.NewInt(0), Int256{0, 0, 0, 0}, true},
+		{big.NewInt(1), Int256{0, 0, 0, 0x1}, true},
+		{bigIntFromString("57896044618658097711785492504343953926634992332820282019728792003956564819967"), Int256{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}, true},
+		{bigIntFromString("57896044618658097711785492504343953926634992332820282019728792003956564819968"), Int256{0x8000000000000000, 0, 0, 0}, false},
+		{bigIntFromString("115792089237316195423570985008687907853269984665640564039457584007913129639936"), Int256{0, 0, 0, 0}, false},
+		{big.NewInt(-1), Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, true},
+		{bigIntFromString("-57896044618658097711785492504343953926634992332820282019728792003956564819968"), Int256{0x8000000000000000, 0, 0, 0}, true},
+		{bigIntFromString("-57896044618658097711785492504343953926634992332820282019728792003956564819969"), Int256{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false},
+	}
+
+	for _, tc := range testCases {
+		got, ok := Int256FromBigInt(tc.x)
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int256FromBigInt(%s) = %d, %t, want %d, %t", tc.x, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func FuzzInt256_BigInt(f *testing.F) {
+	f.Add(uint64(0), uint64(0), uint64(0), uint64(0))
+	f.Add(uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64))
+	f.Add(uint64(1), uint64(0), uint64(0), uint64(0))
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3 uint64) {
+		a := Int256{u0, u1, u2, u3}
+		got := a.BigInt()
+		want := int256ToBigInt(a)
+		if got.Cmp(want) != 0 {
+			t.Errorf("Int256(%d).BigInt() = %s, want %s", a, got, want)
+		}
+		if b, ok := Int256FromBigInt(got); b != a || !ok {
+			t.Errorf("Int256FromBigInt(%s) = %d, %t, want %d, true", got, b, ok, a)
+		}
+	})
+}
+
+func TestInt256FromFloat64(t *testing.T) {
+	testCases := []struct {
+		f    float64
+		mode big.RoundingMode
+		want Int256
+		acc  big.Accuracy
+		err  error
+	}{
+		{0, big.ToNearestEven, Int256{0, 0, 0, 0}, big.Exact, nil},
+		{2.5, big.ToNearestEven, Int256{0, 0, 0, 0x2}, big.Below, nil},
+		{3.5, big.ToNearestEven, Int256{0, 0, 0, 0x4}, big.Above, nil},
+		{2.5, big.ToNearestAway, Int256{0, 0, 0, 0x3}, big.Above, nil},
+		{2.5, big.ToZero, Int256{0, 0, 0, 0x2}, big.Below, nil},
+		{2.5, big.AwayFromZero, Int256{0, 0, 0, 0x3}, big.Above, nil},
+		{2.5, big.ToNegativeInf, Int256{0, 0, 0, 0x2}, big.Below, nil},
+		{2.5, big.ToPositiveInf, Int256{0, 0, 0, 0x3}, big.Above, nil},
+		{math.NaN(), big.ToNearestEven, Int256{0, 0, 0, 0}, big.Exact, ErrNaN},
+		{math.Inf(1), big.ToNearestEven, Int256{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}, big.Below, ErrOverflow},
+		{math.Inf(-1), big.ToNearestEven, Int256{0x8000000000000000, 0, 0, 0}, big.Above, ErrOverflow},
+		{0x1p256, big.ToNearestEven, Int256{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}, big.Below, ErrOverflow},
+		{0x1p255, big.ToNearestEven, Int256{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}, big.Below, ErrOverflow},
+		{-2.5, big.ToNearestEven, Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xfffffffffffffffe}, big.Above, nil},
+		{-2.5, big.ToZero, Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xfffffffffffffffe}, big.Above, nil},
+		{-2.5, big.AwayFromZero, Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xfffffffffffffffd}, big.Below, nil},
+		{-2.5, big.ToNegativeInf, Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xfffffffffffffffd}, big.Below, nil},
+		{-0x1p255, big.ToNearestEven, Int256{0x8000000000000000, 0, 0, 0}, big.Exact, nil},
+	}
+
+	for _, tc := range testCases {
+		got, acc, err := Int256FromFloat64(tc.f, tc.mode)
+		if got != tc.want || acc != tc.acc || err != tc.err {
+			t.Errorf("Int256FromFloat64(%v, %s) = %d, %s, %v, want %d, %s, %v", tc.f, tc.mode, got, acc, err, tc.want, tc.acc, tc.err)
+		}
+
+		if math.IsNaN(tc.f) {
+			continue
+		}
+		got, acc, err = Int256FromBigFloat(big.NewFloat(tc.f), tc.mode)
+		if got != tc.want || acc != tc.acc || err != tc.err {
+			t.Errorf("Int256FromBigFloat(%v, %s) = %d, %s, %v, want %d, %s, %v", tc.f, tc.mode, got, acc, err, tc.want, tc.acc, tc.err)
+		}
+	}
+}
+
+func FuzzInt256_Float64(f *testing.F) {
+	f.Add(uint64(0), uint64(0), uint64(0), uint64(0))
+	f.Add(uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64))
+	f.Add(uint64(0), uint64(0), uint64(0), uint64(1<<53+1))
+	f.Add(uint64(0), uint64(0), uint64(1), uint64(1<<11|1<<10))
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3 uint64) {
+		a := Int256{u0, u1, u2, u3}
+		b := int256ToBigInt(a)
+		got, acc := a.Float64()
+		want, wantAcc := new(big.Float).SetInt(b).Float64()
+		if got != want || acc != wantAcc {
+			t.Errorf("Int256(%d).Float64() = %v, %s, want %v, %s", a, got, acc, want, wantAcc)
+		}
+
+		bf := a.BigFloat()
+		if c, _ := bf.Int(nil); c.Cmp(b) != 0 {
+			t.Errorf("Int256(%d).BigFloat() = %v, want %v", a, bf, b)
+		}
+		if c, acc, err := Int256FromBigFloat(bf, big.ToNearestEven); c != a || acc != big.Exact || err != nil {
+			t.Errorf("Int256FromBigFloat(%v) = %d, %s, %v, want %d, %s, nil", bf, c, acc, err, a, big.Exact)
+		}
+	})
+}
+
+func FuzzInt256FromFloat64(f *testing.F) {
+	f.Add(0.0, uint8(big.ToNearestEven))
+	f.Add(-2.5, uint8(big.ToNearestEven))
+	f.Add(0x1p255, uint8(big.ToZero))
+	f.Add(0x1.fffffffffffffp255, uint8(big.ToPositiveInf))
+
+	f.Fuzz(func(t *testing.T, x float64, mode uint8) {
+		if math.IsNaN(x) || mode > uint8(big.ToPositiveInf) {
+			return
+		}
+		m := big.RoundingMode(mode)
+		got, acc, err := Int256FromFloat64(x, m)
+		want, wantAcc, wantErr := Int256FromBigFloat(big.NewFloat(x), m)
+		if got != want || acc != wantAcc || err != wantErr {
+			t.Errorf("Int256FromFloat64(%v, %s) = %d, %s, %v, want %d, %s, %v", x, m, got, acc, err, want, wantAcc, wantErr)
+		}
+	})
+}
+
+func FuzzInt256_AddOverflow(f *testing.F) {
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(0), uint64(0), uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(0), uint64(0), uint64(0), uint64(1),
+	)
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(1),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(0), uint64(1), uint64(0), uint64(0),
+		uint64(0), uint64(1), uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0), uint64(0), uint64(0),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, v0, v1, v2, v3 uint64) {
+		a := Int256{u0, u1, u2, u3}
+		b := Int256{v0, v1, v2, v3}
+		got, overflow := a.AddOverflow(b)
+
+		want, ok := Int256FromBigInt(new(big.Int).Add(int256ToBigInt(a), int256ToBigInt(b)))
+		if got != want || overflow == ok {
+			t.Errorf("Int256(%d).AddOverflow(%d) = %d, %t, want %d, %t", a, b, got, overflow, want, !ok)
+		}
+	})
+}
+
+func FuzzInt256_SubOverflow(f *testing.F) {
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(0), uint64(0), uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(0), uint64(0), uint64(0), uint64(1),
+	)
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(1),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(0), uint64(1), uint64(0), uint64(0),
+		uint64(0), uint64(1), uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0), uint64(0), uint64(0),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, v0, v1, v2, v3 uint64) {
+		a := Int256{u0, u1, u2, u3}
+		b := Int256{v0, v1, v2, v3}
+		got, overflow := a.SubOverflow(b)
+
+		want, ok := Int256FromBigInt(new(big.Int).Sub(int256ToBigInt(a), int256ToBigInt(b)))
+		if got != want || overflow == ok {
+			t.Errorf("Int256(%d).SubOverflow(%d) = %d, %t, want %d, %t", a, b, got, overflow, want, !ok)
+		}
+	})
+}
+
+func FuzzInt256_MulOverflow(f *testing.F) {
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(0), uint64(0), uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(0), uint64(0), uint64(0), uint64(1),
+	)
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(1),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(0), uint64(1), uint64(0), uint64(0),
+		uint64(0), uint64(1), uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0), uint64(0), uint64(0),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, v0, v1, v2, v3 uint64) {
+		a := Int256{u0, u1, u2, u3}
+		b := Int256{v0, v1, v2, v3}
+		got, overflow := a.MulOverflow(b)
+
+		want, ok := Int256FromBigInt(new(big.Int).Mul(int256ToBigInt(a), int256ToBigInt(b)))
+		if got != want || overflow == ok {
+			t.Errorf("Int256(%d).MulOverflow(%d) = %d, %t, want %d, %t", a, b, got, overflow, want, !ok)
+		}
+	})
+}
+
+func TestInt256_NegOverflow(t *testing.T) {
+	testCases := []struct {
+		x        Int256
+		want     Int256
+		overflow bool
+	}{
+		{Int256{0, 0, 0, 0}, Int256{0, 0, 0, 0}, false},
+		{Int256{0, 0, 0, 0x1}, Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false},
+		{Int256{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Int256{0x8000000000000000, 0, 0, 0x1}, false},
+		{Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Int256{0, 0, 0, 0x1}, false},
+		{Int256{0x8000000000000000, 0, 0, 0}, Int256{0x8000000000000000, 0, 0, 0}, true},
+	}
+
+	for _, tc := range testCases {
+		got, overflow := tc.x.NegOverflow()
+		if got != tc.want || overflow != tc.overflow {
+			t.Errorf("Int256(%d).NegOverflow() = %d, %t, want %d, %t", tc.x, got, overflow, tc.want, tc.overflow)
+		}
+	}
+}
+
+func TestInt256_LshOverflow(t *testing.T) {
+	testCases := []struct {
+		x        Int256
+		i        uint
+		want     Int256
+		overflow bool
+	}{
+		{Int256{0, 0, 0, 0x1}, 0, Int256{0, 0, 0, 0x1}, false},
+		{Int256{0, 0, 0, 0x1}, 254, Int256{0x4000000000000000, 0, 0, 0}, false},
+		{Int256{0, 0, 0, 0x1}, 255, Int256{0x8000000000000000, 0, 0, 0}, true},
+		{Int256{0, 0, 0, 0x1}, 256, Int256{0, 0, 0, 0}, true},
+		{Int256{0, 0, 0, 0x3}, 254, Int256{0xc000000000000000, 0, 0, 0}, true},
+		{Int256{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 1, Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xfffffffffffffffe}, true},
+		{Int256{0, 0, 0, 0}, 266, Int256{0, 0, 0, 0}, false},
+		{Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 255, Int256{0x8000000000000000, 0, 0, 0}, false},
+		{Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 256, Int256{0, 0, 0, 0}, true},
+		{Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xfffffffffffffffe}, 254, Int256{0x8000000000000000, 0, 0, 0}, false},
+		{Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xfffffffffffffffd}, 254, Int256{0x4000000000000000, 0, 0, 0}, true},
+	}
+
+	for _, tc := range testCases {
+		got, overflow := tc.x.LshOverflow(tc.i)
+		if got != tc.want || overflow != tc.overflow {
+			t.Errorf("Int256(%d).LshOverflow(%d) = %d, %t, want %d, %t", tc.x, tc.i, got, overflow, tc.want, tc.overflow)
+		}
+	}
+}
+
+func FuzzInt256_SaturatingAdd(f *testing.F) {
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(0), uint64(0), uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(0), uint64(0), uint64(0), uint64(1),
+	)
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(1),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(0), uint64(1), uint64(0), uint64(0),
+		uint64(0), uint64(1), uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0), uint64(0), uint64(0),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0), uint64(0), uint64(0),
+		uint64(1<<63), uint64(0), uint64(0), uint64(0),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, v0, v1, v2, v3 uint64) {
+		a := Int256{u0, u1, u2, u3}
+		b := Int256{v0, v1, v2, v3}
+		got := a.SaturatingAdd(b)
+
+		want := saturateBigInt(new(big.Int).Add(int256ToBigInt(a), int256ToBigInt(b)), 256, true)
+		if int256ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("Int256(%d).SaturatingAdd(%d) = %d, want %d", a, b, got, want)
+		}
+	})
+}
+
+func FuzzInt256_SaturatingSub(f *testing.F) {
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(0), uint64(0), uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(0), uint64(0), uint64(0), uint64(1),
+	)
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(1),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(0), uint64(1), uint64(0), uint64(0),
+		uint64(0), uint64(1), uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0), uint64(0), uint64(0),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0), uint64(0), uint64(0),
+		uint64(1<<63), uint64(0), uint64(0), uint64(0),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, v0, v1, v2, v3 uint64) {
+		a := Int256{u0, u1, u2, u3}
+		b := Int256{v0, v1, v2, v3}
+		got := a.SaturatingSub(b)
+
+		want := saturateBigInt(new(big.Int).Sub(int256ToBigInt(a), int256ToBigInt(b)), 256, true)
+		if int256ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("Int256(%d).SaturatingSub(%d) = %d, want %d", a, b, got, want)
+		}
+	})
+}
+
+func FuzzInt256_SaturatingMul(f *testing.F) {
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(0), uint64(0), uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(0), uint64(0), uint64(0), uint64(1),
+	)
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(1),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(0), uint64(1), uint64(0), uint64(0),
+		uint64(0), uint64(1), uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0), uint64(0), uint64(0),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0), uint64(0), uint64(0),
+		uint64(1<<63), uint64(0), uint64(0), uint64(0),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, v0, v1, v2, v3 uint64) {
+		a := Int256{u0, u1, u2, u3}
+		b := Int256{v0, v1, v2, v3}
+		got := a.SaturatingMul(b)
+
+		want := saturateBigInt(new(big.Int).Mul(int256ToBigInt(a), int256ToBigInt(b)), 256, true)
+		if int256ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("Int256(%d).SaturatingMul(%d) = %d, want %d", a, b, got, want)
+		}
+	})
+}
+
+func FuzzInt256_Abs(f *testing.F) {
+	f.Add(uint64(0), uint64(0), uint64(0), uint64(0))
+	f.Add(uint64(0), uint64(0), uint64(0), uint64(1))
+	f.Add(uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64))
+	f.Add(uint64(1<<63), uint64(0), uint64(0), uint64(0))
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3 uint64) {
+		a := Int256{u0, u1, u2, u3}
+
+		abs := new(big.Int).Abs(int256ToBigInt(a))
+		// Abs wraps around if a is the minimum value.
+		if got, want := a.Abs(), wrapInt256(abs); got != want {
+			t.Errorf("Int256(%d).Abs() = %d, want %d", a, got, want)
+		}
+		if got, want := a.SaturatingAbs(), saturateBigInt(abs, 256, true); int256ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("Int256(%d).SaturatingAbs() = %d, want %d", a, got, want)
+		}
+
+		neg := new(big.Int).Neg(int256ToBigInt(a))
+		if got, want := a.SaturatingNeg(), saturateBigInt(neg, 256, true); int256ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("Int256(%d).SaturatingNeg() = %d, want %d", a, got, want)
+		}
+	})
+}
+
+func wrapInt256(x *big.Int) Int256 {
+	v, _ := Int256FromBigInt(x)
+	return v
+}
+
+func TestMinMaxInt256(t *testing.T) {
+	max := new(big.Int).Lsh(big.NewInt(1), 255)
+	min := new(big.Int).Neg(max)
+	max.Sub(max, big.NewInt(1))
+	if got := int256ToBigInt(MaxInt256); got.Cmp(max) != 0 {
+		t.Errorf("MaxInt256 = %d, want %d", got, max)
+	}
+	if got := int256ToBigInt(MinInt256); got.Cmp(min) != 0 {
+		t.Errorf("MinInt256 = %d, want %d", got, min)
+	}
+}
+
+func TestInt256FromInt64(t *testing.T) {
+	testCases := []struct {
+		v    int64
+		want Int256
+	}{
+		{0, Int256{0, 0, 0, 0}},
+		{1, Int256{0, 0, 0, 0x1}},
+		{-1, Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{math.MaxInt64, Int256{0, 0, 0, 0x7fffffffffffffff}},
+		{math.MinInt64, Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, 0x8000000000000000}},
+	}
+
+	for _, tc := range testCases {
+		got := Int256FromInt64(tc.v)
+		if got != tc.want {
+			t.Errorf("Int256FromInt64(%d) = %d, want %d", tc.v, got, tc.want)
+		}
+	}
+}
+
+func TestInt256Of(t *testing.T) {
+	testCases := []struct {
+		v    string
+		got  Int256
+		want Int256
+	}{
+		{"int8(-1)", Int256Of(int8(-1)), Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{"int(42)", Int256Of(int(42)), Int256{0, 0, 0, 0x2a}},
+		{"uint64(math.MaxUint64)", Int256Of(uint64(math.MaxUint64)), Int256{0, 0, 0, math.MaxUint64}},
+		{"int64(math.MinInt64)", Int256Of(int64(math.MinInt64)), Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, 0x8000000000000000}},
+		{"uintptr(7)", Int256Of(uintptr(7)), Int256{0, 0, 0, 0x7}},
+	}
+
+	for _, tc := range testCases {
+		if tc.got != tc.want {
+			t.Errorf("Int256Of(%s) = %d, want %d", tc.v, tc.got, tc.want)
+		}
+	}
+}
+
+func TestInt256_IsInt64(t *testing.T) {
+	testCases := []struct {
+		a        Int256
+		isInt64  bool
+		isUint64 bool
+		i64      int64
+		u64      uint64
+	}{
+		{Int256{0x8000000000000000, 0, 0, 0}, false, false, 0, 0},
+		{Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, 0x7fffffffffffffff}, false, false, 9223372036854775807, 9223372036854775807},
+		{Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, 0x8000000000000000}, true, false, math.MinInt64, 9223372036854775808},
+		{Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, true, false, -1, math.MaxUint64},
+		{Int256{0, 0, 0, 0}, true, true, 0, 0},
+		{Int256{0, 0, 0, 0x1}, true, true, 1, 1},
+		{Int256{0, 0, 0, 0x7fffffffffffffff}, true, true, 9223372036854775807, 9223372036854775807},
+		{Int256{0, 0, 0, 0x8000000000000000}, false, true, math.MinInt64, 9223372036854775808},
+		{Int256{0, 0, 0, math.MaxUint64}, false, true, -1, math.MaxUint64},
+		{Int256{0, 0, 0x1, 0}, false, false, 0, 0},
+		{Int256{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false, false, -1, math.MaxUint64},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.a.IsInt64(); got != tc.isInt64 {
+			t.Errorf("Int256(%d).IsInt64() = %t, want %t", tc.a, got, tc.isInt64)
+		}
+		if got := tc.a.IsUint64(); got != tc.isUint64 {
+			t.Errorf("Int256(%d).IsUint64() = %t, want %t", tc.a, got, tc.isUint64)
+		}
+
+		got, ok := tc.a.Int()
+		want, wantOK := int(tc.i64), tc.isInt64 && int64(int(tc.i64)) == tc.i64
+		if got != want || ok != wantOK {
+			t.Errorf("Int256(%d).Int() = %d, %t, want %d, %t", tc.a, got, ok, want, wantOK)
+		}
+
+		gotU, ok := tc.a.Uint()
+		wantU, wantOK := uint(tc.u64), tc.isUint64 && uint64(uint(tc.u64)) == tc.u64
+		if gotU != wantU || ok != wantOK {
+			t.Errorf("Int256(%d).Uint() = %d, %t, want %d, %t", tc.a, gotU, ok, wantU, wantOK)
+		}
+	}
+}
+
+func TestInt256_Bits(t *testing.T) {
+	testCases := []struct {
+		x             Int256
+		leadingZeros  int
+		trailingZeros int
+		bitLen        int
+		onesCount     int
+	}{
+		{Int256{0, 0, 0, 0}, 256, 256, 0, 0},
+		{Int256{0, 0, 0, 0x1}, 255, 0, 1, 1},
+		{Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 0, 0, 1, 256},
+		{Int256{0, 0, 0, 0x2}, 254, 1, 2, 1},
+		{Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xfffffffffffffffe}, 0, 1, 2, 255},
+		{Int256{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 1, 0, 255, 255},
+		{Int256{0x8000000000000000, 0, 0, 0}, 0, 255, 256, 1},
+		{Int256{0x8000000000000000, 0, 0, 0x1}, 0, 0, 255, 2},
+		{Int256{0, 0x1, 0, 0}, 127, 128, 129, 1},
+		{Int256{math.MaxUint64, math.MaxUint64, 0, 0}, 0, 128, 129, 128},
+		{Int256{0, 0, 0, 0x6}, 253, 1, 3, 2},
+		{Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xfffffffffffffffa}, 0, 1, 3, 254},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.x.LeadingZeros(); got != tc.leadingZeros {
+			t.Errorf("Int256(%d).LeadingZeros() = %d, want %d", tc.x, got, tc.leadingZeros)
+		}
+		if got := tc.x.TrailingZeros(); got != tc.trailingZeros {
+			t.Errorf("Int256(%d).TrailingZeros() = %d, want %d", tc.x, got, tc.trailingZeros)
+		}
+		if got := tc.x.BitLen(); got != tc.bitLen {
+			t.Errorf("Int256(%d).BitLen() = %d, want %d", tc.x, got, tc.bitLen)
+		}
+		if got := tc.x.OnesCount(); got != tc.onesCount {
+			t.Errorf("Int256(%d).OnesCount() = %d, want %d", tc.x, got, tc.onesCount)
+		}
+	}
+}
+
+func FuzzInt256_Bit(f *testing.F) {
+	f.Add(uint64(0), uint64(0), uint64(0), uint64(0))
+	f.Add(uint64(0), uint64(0), uint64(0), uint64(1))
+	f.Add(uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64))
+	f.Add(uint64(1<<63), uint64(0), uint64(0), uint64(0))
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3 uint64) {
+		a := Int256{u0, u1, u2, u3}
+		x := int256ToBigInt(a)
+		for i := range 266 {
+			if got, want := a.Bit(i), x.Bit(i); got != want {
+				t.Errorf("Int256(%d).Bit(%d) = %d, want %d", a, i, got, want)
+			}
+		}
+	})
+}
+
+func TestInt256_RshRound(t *testing.T) {
+	values := []Int256{
+		Int256{0, 0, 0, 0},
+		Int256{0, 0, 0, 0x1},
+		Int256{0, 0, 0, 0x2},
+		Int256{0, 0, 0, 0x3},
+		Int256{0, 0, 0, 0x5},
+		Int256{0, 0, 0, 0x6},
+		Int256{0, 0, 0, math.MaxUint64},
+		Int256{0, 0, 0x1, 0x8000000000000000},
+		Int256{0, 0, 0x1, 0x8000000000000001},
+		Int256{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64},
+		Int256{0x8000000000000000, 0, 0, 0},
+		Int256{0x8000000000000000, 0, 0, 0x1},
+		Int256{0xb000000000000000, 0, 0, 0},
+		Int256{0xc000000000000000, 0, 0x1, 0},
+		Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64},
+		Int256{0x3fffffffffffffff, math.MaxUint64, math.MaxUint64, 0},
+		Int256{0x5000000000000000, 0, 0, 0},
+		Int256{math.MaxUint64, math.MaxUint64, 0xfffffffffffffffe, 0x7fffffffffffffff},
+		Int256{math.MaxUint64, math.MaxUint64, 0xfffffffffffffffe, 0x8000000000000000},
+		Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, 0x1},
+		Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xfffffffffffffffa},
+		Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xfffffffffffffffb},
+		Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xfffffffffffffffd},
+		Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xfffffffffffffffe},
+	}
+	shifts := []uint{0, 1, 2, 3, 63, 64, 65, 127, 128, 129, 191, 192, 193, 255, 256, 257, 320}
+
+	for _, a := range values {
+		x := int256ToBigInt(a)
+		for _, i := range shifts {
+			if got, want := a.RshRound(i), rshRoundBigInt(x, i); int256ToBigInt(got).Cmp(want) != 0 {
+				t.Errorf("Int256(%d).RshRound(%d) = %d, want %d", a, i, got, want)
+			}
+			if got, want := a.RshCeil(i), rshCeilBigInt(x, i); int256ToBigInt(got).Cmp(want) != 0 {
+				t.Errorf("Int256(%d).RshCeil(%d) = %d, want %d", a, i, got, want)
+			}
+			if got, want := a.URsh(i), new(big.Int).Rsh(uint256ToBigInt(Uint256(a)), i); uint256ToBigInt(Uint256(got)).Cmp(want) != 0 {
+				t.Errorf("Int256(%d).URsh(%d) = %d, want %d", a, i, got, want)
+			}
+		}
+	}
+}
+
+func FuzzInt256_Mul512(f *testing.F) {
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(0), uint64(0), uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(0), uint64(0), uint64(0), uint64(1),
+	)
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(1),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0), uint64(0), uint64(0),
+		uint64(1<<63), uint64(0), uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0), uint64(0), uint64(0),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, v0, v1, v2, v3 uint64) {
+		a := Int256{u0, u1, u2, u3}
+		b := Int256{v0, v1, v2, v3}
+		got := int512ToBigInt(a.Mul512(b))
+		want := new(big.Int).Mul(int256ToBigInt(a), int256ToBigInt(b))
+		if got.Cmp(want) != 0 {
+			t.Errorf("Int256(%d).Mul512(%d) = %d, want %d", a, b, got, want)
+		}
+	})
+}
+
+func FuzzInt256_Sqrt(f *testing.F) {
+	f.Add(uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint8(2))
+	f.Add(uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x1), uint8(3))
+	f.Add(uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint8(2))
+	f.Add(uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint8(3))
+	f.Add(uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint8(17))
+	f.Add(uint64(0x7fffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint8(7))
+	f.Add(uint64(0x0), uint64(0x0), uint64(0x0), uint64(0xffffffffffffffff), uint8(4))
+	f.Add(uint64(0x1), uint64(0x0), uint64(0x0), uint64(0x0), uint8(63))
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3 uint64, k uint8) {
+		a := Int256{u0, u1, u2, u3}
+		if a.Sign() < 0 {
+			if a.IsPerfectSquare() {
+				t.Errorf("Int256(%d).IsPerfectSquare() = true, want false", a)
+			}
+			return
+		}
+		x := int256ToBigInt(a)
+
+		want := new(big.Int).Sqrt(x)
+		if got := a.Sqrt(); int256ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("Int256(%d).Sqrt() = %d, want %d", a, got, want)
+		}
+		isSquare := new(big.Int).Mul(want, want).Cmp(x) == 0
+		if got := a.IsPerfectSquare(); got != isSquare {
+			t.Errorf("Int256(%d).IsPerfectSquare() = %t, want %t", a, got, isSquare)
+		}
+
+		// r is the floor n-th root of a if r**n <= a < (r+1)**n.
+		for _, n := range []uint{3, uint(k)%256 + 1} {
+			got := a.Root(n)
+			r := int256ToBigInt(got)
+			lo := new(big.Int).Exp(r, big.NewInt(int64(n)), nil)
+			hi := new(big.Int).Exp(r.Add(r, big.NewInt(1)), big.NewInt(int64(n)), nil)
+			if lo.Cmp(x) > 0 || hi.Cmp(x) <= 0 {
+				t.Errorf("Int256(%d).Root(%d) = %d", a, n, got)
+			}
+		}
+		if got, want := a.Cbrt(), a.Root(3); got != want {
+			t.Errorf("Int256(%d).Cbrt() = %d, want %d", a, got, want)
+		}
+	})
+}
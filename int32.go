@@ -2,18 +2,62 @@ package ints
 
 import (
 	"cmp"
+	"encoding/binary"
 	"fmt"
+	"math"
+	"math/big"
+	"math/bits"
 )
 
 // Int32 is a type that represents an 32-bit signed integer.
 // It is an alias for the built-in int32 type.
 type Int32 int32
 
+const (
+	// MaxInt32 is the maximum value of Int32.
+	MaxInt32 Int32 = math.MaxInt32
+
+	// MinInt32 is the minimum value of Int32.
+	MinInt32 Int32 = math.MinInt32
+)
+
+// Int32Of returns v as an Int32.
+// v is converted in the same way as Go's integer conversions.
+func Int32Of[T builtinInteger](v T) Int32 {
+	return Int32(v)
+}
+
 // IsZero returns true if a is zero.
 func (a Int32) IsZero() bool {
 	return a == 0
 }
 
+// IsInt64 reports whether a can be represented as an int64.
+func (a Int32) IsInt64() bool {
+	_, ok := a.TryInt64()
+	return ok
+}
+
+// IsUint64 reports whether a can be represented as a uint64.
+func (a Int32) IsUint64() bool {
+	_, ok := a.TryUint64()
+	return ok
+}
+
+// Int returns a as an int and reports whether a can be represented as an int.
+// If it can't, the result is a truncated to the size of int.
+func (a Int32) Int() (int, bool) {
+	v, ok := a.TryInt64()
+	return int(v), ok && int64(int(v)) == int64(v)
+}
+
+// Uint returns a as a uint and reports whether a can be represented as a uint.
+// If it can't, the result is a truncated to the size of uint.
+func (a Int32) Uint() (uint, bool) {
+	v, ok := a.TryUint64()
+	return uint(v), ok && uint64(uint(v)) == uint64(v)
+}
+
 // Add returns the sum a+b.
 //
 // This function's execution time does not depend on the inputs.
@@ -21,6 +65,27 @@ func (a Int32) Add(b Int32) Int32 {
 	return a + b
 }
 
+// AddOverflow returns the sum a+b and reports whether the addition overflowed.
+//
+// This function's execution time does not depend on the inputs.
+func (a Int32) AddOverflow(b Int32) (Int32, bool) {
+	c := a + b
+	return c, (a^c)&(b^c) < 0
+}
+
+// SaturatingAdd returns the sum a+b.
+// If the result overflows, it is clamped to the minimum or maximum value.
+func (a Int32) SaturatingAdd(b Int32) Int32 {
+	c, overflow := a.AddOverflow(b)
+	if overflow {
+		if a < 0 {
+			return math.MinInt32
+		}
+		return math.MaxInt32
+	}
+	return c
+}
+
 // Sub returns the difference a-b.
 //
 // This function's execution time does not depend on the inputs.
@@ -28,6 +93,27 @@ func (a Int32) Sub(b Int32) Int32 {
 	return a - b
 }
 
+// SubOverflow returns the difference a-b and reports whether the subtraction overflowed.
+//
+// This function's execution time does not depend on the inputs.
+func (a Int32) SubOverflow(b Int32) (Int32, bool) {
+	c := a - b
+	return c, (a^b)&(a^c) < 0
+}
+
+// SaturatingSub returns the difference a-b.
+// If the result overflows, it is clamped to the minimum or maximum value.
+func (a Int32) SaturatingSub(b Int32) Int32 {
+	c, overflow := a.SubOverflow(b)
+	if overflow {
+		if a < 0 {
+			return math.MinInt32
+		}
+		return math.MaxInt32
+	}
+	return c
+}
+
 // Mul returns the product a*b.
 //
 // This function's execution time does not depend on the inputs.
@@ -35,6 +121,32 @@ func (a Int32) Mul(b Int32) Int32 {
 	return a * b
 }
 
+// MulOverflow returns the product a*b and reports whether the multiplication overflowed.
+func (a Int32) MulOverflow(b Int32) (Int32, bool) {
+	c := int64(a) * int64(b)
+	return Int32(c), c != int64(int32(c))
+}
+
+// SaturatingMul returns the product a*b.
+// If the result overflows, it is clamped to the minimum or maximum value.
+func (a Int32) SaturatingMul(b Int32) Int32 {
+	c, overflow := a.MulOverflow(b)
+	if overflow {
+		if (a < 0) != (b < 0) {
+			return math.MinInt32
+		}
+		return math.MaxInt32
+	}
+	return c
+}
+
+// Mul64 returns the product a*b, the result is a 64-bit integer.
+//
+// This function's execution time does not depend on the inputs.
+func (a Int32) Mul64(b Int32) Int64 {
+	return Int64(a) * Int64(b)
+}
+
 // Div returns the quotient a/b for b != 0.
 // If b == 0, a division-by-zero run-time panic occurs.
 // Div implements Euclidean division (unlike Go); see [Int32.DivMod] for more details.
@@ -134,6 +246,13 @@ func (a Int32) Lsh(i uint) Int32 {
 	return a << i
 }
 
+// LshOverflow returns the left shift a<<i and reports whether the shift overflowed,
+// i.e. whether a<<i differs from a multiplied by 2**i.
+func (a Int32) LshOverflow(i uint) (Int32, bool) {
+	c := a << i
+	return c, c>>i != a
+}
+
 // Rsh returns the arithmetic right shift a>>i, preserving the sign bit.
 //
 // This function's execution time does not depend on the inputs.
@@ -141,6 +260,74 @@ func (a Int32) Rsh(i uint) Int32 {
 	return a >> i
 }
 
+// URsh returns the logical right shift a>>i, treating a as an unsigned integer.
+// The vacated upper bits are filled with zeros regardless of the sign of a.
+func (a Int32) URsh(i uint) Int32 {
+	return Int32(Uint32(a).Rsh(i))
+}
+
+// RshRound returns a/2**i rounded to the nearest integer, with ties rounded to even.
+func (a Int32) RshRound(i uint) Int32 {
+	if i == 0 {
+		return a
+	}
+	q := a.Rsh(i)
+	half := a.Bit(int(min(i-1, 32)))
+	sticky := Uint32(a).Extract(0, i-1) != 0
+	if half == 1 && (sticky || q.Bit(0) == 1) {
+		q++
+	}
+	return q
+}
+
+// RshCeil returns a/2**i rounded toward positive infinity.
+func (a Int32) RshCeil(i uint) Int32 {
+	q := a.Rsh(i)
+	if Uint32(a).Extract(0, i) != 0 {
+		q++
+	}
+	return q
+}
+
+// LeadingZeros returns the number of leading zero bits in the two's complement representation of a;
+// the result is 0 for a < 0, and 32 for a == 0.
+func (a Int32) LeadingZeros() int {
+	return bits.LeadingZeros32(uint32(a))
+}
+
+// TrailingZeros returns the number of trailing zero bits in a; the result is 32 for a == 0.
+// a and -a have the same number of trailing zero bits.
+func (a Int32) TrailingZeros() int {
+	return bits.TrailingZeros32(uint32(a))
+}
+
+// BitLen returns the number of bits required to represent the absolute value of a in binary;
+// the result is 0 for a == 0.
+// It is the same as [big.Int.BitLen].
+func (a Int32) BitLen() int {
+	if a < 0 {
+		// the negation of the minimum value is itself,
+		// but it is correctly interpreted as 2**31 in unsigned.
+		a = -a
+	}
+	return bits.Len32(uint32(a))
+}
+
+// OnesCount returns the number of one bits ("population count") in the two's complement representation of a.
+func (a Int32) OnesCount() int {
+	return bits.OnesCount32(uint32(a))
+}
+
+// Bit returns the value of the i'th bit of the two's complement representation of a.
+// That is, it returns (a>>i)&1, and the sign bit for i >= 32, like [big.Int.Bit].
+// The bit index i must be >= 0.
+func (a Int32) Bit(i int) uint {
+	if i < 0 {
+		panic("ints: negative bit index")
+	}
+	return uint(a>>min(uint(i), 31)) & 1
+}
+
 // Sign returns the sign of a.
 // It returns 1 if a > 0, -1 if a < 0, and 0 if a == 0.
 func (a Int32) Sign() int {
@@ -161,6 +348,72 @@ func (a Int32) Neg() Int32 {
 	return -a
 }
 
+// NegOverflow returns the negation of a and reports whether the negation overflowed,
+// which happens only if a is the minimum value.
+//
+// This function's execution time does not depend on the inputs.
+func (a Int32) NegOverflow() (Int32, bool) {
+	return -a, a == -1<<31
+}
+
+// SaturatingNeg returns the negation of a.
+// If a is the minimum value, it returns the maximum value.
+func (a Int32) SaturatingNeg() Int32 {
+	c, overflow := a.NegOverflow()
+	if overflow {
+		return math.MaxInt32
+	}
+	return c
+}
+
+// Abs returns the absolute value of a.
+// If a is the minimum value, Abs returns a itself because its absolute value can't be represented.
+func (a Int32) Abs() Int32 {
+	if a < 0 {
+		return a.Neg()
+	}
+	return a
+}
+
+// SaturatingAbs returns the absolute value of a.
+// If a is the minimum value, it returns the maximum value.
+func (a Int32) SaturatingAbs() Int32 {
+	if a < 0 {
+		return a.SaturatingNeg()
+	}
+	return a
+}
+
+// Sqrt returns the floor square root of a, ⌊√a⌋.
+// It panics if a < 0.
+func (a Int32) Sqrt() Int32 {
+	if a.Sign() < 0 {
+		panic("ints: square root of negative number")
+	}
+	return Int32(Uint32(a).Sqrt())
+}
+
+// Cbrt returns the floor cube root of a, ⌊∛a⌋.
+// It panics if a < 0.
+func (a Int32) Cbrt() Int32 {
+	return a.Root(3)
+}
+
+// Root returns the floor n-th root of a, ⌊a**(1/n)⌋.
+// It panics if a < 0 or n == 0.
+func (a Int32) Root(n uint) Int32 {
+	if a.Sign() < 0 {
+		panic("ints: root of negative number")
+	}
+	return Int32(Uint32(a).Root(n))
+}
+
+// IsPerfectSquare reports whether a is the square of an integer.
+// Negative numbers are never perfect squares.
+func (a Int32) IsPerfectSquare() bool {
+	return a.Sign() >= 0 && Uint32(a).IsPerfectSquare()
+}
+
 // Cmp returns the comparison result of a and b.
 // It returns -1 if a < b, 0 if a == b, and 1 if a > b.
 func (a Int32) Cmp(b Int32) int {
@@ -199,3 +452,174 @@ func (a Int32) Format(s fmt.State, verb rune) {
 	}
 	format(s, verb, sign, b)
 }
+
+// ParseInt32 interprets a string s in the given base (0, 2 to 62) and
+// returns the corresponding value.
+//
+// The string may begin with a leading sign: "+" or "-".
+//
+// If the base argument is 0, the true base is implied by the string's
+// prefix following the sign (if present): 2 for "0b", 8 for "0" or "0o",
+// 16 for "0x", and 10 otherwise. Also, for argument base 0 only,
+// underscore characters are permitted as defined by the Go syntax for
+// [integer literals].
+// For bases <= 36, lower and upper case letters are considered the same:
+// The letters 'a' to 'z' and 'A' to 'Z' represent digit values 10 to 35.
+// For bases > 36, the upper case letters 'A' to 'Z' represent the digit values 36 to 61,
+// as generated by [Int32.Text].
+//
+// The errors that ParseInt32 returns have concrete type [*strconv.NumError]
+// and include err.Num = s. If s is empty or contains invalid
+// digits, err.Err = [strconv.ErrSyntax] and the returned value is 0;
+// if the value corresponding to s cannot be represented by an Int32,
+// err.Err = [strconv.ErrRange] and the returned value is the maximum magnitude integer
+// of the appropriate sign.
+//
+// [integer literals]: https://go.dev/ref/spec#Integer_literals
+func ParseInt32(s string, base int) (Int32, error) {
+	var z [1]uint64
+	err := parseInt("ParseInt32", s, base, 32, z[:])
+	return Int32(z[0]), err
+}
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+// The result is the decimal representation of a.
+func (a Int32) MarshalText() ([]byte, error) {
+	return a.AppendText(nil)
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+// It accepts the same syntax as Go integer literals, such as "0x" prefixes and underscores.
+// See [ParseInt32] for more details.
+func (a *Int32) UnmarshalText(text []byte) error {
+	v, err := ParseInt32(string(text), 0)
+	if err != nil {
+		return err
+	}
+	*a = v
+	return nil
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+// The result is a JSON number. Use [JSONString] to encode a as a JSON string.
+func (a Int32) MarshalJSON() ([]byte, error) {
+	return a.AppendText(nil)
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+// It accepts both a JSON number such as 123 and a JSON string such as "123" or "0x7b".
+// JSON strings are parsed in the same way as [Int32.UnmarshalText].
+func (a *Int32) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(a, data, ParseInt32)
+}
+
+// BytesBE returns the two's complement big-endian representation of a.
+func (a Int32) BytesBE() [4]byte {
+	var b [4]byte
+	a.PutBytesBE(b[:])
+	return b
+}
+
+// BytesLE returns the two's complement little-endian representation of a.
+func (a Int32) BytesLE() [4]byte {
+	var b [4]byte
+	a.PutBytesLE(b[:])
+	return b
+}
+
+// PutBytesBE stores the two's complement big-endian representation of a into b.
+// It panics if len(b) < 4.
+func (a Int32) PutBytesBE(b []byte) {
+	binary.BigEndian.PutUint32(b, uint32(a))
+}
+
+// PutBytesLE stores the two's complement little-endian representation of a into b.
+// It panics if len(b) < 4.
+func (a Int32) PutBytesLE(b []byte) {
+	binary.LittleEndian.PutUint32(b, uint32(a))
+}
+
+// Int32FromBytesBE returns the Int32 represented by the two's complement big-endian bytes b[:4].
+// It panics if len(b) < 4.
+func Int32FromBytesBE(b []byte) Int32 {
+	return Int32(binary.BigEndian.Uint32(b))
+}
+
+// Int32FromBytesLE returns the Int32 represented by the two's complement little-endian bytes b[:4].
+// It panics if len(b) < 4.
+func Int32FromBytesLE(b []byte) Int32 {
+	return Int32(binary.LittleEndian.Uint32(b))
+}
+
+// AppendBinary implements the [encoding.BinaryAppender] interface.
+// It appends the two's complement big-endian representation of a to dst.
+func (a Int32) AppendBinary(dst []byte) ([]byte, error) {
+	b := a.BytesBE()
+	return append(dst, b[:]...), nil
+}
+
+// MarshalBinary implements the [encoding.BinaryMarshaler] interface.
+// The result is the 4-byte two's complement big-endian representation of a.
+func (a Int32) MarshalBinary() ([]byte, error) {
+	return a.AppendBinary(make([]byte, 0, 4))
+}
+
+// UnmarshalBinary implements the [encoding.BinaryUnmarshaler] interface.
+// data must be exactly 4 bytes of the two's complement big-endian representation, as generated by [Int32.MarshalBinary].
+func (a *Int32) UnmarshalBinary(data []byte) error {
+	if len(data) != 4 {
+		return fmt.Errorf("ints: Int32.UnmarshalBinary: invalid length %d, want 4", len(data))
+	}
+	*a = Int32FromBytesBE(data)
+	return nil
+}
+
+// BigInt returns a as a new [*big.Int].
+func (a Int32) BigInt() *big.Int {
+	return new(big.Int).SetInt64(int64(a))
+}
+
+// Int32FromBigInt returns x as an Int32.
+// If x is not representable as an Int32, the result is the lower 32 bits of x in two's complement,
+// and ok is false.
+func Int32FromBigInt(x *big.Int) (Int32, bool) {
+	var z [1]uint64
+	ok := intFromBigInt(z[:], 32, x)
+	return Int32(z[0]), ok
+}
+
+// Float64 returns the float64 value nearest to a, rounding ties to even, and an indication of any rounding error.
+// If a is too large to be represented by a float64, the result is ±Inf.
+func (a Int32) Float64() (float64, big.Accuracy) {
+	return float64(a), big.Exact
+}
+
+// Int32FromFloat64 returns the integer value of f rounded according to mode,
+// and the accuracy of the result: [big.Below] if the result is less than f, [big.Above] if it is greater than f.
+// Use [big.ToZero] to truncate f like the Go conversion, and [big.ToNearestEven] to round f to the nearest integer.
+//
+// If f is NaN, the result is 0 and the error is [ErrNaN].
+// If the rounded value of f is not representable as an Int32, including ±Inf,
+// the result is clamped to the minimum or maximum value of Int32, and the error is [ErrOverflow].
+func Int32FromFloat64(f float64, mode big.RoundingMode) (Int32, big.Accuracy, error) {
+	var z [1]uint64
+	acc, err := intFromFloat64(z[:], 32, f, mode)
+	return Int32(z[0]), acc, err
+}
+
+// BigFloat returns a as a new [*big.Float].
+// The precision of the result is 32 bits, so the conversion is always exact.
+func (a Int32) BigFloat() *big.Float {
+	return new(big.Float).SetPrec(32).SetInt64(int64(a))
+}
+
+// Int32FromBigFloat returns the integer value of x rounded according to mode,
+// and the accuracy of the result: [big.Below] if the result is less than x, [big.Above] if it is greater than x.
+//
+// If the rounded value of x is not representable as an Int32, including ±Inf,
+// the result is clamped to the minimum or maximum value of Int32, and the error is [ErrOverflow].
+func Int32FromBigFloat(x *big.Float, mode big.RoundingMode) (Int32, big.Accuracy, error) {
+	var z [1]uint64
+	acc, err := intFromBigFloat(z[:], 32, x, mode)
+	return Int32(z[0]), acc, err
+}
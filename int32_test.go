@@ -1,9 +1,14 @@
 package ints
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
 	"math/big"
+	"slices"
+	"strconv"
 	"testing"
 )
 
@@ -442,3 +447,792 @@ func TestInt32_Format(t *testing.T) {
 		}
 	}
 }
+
+func TestParseInt32(t *testing.T) {
+	testCases := []struct {
+		s    string
+		base int
+		want Int32
+		err  error
+	}{
+		{"0", 10, 0, nil},
+		{"1", 10, 1, nil},
+		{"2147483647", 10, 2147483647, nil},
+		{"2147483648", 10, 2147483647, strconv.ErrRange},
+		{"0x7fffffff", 0, 2147483647, nil},
+		{"0b101", 0, 5, nil},
+		{"0o17", 0, 15, nil},
+		{"017", 0, 15, nil},
+		{"1_000", 0, 1000, nil},
+		{"1_000", 10, 0, strconv.ErrSyntax},
+		{"_1", 0, 0, strconv.ErrSyntax},
+		{"Z", 62, 61, nil},
+		{"z", 36, 35, nil},
+		{"Z", 36, 35, nil},
+		{"", 10, 0, strconv.ErrSyntax},
+		{"12a", 10, 0, strconv.ErrSyntax},
+		{"-1", 10, -1, nil},
+		{"+1", 10, 1, nil},
+		{"-2147483648", 10, -2147483648, nil},
+		{"-2147483649", 10, -2147483648, strconv.ErrRange},
+		{"-0x80000000", 0, -2147483648, nil},
+		{"-", 10, 0, strconv.ErrSyntax},
+	}
+
+	for _, tc := range testCases {
+		got, err := ParseInt32(tc.s, tc.base)
+		if got != tc.want {
+			t.Errorf("ParseInt32(%q, %d) = %d, want %d", tc.s, tc.base, got, tc.want)
+		}
+		if !errors.Is(err, tc.err) {
+			t.Errorf("ParseInt32(%q, %d) error = %v, want %v", tc.s, tc.base, err, tc.err)
+		}
+		if err != nil {
+			var numErr *strconv.NumError
+			if !errors.As(err, &numErr) || numErr.Func != "ParseInt32" || numErr.Num != tc.s {
+				t.Errorf("ParseInt32(%q, %d) error = %#v, want *strconv.NumError", tc.s, tc.base, err)
+			}
+		}
+	}
+
+	if _, err := ParseInt32("1", 63); err == nil {
+		t.Errorf("ParseInt32(%q, %d) should fail", "1", 63)
+	}
+}
+
+func TestInt32_MarshalText(t *testing.T) {
+	testCases := []struct {
+		x    Int32
+		want string
+	}{
+		{0, "0"},
+		{1, "1"},
+		{2147483647, "2147483647"},
+		{-1, "-1"},
+		{-2147483648, "-2147483648"},
+	}
+
+	for _, tc := range testCases {
+		got, err := tc.x.MarshalText()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != tc.want {
+			t.Errorf("Int32(%d).MarshalText() = %q, want %q", tc.x, got, tc.want)
+		}
+	}
+}
+
+func TestInt32_UnmarshalText(t *testing.T) {
+	testCases := []struct {
+		s    string
+		want Int32
+		err  error
+	}{
+		{"0", 0, nil},
+		{"0x10", 16, nil},
+		{"0b1_0", 2, nil},
+		{"010", 8, nil},
+		{"2147483647", 2147483647, nil},
+		{"2147483648", 0, strconv.ErrRange},
+		{"1.0", 0, strconv.ErrSyntax},
+		{"-0x10", -16, nil},
+		{"-2147483649", 0, strconv.ErrRange},
+	}
+
+	for _, tc := range testCases {
+		var got Int32
+		err := got.UnmarshalText([]byte(tc.s))
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Int32.UnmarshalText(%q) error = %v, want %v", tc.s, err, tc.err)
+		}
+		if got != tc.want {
+			t.Errorf("Int32.UnmarshalText(%q) = %d, want %d", tc.s, got, tc.want)
+		}
+	}
+}
+
+func TestInt32_MarshalJSON(t *testing.T) {
+	testCases := []struct {
+		x    Int32
+		want string
+	}{
+		{0, "0"},
+		{1, "1"},
+		{2147483647, "2147483647"},
+		{-1, "-1"},
+		{-2147483648, "-2147483648"},
+	}
+
+	for _, tc := range testCases {
+		got, err := json.Marshal(tc.x)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != tc.want {
+			t.Errorf("json.Marshal(Int32(%d)) = %s, want %s", tc.x, got, tc.want)
+		}
+	}
+}
+
+func TestInt32_UnmarshalJSON(t *testing.T) {
+	testCases := []struct {
+		s       string
+		want    Int32
+		wantErr bool
+	}{
+		{"0", 0, false},
+		{"123", 123, false},
+		{"\"123\"", 123, false},
+		{"\"0x7f\"", 127, false},
+		{"2147483647", 2147483647, false},
+		{"\"2147483647\"", 2147483647, false},
+		{"2147483648", 0, true},
+		{"0x7f", 0, true},
+		{"\"abc\"", 0, true},
+		{"1.5", 0, true},
+		{"null", 0, false},
+		{"-1", -1, false},
+		{"\"-1\"", -1, false},
+		{"-2147483648", -2147483648, false},
+	}
+
+	for _, tc := range testCases {
+		var got Int32
+		err := json.Unmarshal([]byte(tc.s), &got)
+		if (err != nil) != tc.wantErr {
+			t.Errorf("json.Unmarshal(%s) error = %v, wantErr %t", tc.s, err, tc.wantErr)
+		}
+		if got != tc.want {
+			t.Errorf("json.Unmarshal(%s) = %d, want %d", tc.s, got, tc.want)
+		}
+	}
+}
+
+func TestInt32_Bytes(t *testing.T) {
+	testCases := []struct {
+		x  Int32
+		be [4]byte
+	}{
+		{
+			16909060,
+			[4]byte{0x01, 0x02, 0x03, 0x04},
+		},
+		{
+			-2,
+			[4]byte{0xff, 0xff, 0xff, 0xfe},
+		},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.x.BytesBE(); got != tc.be {
+			t.Errorf("Int32(%d).BytesBE() = %x, want %x", tc.x, got, tc.be)
+		}
+		if got := Int32FromBytesBE(tc.be[:]); got != tc.x {
+			t.Errorf("Int32FromBytesBE(%x) = %d, want %d", tc.be, got, tc.x)
+		}
+
+		le := tc.be
+		slices.Reverse(le[:])
+		if got := tc.x.BytesLE(); got != le {
+			t.Errorf("Int32(%d).BytesLE() = %x, want %x", tc.x, got, le)
+		}
+		if got := Int32FromBytesLE(le[:]); got != tc.x {
+			t.Errorf("Int32FromBytesLE(%x) = %d, want %d", le, got, tc.x)
+		}
+
+		data, err := tc.x.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(data, tc.be[:]) {
+			t.Errorf("Int32(%d).MarshalBinary() = %x, want %x", tc.x, data, tc.be)
+		}
+		var got Int32
+		if err := got.UnmarshalBinary(data); err != nil {
+			t.Fatal(err)
+		}
+		if got != tc.x {
+			t.Errorf("Int32.UnmarshalBinary(%x) = %d, want %d", data, got, tc.x)
+		}
+	}
+}
+
+func TestInt32_UnmarshalBinary_InvalidLength(t *testing.T) {
+	var a Int32
+	if err := a.UnmarshalBinary(make([]byte, 3)); err == nil {
+		t.Error("want error, got nil")
+	}
+	if err := a.UnmarshalBinary(make([]byte, 5)); err == nil {
+		t.Error("want error, got nil")
+	}
+}
+
+func TestInt32FromBigInt(t *testing.T) {
+	testCases := []struct {
+		x    *big.Int
+		want Int32
+		ok   bool
+	}{
+		{big.NewInt(0), 0, true},
+		{big.NewInt(1), 1, true},
+		{big.NewInt(2147483647), 2147483647, true},
+		{big.NewInt(2147483648), -2147483648, false},
+		{big.NewInt(4294967296), 0, false},
+		{big.NewInt(-1), -1, true},
+		{big.NewInt(-2147483648), -2147483648, true},
+		{big.NewInt(-2147483649), 2147483647, false},
+	}
+
+	for _, tc := range testCases {
+		got, ok := Int32FromBigInt(tc.x)
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int32FromBigInt(%s) = %d, %t, want %d, %t", tc.x, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt32_BigInt(t *testing.T) {
+	testCases := []Int32{0, 1, 2147483647, -1, -2147483648}
+
+	for _, a := range testCases {
+		got := a.BigInt()
+		if got.String() != a.String() {
+			t.Errorf("Int32(%d).BigInt() = %s, want %s", a, got, a)
+		}
+		if b, ok := Int32FromBigInt(got); b != a || !ok {
+			t.Errorf("Int32FromBigInt(%s) = %d, %t, want %d, true", got, b, ok, a)
+		}
+	}
+}
+
+func TestInt32FromFloat64(t *testing.T) {
+	testCases := []struct {
+		f    float64
+		mode big.RoundingMode
+		want Int32
+		acc  big.Accuracy
+		err  error
+	}{
+		{0, big.ToNearestEven, 0, big.Exact, nil},
+		{2.5, big.ToNearestEven, 2, big.Below, nil},
+		{3.5, big.ToNearestEven, 4, big.Above, nil},
+		{2.5, big.ToNearestAway, 3, big.Above, nil},
+		{2.5, big.ToZero, 2, big.Below, nil},
+		{2.5, big.AwayFromZero, 3, big.Above, nil},
+		{2.5, big.ToNegativeInf, 2, big.Below, nil},
+		{2.5, big.ToPositiveInf, 3, big.Above, nil},
+		{math.NaN(), big.ToNearestEven, 0, big.Exact, ErrNaN},
+		{math.Inf(1), big.ToNearestEven, 2147483647, big.Below, ErrOverflow},
+		{math.Inf(-1), big.ToNearestEven, -2147483648, big.Above, ErrOverflow},
+		{0x1p32, big.ToNearestEven, 2147483647, big.Below, ErrOverflow},
+		{0x1p31, big.ToNearestEven, 2147483647, big.Below, ErrOverflow},
+		{-2.5, big.ToNearestEven, -2, big.Above, nil},
+		{-2.5, big.ToZero, -2, big.Above, nil},
+		{-2.5, big.AwayFromZero, -3, big.Below, nil},
+		{-2.5, big.ToNegativeInf, -3, big.Below, nil},
+		{-0x1p31, big.ToNearestEven, -2147483648, big.Exact, nil},
+	}
+
+	for _, tc := range testCases {
+		got, acc, err := Int32FromFloat64(tc.f, tc.mode)
+		if got != tc.want || acc != tc.acc || err != tc.err {
+			t.Errorf("Int32FromFloat64(%v, %s) = %d, %s, %v, want %d, %s, %v", tc.f, tc.mode, got, acc, err, tc.want, tc.acc, tc.err)
+		}
+
+		if math.IsNaN(tc.f) {
+			continue
+		}
+		got, acc, err = Int32FromBigFloat(big.NewFloat(tc.f), tc.mode)
+		if got != tc.want || acc != tc.acc || err != tc.err {
+			t.Errorf("Int32FromBigFloat(%v, %s) = %d, %s, %v, want %d, %s, %v", tc.f, tc.mode, got, acc, err, tc.want, tc.acc, tc.err)
+		}
+	}
+}
+
+func TestInt32_Float64(t *testing.T) {
+	testCases := []Int32{0, 1, 2147483647, -1, -2147483648}
+
+	for _, a := range testCases {
+		b := a.BigInt()
+		got, acc := a.Float64()
+		want, wantAcc := new(big.Float).SetInt(b).Float64()
+		if got != want || acc != wantAcc {
+			t.Errorf("Int32(%d).Float64() = %v, %s, want %v, %s", a, got, acc, want, wantAcc)
+		}
+		if c, acc, err := Int32FromBigFloat(a.BigFloat(), big.ToNearestEven); c != a || acc != big.Exact || err != nil {
+			t.Errorf("Int32FromBigFloat(%v) = %d, %s, %v, want %d, %s, nil", a.BigFloat(), c, acc, err, a, big.Exact)
+		}
+	}
+}
+
+func TestInt32_AddOverflow(t *testing.T) {
+	testCases := []struct {
+		x, y     Int32
+		want     Int32
+		overflow bool
+	}{
+		{0, 0, 0, false},
+		{1, 1, 2, false},
+		{2147483647, 1, -2147483648, true},
+		{2147483647, 2147483647, -2, true},
+		{-2147483648, 1, -2147483647, false},
+		{2, 1073741823, 1073741825, false},
+		{2, 1073741824, 1073741826, false},
+		{-1, -2147483648, 2147483647, true},
+		{-2147483648, -1, 2147483647, true},
+		{-2147483648, -2147483648, 0, true},
+		{-1, -1, -2, false},
+	}
+
+	for _, tc := range testCases {
+		got, overflow := tc.x.AddOverflow(tc.y)
+		if got != tc.want || overflow != tc.overflow {
+			t.Errorf("Int32(%d).AddOverflow(%d) = %d, %t, want %d, %t", tc.x, tc.y, got, overflow, tc.want, tc.overflow)
+		}
+	}
+}
+
+func TestInt32_SubOverflow(t *testing.T) {
+	testCases := []struct {
+		x, y     Int32
+		want     Int32
+		overflow bool
+	}{
+		{0, 0, 0, false},
+		{1, 1, 0, false},
+		{2147483647, 1, 2147483646, false},
+		{2147483647, 2147483647, 0, false},
+		{-2147483648, 1, 2147483647, true},
+		{2, 1073741823, -1073741821, false},
+		{2, 1073741824, -1073741822, false},
+		{-1, -2147483648, 2147483647, false},
+		{-2147483648, -1, -2147483647, false},
+		{-2147483648, -2147483648, 0, false},
+		{-1, -1, 0, false},
+	}
+
+	for _, tc := range testCases {
+		got, overflow := tc.x.SubOverflow(tc.y)
+		if got != tc.want || overflow != tc.overflow {
+			t.Errorf("Int32(%d).SubOverflow(%d) = %d, %t, want %d, %t", tc.x, tc.y, got, overflow, tc.want, tc.overflow)
+		}
+	}
+}
+
+func TestInt32_MulOverflow(t *testing.T) {
+	testCases := []struct {
+		x, y     Int32
+		want     Int32
+		overflow bool
+	}{
+		{0, 0, 0, false},
+		{1, 1, 1, false},
+		{2147483647, 1, 2147483647, false},
+		{2147483647, 2147483647, 1, true},
+		{-2147483648, 1, -2147483648, false},
+		{2, 1073741823, 2147483646, false},
+		{2, 1073741824, -2147483648, true},
+		{-1, -2147483648, -2147483648, true},
+		{-2147483648, -1, -2147483648, true},
+		{-2147483648, -2147483648, 0, true},
+		{-1, -1, 1, false},
+	}
+
+	for _, tc := range testCases {
+		got, overflow := tc.x.MulOverflow(tc.y)
+		if got != tc.want || overflow != tc.overflow {
+			t.Errorf("Int32(%d).MulOverflow(%d) = %d, %t, want %d, %t", tc.x, tc.y, got, overflow, tc.want, tc.overflow)
+		}
+	}
+}
+
+func TestInt32_NegOverflow(t *testing.T) {
+	testCases := []struct {
+		x        Int32
+		want     Int32
+		overflow bool
+	}{
+		{0, 0, false},
+		{1, -1, false},
+		{2147483647, -2147483647, false},
+		{-1, 1, false},
+		{-2147483648, -2147483648, true},
+	}
+
+	for _, tc := range testCases {
+		got, overflow := tc.x.NegOverflow()
+		if got != tc.want || overflow != tc.overflow {
+			t.Errorf("Int32(%d).NegOverflow() = %d, %t, want %d, %t", tc.x, got, overflow, tc.want, tc.overflow)
+		}
+	}
+}
+
+func TestInt32_LshOverflow(t *testing.T) {
+	testCases := []struct {
+		x        Int32
+		i        uint
+		want     Int32
+		overflow bool
+	}{
+		{1, 0, 1, false},
+		{1, 30, 1073741824, false},
+		{1, 31, -2147483648, true},
+		{1, 32, 0, true},
+		{3, 30, -1073741824, true},
+		{2147483647, 1, -2, true},
+		{0, 42, 0, false},
+		{-1, 31, -2147483648, false},
+		{-1, 32, 0, true},
+		{-2, 30, -2147483648, false},
+		{-3, 30, 1073741824, true},
+	}
+
+	for _, tc := range testCases {
+		got, overflow := tc.x.LshOverflow(tc.i)
+		if got != tc.want || overflow != tc.overflow {
+			t.Errorf("Int32(%d).LshOverflow(%d) = %d, %t, want %d, %t", tc.x, tc.i, got, overflow, tc.want, tc.overflow)
+		}
+	}
+}
+
+func TestInt32_SaturatingAdd(t *testing.T) {
+	testCases := []struct {
+		x, y Int32
+		want Int32
+	}{
+		{0, 0, 0},
+		{1, 2, 3},
+		{2147483647, 1, 2147483647},
+		{2147483647, 2147483647, 2147483647},
+		{-2147483648, 1, -2147483647},
+		{2, 1073741824, 1073741826},
+		{3, 2, 5},
+		{-2147483648, -1, -2147483648},
+		{-1, -2147483648, -2147483648},
+		{-2147483648, -2147483648, -2147483648},
+		{2147483647, -1, 2147483646},
+		{-2, 1073741824, 1073741822},
+	}
+
+	for _, tc := range testCases {
+		got := tc.x.SaturatingAdd(tc.y)
+		if got != tc.want {
+			t.Errorf("Int32(%d).SaturatingAdd(%d) = %d, want %d", tc.x, tc.y, got, tc.want)
+		}
+	}
+}
+
+func TestInt32_SaturatingSub(t *testing.T) {
+	testCases := []struct {
+		x, y Int32
+		want Int32
+	}{
+		{0, 0, 0},
+		{1, 2, -1},
+		{2147483647, 1, 2147483646},
+		{2147483647, 2147483647, 0},
+		{-2147483648, 1, -2147483648},
+		{2, 1073741824, -1073741822},
+		{3, 2, 1},
+		{-2147483648, -1, -2147483647},
+		{-1, -2147483648, 2147483647},
+		{-2147483648, -2147483648, 0},
+		{2147483647, -1, 2147483647},
+		{-2, 1073741824, -1073741826},
+	}
+
+	for _, tc := range testCases {
+		got := tc.x.SaturatingSub(tc.y)
+		if got != tc.want {
+			t.Errorf("Int32(%d).SaturatingSub(%d) = %d, want %d", tc.x, tc.y, got, tc.want)
+		}
+	}
+}
+
+func TestInt32_SaturatingMul(t *testing.T) {
+	testCases := []struct {
+		x, y Int32
+		want Int32
+	}{
+		{0, 0, 0},
+		{1, 2, 2},
+		{2147483647, 1, 2147483647},
+		{2147483647, 2147483647, 2147483647},
+		{-2147483648, 1, -2147483648},
+		{2, 1073741824, 2147483647},
+		{3, 2, 6},
+		{-2147483648, -1, 2147483647},
+		{-1, -2147483648, 2147483647},
+		{-2147483648, -2147483648, 2147483647},
+		{2147483647, -1, -2147483647},
+		{-2, 1073741824, -2147483648},
+	}
+
+	for _, tc := range testCases {
+		got := tc.x.SaturatingMul(tc.y)
+		if got != tc.want {
+			t.Errorf("Int32(%d).SaturatingMul(%d) = %d, want %d", tc.x, tc.y, got, tc.want)
+		}
+	}
+}
+
+func TestInt32_Abs(t *testing.T) {
+	testCases := []struct {
+		x      Int32
+		abs    Int32
+		satAbs Int32
+		satNeg Int32
+	}{
+		{0, 0, 0, 0},
+		{1, 1, 1, -1},
+		{-1, 1, 1, 1},
+		{2147483647, 2147483647, 2147483647, -2147483647},
+		{-2147483648, -2147483648, 2147483647, 2147483647},
+		{-2147483647, 2147483647, 2147483647, 2147483647},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.x.Abs(); got != tc.abs {
+			t.Errorf("Int32(%d).Abs() = %d, want %d", tc.x, got, tc.abs)
+		}
+		if got := tc.x.SaturatingAbs(); got != tc.satAbs {
+			t.Errorf("Int32(%d).SaturatingAbs() = %d, want %d", tc.x, got, tc.satAbs)
+		}
+		if got := tc.x.SaturatingNeg(); got != tc.satNeg {
+			t.Errorf("Int32(%d).SaturatingNeg() = %d, want %d", tc.x, got, tc.satNeg)
+		}
+	}
+}
+
+func TestInt32Of(t *testing.T) {
+	testCases := []struct {
+		v    string
+		got  Int32
+		want Int32
+	}{
+		{"int8(-1)", Int32Of(int8(-1)), -1},
+		{"int(42)", Int32Of(int(42)), 42},
+		{"uint64(math.MaxUint64)", Int32Of(uint64(math.MaxUint64)), -1},
+		{"int64(math.MinInt64)", Int32Of(int64(math.MinInt64)), 0},
+		{"uintptr(7)", Int32Of(uintptr(7)), 7},
+	}
+
+	for _, tc := range testCases {
+		if tc.got != tc.want {
+			t.Errorf("Int32Of(%s) = %d, want %d", tc.v, tc.got, tc.want)
+		}
+	}
+}
+
+func TestInt32_IsInt64(t *testing.T) {
+	testCases := []struct {
+		a        Int32
+		isInt64  bool
+		isUint64 bool
+		i64      int64
+		u64      uint64
+	}{
+		{-2147483648, true, false, -2147483648, 18446744071562067968},
+		{-1, true, false, -1, math.MaxUint64},
+		{0, true, true, 0, 0},
+		{1, true, true, 1, 1},
+		{2147483647, true, true, 2147483647, 2147483647},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.a.IsInt64(); got != tc.isInt64 {
+			t.Errorf("Int32(%d).IsInt64() = %t, want %t", tc.a, got, tc.isInt64)
+		}
+		if got := tc.a.IsUint64(); got != tc.isUint64 {
+			t.Errorf("Int32(%d).IsUint64() = %t, want %t", tc.a, got, tc.isUint64)
+		}
+
+		got, ok := tc.a.Int()
+		want, wantOK := int(tc.i64), tc.isInt64 && int64(int(tc.i64)) == tc.i64
+		if got != want || ok != wantOK {
+			t.Errorf("Int32(%d).Int() = %d, %t, want %d, %t", tc.a, got, ok, want, wantOK)
+		}
+
+		gotU, ok := tc.a.Uint()
+		wantU, wantOK := uint(tc.u64), tc.isUint64 && uint64(uint(tc.u64)) == tc.u64
+		if gotU != wantU || ok != wantOK {
+			t.Errorf("Int32(%d).Uint() = %d, %t, want %d, %t", tc.a, gotU, ok, wantU, wantOK)
+		}
+	}
+}
+
+func TestInt32_Bits(t *testing.T) {
+	testCases := []struct {
+		x             Int32
+		leadingZeros  int
+		trailingZeros int
+		bitLen        int
+		onesCount     int
+	}{
+		{0, 32, 32, 0, 0},
+		{1, 31, 0, 1, 1},
+		{-1, 0, 0, 1, 32},
+		{2, 30, 1, 2, 1},
+		{-2, 0, 1, 2, 31},
+		{2147483647, 1, 0, 31, 31},
+		{-2147483648, 0, 31, 32, 1},
+		{-2147483647, 0, 0, 31, 2},
+		{65536, 15, 16, 17, 1},
+		{-65536, 0, 16, 17, 16},
+		{6, 29, 1, 3, 2},
+		{-6, 0, 1, 3, 30},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.x.LeadingZeros(); got != tc.leadingZeros {
+			t.Errorf("Int32(%d).LeadingZeros() = %d, want %d", tc.x, got, tc.leadingZeros)
+		}
+		if got := tc.x.TrailingZeros(); got != tc.trailingZeros {
+			t.Errorf("Int32(%d).TrailingZeros() = %d, want %d", tc.x, got, tc.trailingZeros)
+		}
+		if got := tc.x.BitLen(); got != tc.bitLen {
+			t.Errorf("Int32(%d).BitLen() = %d, want %d", tc.x, got, tc.bitLen)
+		}
+		if got := tc.x.OnesCount(); got != tc.onesCount {
+			t.Errorf("Int32(%d).OnesCount() = %d, want %d", tc.x, got, tc.onesCount)
+		}
+	}
+}
+
+func TestInt32_Bit(t *testing.T) {
+	testCases := []Int32{
+		0,
+		1,
+		-1,
+		5,
+		-6,
+		-2147483648,
+		2147483647,
+	}
+
+	for _, a := range testCases {
+		x := big.NewInt(int64(a))
+		for i := range 42 {
+			if got, want := a.Bit(i), x.Bit(i); got != want {
+				t.Errorf("Int32(%d).Bit(%d) = %d, want %d", a, i, got, want)
+			}
+		}
+	}
+}
+
+func TestInt32_RshRound(t *testing.T) {
+	values := []Int32{
+		0,
+		1,
+		2,
+		3,
+		5,
+		6,
+		32768,
+		49152,
+		2147483647,
+		-2147483648,
+		-2147483647,
+		-1342177280,
+		-1,
+		-2,
+		-3,
+		-32768,
+		-49152,
+		-5,
+		-6,
+		1342177280,
+	}
+	shifts := []uint{0, 1, 2, 3, 15, 16, 17, 31, 32, 33, 96}
+
+	for _, a := range values {
+		x := big.NewInt(int64(a))
+		for _, i := range shifts {
+			if got, want := a.RshRound(i), rshRoundBigInt(x, i); got.BigInt().Cmp(want) != 0 {
+				t.Errorf("Int32(%d).RshRound(%d) = %d, want %d", a, i, got, want)
+			}
+			if got, want := a.RshCeil(i), rshCeilBigInt(x, i); got.BigInt().Cmp(want) != 0 {
+				t.Errorf("Int32(%d).RshCeil(%d) = %d, want %d", a, i, got, want)
+			}
+			if got, want := a.URsh(i), new(big.Int).Rsh(new(big.Int).SetUint64(uint64(uint32(a))), i); Uint32(got).BigInt().Cmp(want) != 0 {
+				t.Errorf("Int32(%d).URsh(%d) = %d, want %d", a, i, got, want)
+			}
+		}
+	}
+}
+
+func TestInt32_Mul64(t *testing.T) {
+	testCases := []struct {
+		a, b Int32
+		want Int64
+	}{
+		{0, 0, 0},
+		{1, -1, -1},
+		{2147483647, 2147483647, 4611686014132420609},
+		{-2147483648, -2147483648, 4611686018427387904},
+		{-2147483648, 2147483647, -4611686016279904256},
+		{-1, -2147483648, 2147483648},
+		{12345, -678, -8369910},
+	}
+
+	for _, tc := range testCases {
+		got := tc.a.Mul64(tc.b)
+		if got != tc.want {
+			t.Errorf("Int32(%d).Mul64(%d) = %d, want %d", tc.a, tc.b, got, tc.want)
+		}
+	}
+}
+
+func TestInt32_Sqrt(t *testing.T) {
+	testCases := []struct {
+		a        Int32
+		sqrt     Int32
+		cbrt     Int32
+		root5    Int32
+		isSquare bool
+	}{
+		{0, 0, 0, 0, true},
+		{1, 1, 1, 1, true},
+		{2, 1, 1, 1, false},
+		{3, 1, 1, 1, false},
+		{4, 2, 1, 1, true},
+		{15, 3, 2, 1, false},
+		{16, 4, 2, 1, true},
+		{17, 4, 2, 1, false},
+		{26, 5, 2, 1, false},
+		{27, 5, 3, 1, false},
+		{28, 5, 3, 1, false},
+		{1000, 31, 10, 3, false},
+		{1024, 32, 10, 4, true},
+		{19683, 140, 27, 7, false},
+		{12345678, 3513, 231, 26, false},
+		{1073741823, 32767, 1023, 63, false},
+		{2147483646, 46340, 1290, 73, false},
+		{2147483647, 46340, 1290, 73, false},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.a.Sqrt(); got != tc.sqrt {
+			t.Errorf("Int32(%d).Sqrt() = %d, want %d", tc.a, got, tc.sqrt)
+		}
+		if got := tc.a.Cbrt(); got != tc.cbrt {
+			t.Errorf("Int32(%d).Cbrt() = %d, want %d", tc.a, got, tc.cbrt)
+		}
+		if got := tc.a.Root(5); got != tc.root5 {
+			t.Errorf("Int32(%d).Root(5) = %d, want %d", tc.a, got, tc.root5)
+		}
+		if got := tc.a.IsPerfectSquare(); got != tc.isSquare {
+			t.Errorf("Int32(%d).IsPerfectSquare() = %t, want %t", tc.a, got, tc.isSquare)
+		}
+	}
+}
+
+func TestInt32_Sqrt_Panic(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Int32(-4).Sqrt() did not panic")
+		}
+	}()
+	Int32(-4).Sqrt()
+}
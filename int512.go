@@ -2,19 +2,72 @@ package ints
 
 import (
 	"cmp"
+	"encoding/binary"
 	"fmt"
+	"math"
+	"math/big"
 	"math/bits"
 )
 
 // Int512 is a type that represents an 512-bit signed integer.
 type Int512 [8]uint64
 
+var (
+	// MaxInt512 is the maximum value of Int512.
+	MaxInt512 = Int512{math.MaxInt64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+
+	// MinInt512 is the minimum value of Int512.
+	MinInt512 = Int512{1 << 63, 0, 0, 0, 0, 0, 0, 0}
+)
+
+// Int512FromInt64 returns v as an Int512.
+func Int512FromInt64(v int64) Int512 {
+	s := uint64(v >> 63) // sign extension
+	return Int512{s, s, s, s, s, s, s, uint64(v)}
+}
+
+// Int512Of returns v as an Int512.
+// v is converted in the same way as Go's integer conversions:
+// it is sign-extended if T is a signed type, and zero-extended otherwise.
+func Int512Of[T builtinInteger](v T) Int512 {
+	if isSigned[T]() {
+		return Int64(v).Int512()
+	}
+	return Uint64(v).Int512()
+}
+
 // IsZero returns true if a is zero.
 func (a Int512) IsZero() bool {
 	var zero Int512
 	return a == zero
 }
 
+// IsInt64 reports whether a can be represented as an int64.
+func (a Int512) IsInt64() bool {
+	_, ok := a.TryInt64()
+	return ok
+}
+
+// IsUint64 reports whether a can be represented as a uint64.
+func (a Int512) IsUint64() bool {
+	_, ok := a.TryUint64()
+	return ok
+}
+
+// Int returns a as an int and reports whether a can be represented as an int.
+// If it can't, the result is a truncated to the size of int.
+func (a Int512) Int() (int, bool) {
+	v, ok := a.TryInt64()
+	return int(v), ok && int64(int(v)) == int64(v)
+}
+
+// Uint returns a as a uint and reports whether a can be represented as a uint.
+// If it can't, the result is a truncated to the size of uint.
+func (a Int512) Uint() (uint, bool) {
+	v, ok := a.TryUint64()
+	return uint(v), ok && uint64(uint(v)) == uint64(v)
+}
+
 // Add returns the sum a+b.
 //
 // This function's execution time does not depend on the inputs.
@@ -30,6 +83,27 @@ func (a Int512) Add(b Int512) Int512 {
 	return Int512{u0, u1, u2, u3, u4, u5, u6, u7}
 }
 
+// AddOverflow returns the sum a+b and reports whether the addition overflowed.
+//
+// This function's execution time does not depend on the inputs.
+func (a Int512) AddOverflow(b Int512) (Int512, bool) {
+	c := a.Add(b)
+	return c, ((a[0]^c[0])&(b[0]^c[0]))>>63 != 0
+}
+
+// SaturatingAdd returns the sum a+b.
+// If the result overflows, it is clamped to the minimum or maximum value.
+func (a Int512) SaturatingAdd(b Int512) Int512 {
+	c, overflow := a.AddOverflow(b)
+	if overflow {
+		if int64(a[0]) < 0 {
+			return Int512{1 << 63, 0, 0, 0, 0, 0, 0, 0}
+		}
+		return Int512{math.MaxInt64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return c
+}
+
 // Sub returns the difference a-b.
 //
 // This function's execution time does not depend on the inputs.
@@ -45,6 +119,27 @@ func (a Int512) Sub(b Int512) Int512 {
 	return Int512{u0, u1, u2, u3, u4, u5, u6, u7}
 }
 
+// SubOverflow returns the difference a-b and reports whether the subtraction overflowed.
+//
+// This function's execution time does not depend on the inputs.
+func (a Int512) SubOverflow(b Int512) (Int512, bool) {
+	c := a.Sub(b)
+	return c, ((a[0]^b[0])&(a[0]^c[0]))>>63 != 0
+}
+
+// SaturatingSub returns the difference a-b.
+// If the result overflows, it is clamped to the minimum or maximum value.
+func (a Int512) SaturatingSub(b Int512) Int512 {
+	c, overflow := a.SubOverflow(b)
+	if overflow {
+		if int64(a[0]) < 0 {
+			return Int512{1 << 63, 0, 0, 0, 0, 0, 0, 0}
+		}
+		return Int512{math.MaxInt64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return c
+}
+
 // Mul returns the product a*b.
 func (a Int512) Mul(b Int512) Int512 {
 	neg := false
@@ -65,6 +160,64 @@ func (a Int512) Mul(b Int512) Int512 {
 	return c
 }
 
+// MulOverflow returns the product a*b and reports whether the multiplication overflowed.
+func (a Int512) MulOverflow(b Int512) (Int512, bool) {
+	neg := false
+	if a.Sign() < 0 {
+		neg = !neg
+		a = a.Neg()
+	}
+	if b.Sign() < 0 {
+		neg = !neg
+		b = b.Neg()
+	}
+
+	c, overflow := Uint512(a).MulOverflow(Uint512(b))
+
+	// The magnitude of the result must be less than 2**511,
+	// or equal to 2**511 if the result is negative.
+	limit := Uint512{1 << 63, 0, 0, 0, 0, 0, 0, 0}
+	if neg {
+		overflow = overflow || c.Cmp(limit) > 0
+		c = c.Neg()
+	} else {
+		overflow = overflow || c.Cmp(limit) >= 0
+	}
+	return Int512(c), overflow
+}
+
+// SaturatingMul returns the product a*b.
+// If the result overflows, it is clamped to the minimum or maximum value.
+func (a Int512) SaturatingMul(b Int512) Int512 {
+	c, overflow := a.MulOverflow(b)
+	if overflow {
+		if (int64(a[0]) < 0) != (int64(b[0]) < 0) {
+			return Int512{1 << 63, 0, 0, 0, 0, 0, 0, 0}
+		}
+		return Int512{math.MaxInt64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return c
+}
+
+// Mul1024 returns the product a*b, the result is a 1024-bit integer.
+func (a Int512) Mul1024(b Int512) Int1024 {
+	neg := false
+	if a.Sign() < 0 {
+		a = a.Neg()
+		neg = true
+	}
+	if b.Sign() < 0 {
+		b = b.Neg()
+		neg = !neg
+	}
+
+	c := Int1024(Uint512(a).Mul1024(Uint512(b)))
+	if neg {
+		c = c.Neg()
+	}
+	return c
+}
+
 // Div returns the quotient a/b for b != 0.
 // If b == 0, a division-by-zero run-time panic occurs.
 // Div implements Euclidean division (unlike Go); see [Int512.DivMod] for more details.
@@ -255,6 +408,13 @@ func (a Int512) Lsh(i uint) Int512 {
 	}
 }
 
+// LshOverflow returns the left shift a<<i and reports whether the shift overflowed,
+// i.e. whether a<<i differs from a multiplied by 2**i.
+func (a Int512) LshOverflow(i uint) (Int512, bool) {
+	c := a.Lsh(i)
+	return c, c.Rsh(i) != a
+}
+
 // Rsh returns the arithmetic right shift a>>i, preserving the sign bit.
 //
 // This function's execution time does not depend on the inputs.
@@ -297,6 +457,77 @@ func (a Int512) Rsh(i uint) Int512 {
 	}
 }
 
+// URsh returns the logical right shift a>>i, treating a as an unsigned integer.
+// The vacated upper bits are filled with zeros regardless of the sign of a.
+func (a Int512) URsh(i uint) Int512 {
+	return Int512(Uint512(a).Rsh(i))
+}
+
+// RshRound returns a/2**i rounded to the nearest integer, with ties rounded to even.
+func (a Int512) RshRound(i uint) Int512 {
+	if i == 0 {
+		return a
+	}
+	q := a.Rsh(i)
+	half := a.Bit(int(min(i-1, 512)))
+	sticky := !Uint512(a).Extract(0, i-1).IsZero()
+	if half == 1 && (sticky || q.Bit(0) == 1) {
+		q = q.Add(Int512{0, 0, 0, 0, 0, 0, 0, 1})
+	}
+	return q
+}
+
+// RshCeil returns a/2**i rounded toward positive infinity.
+func (a Int512) RshCeil(i uint) Int512 {
+	q := a.Rsh(i)
+	if !Uint512(a).Extract(0, i).IsZero() {
+		q = q.Add(Int512{0, 0, 0, 0, 0, 0, 0, 1})
+	}
+	return q
+}
+
+// LeadingZeros returns the number of leading zero bits in the two's complement representation of a;
+// the result is 0 for a < 0, and 512 for a == 0.
+func (a Int512) LeadingZeros() int {
+	return Uint512(a).LeadingZeros()
+}
+
+// TrailingZeros returns the number of trailing zero bits in a; the result is 512 for a == 0.
+// a and -a have the same number of trailing zero bits.
+func (a Int512) TrailingZeros() int {
+	return Uint512(a).TrailingZeros()
+}
+
+// BitLen returns the number of bits required to represent the absolute value of a in binary;
+// the result is 0 for a == 0.
+// It is the same as [big.Int.BitLen].
+func (a Int512) BitLen() int {
+	if int64(a[0]) < 0 {
+		// the negation of the minimum value is itself,
+		// but it is correctly interpreted as 2**511 in unsigned.
+		a = a.Neg()
+	}
+	return Uint512(a).BitLen()
+}
+
+// OnesCount returns the number of one bits ("population count") in the two's complement representation of a.
+func (a Int512) OnesCount() int {
+	return Uint512(a).OnesCount()
+}
+
+// Bit returns the value of the i'th bit of the two's complement representation of a.
+// That is, it returns (a>>i)&1, and the sign bit for i >= 512, like [big.Int.Bit].
+// The bit index i must be >= 0.
+func (a Int512) Bit(i int) uint {
+	if i < 0 {
+		panic("ints: negative bit index")
+	}
+	if i >= 512 {
+		return uint(a[0] >> 63)
+	}
+	return uint(a[7-i/64]>>(uint(i)%64)) & 1
+}
+
 // Sign returns the sign of a.
 // It returns 1 if a > 0, -1 if a < 0, and 0 if a == 0.
 func (a Int512) Sign() int {
@@ -326,6 +557,73 @@ func (a Int512) Neg() Int512 {
 	return Int512{u0, u1, u2, u3, u4, u5, u6, u7}
 }
 
+// NegOverflow returns the negation of a and reports whether the negation overflowed,
+// which happens only if a is the minimum value.
+//
+// This function's execution time does not depend on the inputs.
+func (a Int512) NegOverflow() (Int512, bool) {
+	c := a.Neg()
+	return c, (a[0]&c[0])>>63 != 0
+}
+
+// SaturatingNeg returns the negation of a.
+// If a is the minimum value, it returns the maximum value.
+func (a Int512) SaturatingNeg() Int512 {
+	c, overflow := a.NegOverflow()
+	if overflow {
+		return Int512{math.MaxInt64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return c
+}
+
+// Abs returns the absolute value of a.
+// If a is the minimum value, Abs returns a itself because its absolute value can't be represented.
+func (a Int512) Abs() Int512 {
+	if int64(a[0]) < 0 {
+		return a.Neg()
+	}
+	return a
+}
+
+// SaturatingAbs returns the absolute value of a.
+// If a is the minimum value, it returns the maximum value.
+func (a Int512) SaturatingAbs() Int512 {
+	if int64(a[0]) < 0 {
+		return a.SaturatingNeg()
+	}
+	return a
+}
+
+// Sqrt returns the floor square root of a, ⌊√a⌋.
+// It panics if a < 0.
+func (a Int512) Sqrt() Int512 {
+	if a.Sign() < 0 {
+		panic("ints: square root of negative number")
+	}
+	return Int512(Uint512(a).Sqrt())
+}
+
+// Cbrt returns the floor cube root of a, ⌊∛a⌋.
+// It panics if a < 0.
+func (a Int512) Cbrt() Int512 {
+	return a.Root(3)
+}
+
+// Root returns the floor n-th root of a, ⌊a**(1/n)⌋.
+// It panics if a < 0 or n == 0.
+func (a Int512) Root(n uint) Int512 {
+	if a.Sign() < 0 {
+		panic("ints: root of negative number")
+	}
+	return Int512(Uint512(a).Root(n))
+}
+
+// IsPerfectSquare reports whether a is the square of an integer.
+// Negative numbers are never perfect squares.
+func (a Int512) IsPerfectSquare() bool {
+	return a.Sign() >= 0 && Uint512(a).IsPerfectSquare()
+}
+
 // Cmp returns the comparison result of a and b.
 // It returns -1 if a < b, 0 if a == b, and 1 if a > b.
 func (a Int512) Cmp(b Int512) int {
@@ -389,3 +687,222 @@ func (a Int512) Format(s fmt.State, verb rune) {
 	}
 	format(s, verb, sign, b)
 }
+
+// ParseInt512 interprets a string s in the given base (0, 2 to 62) and
+// returns the corresponding value.
+//
+// The string may begin with a leading sign: "+" or "-".
+//
+// If the base argument is 0, the true base is implied by the string's
+// prefix following the sign (if present): 2 for "0b", 8 for "0" or "0o",
+// 16 for "0x", and 10 otherwise. Also, for argument base 0 only,
+// underscore characters are permitted as defined by the Go syntax for
+// [integer literals].
+// For bases <= 36, lower and upper case letters are considered the same:
+// The letters 'a' to 'z' and 'A' to 'Z' represent digit values 10 to 35.
+// For bases > 36, the upper case letters 'A' to 'Z' represent the digit values 36 to 61,
+// as generated by [Int512.Text].
+//
+// The errors that ParseInt512 returns have concrete type [*strconv.NumError]
+// and include err.Num = s. If s is empty or contains invalid
+// digits, err.Err = [strconv.ErrSyntax] and the returned value is 0;
+// if the value corresponding to s cannot be represented by an Int512,
+// err.Err = [strconv.ErrRange] and the returned value is the maximum magnitude integer
+// of the appropriate sign.
+//
+// [integer literals]: https://go.dev/ref/spec#Integer_literals
+func ParseInt512(s string, base int) (Int512, error) {
+	var z Int512
+	err := parseInt("ParseInt512", s, base, 512, z[:])
+	return z, err
+}
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+// The result is the decimal representation of a.
+func (a Int512) MarshalText() ([]byte, error) {
+	return a.AppendText(nil)
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+// It accepts the same syntax as Go integer literals, such as "0x" prefixes and underscores.
+// See [ParseInt512] for more details.
+func (a *Int512) UnmarshalText(text []byte) error {
+	v, err := ParseInt512(string(text), 0)
+	if err != nil {
+		return err
+	}
+	*a = v
+	return nil
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+// The result is a JSON number. Use [JSONString] to encode a as a JSON string.
+func (a Int512) MarshalJSON() ([]byte, error) {
+	return a.AppendText(nil)
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+// It accepts both a JSON number such as 123 and a JSON string such as "123" or "0x7b".
+// JSON strings are parsed in the same way as [Int512.UnmarshalText].
+func (a *Int512) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(a, data, ParseInt512)
+}
+
+// BytesBE returns the two's complement big-endian representation of a.
+func (a Int512) BytesBE() [64]byte {
+	var b [64]byte
+	a.PutBytesBE(b[:])
+	return b
+}
+
+// BytesLE returns the two's complement little-endian representation of a.
+func (a Int512) BytesLE() [64]byte {
+	var b [64]byte
+	a.PutBytesLE(b[:])
+	return b
+}
+
+// PutBytesBE stores the two's complement big-endian representation of a into b.
+// It panics if len(b) < 64.
+func (a Int512) PutBytesBE(b []byte) {
+	_ = b[63] // bounds check hint to compiler; see golang.org/issue/14808
+	binary.BigEndian.PutUint64(b[0:], a[0])
+	binary.BigEndian.PutUint64(b[8:], a[1])
+	binary.BigEndian.PutUint64(b[16:], a[2])
+	binary.BigEndian.PutUint64(b[24:], a[3])
+	binary.BigEndian.PutUint64(b[32:], a[4])
+	binary.BigEndian.PutUint64(b[40:], a[5])
+	binary.BigEndian.PutUint64(b[48:], a[6])
+	binary.BigEndian.PutUint64(b[56:], a[7])
+}
+
+// PutBytesLE stores the two's complement little-endian representation of a into b.
+// It panics if len(b) < 64.
+func (a Int512) PutBytesLE(b []byte) {
+	_ = b[63] // bounds check hint to compiler; see golang.org/issue/14808
+	binary.LittleEndian.PutUint64(b[0:], a[7])
+	binary.LittleEndian.PutUint64(b[8:], a[6])
+	binary.LittleEndian.PutUint64(b[16:], a[5])
+	binary.LittleEndian.PutUint64(b[24:], a[4])
+	binary.LittleEndian.PutUint64(b[32:], a[3])
+	binary.LittleEndian.PutUint64(b[40:], a[2])
+	binary.LittleEndian.PutUint64(b[48:], a[1])
+	binary.LittleEndian.PutUint64(b[56:], a[0])
+}
+
+// Int512FromBytesBE returns the Int512 represented by the two's complement big-endian bytes b[:64].
+// It panics if len(b) < 64.
+func Int512FromBytesBE(b []byte) Int512 {
+	_ = b[63] // bounds check hint to compiler; see golang.org/issue/14808
+	return Int512{
+		binary.BigEndian.Uint64(b[0:]),
+		binary.BigEndian.Uint64(b[8:]),
+		binary.BigEndian.Uint64(b[16:]),
+		binary.BigEndian.Uint64(b[24:]),
+		binary.BigEndian.Uint64(b[32:]),
+		binary.BigEndian.Uint64(b[40:]),
+		binary.BigEndian.Uint64(b[48:]),
+		binary.BigEndian.Uint64(b[56:]),
+	}
+}
+
+// Int512FromBytesLE returns the Int512 represented by the two's complement little-endian bytes b[:64].
+// It panics if len(b) < 64.
+func Int512FromBytesLE(b []byte) Int512 {
+	_ = b[63] // bounds check hint to compiler; see golang.org/issue/14808
+	return Int512{
+		binary.LittleEndian.Uint64(b[56:]),
+		binary.LittleEndian.Uint64(b[48:]),
+		binary.LittleEndian.Uint64(b[40:]),
+		binary.LittleEndian.Uint64(b[32:]),
+		binary.LittleEndian.Uint64(b[24:]),
+		binary.LittleEndian.Uint64(b[16:]),
+		binary.LittleEndian.Uint64(b[8:]),
+		binary.LittleEndian.Uint64(b[0:]),
+	}
+}
+
+// AppendBinary implements the [encoding.BinaryAppender] interface.
+// It appends the two's complement big-endian representation of a to dst.
+func (a Int512) AppendBinary(dst []byte) ([]byte, error) {
+	b := a.BytesBE()
+	return append(dst, b[:]...), nil
+}
+
+// MarshalBinary implements the [encoding.BinaryMarshaler] interface.
+// The result is the 64-byte two's complement big-endian representation of a.
+func (a Int512) MarshalBinary() ([]byte, error) {
+	return a.AppendBinary(make([]byte, 0, 64))
+}
+
+// UnmarshalBinary implements the [encoding.BinaryUnmarshaler] interface.
+// data must be exactly 64 bytes of the two's complement big-endian representation, as generated by [Int512.MarshalBinary].
+func (a *Int512) UnmarshalBinary(data []byte) error {
+	if len(data) != 64 {
+		return fmt.Errorf("ints: Int512.UnmarshalBinary: invalid length %d, want 64", len(data))
+	}
+	*a = Int512FromBytesBE(data)
+	return nil
+}
+
+// BigInt returns a as a new [*big.Int].
+func (a Int512) BigInt() *big.Int {
+	if a.Sign() < 0 {
+		a = a.Neg()
+		var w [512 / bits.UintSize]big.Word
+		z := new(big.Int).SetBits(wordsFromLimbs(w[:], a[:]))
+		return z.Neg(z)
+	}
+	var w [512 / bits.UintSize]big.Word
+	return new(big.Int).SetBits(wordsFromLimbs(w[:], a[:]))
+}
+
+// Int512FromBigInt returns x as an Int512.
+// If x is not representable as an Int512, the result is the lower 512 bits of x in two's complement,
+// and ok is false.
+func Int512FromBigInt(x *big.Int) (Int512, bool) {
+	var z Int512
+	ok := intFromBigInt(z[:], 512, x)
+	return z, ok
+}
+
+// Float64 returns the float64 value nearest to a, rounding ties to even, and an indication of any rounding error.
+// If a is too large to be represented by a float64, the result is ±Inf.
+func (a Int512) Float64() (float64, big.Accuracy) {
+	if a.Sign() < 0 {
+		b := Uint512(a).Neg()
+		f, acc := float64FromLimbs(b[:])
+		return -f, -acc
+	}
+	return float64FromLimbs(a[:])
+}
+
+// Int512FromFloat64 returns the integer value of f rounded according to mode,
+// and the accuracy of the result: [big.Below] if the result is less than f, [big.Above] if it is greater than f.
+// Use [big.ToZero] to truncate f like the Go conversion, and [big.ToNearestEven] to round f to the nearest integer.
+//
+// If f is NaN, the result is 0 and the error is [ErrNaN].
+// If the rounded value of f is not representable as an Int512, including ±Inf,
+// the result is clamped to the minimum or maximum value of Int512, and the error is [ErrOverflow].
+func Int512FromFloat64(f float64, mode big.RoundingMode) (Int512, big.Accuracy, error) {
+	var z Int512
+	acc, err := intFromFloat64(z[:], 512, f, mode)
+	return z, acc, err
+}
+
+// BigFloat returns a as a new [*big.Float].
+// The precision of the result is 512 bits, so the conversion is always exact.
+func (a Int512) BigFloat() *big.Float {
+	return new(big.Float).SetPrec(512).SetInt(a.BigInt())
+}
+
+// Int512FromBigFloat returns the integer value of x rounded according to mode,
+// and the accuracy of the result: [big.Below] if the result is less than x, [big.Above] if it is greater than x.
+//
+// If the rounded value of x is not representable as an Int512, including ±Inf,
+// the result is clamped to the minimum or maximum value of Int512, and the error is [ErrOverflow].
+func Int512FromBigFloat(x *big.Float, mode big.RoundingMode) (Int512, big.Accuracy, error) {
+	var z Int512
+	acc, err := intFromBigFloat(z[:], 512, x, mode)
+	return z, acc, err
+}
@@ -1,10 +1,15 @@
 package ints
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
 	"math/big"
 	"runtime"
+	"slices"
+	"strconv"
 	"testing"
 )
 
@@ -673,3 +678,958 @@ func TestInt512_Format(t *testing.T) {
 		}
 	}
 }
+
+func TestParseInt512(t *testing.T) {
+	testCases := []struct {
+		s    string
+		base int
+		want Int512
+		err  error
+	}{
+		{"0", 10, Int512{0, 0, 0, 0, 0, 0, 0, 0}, nil},
+		{"1", 10, Int512{0, 0, 0, 0, 0, 0, 0, 0x1}, nil},
+		{"6703903964971298549787012499102923063739682910296196688861780721860882015036773488400937149083451713845015929093243025426876941405973284973216824503042047", 10, Int512{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, nil},
+		{"6703903964971298549787012499102923063739682910296196688861780721860882015036773488400937149083451713845015929093243025426876941405973284973216824503042048", 10, Int512{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, strconv.ErrRange},
+		{"0x7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", 0, Int512{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, nil},
+		{"0b101", 0, Int512{0, 0, 0, 0, 0, 0, 0, 0x5}, nil},
+		{"0o17", 0, Int512{0, 0, 0, 0, 0, 0, 0, 0xf}, nil},
+		{"017", 0, Int512{0, 0, 0, 0, 0, 0, 0, 0xf}, nil},
+		{"1_000", 0, Int512{0, 0, 0, 0, 0, 0, 0, 0x3e8}, nil},
+		{"1_000", 10, Int512{0, 0, 0, 0, 0, 0, 0, 0}, strconv.ErrSyntax},
+		{"_1", 0, Int512{0, 0, 0, 0, 0, 0, 0, 0}, strconv.ErrSyntax},
+		{"Z", 62, Int512{0, 0, 0, 0, 0, 0, 0, 0x3d}, nil},
+		{"z", 36, Int512{0, 0, 0, 0, 0, 0, 0, 0x23}, nil},
+		{"Z", 36, Int512{0, 0, 0, 0, 0, 0, 0, 0x23}, nil},
+		{"", 10, Int512{0, 0, 0, 0, 0, 0, 0, 0}, strconv.ErrSyntax},
+		{"12a", 10, Int512{0, 0, 0, 0, 0, 0, 0, 0}, strconv.ErrSyntax},
+		{"-1", 10, Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, nil},
+		{"+1", 10, Int512{0, 0, 0, 0, 0, 0, 0, 0x1}, nil},
+		{"-6703903964971298549787012499102923063739682910296196688861780721860882015036773488400937149083451713845015929093243025426876941405973284973216824503042048", 10, Int512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, nil},
+		{"-6703903964971298549787012499102923063739682910296196688861780721860882015036773488400937149083451713845015929093243025426876941405973284973216824503042049", 10, Int512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, strconv.ErrRange},
+		{"-0x80000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000", 0, Int512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, nil},
+		{"-", 10, Int512{0, 0, 0, 0, 0, 0, 0, 0}, strconv.ErrSyntax},
+	}
+
+	for _, tc := range testCases {
+		got, err := ParseInt512(tc.s, tc.base)
+		if got != tc.want {
+			t.Errorf("ParseInt512(%q, %d) = %d, want %d", tc.s, tc.base, got, tc.want)
+		}
+		if !errors.Is(err, tc.err) {
+			t.Errorf("ParseInt512(%q, %d) error = %v, want %v", tc.s, tc.base, err, tc.err)
+		}
+		if err != nil {
+			var numErr *strconv.NumError
+			if !errors.As(err, &numErr) || numErr.Func != "ParseInt512" || numErr.Num != tc.s {
+				t.Errorf("ParseInt512(%q, %d) error = %#v, want *strconv.NumError", tc.s, tc.base, err)
+			}
+		}
+	}
+
+	if _, err := ParseInt512("1", 63); err == nil {
+		t.Errorf("ParseInt512(%q, %d) should fail", "1", 63)
+	}
+}
+
+func FuzzParseInt512(f *testing.F) {
+	f.Add(uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), 10)
+	f.Add(uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), 16)
+	f.Add(uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), 62)
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, u4, u5, u6, u7 uint64, base int) {
+		if base < 2 || base > 62 {
+			return
+		}
+		a := Int512{u0, u1, u2, u3, u4, u5, u6, u7}
+		s := int512ToBigInt(a).Text(base)
+		got, err := ParseInt512(s, base)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != a {
+			t.Errorf("ParseInt512(%q, %d) = %d, want %d", s, base, got, a)
+		}
+	})
+}
+
+func TestInt512_MarshalText(t *testing.T) {
+	testCases := []struct {
+		x    Int512
+		want string
+	}{
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0}, "0"},
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0x1}, "1"},
+		{Int512{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, "6703903964971298549787012499102923063739682910296196688861780721860882015036773488400937149083451713845015929093243025426876941405973284973216824503042047"},
+		{Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, "-1"},
+		{Int512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, "-6703903964971298549787012499102923063739682910296196688861780721860882015036773488400937149083451713845015929093243025426876941405973284973216824503042048"},
+	}
+
+	for _, tc := range testCases {
+		got, err := tc.x.MarshalText()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != tc.want {
+			t.Errorf("Int512(%d).MarshalText() = %q, want %q", tc.x, got, tc.want)
+		}
+	}
+}
+
+func TestInt512_UnmarshalText(t *testing.T) {
+	testCases := []struct {
+		s    string
+		want Int512
+		err  error
+	}{
+		{"0", Int512{0, 0, 0, 0, 0, 0, 0, 0}, nil},
+		{"0x10", Int512{0, 0, 0, 0, 0, 0, 0, 0x10}, nil},
+		{"0b1_0", Int512{0, 0, 0, 0, 0, 0, 0, 0x2}, nil},
+		{"010", Int512{0, 0, 0, 0, 0, 0, 0, 0x8}, nil},
+		{"6703903964971298549787012499102923063739682910296196688861780721860882015036773488400937149083451713845015929093243025426876941405973284973216824503042047", Int512{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, nil},
+		{"6703903964971298549787012499102923063739682910296196688861780721860882015036773488400937149083451713845015929093243025426876941405973284973216824503042048", Int512{0, 0, 0, 0, 0, 0, 0, 0}, strconv.ErrRange},
+		{"1.0", Int512{0, 0, 0, 0, 0, 0, 0, 0}, strconv.ErrSyntax},
+		{"-0x10", Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xfffffffffffffff0}, nil},
+		{"-6703903964971298549787012499102923063739682910296196688861780721860882015036773488400937149083451713845015929093243025426876941405973284973216824503042049", Int512{0, 0, 0, 0, 0, 0, 0, 0}, strconv.ErrRange},
+	}
+
+	for _, tc := range testCases {
+		var got Int512
+		err := got.UnmarshalText([]byte(tc.s))
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Int512.UnmarshalText(%q) error = %v, want %v", tc.s, err, tc.err)
+		}
+		if got != tc.want {
+			t.Errorf("Int512.UnmarshalText(%q) = %d, want %d", tc.s, got, tc.want)
+		}
+	}
+}
+
+func TestInt512_MarshalJSON(t *testing.T) {
+	testCases := []struct {
+		x    Int512
+		want string
+	}{
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0}, "0"},
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0x1}, "1"},
+		{Int512{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, "6703903964971298549787012499102923063739682910296196688861780721860882015036773488400937149083451713845015929093243025426876941405973284973216824503042047"},
+		{Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, "-1"},
+		{Int512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, "-6703903964971298549787012499102923063739682910296196688861780721860882015036773488400937149083451713845015929093243025426876941405973284973216824503042048"},
+	}
+
+	for _, tc := range testCases {
+		got, err := json.Marshal(tc.x)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != tc.want {
+			t.Errorf("json.Marshal(Int512(%d)) = %s, want %s", tc.x, got, tc.want)
+		}
+	}
+}
+
+func TestInt512_UnmarshalJSON(t *testing.T) {
+	testCases := []struct {
+		s       string
+		want    Int512
+		wantErr bool
+	}{
+		{"0", Int512{0, 0, 0, 0, 0, 0, 0, 0}, false},
+		{"123", Int512{0, 0, 0, 0, 0, 0, 0, 0x7b}, false},
+		{"\"123\"", Int512{0, 0, 0, 0, 0, 0, 0, 0x7b}, false},
+		{"\"0x7f\"", Int512{0, 0, 0, 0, 0, 0, 0, 0x7f}, false},
+		{"6703903964971298549787012499102923063739682910296196688861780721860882015036773488400937149083451713845015929093243025426876941405973284973216824503042047", Int512{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false},
+		{"\"6703903964971298549787012499102923063739682910296196688861780721860882015036773488400937149083451713845015929093243025426876941405973284973216824503042047\"", Int512{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false},
+		{"6703903964971298549787012499102923063739682910296196688861780721860882015036773488400937149083451713845015929093243025426876941405973284973216824503042048", Int512{0, 0, 0, 0, 0, 0, 0, 0}, true},
+		{"0x7f", Int512{0, 0, 0, 0, 0, 0, 0, 0}, true},
+		{"\"abc\"", Int512{0, 0, 0, 0, 0, 0, 0, 0}, true},
+		{"1.5", Int512{0, 0, 0, 0, 0, 0, 0, 0}, true},
+		{"null", Int512{0, 0, 0, 0, 0, 0, 0, 0}, false},
+		{"-1", Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false},
+		{"\"-1\"", Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false},
+		{"-6703903964971298549787012499102923063739682910296196688861780721860882015036773488400937149083451713845015929093243025426876941405973284973216824503042048", Int512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, false},
+	}
+
+	for _, tc := range testCases {
+		var got Int512
+		err := json.Unmarshal([]byte(tc.s), &got)
+		if (err != nil) != tc.wantErr {
+			t.Errorf("json.Unmarshal(%s) error = %v, wantErr %t", tc.s, err, tc.wantErr)
+		}
+		if got != tc.want {
+			t.Errorf("json.Unmarshal(%s) = %d, want %d", tc.s, got, tc.want)
+		}
+	}
+}
+
+func TestInt512_Bytes(t *testing.T) {
+	testCases := []struct {
+		x  Int512
+		be [64]byte
+	}{
+		{
+			Int512{0x102030405060708, 0x90a0b0c0d0e0f10, 0x1112131415161718, 0x191a1b1c1d1e1f20, 0x2122232425262728, 0x292a2b2c2d2e2f30, 0x3132333435363738, 0x393a3b3c3d3e3f40},
+			[64]byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10, 0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18, 0x19, 0x1a, 0x1b, 0x1c, 0x1d, 0x1e, 0x1f, 0x20, 0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28, 0x29, 0x2a, 0x2b, 0x2c, 0x2d, 0x2e, 0x2f, 0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3d, 0x3e, 0x3f, 0x40},
+		},
+		{
+			Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xfffffffffffffffe},
+			[64]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xfe},
+		},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.x.BytesBE(); got != tc.be {
+			t.Errorf("Int512(%d).BytesBE() = %x, want %x", tc.x, got, tc.be)
+		}
+		if got := Int512FromBytesBE(tc.be[:]); got != tc.x {
+			t.Errorf("Int512FromBytesBE(%x) = %d, want %d", tc.be, got, tc.x)
+		}
+
+		le := tc.be
+		slices.Reverse(le[:])
+		if got := tc.x.BytesLE(); got != le {
+			t.Errorf("Int512(%d).BytesLE() = %x, want %x", tc.x, got, le)
+		}
+		if got := Int512FromBytesLE(le[:]); got != tc.x {
+			t.Errorf("Int512FromBytesLE(%x) = %d, want %d", le, got, tc.x)
+		}
+
+		data, err := tc.x.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(data, tc.be[:]) {
+			t.Errorf("Int512(%d).MarshalBinary() = %x, want %x", tc.x, data, tc.be)
+		}
+		var got Int512
+		if err := got.UnmarshalBinary(data); err != nil {
+			t.Fatal(err)
+		}
+		if got != tc.x {
+			t.Errorf("Int512.UnmarshalBinary(%x) = %d, want %d", data, got, tc.x)
+		}
+	}
+}
+
+func TestInt512_UnmarshalBinary_InvalidLength(t *testing.T) {
+	var a Int512
+	if err := a.UnmarshalBinary(make([]byte, 63)); err == nil {
+		t.Error("want error, got nil")
+	}
+	if err := a.UnmarshalBinary(make([]byte, 65)); err == nil {
+		t.Error("want error, got nil")
+	}
+}
+
+func TestInt512FromBigInt(t *testing.T) {
+	testCases := []struct {
+		x    *big.Int
+		want Int512
+		ok   bool
+	}{
+		{big.NewInt(0), Int512{0, 0, 0, 0, 0, 0, 0, 0}, true},
+		{big.NewInt(1), Int512{0, 0, 0, 0, 0, 0, 0, 0x1}, true},
+		{bigIntFromString("6703903964971298549787012499102923063739682910296196688861780721860882015036773488400937149083451713845015929093243025426876941405973284973216824503042047"), Int512{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, true},
+		{bigIntFromString("6703903964971298549787012499102923063739682910296196688861780721860882015036773488400937149083451713845015929093243025426876941405973284973216824503042048"), Int512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, false},
+		{bigIntFromString("13407807929942597099574024998205846127479365820592393377723561443721764030073546976801874298166903427690031858186486050853753882811946569946433649006084096"), Int512{0, 0, 0, 0, 0, 0, 0, 0}, false},
+		{big.NewInt(-1), Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, true},
+		{bigIntFromString("-6703903964971298549787012499102923063739682910296196688861780721860882015036773488400937149083451713845015929093243025426876941405973284973216824503042048"), Int512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, true},
+		{bigIntFromString("-6703903964971298549787012499102923063739682910296196688861780721860882015036773488400937149083451713845015929093243025426876941405973284973216824503042049"), Int512{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false},
+	}
+
+	for _, tc := range testCases {
+		got, ok := Int512FromBigInt(tc.x)
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int512FromBigInt(%s) = %d, %t, want %d, %t", tc.x, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func FuzzInt512_BigInt(f *testing.F) {
+	f.Add(uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0))
+	f.Add(uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64))
+	f.Add(uint64(1), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0))
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, u4, u5, u6, u7 uint64) {
+		a := Int512{u0, u1, u2, u3, u4, u5, u6, u7}
+		got := a.BigInt()
+		want := int512ToBigInt(a)
+		if got.Cmp(want) != 0 {
+			t.Errorf("Int512(%d).BigInt() = %s, want %s", a, got, want)
+		}
+		if b, ok := Int512FromBigInt(got); b != a || !ok {
+			t.Errorf("Int512FromBigInt(%s) = %d, %t, want %d, true", got, b, ok, a)
+		}
+	})
+}
+
+func TestInt512FromFloat64(t *testing.T) {
+	testCases := []struct {
+		f    float64
+		mode big.RoundingMode
+		want Int512
+		acc  big.Accuracy
+		err  error
+	}{
+		{0, big.ToNearestEven, Int512{0, 0, 0, 0, 0, 0, 0, 0}, big.Exact, nil},
+		{2.5, big.ToNearestEven, Int512{0, 0, 0, 0, 0, 0, 0, 0x2}, big.Below, nil},
+		{3.5, big.ToNearestEven, Int512{0, 0, 0, 0, 0, 0, 0, 0x4}, big.Above, nil},
+		{2.5, big.ToNearestAway, Int512{0, 0, 0, 0, 0, 0, 0, 0x3}, big.Above, nil},
+		{2.5, big.ToZero, Int512{0, 0, 0, 0, 0, 0, 0, 0x2}, big.Below, nil},
+		{2.5, big.AwayFromZero, Int512{0, 0, 0, 0, 0, 0, 0, 0x3}, big.Above, nil},
+		{2.5, big.ToNegativeInf, Int512{0, 0, 0, 0, 0, 0, 0, 0x2}, big.Below, nil},
+		{2.5, big.ToPositiveInf, Int512{0, 0, 0, 0, 0, 0, 0, 0x3}, big.Above, nil},
+		{math.NaN(), big.ToNearestEven, Int512{0, 0, 0, 0, 0, 0, 0, 0}, big.Exact, ErrNaN},
+		{math.Inf(1), big.ToNearestEven, Int512{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, big.Below, ErrOverflow},
+		{math.Inf(-1), big.ToNearestEven, Int512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, big.Above, ErrOverflow},
+		{0x1p512, big.ToNearestEven, Int512{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, big.Below, ErrOverflow},
+		{0x1p511, big.ToNearestEven, Int512{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, big.Below, ErrOverflow},
+		{-2.5, big.ToNearestEven, Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xfffffffffffffffe}, big.Above, nil},
+		{-2.5, big.ToZero, Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xfffffffffffffffe}, big.Above, nil},
+		{-2.5, big.AwayFromZero, Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xfffffffffffffffd}, big.Below, nil},
+		{-2.5, big.ToNegativeInf, Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xfffffffffffffffd}, big.Below, nil},
+		{-0x1p511, big.ToNearestEven, Int512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, big.Exact, nil},
+	}
+
+	for _, tc := range testCases {
+		got, acc, err := Int512FromFloat64(tc.f, tc.mode)
+		if got != tc.want || acc != tc.acc || err != tc.err {
+			t.Errorf("Int512FromFloat64(%v, %s) = %d, %s, %v, want %d, %s, %v", tc.f, tc.mode, got, acc, err, tc.want, tc.acc, tc.err)
+		}
+
+		if math.IsNaN(tc.f) {
+			continue
+		}
+		got, acc, err = Int512FromBigFloat(big.NewFloat(tc.f), tc.mode)
+		if got != tc.want || acc != tc.acc || err != tc.err {
+			t.Errorf("Int512FromBigFloat(%v, %s) = %d, %s, %v, want %d, %s, %v", tc.f, tc.mode, got, acc, err, tc.want, tc.acc, tc.err)
+		}
+	}
+}
+
+func FuzzInt512_Float64(f *testing.F) {
+	f.Add(uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0))
+	f.Add(uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64))
+	f.Add(uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(1<<53+1))
+	f.Add(uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(1), uint64(1<<11|1<<10))
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, u4, u5, u6, u7 uint64) {
+		a := Int512{u0, u1, u2, u3, u4, u5, u6, u7}
+		b := int512ToBigInt(a)
+		got, acc := a.Float64()
+		want, wantAcc := new(big.Float).SetInt(b).Float64()
+		if got != want || acc != wantAcc {
+			t.Errorf("Int512(%d).Float64() = %v, %s, want %v, %s", a, got, acc, want, wantAcc)
+		}
+
+		bf := a.BigFloat()
+		if c, _ := bf.Int(nil); c.Cmp(b) != 0 {
+			t.Errorf("Int512(%d).BigFloat() = %v, want %v", a, bf, b)
+		}
+		if c, acc, err := Int512FromBigFloat(bf, big.ToNearestEven); c != a || acc != big.Exact || err != nil {
+			t.Errorf("Int512FromBigFloat(%v) = %d, %s, %v, want %d, %s, nil", bf, c, acc, err, a, big.Exact)
+		}
+	})
+}
+
+func FuzzInt512FromFloat64(f *testing.F) {
+	f.Add(0.0, uint8(big.ToNearestEven))
+	f.Add(-2.5, uint8(big.ToNearestEven))
+	f.Add(0x1p511, uint8(big.ToZero))
+	f.Add(0x1.fffffffffffffp511, uint8(big.ToPositiveInf))
+
+	f.Fuzz(func(t *testing.T, x float64, mode uint8) {
+		if math.IsNaN(x) || mode > uint8(big.ToPositiveInf) {
+			return
+		}
+		m := big.RoundingMode(mode)
+		got, acc, err := Int512FromFloat64(x, m)
+		want, wantAcc, wantErr := Int512FromBigFloat(big.NewFloat(x), m)
+		if got != want || acc != wantAcc || err != wantErr {
+			t.Errorf("Int512FromFloat64(%v, %s) = %d, %s, %v, want %d, %s, %v", x, m, got, acc, err, want, wantAcc, wantErr)
+		}
+	})
+}
+
+func FuzzInt512_AddOverflow(f *testing.F) {
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(1),
+	)
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(1),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(1), uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(0), uint64(0), uint64(0), uint64(1), uint64(0), uint64(0), uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, u4, u5, u6, u7, v0, v1, v2, v3, v4, v5, v6, v7 uint64) {
+		a := Int512{u0, u1, u2, u3, u4, u5, u6, u7}
+		b := Int512{v0, v1, v2, v3, v4, v5, v6, v7}
+		got, overflow := a.AddOverflow(b)
+
+		want, ok := Int512FromBigInt(new(big.Int).Add(int512ToBigInt(a), int512ToBigInt(b)))
+		if got != want || overflow == ok {
+			t.Errorf("Int512(%d).AddOverflow(%d) = %d, %t, want %d, %t", a, b, got, overflow, want, !ok)
+		}
+	})
+}
+
+func FuzzInt512_SubOverflow(f *testing.F) {
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(1),
+	)
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(1),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(1), uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(0), uint64(0), uint64(0), uint64(1), uint64(0), uint64(0), uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, u4, u5, u6, u7, v0, v1, v2, v3, v4, v5, v6, v7 uint64) {
+		a := Int512{u0, u1, u2, u3, u4, u5, u6, u7}
+		b := Int512{v0, v1, v2, v3, v4, v5, v6, v7}
+		got, overflow := a.SubOverflow(b)
+
+		want, ok := Int512FromBigInt(new(big.Int).Sub(int512ToBigInt(a), int512ToBigInt(b)))
+		if got != want || overflow == ok {
+			t.Errorf("Int512(%d).SubOverflow(%d) = %d, %t, want %d, %t", a, b, got, overflow, want, !ok)
+		}
+	})
+}
+
+func FuzzInt512_MulOverflow(f *testing.F) {
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(1),
+	)
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(1),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(1), uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(0), uint64(0), uint64(0), uint64(1), uint64(0), uint64(0), uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, u4, u5, u6, u7, v0, v1, v2, v3, v4, v5, v6, v7 uint64) {
+		a := Int512{u0, u1, u2, u3, u4, u5, u6, u7}
+		b := Int512{v0, v1, v2, v3, v4, v5, v6, v7}
+		got, overflow := a.MulOverflow(b)
+
+		want, ok := Int512FromBigInt(new(big.Int).Mul(int512ToBigInt(a), int512ToBigInt(b)))
+		if got != want || overflow == ok {
+			t.Errorf("Int512(%d).MulOverflow(%d) = %d, %t, want %d, %t", a, b, got, overflow, want, !ok)
+		}
+	})
+}
+
+func TestInt512_NegOverflow(t *testing.T) {
+	testCases := []struct {
+		x        Int512
+		want     Int512
+		overflow bool
+	}{
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0}, Int512{0, 0, 0, 0, 0, 0, 0, 0}, false},
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0x1}, Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false},
+		{Int512{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Int512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0x1}, false},
+		{Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Int512{0, 0, 0, 0, 0, 0, 0, 0x1}, false},
+		{Int512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, Int512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, true},
+	}
+
+	for _, tc := range testCases {
+		got, overflow := tc.x.NegOverflow()
+		if got != tc.want || overflow != tc.overflow {
+			t.Errorf("Int512(%d).NegOverflow() = %d, %t, want %d, %t", tc.x, got, overflow, tc.want, tc.overflow)
+		}
+	}
+}
+
+func TestInt512_LshOverflow(t *testing.T) {
+	testCases := []struct {
+		x        Int512
+		i        uint
+		want     Int512
+		overflow bool
+	}{
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0x1}, 0, Int512{0, 0, 0, 0, 0, 0, 0, 0x1}, false},
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0x1}, 510, Int512{0x4000000000000000, 0, 0, 0, 0, 0, 0, 0}, false},
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0x1}, 511, Int512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, true},
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0x1}, 512, Int512{0, 0, 0, 0, 0, 0, 0, 0}, true},
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0x3}, 510, Int512{0xc000000000000000, 0, 0, 0, 0, 0, 0, 0}, true},
+		{Int512{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 1, Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xfffffffffffffffe}, true},
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0}, 522, Int512{0, 0, 0, 0, 0, 0, 0, 0}, false},
+		{Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 511, Int512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, false},
+		{Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 512, Int512{0, 0, 0, 0, 0, 0, 0, 0}, true},
+		{Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xfffffffffffffffe}, 510, Int512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, false},
+		{Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xfffffffffffffffd}, 510, Int512{0x4000000000000000, 0, 0, 0, 0, 0, 0, 0}, true},
+	}
+
+	for _, tc := range testCases {
+		got, overflow := tc.x.LshOverflow(tc.i)
+		if got != tc.want || overflow != tc.overflow {
+			t.Errorf("Int512(%d).LshOverflow(%d) = %d, %t, want %d, %t", tc.x, tc.i, got, overflow, tc.want, tc.overflow)
+		}
+	}
+}
+
+func FuzzInt512_SaturatingAdd(f *testing.F) {
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(1),
+	)
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(1),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(1), uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(0), uint64(0), uint64(0), uint64(1), uint64(0), uint64(0), uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(1<<63), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, u4, u5, u6, u7, v0, v1, v2, v3, v4, v5, v6, v7 uint64) {
+		a := Int512{u0, u1, u2, u3, u4, u5, u6, u7}
+		b := Int512{v0, v1, v2, v3, v4, v5, v6, v7}
+		got := a.SaturatingAdd(b)
+
+		want := saturateBigInt(new(big.Int).Add(int512ToBigInt(a), int512ToBigInt(b)), 512, true)
+		if int512ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("Int512(%d).SaturatingAdd(%d) = %d, want %d", a, b, got, want)
+		}
+	})
+}
+
+func FuzzInt512_SaturatingSub(f *testing.F) {
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(1),
+	)
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(1),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(1), uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(0), uint64(0), uint64(0), uint64(1), uint64(0), uint64(0), uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(1<<63), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, u4, u5, u6, u7, v0, v1, v2, v3, v4, v5, v6, v7 uint64) {
+		a := Int512{u0, u1, u2, u3, u4, u5, u6, u7}
+		b := Int512{v0, v1, v2, v3, v4, v5, v6, v7}
+		got := a.SaturatingSub(b)
+
+		want := saturateBigInt(new(big.Int).Sub(int512ToBigInt(a), int512ToBigInt(b)), 512, true)
+		if int512ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("Int512(%d).SaturatingSub(%d) = %d, want %d", a, b, got, want)
+		}
+	})
+}
+
+func FuzzInt512_SaturatingMul(f *testing.F) {
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(1),
+	)
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(1),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(1), uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(0), uint64(0), uint64(0), uint64(1), uint64(0), uint64(0), uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(1<<63), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, u4, u5, u6, u7, v0, v1, v2, v3, v4, v5, v6, v7 uint64) {
+		a := Int512{u0, u1, u2, u3, u4, u5, u6, u7}
+		b := Int512{v0, v1, v2, v3, v4, v5, v6, v7}
+		got := a.SaturatingMul(b)
+
+		want := saturateBigInt(new(big.Int).Mul(int512ToBigInt(a), int512ToBigInt(b)), 512, true)
+		if int512ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("Int512(%d).SaturatingMul(%d) = %d, want %d", a, b, got, want)
+		}
+	})
+}
+
+func FuzzInt512_Abs(f *testing.F) {
+	f.Add(uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0))
+	f.Add(uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(1))
+	f.Add(uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64))
+	f.Add(uint64(1<<63), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0))
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, u4, u5, u6, u7 uint64) {
+		a := Int512{u0, u1, u2, u3, u4, u5, u6, u7}
+
+		abs := new(big.Int).Abs(int512ToBigInt(a))
+		// Abs wraps around if a is the minimum value.
+		if got, want := a.Abs(), wrapInt512(abs); got != want {
+			t.Errorf("Int512(%d).Abs() = %d, want %d", a, got, want)
+		}
+		if got, want := a.SaturatingAbs(), saturateBigInt(abs, 512, true); int512ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("Int512(%d).SaturatingAbs() = %d, want %d", a, got, want)
+		}
+
+		neg := new(big.Int).Neg(int512ToBigInt(a))
+		if got, want := a.SaturatingNeg(), saturateBigInt(neg, 512, true); int512ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("Int512(%d).SaturatingNeg() = %d, want %d", a, got, want)
+		}
+	})
+}
+
+func wrapInt512(x *big.Int) Int512 {
+	v, _ := Int512FromBigInt(x)
+	return v
+}
+
+func TestMinMaxInt512(t *testing.T) {
+	max := new(big.Int).Lsh(big.NewInt(1), 511)
+	min := new(big.Int).Neg(max)
+	max.Sub(max, big.NewInt(1))
+	if got := int512ToBigInt(MaxInt512); got.Cmp(max) != 0 {
+		t.Errorf("MaxInt512 = %d, want %d", got, max)
+	}
+	if got := int512ToBigInt(MinInt512); got.Cmp(min) != 0 {
+		t.Errorf("MinInt512 = %d, want %d", got, min)
+	}
+}
+
+func TestInt512FromInt64(t *testing.T) {
+	testCases := []struct {
+		v    int64
+		want Int512
+	}{
+		{0, Int512{0, 0, 0, 0, 0, 0, 0, 0}},
+		{1, Int512{0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{-1, Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{math.MaxInt64, Int512{0, 0, 0, 0, 0, 0, 0, 0x7fffffffffffffff}},
+		{math.MinInt64, Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0x8000000000000000}},
+	}
+
+	for _, tc := range testCases {
+		got := Int512FromInt64(tc.v)
+		if got != tc.want {
+			t.Errorf("Int512FromInt64(%d) = %d, want %d", tc.v, got, tc.want)
+		}
+	}
+}
+
+func TestInt512Of(t *testing.T) {
+	testCases := []struct {
+		v    string
+		got  Int512
+		want Int512
+	}{
+		{"int8(-1)", Int512Of(int8(-1)), Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{"int(42)", Int512Of(int(42)), Int512{0, 0, 0, 0, 0, 0, 0, 0x2a}},
+		{"uint64(math.MaxUint64)", Int512Of(uint64(math.MaxUint64)), Int512{0, 0, 0, 0, 0, 0, 0, math.MaxUint64}},
+		{"int64(math.MinInt64)", Int512Of(int64(math.MinInt64)), Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0x8000000000000000}},
+		{"uintptr(7)", Int512Of(uintptr(7)), Int512{0, 0, 0, 0, 0, 0, 0, 0x7}},
+	}
+
+	for _, tc := range testCases {
+		if tc.got != tc.want {
+			t.Errorf("Int512Of(%s) = %d, want %d", tc.v, tc.got, tc.want)
+		}
+	}
+}
+
+func TestInt512_IsInt64(t *testing.T) {
+	testCases := []struct {
+		a        Int512
+		isInt64  bool
+		isUint64 bool
+		i64      int64
+		u64      uint64
+	}{
+		{Int512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, false, false, 0, 0},
+		{Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0x7fffffffffffffff}, false, false, 9223372036854775807, 9223372036854775807},
+		{Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0x8000000000000000}, true, false, math.MinInt64, 9223372036854775808},
+		{Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, true, false, -1, math.MaxUint64},
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0}, true, true, 0, 0},
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0x1}, true, true, 1, 1},
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0x7fffffffffffffff}, true, true, 9223372036854775807, 9223372036854775807},
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0x8000000000000000}, false, true, math.MinInt64, 9223372036854775808},
+		{Int512{0, 0, 0, 0, 0, 0, 0, math.MaxUint64}, false, true, -1, math.MaxUint64},
+		{Int512{0, 0, 0, 0, 0, 0, 0x1, 0}, false, false, 0, 0},
+		{Int512{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false, false, -1, math.MaxUint64},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.a.IsInt64(); got != tc.isInt64 {
+			t.Errorf("Int512(%d).IsInt64() = %t, want %t", tc.a, got, tc.isInt64)
+		}
+		if got := tc.a.IsUint64(); got != tc.isUint64 {
+			t.Errorf("Int512(%d).IsUint64() = %t, want %t", tc.a, got, tc.isUint64)
+		}
+
+		got, ok := tc.a.Int()
+		want, wantOK := int(tc.i64), tc.isInt64 && int64(int(tc.i64)) == tc.i64
+		if got != want || ok != wantOK {
+			t.Errorf("Int512(%d).Int() = %d, %t, want %d, %t", tc.a, got, ok, want, wantOK)
+		}
+
+		gotU, ok := tc.a.Uint()
+		wantU, wantOK := uint(tc.u64), tc.isUint64 && uint64(uint(tc.u64)) == tc.u64
+		if gotU != wantU || ok != wantOK {
+			t.Errorf("Int512(%d).Uint() = %d, %t, want %d, %t", tc.a, gotU, ok, wantU, wantOK)
+		}
+	}
+}
+
+func TestInt512_Bits(t *testing.T) {
+	testCases := []struct {
+		x             Int512
+		leadingZeros  int
+		trailingZeros int
+		bitLen        int
+		onesCount     int
+	}{
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0}, 512, 512, 0, 0},
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0x1}, 511, 0, 1, 1},
+		{Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 0, 0, 1, 512},
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0x2}, 510, 1, 2, 1},
+		{Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xfffffffffffffffe}, 0, 1, 2, 511},
+		{Int512{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 1, 0, 511, 511},
+		{Int512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, 0, 511, 512, 1},
+		{Int512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0x1}, 0, 0, 511, 2},
+		{Int512{0, 0, 0, 0x1, 0, 0, 0, 0}, 255, 256, 257, 1},
+		{Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0, 0, 0, 0}, 0, 256, 257, 256},
+		{Int512{0, 0, 0, 0, 0, 0, 0, 0x6}, 509, 1, 3, 2},
+		{Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xfffffffffffffffa}, 0, 1, 3, 510},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.x.LeadingZeros(); got != tc.leadingZeros {
+			t.Errorf("Int512(%d).LeadingZeros() = %d, want %d", tc.x, got, tc.leadingZeros)
+		}
+		if got := tc.x.TrailingZeros(); got != tc.trailingZeros {
+			t.Errorf("Int512(%d).TrailingZeros() = %d, want %d", tc.x, got, tc.trailingZeros)
+		}
+		if got := tc.x.BitLen(); got != tc.bitLen {
+			t.Errorf("Int512(%d).BitLen() = %d, want %d", tc.x, got, tc.bitLen)
+		}
+		if got := tc.x.OnesCount(); got != tc.onesCount {
+			t.Errorf("Int512(%d).OnesCount() = %d, want %d", tc.x, got, tc.onesCount)
+		}
+	}
+}
+
+func FuzzInt512_Bit(f *testing.F) {
+	f.Add(uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0))
+	f.Add(uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(1))
+	f.Add(uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64))
+	f.Add(uint64(1<<63), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0))
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, u4, u5, u6, u7 uint64) {
+		a := Int512{u0, u1, u2, u3, u4, u5, u6, u7}
+		x := int512ToBigInt(a)
+		for i := range 522 {
+			if got, want := a.Bit(i), x.Bit(i); got != want {
+				t.Errorf("Int512(%d).Bit(%d) = %d, want %d", a, i, got, want)
+			}
+		}
+	})
+}
+
+func TestInt512_RshRound(t *testing.T) {
+	values := []Int512{
+		Int512{0, 0, 0, 0, 0, 0, 0, 0},
+		Int512{0, 0, 0, 0, 0, 0, 0, 0x1},
+		Int512{0, 0, 0, 0, 0, 0, 0, 0x2},
+		Int512{0, 0, 0, 0, 0, 0, 0, 0x3},
+		Int512{0, 0, 0, 0, 0, 0, 0, 0x5},
+		Int512{0, 0, 0, 0, 0, 0, 0, 0x6},
+		Int512{0, 0, 0, 0, 0, 0, 0, math.MaxUint64},
+		Int512{0, 0, 0, 0, 0, 0, 0x1, 0x8000000000000000},
+		Int512{0, 0, 0, 0, 0, 0, 0x1, 0x8000000000000001},
+		Int512{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64},
+		Int512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0},
+		Int512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0x1},
+		Int512{0xb000000000000000, 0, 0, 0, 0, 0, 0, 0},
+		Int512{0xc000000000000000, 0, 0, 0, 0, 0, 0x1, 0},
+		Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64},
+		Int512{0x3fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0},
+		Int512{0x5000000000000000, 0, 0, 0, 0, 0, 0, 0},
+		Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xfffffffffffffffe, 0x7fffffffffffffff},
+		Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xfffffffffffffffe, 0x8000000000000000},
+		Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0x1},
+		Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xfffffffffffffffa},
+		Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xfffffffffffffffb},
+		Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xfffffffffffffffd},
+		Int512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xfffffffffffffffe},
+	}
+	shifts := []uint{0, 1, 2, 3, 63, 64, 65, 127, 128, 129, 191, 192, 193, 255, 256, 257, 319, 320, 321, 383, 384, 385, 447, 448, 449, 511, 512, 513, 576}
+
+	for _, a := range values {
+		x := int512ToBigInt(a)
+		for _, i := range shifts {
+			if got, want := a.RshRound(i), rshRoundBigInt(x, i); int512ToBigInt(got).Cmp(want) != 0 {
+				t.Errorf("Int512(%d).RshRound(%d) = %d, want %d", a, i, got, want)
+			}
+			if got, want := a.RshCeil(i), rshCeilBigInt(x, i); int512ToBigInt(got).Cmp(want) != 0 {
+				t.Errorf("Int512(%d).RshCeil(%d) = %d, want %d", a, i, got, want)
+			}
+			if got, want := a.URsh(i), new(big.Int).Rsh(uint512ToBigInt(Uint512(a)), i); uint512ToBigInt(Uint512(got)).Cmp(want) != 0 {
+				t.Errorf("Int512(%d).URsh(%d) = %d, want %d", a, i, got, want)
+			}
+		}
+	}
+}
+
+func FuzzInt512_Mul1024(f *testing.F) {
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(1),
+	)
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(1),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(1<<63), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, u4, u5, u6, u7, v0, v1, v2, v3, v4, v5, v6, v7 uint64) {
+		a := Int512{u0, u1, u2, u3, u4, u5, u6, u7}
+		b := Int512{v0, v1, v2, v3, v4, v5, v6, v7}
+		got := int1024ToBigInt(a.Mul1024(b))
+		want := new(big.Int).Mul(int512ToBigInt(a), int512ToBigInt(b))
+		if got.Cmp(want) != 0 {
+			t.Errorf("Int512(%d).Mul1024(%d) = %d, want %d", a, b, got, want)
+		}
+	})
+}
+
+func FuzzInt512_Sqrt(f *testing.F) {
+	f.Add(uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint8(2))
+	f.Add(uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x1), uint8(3))
+	f.Add(uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint8(2))
+	f.Add(uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint8(3))
+	f.Add(uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint8(17))
+	f.Add(uint64(0x7fffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint8(7))
+	f.Add(uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0xffffffffffffffff), uint8(4))
+	f.Add(uint64(0x1), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint8(63))
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, u4, u5, u6, u7 uint64, k uint8) {
+		a := Int512{u0, u1, u2, u3, u4, u5, u6, u7}
+		if a.Sign() < 0 {
+			if a.IsPerfectSquare() {
+				t.Errorf("Int512(%d).IsPerfectSquare() = true, want false", a)
+			}
+			return
+		}
+		x := int512ToBigInt(a)
+
+		want := new(big.Int).Sqrt(x)
+		if got := a.Sqrt(); int512ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("Int512(%d).Sqrt() = %d, want %d", a, got, want)
+		}
+		isSquare := new(big.Int).Mul(want, want).Cmp(x) == 0
+		if got := a.IsPerfectSquare(); got != isSquare {
+			t.Errorf("Int512(%d).IsPerfectSquare() = %t, want %t", a, got, isSquare)
+		}
+
+		// r is the floor n-th root of a if r**n <= a < (r+1)**n.
+		for _, n := range []uint{3, uint(k)%512 + 1} {
+			got := a.Root(n)
+			r := int512ToBigInt(got)
+			lo := new(big.Int).Exp(r, big.NewInt(int64(n)), nil)
+			hi := new(big.Int).Exp(r.Add(r, big.NewInt(1)), big.NewInt(int64(n)), nil)
+			if lo.Cmp(x) > 0 || hi.Cmp(x) <= 0 {
+				t.Errorf("Int512(%d).Root(%d) = %d", a, n, got)
+			}
+		}
+		if got, want := a.Cbrt(), a.Root(3); got != want {
+			t.Errorf("Int512(%d).Cbrt() = %d, want %d", a, got, want)
+		}
+	})
+}
@@ -2,18 +2,62 @@ package ints
 
 import (
 	"cmp"
+	"encoding/binary"
 	"fmt"
+	"math"
+	"math/big"
+	"math/bits"
 )
 
 // Int64 is a type that represents an 64-bit signed integer.
 // It is an alias for the built-in int64 type.
 type Int64 int64
 
+const (
+	// MaxInt64 is the maximum value of Int64.
+	MaxInt64 Int64 = math.MaxInt64
+
+	// MinInt64 is the minimum value of Int64.
+	MinInt64 Int64 = math.MinInt64
+)
+
+// Int64Of returns v as an Int64.
+// v is converted in the same way as Go's integer conversions.
+func Int64Of[T builtinInteger](v T) Int64 {
+	return Int64(v)
+}
+
 // IsZero returns true if a is zero.
 func (a Int64) IsZero() bool {
 	return a == 0
 }
 
+// IsInt64 reports whether a can be represented as an int64.
+func (a Int64) IsInt64() bool {
+	_, ok := a.TryInt64()
+	return ok
+}
+
+// IsUint64 reports whether a can be represented as a uint64.
+func (a Int64) IsUint64() bool {
+	_, ok := a.TryUint64()
+	return ok
+}
+
+// Int returns a as an int and reports whether a can be represented as an int.
+// If it can't, the result is a truncated to the size of int.
+func (a Int64) Int() (int, bool) {
+	v, ok := a.TryInt64()
+	return int(v), ok && int64(int(v)) == int64(v)
+}
+
+// Uint returns a as a uint and reports whether a can be represented as a uint.
+// If it can't, the result is a truncated to the size of uint.
+func (a Int64) Uint() (uint, bool) {
+	v, ok := a.TryUint64()
+	return uint(v), ok && uint64(uint(v)) == uint64(v)
+}
+
 // Add returns the sum a+b.
 //
 // This function's execution time does not depend on the inputs.
@@ -21,6 +65,27 @@ func (a Int64) Add(b Int64) Int64 {
 	return a + b
 }
 
+// AddOverflow returns the sum a+b and reports whether the addition overflowed.
+//
+// This function's execution time does not depend on the inputs.
+func (a Int64) AddOverflow(b Int64) (Int64, bool) {
+	c := a + b
+	return c, (a^c)&(b^c) < 0
+}
+
+// SaturatingAdd returns the sum a+b.
+// If the result overflows, it is clamped to the minimum or maximum value.
+func (a Int64) SaturatingAdd(b Int64) Int64 {
+	c, overflow := a.AddOverflow(b)
+	if overflow {
+		if a < 0 {
+			return math.MinInt64
+		}
+		return math.MaxInt64
+	}
+	return c
+}
+
 // Sub returns the difference a-b.
 //
 // This function's execution time does not depend on the inputs.
@@ -28,6 +93,27 @@ func (a Int64) Sub(b Int64) Int64 {
 	return a - b
 }
 
+// SubOverflow returns the difference a-b and reports whether the subtraction overflowed.
+//
+// This function's execution time does not depend on the inputs.
+func (a Int64) SubOverflow(b Int64) (Int64, bool) {
+	c := a - b
+	return c, (a^b)&(a^c) < 0
+}
+
+// SaturatingSub returns the difference a-b.
+// If the result overflows, it is clamped to the minimum or maximum value.
+func (a Int64) SaturatingSub(b Int64) Int64 {
+	c, overflow := a.SubOverflow(b)
+	if overflow {
+		if a < 0 {
+			return math.MinInt64
+		}
+		return math.MaxInt64
+	}
+	return c
+}
+
 // Mul returns the product a*b.
 //
 // This function's execution time does not depend on the inputs.
@@ -35,6 +121,40 @@ func (a Int64) Mul(b Int64) Int64 {
 	return a * b
 }
 
+// MulOverflow returns the product a*b and reports whether the multiplication overflowed.
+func (a Int64) MulOverflow(b Int64) (Int64, bool) {
+	c := a * b
+	if a == 0 || b == 0 {
+		return c, false
+	}
+	return c, c/b != a || (a == -1<<63 && b == -1)
+}
+
+// SaturatingMul returns the product a*b.
+// If the result overflows, it is clamped to the minimum or maximum value.
+func (a Int64) SaturatingMul(b Int64) Int64 {
+	c, overflow := a.MulOverflow(b)
+	if overflow {
+		if (a < 0) != (b < 0) {
+			return math.MinInt64
+		}
+		return math.MaxInt64
+	}
+	return c
+}
+
+// Mul128 returns the product a*b, the result is a 128-bit integer.
+//
+// This function's execution time does not depend on the inputs.
+func (a Int64) Mul128(b Int64) Int128 {
+	hi, lo := bits.Mul64(uint64(a), uint64(b))
+
+	// convert the unsigned product into the signed one.
+	hi -= uint64(a>>63) & uint64(b)
+	hi -= uint64(b>>63) & uint64(a)
+	return Int128{hi, lo}
+}
+
 // Div returns the quotient a/b for b != 0.
 // If b == 0, a division-by-zero run-time panic occurs.
 // Div implements Euclidean division (unlike Go); see [Int64.DivMod] for more details.
@@ -134,6 +254,13 @@ func (a Int64) Lsh(i uint) Int64 {
 	return a << i
 }
 
+// LshOverflow returns the left shift a<<i and reports whether the shift overflowed,
+// i.e. whether a<<i differs from a multiplied by 2**i.
+func (a Int64) LshOverflow(i uint) (Int64, bool) {
+	c := a << i
+	return c, c>>i != a
+}
+
 // Rsh returns the arithmetic right shift a>>i, preserving the sign bit.
 //
 // This function's execution time does not depend on the inputs.
@@ -141,6 +268,74 @@ func (a Int64) Rsh(i uint) Int64 {
 	return a >> i
 }
 
+// URsh returns the logical right shift a>>i, treating a as an unsigned integer.
+// The vacated upper bits are filled with zeros regardless of the sign of a.
+func (a Int64) URsh(i uint) Int64 {
+	return Int64(Uint64(a).Rsh(i))
+}
+
+// RshRound returns a/2**i rounded to the nearest integer, with ties rounded to even.
+func (a Int64) RshRound(i uint) Int64 {
+	if i == 0 {
+		return a
+	}
+	q := a.Rsh(i)
+	half := a.Bit(int(min(i-1, 64)))
+	sticky := Uint64(a).Extract(0, i-1) != 0
+	if half == 1 && (sticky || q.Bit(0) == 1) {
+		q++
+	}
+	return q
+}
+
+// RshCeil returns a/2**i rounded toward positive infinity.
+func (a Int64) RshCeil(i uint) Int64 {
+	q := a.Rsh(i)
+	if Uint64(a).Extract(0, i) != 0 {
+		q++
+	}
+	return q
+}
+
+// LeadingZeros returns the number of leading zero bits in the two's complement representation of a;
+// the result is 0 for a < 0, and 64 for a == 0.
+func (a Int64) LeadingZeros() int {
+	return bits.LeadingZeros64(uint64(a))
+}
+
+// TrailingZeros returns the number of trailing zero bits in a; the result is 64 for a == 0.
+// a and -a have the same number of trailing zero bits.
+func (a Int64) TrailingZeros() int {
+	return bits.TrailingZeros64(uint64(a))
+}
+
+// BitLen returns the number of bits required to represent the absolute value of a in binary;
+// the result is 0 for a == 0.
+// It is the same as [big.Int.BitLen].
+func (a Int64) BitLen() int {
+	if a < 0 {
+		// the negation of the minimum value is itself,
+		// but it is correctly interpreted as 2**63 in unsigned.
+		a = -a
+	}
+	return bits.Len64(uint64(a))
+}
+
+// OnesCount returns the number of one bits ("population count") in the two's complement representation of a.
+func (a Int64) OnesCount() int {
+	return bits.OnesCount64(uint64(a))
+}
+
+// Bit returns the value of the i'th bit of the two's complement representation of a.
+// That is, it returns (a>>i)&1, and the sign bit for i >= 64, like [big.Int.Bit].
+// The bit index i must be >= 0.
+func (a Int64) Bit(i int) uint {
+	if i < 0 {
+		panic("ints: negative bit index")
+	}
+	return uint(a>>min(uint(i), 63)) & 1
+}
+
 // Sign returns the sign of a.
 // It returns 1 if a > 0, -1 if a < 0, and 0 if a == 0.
 func (a Int64) Sign() int {
@@ -161,6 +356,72 @@ func (a Int64) Neg() Int64 {
 	return -a
 }
 
+// NegOverflow returns the negation of a and reports whether the negation overflowed,
+// which happens only if a is the minimum value.
+//
+// This function's execution time does not depend on the inputs.
+func (a Int64) NegOverflow() (Int64, bool) {
+	return -a, a == -1<<63
+}
+
+// SaturatingNeg returns the negation of a.
+// If a is the minimum value, it returns the maximum value.
+func (a Int64) SaturatingNeg() Int64 {
+	c, overflow := a.NegOverflow()
+	if overflow {
+		return math.MaxInt64
+	}
+	return c
+}
+
+// Abs returns the absolute value of a.
+// If a is the minimum value, Abs returns a itself because its absolute value can't be represented.
+func (a Int64) Abs() Int64 {
+	if a < 0 {
+		return a.Neg()
+	}
+	return a
+}
+
+// SaturatingAbs returns the absolute value of a.
+// If a is the minimum value, it returns the maximum value.
+func (a Int64) SaturatingAbs() Int64 {
+	if a < 0 {
+		return a.SaturatingNeg()
+	}
+	return a
+}
+
+// Sqrt returns the floor square root of a, ⌊√a⌋.
+// It panics if a < 0.
+func (a Int64) Sqrt() Int64 {
+	if a.Sign() < 0 {
+		panic("ints: square root of negative number")
+	}
+	return Int64(Uint64(a).Sqrt())
+}
+
+// Cbrt returns the floor cube root of a, ⌊∛a⌋.
+// It panics if a < 0.
+func (a Int64) Cbrt() Int64 {
+	return a.Root(3)
+}
+
+// Root returns the floor n-th root of a, ⌊a**(1/n)⌋.
+// It panics if a < 0 or n == 0.
+func (a Int64) Root(n uint) Int64 {
+	if a.Sign() < 0 {
+		panic("ints: root of negative number")
+	}
+	return Int64(Uint64(a).Root(n))
+}
+
+// IsPerfectSquare reports whether a is the square of an integer.
+// Negative numbers are never perfect squares.
+func (a Int64) IsPerfectSquare() bool {
+	return a.Sign() >= 0 && Uint64(a).IsPerfectSquare()
+}
+
 // Cmp returns the comparison result of a and b.
 // It returns -1 if a < b, 0 if a == b, and 1 if a > b.
 func (a Int64) Cmp(b Int64) int {
@@ -199,3 +460,180 @@ func (a Int64) Format(s fmt.State, verb rune) {
 	}
 	format(s, verb, sign, b)
 }
+
+// ParseInt64 interprets a string s in the given base (0, 2 to 62) and
+// returns the corresponding value.
+//
+// The string may begin with a leading sign: "+" or "-".
+//
+// If the base argument is 0, the true base is implied by the string's
+// prefix following the sign (if present): 2 for "0b", 8 for "0" or "0o",
+// 16 for "0x", and 10 otherwise. Also, for argument base 0 only,
+// underscore characters are permitted as defined by the Go syntax for
+// [integer literals].
+// For bases <= 36, lower and upper case letters are considered the same:
+// The letters 'a' to 'z' and 'A' to 'Z' represent digit values 10 to 35.
+// For bases > 36, the upper case letters 'A' to 'Z' represent the digit values 36 to 61,
+// as generated by [Int64.Text].
+//
+// The errors that ParseInt64 returns have concrete type [*strconv.NumError]
+// and include err.Num = s. If s is empty or contains invalid
+// digits, err.Err = [strconv.ErrSyntax] and the returned value is 0;
+// if the value corresponding to s cannot be represented by an Int64,
+// err.Err = [strconv.ErrRange] and the returned value is the maximum magnitude integer
+// of the appropriate sign.
+//
+// [integer literals]: https://go.dev/ref/spec#Integer_literals
+func ParseInt64(s string, base int) (Int64, error) {
+	var z [1]uint64
+	err := parseInt("ParseInt64", s, base, 64, z[:])
+	return Int64(z[0]), err
+}
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+// The result is the decimal representation of a.
+func (a Int64) MarshalText() ([]byte, error) {
+	return a.AppendText(nil)
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+// It accepts the same syntax as Go integer literals, such as "0x" prefixes and underscores.
+// See [ParseInt64] for more details.
+func (a *Int64) UnmarshalText(text []byte) error {
+	v, err := ParseInt64(string(text), 0)
+	if err != nil {
+		return err
+	}
+	*a = v
+	return nil
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+// The result is a JSON number. Use [JSONString] to encode a as a JSON string.
+func (a Int64) MarshalJSON() ([]byte, error) {
+	return a.AppendText(nil)
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+// It accepts both a JSON number such as 123 and a JSON string such as "123" or "0x7b".
+// JSON strings are parsed in the same way as [Int64.UnmarshalText].
+func (a *Int64) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(a, data, ParseInt64)
+}
+
+// BytesBE returns the two's complement big-endian representation of a.
+func (a Int64) BytesBE() [8]byte {
+	var b [8]byte
+	a.PutBytesBE(b[:])
+	return b
+}
+
+// BytesLE returns the two's complement little-endian representation of a.
+func (a Int64) BytesLE() [8]byte {
+	var b [8]byte
+	a.PutBytesLE(b[:])
+	return b
+}
+
+// PutBytesBE stores the two's complement big-endian representation of a into b.
+// It panics if len(b) < 8.
+func (a Int64) PutBytesBE(b []byte) {
+	binary.BigEndian.PutUint64(b, uint64(a))
+}
+
+// PutBytesLE stores the two's complement little-endian representation of a into b.
+// It panics if len(b) < 8.
+func (a Int64) PutBytesLE(b []byte) {
+	binary.LittleEndian.PutUint64(b, uint64(a))
+}
+
+// Int64FromBytesBE returns the Int64 represented by the two's complement big-endian bytes b[:8].
+// It panics if len(b) < 8.
+func Int64FromBytesBE(b []byte) Int64 {
+	return Int64(binary.BigEndian.Uint64(b))
+}
+
+// Int64FromBytesLE returns the Int64 represented by the two's complement little-endian bytes b[:8].
+// It panics if len(b) < 8.
+func Int64FromBytesLE(b []byte) Int64 {
+	return Int64(binary.LittleEndian.Uint64(b))
+}
+
+// AppendBinary implements the [encoding.BinaryAppender] interface.
+// It appends the two's complement big-endian representation of a to dst.
+func (a Int64) AppendBinary(dst []byte) ([]byte, error) {
+	b := a.BytesBE()
+	return append(dst, b[:]...), nil
+}
+
+// MarshalBinary implements the [encoding.BinaryMarshaler] interface.
+// The result is the 8-byte two's complement big-endian representation of a.
+func (a Int64) MarshalBinary() ([]byte, error) {
+	return a.AppendBinary(make([]byte, 0, 8))
+}
+
+// UnmarshalBinary implements the [encoding.BinaryUnmarshaler] interface.
+// data must be exactly 8 bytes of the two's complement big-endian representation, as generated by [Int64.MarshalBinary].
+func (a *Int64) UnmarshalBinary(data []byte) error {
+	if len(data) != 8 {
+		return fmt.Errorf("ints: Int64.UnmarshalBinary: invalid length %d, want 8", len(data))
+	}
+	*a = Int64FromBytesBE(data)
+	return nil
+}
+
+// BigInt returns a as a new [*big.Int].
+func (a Int64) BigInt() *big.Int {
+	return new(big.Int).SetInt64(int64(a))
+}
+
+// Int64FromBigInt returns x as an Int64.
+// If x is not representable as an Int64, the result is the lower 64 bits of x in two's complement,
+// and ok is false.
+func Int64FromBigInt(x *big.Int) (Int64, bool) {
+	var z [1]uint64
+	ok := intFromBigInt(z[:], 64, x)
+	return Int64(z[0]), ok
+}
+
+// Float64 returns the float64 value nearest to a, rounding ties to even, and an indication of any rounding error.
+// If a is too large to be represented by a float64, the result is ±Inf.
+func (a Int64) Float64() (float64, big.Accuracy) {
+	if a < 0 {
+		z := [1]uint64{-uint64(a)}
+		f, acc := float64FromLimbs(z[:])
+		return -f, -acc
+	}
+	z := [1]uint64{uint64(a)}
+	return float64FromLimbs(z[:])
+}
+
+// Int64FromFloat64 returns the integer value of f rounded according to mode,
+// and the accuracy of the result: [big.Below] if the result is less than f, [big.Above] if it is greater than f.
+// Use [big.ToZero] to truncate f like the Go conversion, and [big.ToNearestEven] to round f to the nearest integer.
+//
+// If f is NaN, the result is 0 and the error is [ErrNaN].
+// If the rounded value of f is not representable as an Int64, including ±Inf,
+// the result is clamped to the minimum or maximum value of Int64, and the error is [ErrOverflow].
+func Int64FromFloat64(f float64, mode big.RoundingMode) (Int64, big.Accuracy, error) {
+	var z [1]uint64
+	acc, err := intFromFloat64(z[:], 64, f, mode)
+	return Int64(z[0]), acc, err
+}
+
+// BigFloat returns a as a new [*big.Float].
+// The precision of the result is 64 bits, so the conversion is always exact.
+func (a Int64) BigFloat() *big.Float {
+	return new(big.Float).SetPrec(64).SetInt64(int64(a))
+}
+
+// Int64FromBigFloat returns the integer value of x rounded according to mode,
+// and the accuracy of the result: [big.Below] if the result is less than x, [big.Above] if it is greater than x.
+//
+// If the rounded value of x is not representable as an Int64, including ±Inf,
+// the result is clamped to the minimum or maximum value of Int64, and the error is [ErrOverflow].
+func Int64FromBigFloat(x *big.Float, mode big.RoundingMode) (Int64, big.Accuracy, error) {
+	var z [1]uint64
+	acc, err := intFromBigFloat(z[:], 64, x, mode)
+	return Int64(z[0]), acc, err
+}
@@ -1,9 +1,14 @@
 package ints
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
 	"math/big"
+	"slices"
+	"strconv"
 	"testing"
 )
 
@@ -445,3 +450,787 @@ func TestInt64_Format(t *testing.T) {
 		}
 	}
 }
+
+func TestParseInt64(t *testing.T) {
+	testCases := []struct {
+		s    string
+		base int
+		want Int64
+		err  error
+	}{
+		{"0", 10, 0, nil},
+		{"1", 10, 1, nil},
+		{"9223372036854775807", 10, 9223372036854775807, nil},
+		{"9223372036854775808", 10, 9223372036854775807, strconv.ErrRange},
+		{"0x7fffffffffffffff", 0, 9223372036854775807, nil},
+		{"0b101", 0, 5, nil},
+		{"0o17", 0, 15, nil},
+		{"017", 0, 15, nil},
+		{"1_000", 0, 1000, nil},
+		{"1_000", 10, 0, strconv.ErrSyntax},
+		{"_1", 0, 0, strconv.ErrSyntax},
+		{"Z", 62, 61, nil},
+		{"z", 36, 35, nil},
+		{"Z", 36, 35, nil},
+		{"", 10, 0, strconv.ErrSyntax},
+		{"12a", 10, 0, strconv.ErrSyntax},
+		{"-1", 10, -1, nil},
+		{"+1", 10, 1, nil},
+		{"-9223372036854775808", 10, -9223372036854775808, nil},
+		{"-9223372036854775809", 10, -9223372036854775808, strconv.ErrRange},
+		{"-0x8000000000000000", 0, -9223372036854775808, nil},
+		{"-", 10, 0, strconv.ErrSyntax},
+	}
+
+	for _, tc := range testCases {
+		got, err := ParseInt64(tc.s, tc.base)
+		if got != tc.want {
+			t.Errorf("ParseInt64(%q, %d) = %d, want %d", tc.s, tc.base, got, tc.want)
+		}
+		if !errors.Is(err, tc.err) {
+			t.Errorf("ParseInt64(%q, %d) error = %v, want %v", tc.s, tc.base, err, tc.err)
+		}
+		if err != nil {
+			var numErr *strconv.NumError
+			if !errors.As(err, &numErr) || numErr.Func != "ParseInt64" || numErr.Num != tc.s {
+				t.Errorf("ParseInt64(%q, %d) error = %#v, want *strconv.NumError", tc.s, tc.base, err)
+			}
+		}
+	}
+
+	if _, err := ParseInt64("1", 63); err == nil {
+		t.Errorf("ParseInt64(%q, %d) should fail", "1", 63)
+	}
+}
+
+func TestInt64_MarshalText(t *testing.T) {
+	testCases := []struct {
+		x    Int64
+		want string
+	}{
+		{0, "0"},
+		{1, "1"},
+		{9223372036854775807, "9223372036854775807"},
+		{-1, "-1"},
+		{-9223372036854775808, "-9223372036854775808"},
+	}
+
+	for _, tc := range testCases {
+		got, err := tc.x.MarshalText()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != tc.want {
+			t.Errorf("Int64(%d).MarshalText() = %q, want %q", tc.x, got, tc.want)
+		}
+	}
+}
+
+func TestInt64_UnmarshalText(t *testing.T) {
+	testCases := []struct {
+		s    string
+		want Int64
+		err  error
+	}{
+		{"0", 0, nil},
+		{"0x10", 16, nil},
+		{"0b1_0", 2, nil},
+		{"010", 8, nil},
+		{"9223372036854775807", 9223372036854775807, nil},
+		{"9223372036854775808", 0, strconv.ErrRange},
+		{"1.0", 0, strconv.ErrSyntax},
+		{"-0x10", -16, nil},
+		{"-9223372036854775809", 0, strconv.ErrRange},
+	}
+
+	for _, tc := range testCases {
+		var got Int64
+		err := got.UnmarshalText([]byte(tc.s))
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Int64.UnmarshalText(%q) error = %v, want %v", tc.s, err, tc.err)
+		}
+		if got != tc.want {
+			t.Errorf("Int64.UnmarshalText(%q) = %d, want %d", tc.s, got, tc.want)
+		}
+	}
+}
+
+func TestInt64_MarshalJSON(t *testing.T) {
+	testCases := []struct {
+		x    Int64
+		want string
+	}{
+		{0, "0"},
+		{1, "1"},
+		{9223372036854775807, "9223372036854775807"},
+		{-1, "-1"},
+		{-9223372036854775808, "-9223372036854775808"},
+	}
+
+	for _, tc := range testCases {
+		got, err := json.Marshal(tc.x)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != tc.want {
+			t.Errorf("json.Marshal(Int64(%d)) = %s, want %s", tc.x, got, tc.want)
+		}
+	}
+}
+
+func TestInt64_UnmarshalJSON(t *testing.T) {
+	testCases := []struct {
+		s       string
+		want    Int64
+		wantErr bool
+	}{
+		{"0", 0, false},
+		{"123", 123, false},
+		{"\"123\"", 123, false},
+		{"\"0x7f\"", 127, false},
+		{"9223372036854775807", 9223372036854775807, false},
+		{"\"9223372036854775807\"", 9223372036854775807, false},
+		{"9223372036854775808", 0, true},
+		{"0x7f", 0, true},
+		{"\"abc\"", 0, true},
+		{"1.5", 0, true},
+		{"null", 0, false},
+		{"-1", -1, false},
+		{"\"-1\"", -1, false},
+		{"-9223372036854775808", -9223372036854775808, false},
+	}
+
+	for _, tc := range testCases {
+		var got Int64
+		err := json.Unmarshal([]byte(tc.s), &got)
+		if (err != nil) != tc.wantErr {
+			t.Errorf("json.Unmarshal(%s) error = %v, wantErr %t", tc.s, err, tc.wantErr)
+		}
+		if got != tc.want {
+			t.Errorf("json.Unmarshal(%s) = %d, want %d", tc.s, got, tc.want)
+		}
+	}
+}
+
+func TestInt64_Bytes(t *testing.T) {
+	testCases := []struct {
+		x  Int64
+		be [8]byte
+	}{
+		{
+			72623859790382856,
+			[8]byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08},
+		},
+		{
+			-2,
+			[8]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xfe},
+		},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.x.BytesBE(); got != tc.be {
+			t.Errorf("Int64(%d).BytesBE() = %x, want %x", tc.x, got, tc.be)
+		}
+		if got := Int64FromBytesBE(tc.be[:]); got != tc.x {
+			t.Errorf("Int64FromBytesBE(%x) = %d, want %d", tc.be, got, tc.x)
+		}
+
+		le := tc.be
+		slices.Reverse(le[:])
+		if got := tc.x.BytesLE(); got != le {
+			t.Errorf("Int64(%d).BytesLE() = %x, want %x", tc.x, got, le)
+		}
+		if got := Int64FromBytesLE(le[:]); got != tc.x {
+			t.Errorf("Int64FromBytesLE(%x) = %d, want %d", le, got, tc.x)
+		}
+
+		data, err := tc.x.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(data, tc.be[:]) {
+			t.Errorf("Int64(%d).MarshalBinary() = %x, want %x", tc.x, data, tc.be)
+		}
+		var got Int64
+		if err := got.UnmarshalBinary(data); err != nil {
+			t.Fatal(err)
+		}
+		if got != tc.x {
+			t.Errorf("Int64.UnmarshalBinary(%x) = %d, want %d", data, got, tc.x)
+		}
+	}
+}
+
+func TestInt64_UnmarshalBinary_InvalidLength(t *testing.T) {
+	var a Int64
+	if err := a.UnmarshalBinary(make([]byte, 7)); err == nil {
+		t.Error("want error, got nil")
+	}
+	if err := a.UnmarshalBinary(make([]byte, 9)); err == nil {
+		t.Error("want error, got nil")
+	}
+}
+
+func TestInt64FromBigInt(t *testing.T) {
+	testCases := []struct {
+		x    *big.Int
+		want Int64
+		ok   bool
+	}{
+		{big.NewInt(0), 0, true},
+		{big.NewInt(1), 1, true},
+		{big.NewInt(9223372036854775807), 9223372036854775807, true},
+		{bigIntFromString("9223372036854775808"), -9223372036854775808, false},
+		{bigIntFromString("18446744073709551616"), 0, false},
+		{big.NewInt(-1), -1, true},
+		{big.NewInt(-9223372036854775808), -9223372036854775808, true},
+		{bigIntFromString("-9223372036854775809"), 9223372036854775807, false},
+	}
+
+	for _, tc := range testCases {
+		got, ok := Int64FromBigInt(tc.x)
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int64FromBigInt(%s) = %d, %t, want %d, %t", tc.x, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt64_BigInt(t *testing.T) {
+	testCases := []Int64{0, 1, 9223372036854775807, -1, -9223372036854775808}
+
+	for _, a := range testCases {
+		got := a.BigInt()
+		if got.String() != a.String() {
+			t.Errorf("Int64(%d).BigInt() = %s, want %s", a, got, a)
+		}
+		if b, ok := Int64FromBigInt(got); b != a || !ok {
+			t.Errorf("Int64FromBigInt(%s) = %d, %t, want %d, true", got, b, ok, a)
+		}
+	}
+}
+
+func TestInt64FromFloat64(t *testing.T) {
+	testCases := []struct {
+		f    float64
+		mode big.RoundingMode
+		want Int64
+		acc  big.Accuracy
+		err  error
+	}{
+		{0, big.ToNearestEven, 0, big.Exact, nil},
+		{2.5, big.ToNearestEven, 2, big.Below, nil},
+		{3.5, big.ToNearestEven, 4, big.Above, nil},
+		{2.5, big.ToNearestAway, 3, big.Above, nil},
+		{2.5, big.ToZero, 2, big.Below, nil},
+		{2.5, big.AwayFromZero, 3, big.Above, nil},
+		{2.5, big.ToNegativeInf, 2, big.Below, nil},
+		{2.5, big.ToPositiveInf, 3, big.Above, nil},
+		{math.NaN(), big.ToNearestEven, 0, big.Exact, ErrNaN},
+		{math.Inf(1), big.ToNearestEven, 9223372036854775807, big.Below, ErrOverflow},
+		{math.Inf(-1), big.ToNearestEven, -9223372036854775808, big.Above, ErrOverflow},
+		{0x1p64, big.ToNearestEven, 9223372036854775807, big.Below, ErrOverflow},
+		{0x1p63, big.ToNearestEven, 9223372036854775807, big.Below, ErrOverflow},
+		{-2.5, big.ToNearestEven, -2, big.Above, nil},
+		{-2.5, big.ToZero, -2, big.Above, nil},
+		{-2.5, big.AwayFromZero, -3, big.Below, nil},
+		{-2.5, big.ToNegativeInf, -3, big.Below, nil},
+		{-0x1p63, big.ToNearestEven, -9223372036854775808, big.Exact, nil},
+	}
+
+	for _, tc := range testCases {
+		got, acc, err := Int64FromFloat64(tc.f, tc.mode)
+		if got != tc.want || acc != tc.acc || err != tc.err {
+			t.Errorf("Int64FromFloat64(%v, %s) = %d, %s, %v, want %d, %s, %v", tc.f, tc.mode, got, acc, err, tc.want, tc.acc, tc.err)
+		}
+
+		if math.IsNaN(tc.f) {
+			continue
+		}
+		got, acc, err = Int64FromBigFloat(big.NewFloat(tc.f), tc.mode)
+		if got != tc.want || acc != tc.acc || err != tc.err {
+			t.Errorf("Int64FromBigFloat(%v, %s) = %d, %s, %v, want %d, %s, %v", tc.f, tc.mode, got, acc, err, tc.want, tc.acc, tc.err)
+		}
+	}
+}
+
+func TestInt64_Float64(t *testing.T) {
+	testCases := []Int64{0, 1, 9223372036854775807, -1, -9223372036854775808}
+
+	for _, a := range testCases {
+		b := a.BigInt()
+		got, acc := a.Float64()
+		want, wantAcc := new(big.Float).SetInt(b).Float64()
+		if got != want || acc != wantAcc {
+			t.Errorf("Int64(%d).Float64() = %v, %s, want %v, %s", a, got, acc, want, wantAcc)
+		}
+		if c, acc, err := Int64FromBigFloat(a.BigFloat(), big.ToNearestEven); c != a || acc != big.Exact || err != nil {
+			t.Errorf("Int64FromBigFloat(%v) = %d, %s, %v, want %d, %s, nil", a.BigFloat(), c, acc, err, a, big.Exact)
+		}
+	}
+}
+
+func TestInt64_AddOverflow(t *testing.T) {
+	testCases := []struct {
+		x, y     Int64
+		want     Int64
+		overflow bool
+	}{
+		{0, 0, 0, false},
+		{1, 1, 2, false},
+		{9223372036854775807, 1, -9223372036854775808, true},
+		{9223372036854775807, 9223372036854775807, -2, true},
+		{-9223372036854775808, 1, -9223372036854775807, false},
+		{2, 4611686018427387903, 4611686018427387905, false},
+		{2, 4611686018427387904, 4611686018427387906, false},
+		{-1, -9223372036854775808, 9223372036854775807, true},
+		{-9223372036854775808, -1, 9223372036854775807, true},
+		{-9223372036854775808, -9223372036854775808, 0, true},
+		{-1, -1, -2, false},
+	}
+
+	for _, tc := range testCases {
+		got, overflow := tc.x.AddOverflow(tc.y)
+		if got != tc.want || overflow != tc.overflow {
+			t.Errorf("Int64(%d).AddOverflow(%d) = %d, %t, want %d, %t", tc.x, tc.y, got, overflow, tc.want, tc.overflow)
+		}
+	}
+}
+
+func TestInt64_SubOverflow(t *testing.T) {
+	testCases := []struct {
+		x, y     Int64
+		want     Int64
+		overflow bool
+	}{
+		{0, 0, 0, false},
+		{1, 1, 0, false},
+		{9223372036854775807, 1, 9223372036854775806, false},
+		{9223372036854775807, 9223372036854775807, 0, false},
+		{-9223372036854775808, 1, 9223372036854775807, true},
+		{2, 4611686018427387903, -4611686018427387901, false},
+		{2, 4611686018427387904, -4611686018427387902, false},
+		{-1, -9223372036854775808, 9223372036854775807, false},
+		{-9223372036854775808, -1, -9223372036854775807, false},
+		{-9223372036854775808, -9223372036854775808, 0, false},
+		{-1, -1, 0, false},
+	}
+
+	for _, tc := range testCases {
+		got, overflow := tc.x.SubOverflow(tc.y)
+		if got != tc.want || overflow != tc.overflow {
+			t.Errorf("Int64(%d).SubOverflow(%d) = %d, %t, want %d, %t", tc.x, tc.y, got, overflow, tc.want, tc.overflow)
+		}
+	}
+}
+
+func TestInt64_MulOverflow(t *testing.T) {
+	testCases := []struct {
+		x, y     Int64
+		want     Int64
+		overflow bool
+	}{
+		{0, 0, 0, false},
+		{1, 1, 1, false},
+		{9223372036854775807, 1, 9223372036854775807, false},
+		{9223372036854775807, 9223372036854775807, 1, true},
+		{-9223372036854775808, 1, -9223372036854775808, false},
+		{2, 4611686018427387903, 9223372036854775806, false},
+		{2, 4611686018427387904, -9223372036854775808, true},
+		{-1, -9223372036854775808, -9223372036854775808, true},
+		{-9223372036854775808, -1, -9223372036854775808, true},
+		{-9223372036854775808, -9223372036854775808, 0, true},
+		{-1, -1, 1, false},
+	}
+
+	for _, tc := range testCases {
+		got, overflow := tc.x.MulOverflow(tc.y)
+		if got != tc.want || overflow != tc.overflow {
+			t.Errorf("Int64(%d).MulOverflow(%d) = %d, %t, want %d, %t", tc.x, tc.y, got, overflow, tc.want, tc.overflow)
+		}
+	}
+}
+
+func TestInt64_NegOverflow(t *testing.T) {
+	testCases := []struct {
+		x        Int64
+		want     Int64
+		overflow bool
+	}{
+		{0, 0, false},
+		{1, -1, false},
+		{9223372036854775807, -9223372036854775807, false},
+		{-1, 1, false},
+		{-9223372036854775808, -9223372036854775808, true},
+	}
+
+	for _, tc := range testCases {
+		got, overflow := tc.x.NegOverflow()
+		if got != tc.want || overflow != tc.overflow {
+			t.Errorf("Int64(%d).NegOverflow() = %d, %t, want %d, %t", tc.x, got, overflow, tc.want, tc.overflow)
+		}
+	}
+}
+
+func TestInt64_LshOverflow(t *testing.T) {
+	testCases := []struct {
+		x        Int64
+		i        uint
+		want     Int64
+		overflow bool
+	}{
+		{1, 0, 1, false},
+		{1, 62, 4611686018427387904, false},
+		{1, 63, -9223372036854775808, true},
+		{1, 64, 0, true},
+		{3, 62, -4611686018427387904, true},
+		{9223372036854775807, 1, -2, true},
+		{0, 74, 0, false},
+		{-1, 63, -9223372036854775808, false},
+		{-1, 64, 0, true},
+		{-2, 62, -9223372036854775808, false},
+		{-3, 62, 4611686018427387904, true},
+	}
+
+	for _, tc := range testCases {
+		got, overflow := tc.x.LshOverflow(tc.i)
+		if got != tc.want || overflow != tc.overflow {
+			t.Errorf("Int64(%d).LshOverflow(%d) = %d, %t, want %d, %t", tc.x, tc.i, got, overflow, tc.want, tc.overflow)
+		}
+	}
+}
+
+func TestInt64_SaturatingAdd(t *testing.T) {
+	testCases := []struct {
+		x, y Int64
+		want Int64
+	}{
+		{0, 0, 0},
+		{1, 2, 3},
+		{9223372036854775807, 1, 9223372036854775807},
+		{9223372036854775807, 9223372036854775807, 9223372036854775807},
+		{-9223372036854775808, 1, -9223372036854775807},
+		{2, 4611686018427387904, 4611686018427387906},
+		{3, 2, 5},
+		{-9223372036854775808, -1, -9223372036854775808},
+		{-1, -9223372036854775808, -9223372036854775808},
+		{-9223372036854775808, -9223372036854775808, -9223372036854775808},
+		{9223372036854775807, -1, 9223372036854775806},
+		{-2, 4611686018427387904, 4611686018427387902},
+	}
+
+	for _, tc := range testCases {
+		got := tc.x.SaturatingAdd(tc.y)
+		if got != tc.want {
+			t.Errorf("Int64(%d).SaturatingAdd(%d) = %d, want %d", tc.x, tc.y, got, tc.want)
+		}
+	}
+}
+
+func TestInt64_SaturatingSub(t *testing.T) {
+	testCases := []struct {
+		x, y Int64
+		want Int64
+	}{
+		{0, 0, 0},
+		{1, 2, -1},
+		{9223372036854775807, 1, 9223372036854775806},
+		{9223372036854775807, 9223372036854775807, 0},
+		{-9223372036854775808, 1, -9223372036854775808},
+		{2, 4611686018427387904, -4611686018427387902},
+		{3, 2, 1},
+		{-9223372036854775808, -1, -9223372036854775807},
+		{-1, -9223372036854775808, 9223372036854775807},
+		{-9223372036854775808, -9223372036854775808, 0},
+		{9223372036854775807, -1, 9223372036854775807},
+		{-2, 4611686018427387904, -4611686018427387906},
+	}
+
+	for _, tc := range testCases {
+		got := tc.x.SaturatingSub(tc.y)
+		if got != tc.want {
+			t.Errorf("Int64(%d).SaturatingSub(%d) = %d, want %d", tc.x, tc.y, got, tc.want)
+		}
+	}
+}
+
+func TestInt64_SaturatingMul(t *testing.T) {
+	testCases := []struct {
+		x, y Int64
+		want Int64
+	}{
+		{0, 0, 0},
+		{1, 2, 2},
+		{9223372036854775807, 1, 9223372036854775807},
+		{9223372036854775807, 9223372036854775807, 9223372036854775807},
+		{-9223372036854775808, 1, -9223372036854775808},
+		{2, 4611686018427387904, 9223372036854775807},
+		{3, 2, 6},
+		{-9223372036854775808, -1, 9223372036854775807},
+		{-1, -9223372036854775808, 9223372036854775807},
+		{-9223372036854775808, -9223372036854775808, 9223372036854775807},
+		{9223372036854775807, -1, -9223372036854775807},
+		{-2, 4611686018427387904, -9223372036854775808},
+	}
+
+	for _, tc := range testCases {
+		got := tc.x.SaturatingMul(tc.y)
+		if got != tc.want {
+			t.Errorf("Int64(%d).SaturatingMul(%d) = %d, want %d", tc.x, tc.y, got, tc.want)
+		}
+	}
+}
+
+func TestInt64_Abs(t *testing.T) {
+	testCases := []struct {
+		x      Int64
+		abs    Int64
+		satAbs Int64
+		satNeg Int64
+	}{
+		{0, 0, 0, 0},
+		{1, 1, 1, -1},
+		{-1, 1, 1, 1},
+		{9223372036854775807, 9223372036854775807, 9223372036854775807, -9223372036854775807},
+		{-9223372036854775808, -9223372036854775808, 9223372036854775807, 9223372036854775807},
+		{-9223372036854775807, 9223372036854775807, 9223372036854775807, 9223372036854775807},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.x.Abs(); got != tc.abs {
+			t.Errorf("Int64(%d).Abs() = %d, want %d", tc.x, got, tc.abs)
+		}
+		if got := tc.x.SaturatingAbs(); got != tc.satAbs {
+			t.Errorf("Int64(%d).SaturatingAbs() = %d, want %d", tc.x, got, tc.satAbs)
+		}
+		if got := tc.x.SaturatingNeg(); got != tc.satNeg {
+			t.Errorf("Int64(%d).SaturatingNeg() = %d, want %d", tc.x, got, tc.satNeg)
+		}
+	}
+}
+
+func TestInt64Of(t *testing.T) {
+	testCases := []struct {
+		v    string
+		got  Int64
+		want Int64
+	}{
+		{"int8(-1)", Int64Of(int8(-1)), -1},
+		{"int(42)", Int64Of(int(42)), 42},
+		{"uint64(math.MaxUint64)", Int64Of(uint64(math.MaxUint64)), -1},
+		{"int64(math.MinInt64)", Int64Of(int64(math.MinInt64)), -9223372036854775808},
+		{"uintptr(7)", Int64Of(uintptr(7)), 7},
+	}
+
+	for _, tc := range testCases {
+		if tc.got != tc.want {
+			t.Errorf("Int64Of(%s) = %d, want %d", tc.v, tc.got, tc.want)
+		}
+	}
+}
+
+func TestInt64_IsInt64(t *testing.T) {
+	testCases := []struct {
+		a        Int64
+		isInt64  bool
+		isUint64 bool
+		i64      int64
+		u64      uint64
+	}{
+		{-9223372036854775808, true, false, math.MinInt64, 9223372036854775808},
+		{-1, true, false, -1, math.MaxUint64},
+		{0, true, true, 0, 0},
+		{1, true, true, 1, 1},
+		{9223372036854775807, true, true, 9223372036854775807, 9223372036854775807},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.a.IsInt64(); got != tc.isInt64 {
+			t.Errorf("Int64(%d).IsInt64() = %t, want %t", tc.a, got, tc.isInt64)
+		}
+		if got := tc.a.IsUint64(); got != tc.isUint64 {
+			t.Errorf("Int64(%d).IsUint64() = %t, want %t", tc.a, got, tc.isUint64)
+		}
+
+		got, ok := tc.a.Int()
+		want, wantOK := int(tc.i64), tc.isInt64 && int64(int(tc.i64)) == tc.i64
+		if got != want || ok != wantOK {
+			t.Errorf("Int64(%d).Int() = %d, %t, want %d, %t", tc.a, got, ok, want, wantOK)
+		}
+
+		gotU, ok := tc.a.Uint()
+		wantU, wantOK := uint(tc.u64), tc.isUint64 && uint64(uint(tc.u64)) == tc.u64
+		if gotU != wantU || ok != wantOK {
+			t.Errorf("Int64(%d).Uint() = %d, %t, want %d, %t", tc.a, gotU, ok, wantU, wantOK)
+		}
+	}
+}
+
+func TestInt64_Bits(t *testing.T) {
+	testCases := []struct {
+		x             Int64
+		leadingZeros  int
+		trailingZeros int
+		bitLen        int
+		onesCount     int
+	}{
+		{0, 64, 64, 0, 0},
+		{1, 63, 0, 1, 1},
+		{-1, 0, 0, 1, 64},
+		{2, 62, 1, 2, 1},
+		{-2, 0, 1, 2, 63},
+		{9223372036854775807, 1, 0, 63, 63},
+		{-9223372036854775808, 0, 63, 64, 1},
+		{-9223372036854775807, 0, 0, 63, 2},
+		{4294967296, 31, 32, 33, 1},
+		{-4294967296, 0, 32, 33, 32},
+		{6, 61, 1, 3, 2},
+		{-6, 0, 1, 3, 62},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.x.LeadingZeros(); got != tc.leadingZeros {
+			t.Errorf("Int64(%d).LeadingZeros() = %d, want %d", tc.x, got, tc.leadingZeros)
+		}
+		if got := tc.x.TrailingZeros(); got != tc.trailingZeros {
+			t.Errorf("Int64(%d).TrailingZeros() = %d, want %d", tc.x, got, tc.trailingZeros)
+		}
+		if got := tc.x.BitLen(); got != tc.bitLen {
+			t.Errorf("Int64(%d).BitLen() = %d, want %d", tc.x, got, tc.bitLen)
+		}
+		if got := tc.x.OnesCount(); got != tc.onesCount {
+			t.Errorf("Int64(%d).OnesCount() = %d, want %d", tc.x, got, tc.onesCount)
+		}
+	}
+}
+
+func TestInt64_Bit(t *testing.T) {
+	testCases := []Int64{
+		0,
+		1,
+		-1,
+		5,
+		-6,
+		-9223372036854775808,
+		9223372036854775807,
+	}
+
+	for _, a := range testCases {
+		x := big.NewInt(int64(a))
+		for i := range 74 {
+			if got, want := a.Bit(i), x.Bit(i); got != want {
+				t.Errorf("Int64(%d).Bit(%d) = %d, want %d", a, i, got, want)
+			}
+		}
+	}
+}
+
+func TestInt64_RshRound(t *testing.T) {
+	values := []Int64{
+		0,
+		1,
+		2,
+		3,
+		5,
+		6,
+		2147483648,
+		3221225472,
+		9223372036854775807,
+		-9223372036854775808,
+		-9223372036854775807,
+		-5764607523034234880,
+		-1,
+		-2,
+		-2147483648,
+		-3,
+		-3221225472,
+		-5,
+		-6,
+		5764607523034234880,
+	}
+	shifts := []uint{0, 1, 2, 3, 31, 32, 33, 63, 64, 65, 128}
+
+	for _, a := range values {
+		x := big.NewInt(int64(a))
+		for _, i := range shifts {
+			if got, want := a.RshRound(i), rshRoundBigInt(x, i); got.BigInt().Cmp(want) != 0 {
+				t.Errorf("Int64(%d).RshRound(%d) = %d, want %d", a, i, got, want)
+			}
+			if got, want := a.RshCeil(i), rshCeilBigInt(x, i); got.BigInt().Cmp(want) != 0 {
+				t.Errorf("Int64(%d).RshCeil(%d) = %d, want %d", a, i, got, want)
+			}
+			if got, want := a.URsh(i), new(big.Int).Rsh(new(big.Int).SetUint64(uint64(uint64(a))), i); Uint64(got).BigInt().Cmp(want) != 0 {
+				t.Errorf("Int64(%d).URsh(%d) = %d, want %d", a, i, got, want)
+			}
+		}
+	}
+}
+
+func FuzzInt64_Mul128(f *testing.F) {
+	f.Add(int64(0), int64(0))
+	f.Add(int64(-1), int64(1))
+	f.Add(int64(math.MinInt64), int64(math.MinInt64))
+	f.Add(int64(math.MinInt64), int64(math.MaxInt64))
+	f.Add(int64(math.MaxInt64), int64(math.MaxInt64))
+
+	f.Fuzz(func(t *testing.T, x, y int64) {
+		a, b := Int64(x), Int64(y)
+		got := int128ToBigInt(a.Mul128(b))
+		want := new(big.Int).Mul(big.NewInt(x), big.NewInt(y))
+		if got.Cmp(want) != 0 {
+			t.Errorf("Int64(%d).Mul128(%d) = %d, want %d", a, b, got, want)
+		}
+	})
+}
+
+func TestInt64_Sqrt(t *testing.T) {
+	testCases := []struct {
+		a        Int64
+		sqrt     Int64
+		cbrt     Int64
+		root5    Int64
+		isSquare bool
+	}{
+		{0, 0, 0, 0, true},
+		{1, 1, 1, 1, true},
+		{2, 1, 1, 1, false},
+		{3, 1, 1, 1, false},
+		{4, 2, 1, 1, true},
+		{15, 3, 2, 1, false},
+		{16, 4, 2, 1, true},
+		{17, 4, 2, 1, false},
+		{26, 5, 2, 1, false},
+		{27, 5, 3, 1, false},
+		{28, 5, 3, 1, false},
+		{1000, 31, 10, 3, false},
+		{1024, 32, 10, 4, true},
+		{12345678, 3513, 231, 26, false},
+		{3486784401, 59049, 1516, 81, true},
+		{4611686018427387903, 2147483647, 1664510, 5404, false},
+		{9223372036854775806, 3037000499, 2097151, 6208, false},
+		{9223372036854775807, 3037000499, 2097151, 6208, false},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.a.Sqrt(); got != tc.sqrt {
+			t.Errorf("Int64(%d).Sqrt() = %d, want %d", tc.a, got, tc.sqrt)
+		}
+		if got := tc.a.Cbrt(); got != tc.cbrt {
+			t.Errorf("Int64(%d).Cbrt() = %d, want %d", tc.a, got, tc.cbrt)
+		}
+		if got := tc.a.Root(5); got != tc.root5 {
+			t.Errorf("Int64(%d).Root(5) = %d, want %d", tc.a, got, tc.root5)
+		}
+		if got := tc.a.IsPerfectSquare(); got != tc.isSquare {
+			t.Errorf("Int64(%d).IsPerfectSquare() = %t, want %t", tc.a, got, tc.isSquare)
+		}
+	}
+}
+
+func TestInt64_Sqrt_Panic(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Int64(-4).Sqrt() did not panic")
+		}
+	}()
+	Int64(-4).Sqrt()
+}
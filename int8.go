@@ -3,17 +3,60 @@ package ints
 import (
 	"cmp"
 	"fmt"
+	"math"
+	"math/big"
+	"math/bits"
 )
 
 // Int8 is a type that represents an 8-bit signed integer.
 // It is an alias for the built-in int8 type.
 type Int8 int8
 
+const (
+	// MaxInt8 is the maximum value of Int8.
+	MaxInt8 Int8 = math.MaxInt8
+
+	// MinInt8 is the minimum value of Int8.
+	MinInt8 Int8 = math.MinInt8
+)
+
+// Int8Of returns v as an Int8.
+// v is converted in the same way as Go's integer conversions.
+func Int8Of[T builtinInteger](v T) Int8 {
+	return Int8(v)
+}
+
 // IsZero returns true if a is zero.
 func (a Int8) IsZero() bool {
 	return a == 0
 }
 
+// IsInt64 reports whether a can be represented as an int64.
+func (a Int8) IsInt64() bool {
+	_, ok := a.TryInt64()
+	return ok
+}
+
+// IsUint64 reports whether a can be represented as a uint64.
+func (a Int8) IsUint64() bool {
+	_, ok := a.TryUint64()
+	return ok
+}
+
+// Int returns a as an int and reports whether a can be represented as an int.
+// If it can't, the result is a truncated to the size of int.
+func (a Int8) Int() (int, bool) {
+	v, ok := a.TryInt64()
+	return int(v), ok && int64(int(v)) == int64(v)
+}
+
+// Uint returns a as a uint and reports whether a can be represented as a uint.
+// If it can't, the result is a truncated to the size of uint.
+func (a Int8) Uint() (uint, bool) {
+	v, ok := a.TryUint64()
+	return uint(v), ok && uint64(uint(v)) == uint64(v)
+}
+
 // Add returns the sum a+b.
 //
 // This function's execution time does not depend on the inputs.
@@ -21,6 +64,27 @@ func (a Int8) Add(b Int8) Int8 {
 	return a + b
 }
 
+// AddOverflow returns the sum a+b and reports whether the addition overflowed.
+//
+// This function's execution time does not depend on the inputs.
+func (a Int8) AddOverflow(b Int8) (Int8, bool) {
+	c := a + b
+	return c, (a^c)&(b^c) < 0
+}
+
+// SaturatingAdd returns the sum a+b.
+// If the result overflows, it is clamped to the minimum or maximum value.
+func (a Int8) SaturatingAdd(b Int8) Int8 {
+	c, overflow := a.AddOverflow(b)
+	if overflow {
+		if a < 0 {
+			return math.MinInt8
+		}
+		return math.MaxInt8
+	}
+	return c
+}
+
 // Sub returns the difference a-b.
 //
 // This function's execution time does not depend on the inputs.
@@ -28,6 +92,27 @@ func (a Int8) Sub(b Int8) Int8 {
 	return a - b
 }
 
+// SubOverflow returns the difference a-b and reports whether the subtraction overflowed.
+//
+// This function's execution time does not depend on the inputs.
+func (a Int8) SubOverflow(b Int8) (Int8, bool) {
+	c := a - b
+	return c, (a^b)&(a^c) < 0
+}
+
+// SaturatingSub returns the difference a-b.
+// If the result overflows, it is clamped to the minimum or maximum value.
+func (a Int8) SaturatingSub(b Int8) Int8 {
+	c, overflow := a.SubOverflow(b)
+	if overflow {
+		if a < 0 {
+			return math.MinInt8
+		}
+		return math.MaxInt8
+	}
+	return c
+}
+
 // Mul returns the product a*b.
 //
 // This function's execution time does not depend on the inputs.
@@ -35,6 +120,32 @@ func (a Int8) Mul(b Int8) Int8 {
 	return a * b
 }
 
+// MulOverflow returns the product a*b and reports whether the multiplication overflowed.
+func (a Int8) MulOverflow(b Int8) (Int8, bool) {
+	c := int16(a) * int16(b)
+	return Int8(c), c != int16(int8(c))
+}
+
+// SaturatingMul returns the product a*b.
+// If the result overflows, it is clamped to the minimum or maximum value.
+func (a Int8) SaturatingMul(b Int8) Int8 {
+	c, overflow := a.MulOverflow(b)
+	if overflow {
+		if (a < 0) != (b < 0) {
+			return math.MinInt8
+		}
+		return math.MaxInt8
+	}
+	return c
+}
+
+// Mul16 returns the product a*b, the result is a 16-bit integer.
+//
+// This function's execution time does not depend on the inputs.
+func (a Int8) Mul16(b Int8) Int16 {
+	return Int16(a) * Int16(b)
+}
+
 // Div returns the quotient a/b for b != 0.
 // If b == 0, a division-by-zero run-time panic occurs.
 // Div implements Euclidean division (unlike Go); see [Int8.DivMod] for more details.
@@ -134,6 +245,13 @@ func (a Int8) Lsh(i uint) Int8 {
 	return a << i
 }
 
+// LshOverflow returns the left shift a<<i and reports whether the shift overflowed,
+// i.e. whether a<<i differs from a multiplied by 2**i.
+func (a Int8) LshOverflow(i uint) (Int8, bool) {
+	c := a << i
+	return c, c>>i != a
+}
+
 // Rsh returns the arithmetic right shift a>>i, preserving the sign bit.
 //
 // This function's execution time does not depend on the inputs.
@@ -141,6 +259,74 @@ func (a Int8) Rsh(i uint) Int8 {
 	return a >> i
 }
 
+// URsh returns the logical right shift a>>i, treating a as an unsigned integer.
+// The vacated upper bits are filled with zeros regardless of the sign of a.
+func (a Int8) URsh(i uint) Int8 {
+	return Int8(Uint8(a).Rsh(i))
+}
+
+// RshRound returns a/2**i rounded to the nearest integer, with ties rounded to even.
+func (a Int8) RshRound(i uint) Int8 {
+	if i == 0 {
+		return a
+	}
+	q := a.Rsh(i)
+	half := a.Bit(int(min(i-1, 8)))
+	sticky := Uint8(a).Extract(0, i-1) != 0
+	if half == 1 && (sticky || q.Bit(0) == 1) {
+		q++
+	}
+	return q
+}
+
+// RshCeil returns a/2**i rounded toward positive infinity.
+func (a Int8) RshCeil(i uint) Int8 {
+	q := a.Rsh(i)
+	if Uint8(a).Extract(0, i) != 0 {
+		q++
+	}
+	return q
+}
+
+// LeadingZeros returns the number of leading zero bits in the two's complement representation of a;
+// the result is 0 for a < 0, and 8 for a == 0.
+func (a Int8) LeadingZeros() int {
+	return bits.LeadingZeros8(uint8(a))
+}
+
+// TrailingZeros returns the number of trailing zero bits in a; the result is 8 for a == 0.
+// a and -a have the same number of trailing zero bits.
+func (a Int8) TrailingZeros() int {
+	return bits.TrailingZeros8(uint8(a))
+}
+
+// BitLen returns the number of bits required to represent the absolute value of a in binary;
+// the result is 0 for a == 0.
+// It is the same as [big.Int.BitLen].
+func (a Int8) BitLen() int {
+	if a < 0 {
+		// the negation of the minimum value is itself,
+		// but it is correctly interpreted as 2**7 in unsigned.
+		a = -a
+	}
+	return bits.Len8(uint8(a))
+}
+
+// OnesCount returns the number of one bits ("population count") in the two's complement representation of a.
+func (a Int8) OnesCount() int {
+	return bits.OnesCount8(uint8(a))
+}
+
+// Bit returns the value of the i'th bit of the two's complement representation of a.
+// That is, it returns (a>>i)&1, and the sign bit for i >= 8, like [big.Int.Bit].
+// The bit index i must be >= 0.
+func (a Int8) Bit(i int) uint {
+	if i < 0 {
+		panic("ints: negative bit index")
+	}
+	return uint(a>>min(uint(i), 7)) & 1
+}
+
 // Sign returns the sign of a.
 // It returns 1 if a > 0, -1 if a < 0, and 0 if a == 0.
 func (a Int8) Sign() int {
@@ -161,6 +347,72 @@ func (a Int8) Neg() Int8 {
 	return -a
 }
 
+// NegOverflow returns the negation of a and reports whether the negation overflowed,
+// which happens only if a is the minimum value.
+//
+// This function's execution time does not depend on the inputs.
+func (a Int8) NegOverflow() (Int8, bool) {
+	return -a, a == -1<<7
+}
+
+// SaturatingNeg returns the negation of a.
+// If a is the minimum value, it returns the maximum value.
+func (a Int8) SaturatingNeg() Int8 {
+	c, overflow := a.NegOverflow()
+	if overflow {
+		return math.MaxInt8
+	}
+	return c
+}
+
+// Abs returns the absolute value of a.
+// If a is the minimum value, Abs returns a itself because its absolute value can't be represented.
+func (a Int8) Abs() Int8 {
+	if a < 0 {
+		return a.Neg()
+	}
+	return a
+}
+
+// SaturatingAbs returns the absolute value of a.
+// If a is the minimum value, it returns the maximum value.
+func (a Int8) SaturatingAbs() Int8 {
+	if a < 0 {
+		return a.SaturatingNeg()
+	}
+	return a
+}
+
+// Sqrt returns the floor square root of a, ⌊√a⌋.
+// It panics if a < 0.
+func (a Int8) Sqrt() Int8 {
+	if a.Sign() < 0 {
+		panic("ints: square root of negative number")
+	}
+	return Int8(Uint8(a).Sqrt())
+}
+
+// Cbrt returns the floor cube root of a, ⌊∛a⌋.
+// It panics if a < 0.
+func (a Int8) Cbrt() Int8 {
+	return a.Root(3)
+}
+
+// Root returns the floor n-th root of a, ⌊a**(1/n)⌋.
+// It panics if a < 0 or n == 0.
+func (a Int8) Root(n uint) Int8 {
+	if a.Sign() < 0 {
+		panic("ints: root of negative number")
+	}
+	return Int8(Uint8(a).Root(n))
+}
+
+// IsPerfectSquare reports whether a is the square of an integer.
+// Negative numbers are never perfect squares.
+func (a Int8) IsPerfectSquare() bool {
+	return a.Sign() >= 0 && Uint8(a).IsPerfectSquare()
+}
+
 // Cmp returns the comparison result of a and b.
 // It returns -1 if a < b, 0 if a == b, and 1 if a > b.
 func (a Int8) Cmp(b Int8) int {
@@ -199,3 +451,174 @@ func (a Int8) Format(s fmt.State, verb rune) {
 	}
 	format(s, verb, sign, b)
 }
+
+// ParseInt8 interprets a string s in the given base (0, 2 to 62) and
+// returns the corresponding value.
+//
+// The string may begin with a leading sign: "+" or "-".
+//
+// If the base argument is 0, the true base is implied by the string's
+// prefix following the sign (if present): 2 for "0b", 8 for "0" or "0o",
+// 16 for "0x", and 10 otherwise. Also, for argument base 0 only,
+// underscore characters are permitted as defined by the Go syntax for
+// [integer literals].
+// For bases <= 36, lower and upper case letters are considered the same:
+// The letters 'a' to 'z' and 'A' to 'Z' represent digit values 10 to 35.
+// For bases > 36, the upper case letters 'A' to 'Z' represent the digit values 36 to 61,
+// as generated by [Int8.Text].
+//
+// The errors that ParseInt8 returns have concrete type [*strconv.NumError]
+// and include err.Num = s. If s is empty or contains invalid
+// digits, err.Err = [strconv.ErrSyntax] and the returned value is 0;
+// if the value corresponding to s cannot be represented by an Int8,
+// err.Err = [strconv.ErrRange] and the returned value is the maximum magnitude integer
+// of the appropriate sign.
+//
+// [integer literals]: https://go.dev/ref/spec#Integer_literals
+func ParseInt8(s string, base int) (Int8, error) {
+	var z [1]uint64
+	err := parseInt("ParseInt8", s, base, 8, z[:])
+	return Int8(z[0]), err
+}
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+// The result is the decimal representation of a.
+func (a Int8) MarshalText() ([]byte, error) {
+	return a.AppendText(nil)
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+// It accepts the same syntax as Go integer literals, such as "0x" prefixes and underscores.
+// See [ParseInt8] for more details.
+func (a *Int8) UnmarshalText(text []byte) error {
+	v, err := ParseInt8(string(text), 0)
+	if err != nil {
+		return err
+	}
+	*a = v
+	return nil
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+// The result is a JSON number. Use [JSONString] to encode a as a JSON string.
+func (a Int8) MarshalJSON() ([]byte, error) {
+	return a.AppendText(nil)
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+// It accepts both a JSON number such as 123 and a JSON string such as "123" or "0x7b".
+// JSON strings are parsed in the same way as [Int8.UnmarshalText].
+func (a *Int8) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(a, data, ParseInt8)
+}
+
+// BytesBE returns the two's complement big-endian representation of a.
+func (a Int8) BytesBE() [1]byte {
+	var b [1]byte
+	a.PutBytesBE(b[:])
+	return b
+}
+
+// BytesLE returns the two's complement little-endian representation of a.
+func (a Int8) BytesLE() [1]byte {
+	var b [1]byte
+	a.PutBytesLE(b[:])
+	return b
+}
+
+// PutBytesBE stores the two's complement big-endian representation of a into b.
+// It panics if len(b) < 1.
+func (a Int8) PutBytesBE(b []byte) {
+	b[0] = byte(a)
+}
+
+// PutBytesLE stores the two's complement little-endian representation of a into b.
+// It panics if len(b) < 1.
+func (a Int8) PutBytesLE(b []byte) {
+	b[0] = byte(a)
+}
+
+// Int8FromBytesBE returns the Int8 represented by the two's complement big-endian bytes b[:1].
+// It panics if len(b) < 1.
+func Int8FromBytesBE(b []byte) Int8 {
+	return Int8(b[0])
+}
+
+// Int8FromBytesLE returns the Int8 represented by the two's complement little-endian bytes b[:1].
+// It panics if len(b) < 1.
+func Int8FromBytesLE(b []byte) Int8 {
+	return Int8(b[0])
+}
+
+// AppendBinary implements the [encoding.BinaryAppender] interface.
+// It appends the two's complement big-endian representation of a to dst.
+func (a Int8) AppendBinary(dst []byte) ([]byte, error) {
+	b := a.BytesBE()
+	return append(dst, b[:]...), nil
+}
+
+// MarshalBinary implements the [encoding.BinaryMarshaler] interface.
+// The result is the 1-byte two's complement big-endian representation of a.
+func (a Int8) MarshalBinary() ([]byte, error) {
+	return a.AppendBinary(make([]byte, 0, 1))
+}
+
+// UnmarshalBinary implements the [encoding.BinaryUnmarshaler] interface.
+// data must be exactly 1 bytes of the two's complement big-endian representation, as generated by [Int8.MarshalBinary].
+func (a *Int8) UnmarshalBinary(data []byte) error {
+	if len(data) != 1 {
+		return fmt.Errorf("ints: Int8.UnmarshalBinary: invalid length %d, want 1", len(data))
+	}
+	*a = Int8FromBytesBE(data)
+	return nil
+}
+
+// BigInt returns a as a new [*big.Int].
+func (a Int8) BigInt() *big.Int {
+	return new(big.Int).SetInt64(int64(a))
+}
+
+// Int8FromBigInt returns x as an Int8.
+// If x is not representable as an Int8, the result is the lower 8 bits of x in two's complement,
+// and ok is false.
+func Int8FromBigInt(x *big.Int) (Int8, bool) {
+	var z [1]uint64
+	ok := intFromBigInt(z[:], 8, x)
+	return Int8(z[0]), ok
+}
+
+// Float64 returns the float64 value nearest to a, rounding ties to even, and an indication of any rounding error.
+// If a is too large to be represented by a float64, the result is ±Inf.
+func (a Int8) Float64() (float64, big.Accuracy) {
+	return float64(a), big.Exact
+}
+
+// Int8FromFloat64 returns the integer value of f rounded according to mode,
+// and the accuracy of the result: [big.Below] if the result is less than f, [big.Above] if it is greater than f.
+// Use [big.ToZero] to truncate f like the Go conversion, and [big.ToNearestEven] to round f to the nearest integer.
+//
+// If f is NaN, the result is 0 and the error is [ErrNaN].
+// If the rounded value of f is not representable as an Int8, including ±Inf,
+// the result is clamped to the minimum or maximum value of Int8, and the error is [ErrOverflow].
+func Int8FromFloat64(f float64, mode big.RoundingMode) (Int8, big.Accuracy, error) {
+	var z [1]uint64
+	acc, err := intFromFloat64(z[:], 8, f, mode)
+	return Int8(z[0]), acc, err
+}
+
+// BigFloat returns a as a new [*big.Float].
+// The precision of the result is 8 bits, so the conversion is always exact.
+func (a Int8) BigFloat() *big.Float {
+	return new(big.Float).SetPrec(8).SetInt64(int64(a))
+}
+
+// Int8FromBigFloat returns the integer value of x rounded according to mode,
+// and the accuracy of the result: [big.Below] if the result is less than x, [big.Above] if it is greater than x.
+//
+// If the rounded value of x is not representable as an Int8, including ±Inf,
+// the result is clamped to the minimum or maximum value of Int8, and the error is [ErrOverflow].
+func Int8FromBigFloat(x *big.Float, mode big.RoundingMode) (Int8, big.Accuracy, error) {
+	var z [1]uint64
+	acc, err := intFromBigFloat(z[:], 8, x, mode)
+	return Int8(z[0]), acc, err
+}
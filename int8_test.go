@@ -1,9 +1,14 @@
 package ints
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
 	"math/big"
+	"math/bits"
+	"slices"
 	"strconv"
 	"testing"
 )
@@ -450,3 +455,578 @@ func TestInt8_Format(t *testing.T) {
 		}
 	}
 }
+
+func TestParseInt8(t *testing.T) {
+	testCases := []struct {
+		s    string
+		base int
+		want Int8
+		err  error
+	}{
+		{"0", 10, 0, nil},
+		{"1", 10, 1, nil},
+		{"127", 10, 127, nil},
+		{"128", 10, 127, strconv.ErrRange},
+		{"0x7f", 0, 127, nil},
+		{"0b101", 0, 5, nil},
+		{"0o17", 0, 15, nil},
+		{"017", 0, 15, nil},
+		{"1_000", 0, 127, strconv.ErrRange},
+		{"1_000", 10, 0, strconv.ErrSyntax},
+		{"_1", 0, 0, strconv.ErrSyntax},
+		{"Z", 62, 61, nil},
+		{"z", 36, 35, nil},
+		{"Z", 36, 35, nil},
+		{"", 10, 0, strconv.ErrSyntax},
+		{"12a", 10, 0, strconv.ErrSyntax},
+		{"-1", 10, -1, nil},
+		{"+1", 10, 1, nil},
+		{"-128", 10, -128, nil},
+		{"-129", 10, -128, strconv.ErrRange},
+		{"-0x80", 0, -128, nil},
+		{"-", 10, 0, strconv.ErrSyntax},
+	}
+
+	for _, tc := range testCases {
+		got, err := ParseInt8(tc.s, tc.base)
+		if got != tc.want {
+			t.Errorf("ParseInt8(%q, %d) = %d, want %d", tc.s, tc.base, got, tc.want)
+		}
+		if !errors.Is(err, tc.err) {
+			t.Errorf("ParseInt8(%q, %d) error = %v, want %v", tc.s, tc.base, err, tc.err)
+		}
+		if err != nil {
+			var numErr *strconv.NumError
+			if !errors.As(err, &numErr) || numErr.Func != "ParseInt8" || numErr.Num != tc.s {
+				t.Errorf("ParseInt8(%q, %d) error = %#v, want *strconv.NumError", tc.s, tc.base, err)
+			}
+		}
+	}
+
+	if _, err := ParseInt8("1", 63); err == nil {
+		t.Errorf("ParseInt8(%q, %d) should fail", "1", 63)
+	}
+}
+
+func TestParseInt8_RoundTrip(t *testing.T) {
+	for i := math.MinInt8; i <= math.MaxInt8; i++ {
+		a := Int8(i)
+		for base := 2; base <= 62; base++ {
+			got, err := ParseInt8(a.Text(base), base)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != a {
+				t.Errorf("ParseInt8(%q, %d) = %d, want %d", a.Text(base), base, got, a)
+			}
+		}
+	}
+}
+
+func TestInt8_MarshalText(t *testing.T) {
+	testCases := []struct {
+		x    Int8
+		want string
+	}{
+		{0, "0"},
+		{1, "1"},
+		{127, "127"},
+		{-1, "-1"},
+		{-128, "-128"},
+	}
+
+	for _, tc := range testCases {
+		got, err := tc.x.MarshalText()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != tc.want {
+			t.Errorf("Int8(%d).MarshalText() = %q, want %q", tc.x, got, tc.want)
+		}
+	}
+}
+
+func TestInt8_UnmarshalText(t *testing.T) {
+	testCases := []struct {
+		s    string
+		want Int8
+		err  error
+	}{
+		{"0", 0, nil},
+		{"0x10", 16, nil},
+		{"0b1_0", 2, nil},
+		{"010", 8, nil},
+		{"127", 127, nil},
+		{"128", 0, strconv.ErrRange},
+		{"1.0", 0, strconv.ErrSyntax},
+		{"-0x10", -16, nil},
+		{"-129", 0, strconv.ErrRange},
+	}
+
+	for _, tc := range testCases {
+		var got Int8
+		err := got.UnmarshalText([]byte(tc.s))
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Int8.UnmarshalText(%q) error = %v, want %v", tc.s, err, tc.err)
+		}
+		if got != tc.want {
+			t.Errorf("Int8.UnmarshalText(%q) = %d, want %d", tc.s, got, tc.want)
+		}
+	}
+}
+
+func TestInt8_MarshalJSON(t *testing.T) {
+	testCases := []struct {
+		x    Int8
+		want string
+	}{
+		{0, "0"},
+		{1, "1"},
+		{127, "127"},
+		{-1, "-1"},
+		{-128, "-128"},
+	}
+
+	for _, tc := range testCases {
+		got, err := json.Marshal(tc.x)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != tc.want {
+			t.Errorf("json.Marshal(Int8(%d)) = %s, want %s", tc.x, got, tc.want)
+		}
+	}
+}
+
+func TestInt8_UnmarshalJSON(t *testing.T) {
+	testCases := []struct {
+		s       string
+		want    Int8
+		wantErr bool
+	}{
+		{"0", 0, false},
+		{"123", 123, false},
+		{"\"123\"", 123, false},
+		{"\"0x7f\"", 127, false},
+		{"127", 127, false},
+		{"\"127\"", 127, false},
+		{"128", 0, true},
+		{"0x7f", 0, true},
+		{"\"abc\"", 0, true},
+		{"1.5", 0, true},
+		{"null", 0, false},
+		{"-1", -1, false},
+		{"\"-1\"", -1, false},
+		{"-128", -128, false},
+	}
+
+	for _, tc := range testCases {
+		var got Int8
+		err := json.Unmarshal([]byte(tc.s), &got)
+		if (err != nil) != tc.wantErr {
+			t.Errorf("json.Unmarshal(%s) error = %v, wantErr %t", tc.s, err, tc.wantErr)
+		}
+		if got != tc.want {
+			t.Errorf("json.Unmarshal(%s) = %d, want %d", tc.s, got, tc.want)
+		}
+	}
+}
+
+func TestInt8_Bytes(t *testing.T) {
+	testCases := []struct {
+		x  Int8
+		be [1]byte
+	}{
+		{
+			1,
+			[1]byte{0x01},
+		},
+		{
+			-2,
+			[1]byte{0xfe},
+		},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.x.BytesBE(); got != tc.be {
+			t.Errorf("Int8(%d).BytesBE() = %x, want %x", tc.x, got, tc.be)
+		}
+		if got := Int8FromBytesBE(tc.be[:]); got != tc.x {
+			t.Errorf("Int8FromBytesBE(%x) = %d, want %d", tc.be, got, tc.x)
+		}
+
+		le := tc.be
+		slices.Reverse(le[:])
+		if got := tc.x.BytesLE(); got != le {
+			t.Errorf("Int8(%d).BytesLE() = %x, want %x", tc.x, got, le)
+		}
+		if got := Int8FromBytesLE(le[:]); got != tc.x {
+			t.Errorf("Int8FromBytesLE(%x) = %d, want %d", le, got, tc.x)
+		}
+
+		data, err := tc.x.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(data, tc.be[:]) {
+			t.Errorf("Int8(%d).MarshalBinary() = %x, want %x", tc.x, data, tc.be)
+		}
+		var got Int8
+		if err := got.UnmarshalBinary(data); err != nil {
+			t.Fatal(err)
+		}
+		if got != tc.x {
+			t.Errorf("Int8.UnmarshalBinary(%x) = %d, want %d", data, got, tc.x)
+		}
+	}
+}
+
+func TestInt8_UnmarshalBinary_InvalidLength(t *testing.T) {
+	var a Int8
+	if err := a.UnmarshalBinary(make([]byte, 0)); err == nil {
+		t.Error("want error, got nil")
+	}
+	if err := a.UnmarshalBinary(make([]byte, 2)); err == nil {
+		t.Error("want error, got nil")
+	}
+}
+
+func TestInt8FromBigInt(t *testing.T) {
+	testCases := []struct {
+		x    *big.Int
+		want Int8
+		ok   bool
+	}{
+		{big.NewInt(0), 0, true},
+		{big.NewInt(1), 1, true},
+		{big.NewInt(127), 127, true},
+		{big.NewInt(128), -128, false},
+		{big.NewInt(256), 0, false},
+		{big.NewInt(-1), -1, true},
+		{big.NewInt(-128), -128, true},
+		{big.NewInt(-129), 127, false},
+	}
+
+	for _, tc := range testCases {
+		got, ok := Int8FromBigInt(tc.x)
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Int8FromBigInt(%s) = %d, %t, want %d, %t", tc.x, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestInt8_BigInt(t *testing.T) {
+	for i := math.MinInt8; i <= math.MaxInt8; i++ {
+		a := Int8(i)
+		got := a.BigInt()
+		if got.Cmp(big.NewInt(int64(i))) != 0 {
+			t.Errorf("Int8(%d).BigInt() = %s, want %d", a, got, i)
+		}
+		if b, ok := Int8FromBigInt(got); b != a || !ok {
+			t.Errorf("Int8FromBigInt(%s) = %d, %t, want %d, true", got, b, ok, a)
+		}
+	}
+}
+
+func TestInt8FromFloat64(t *testing.T) {
+	testCases := []struct {
+		f    float64
+		mode big.RoundingMode
+		want Int8
+		acc  big.Accuracy
+		err  error
+	}{
+		{0, big.ToNearestEven, 0, big.Exact, nil},
+		{2.5, big.ToNearestEven, 2, big.Below, nil},
+		{3.5, big.ToNearestEven, 4, big.Above, nil},
+		{2.5, big.ToNearestAway, 3, big.Above, nil},
+		{2.5, big.ToZero, 2, big.Below, nil},
+		{2.5, big.AwayFromZero, 3, big.Above, nil},
+		{2.5, big.ToNegativeInf, 2, big.Below, nil},
+		{2.5, big.ToPositiveInf, 3, big.Above, nil},
+		{math.NaN(), big.ToNearestEven, 0, big.Exact, ErrNaN},
+		{math.Inf(1), big.ToNearestEven, 127, big.Below, ErrOverflow},
+		{math.Inf(-1), big.ToNearestEven, -128, big.Above, ErrOverflow},
+		{0x1p8, big.ToNearestEven, 127, big.Below, ErrOverflow},
+		{0x1p7, big.ToNearestEven, 127, big.Below, ErrOverflow},
+		{-2.5, big.ToNearestEven, -2, big.Above, nil},
+		{-2.5, big.ToZero, -2, big.Above, nil},
+		{-2.5, big.AwayFromZero, -3, big.Below, nil},
+		{-2.5, big.ToNegativeInf, -3, big.Below, nil},
+		{-0x1p7, big.ToNearestEven, -128, big.Exact, nil},
+	}
+
+	for _, tc := range testCases {
+		got, acc, err := Int8FromFloat64(tc.f, tc.mode)
+		if got != tc.want || acc != tc.acc || err != tc.err {
+			t.Errorf("Int8FromFloat64(%v, %s) = %d, %s, %v, want %d, %s, %v", tc.f, tc.mode, got, acc, err, tc.want, tc.acc, tc.err)
+		}
+
+		if math.IsNaN(tc.f) {
+			continue
+		}
+		got, acc, err = Int8FromBigFloat(big.NewFloat(tc.f), tc.mode)
+		if got != tc.want || acc != tc.acc || err != tc.err {
+			t.Errorf("Int8FromBigFloat(%v, %s) = %d, %s, %v, want %d, %s, %v", tc.f, tc.mode, got, acc, err, tc.want, tc.acc, tc.err)
+		}
+	}
+}
+
+func TestInt8_Float64(t *testing.T) {
+	for i := math.MinInt8; i <= math.MaxInt8; i++ {
+		a := Int8(i)
+		f, acc := a.Float64()
+		if f != float64(i) || acc != big.Exact {
+			t.Errorf("Int8(%d).Float64() = %v, %s, want %v, %s", a, f, acc, float64(i), big.Exact)
+		}
+		if got := a.BigFloat(); got.Cmp(big.NewFloat(float64(i))) != 0 {
+			t.Errorf("Int8(%d).BigFloat() = %v, want %v", a, got, i)
+		}
+	}
+}
+
+func TestInt8_Overflow(t *testing.T) {
+	lo, hi := math.MinInt8, math.MaxInt8
+	for i := lo; i <= hi; i++ {
+		a := Int8(i)
+		for j := lo; j <= hi; j++ {
+			b := Int8(j)
+
+			got, overflow := a.AddOverflow(b)
+			if want := i + j; got != Int8(want) || overflow != (want < lo || want > hi) {
+				t.Errorf("Int8(%d).AddOverflow(%d) = %d, %t, want %d", a, b, got, overflow, want)
+			}
+
+			got, overflow = a.SubOverflow(b)
+			if want := i - j; got != Int8(want) || overflow != (want < lo || want > hi) {
+				t.Errorf("Int8(%d).SubOverflow(%d) = %d, %t, want %d", a, b, got, overflow, want)
+			}
+
+			got, overflow = a.MulOverflow(b)
+			if want := i * j; got != Int8(want) || overflow != (want < lo || want > hi) {
+				t.Errorf("Int8(%d).MulOverflow(%d) = %d, %t, want %d", a, b, got, overflow, want)
+			}
+		}
+
+		got, overflow := a.NegOverflow()
+		if want := -i; got != Int8(want) || overflow != (want < lo || want > hi) {
+			t.Errorf("Int8(%d).NegOverflow() = %d, %t, want %d", a, got, overflow, want)
+		}
+
+		for s := range uint(10) {
+			got, overflow := a.LshOverflow(s)
+			if want := i << s; got != Int8(want) || overflow != (want < lo || want > hi) {
+				t.Errorf("Int8(%d).LshOverflow(%d) = %d, %t, want %d", a, s, got, overflow, want)
+			}
+		}
+	}
+}
+
+func TestInt8_Saturating(t *testing.T) {
+	lo, hi := math.MinInt8, math.MaxInt8
+	for i := lo; i <= hi; i++ {
+		a := Int8(i)
+		for j := lo; j <= hi; j++ {
+			b := Int8(j)
+			if want := min(max(i+j, lo), hi); a.SaturatingAdd(b) != Int8(want) {
+				t.Errorf("Int8(%d).SaturatingAdd(%d) = %d, want %d", a, b, a.SaturatingAdd(b), want)
+			}
+			if want := min(max(i-j, lo), hi); a.SaturatingSub(b) != Int8(want) {
+				t.Errorf("Int8(%d).SaturatingSub(%d) = %d, want %d", a, b, a.SaturatingSub(b), want)
+			}
+			if want := min(max(i*j, lo), hi); a.SaturatingMul(b) != Int8(want) {
+				t.Errorf("Int8(%d).SaturatingMul(%d) = %d, want %d", a, b, a.SaturatingMul(b), want)
+			}
+		}
+
+		if want := min(max(-i, lo), hi); a.SaturatingNeg() != Int8(want) {
+			t.Errorf("Int8(%d).SaturatingNeg() = %d, want %d", a, a.SaturatingNeg(), want)
+		}
+		if want := min(max(i, -i), hi); a.SaturatingAbs() != Int8(want) {
+			t.Errorf("Int8(%d).SaturatingAbs() = %d, want %d", a, a.SaturatingAbs(), want)
+		}
+		if want := max(i, -i); a.Abs() != Int8(want) {
+			t.Errorf("Int8(%d).Abs() = %d, want %d", a, a.Abs(), Int8(want))
+		}
+	}
+}
+
+func TestInt8Of(t *testing.T) {
+	testCases := []struct {
+		v    string
+		got  Int8
+		want Int8
+	}{
+		{"int8(-1)", Int8Of(int8(-1)), -1},
+		{"int(42)", Int8Of(int(42)), 42},
+		{"uint64(math.MaxUint64)", Int8Of(uint64(math.MaxUint64)), -1},
+		{"int64(math.MinInt64)", Int8Of(int64(math.MinInt64)), 0},
+		{"uintptr(7)", Int8Of(uintptr(7)), 7},
+	}
+
+	for _, tc := range testCases {
+		if tc.got != tc.want {
+			t.Errorf("Int8Of(%s) = %d, want %d", tc.v, tc.got, tc.want)
+		}
+	}
+}
+
+func TestInt8_IsInt64(t *testing.T) {
+	testCases := []struct {
+		a        Int8
+		isInt64  bool
+		isUint64 bool
+		i64      int64
+		u64      uint64
+	}{
+		{-128, true, false, -128, 18446744073709551488},
+		{-1, true, false, -1, math.MaxUint64},
+		{0, true, true, 0, 0},
+		{1, true, true, 1, 1},
+		{127, true, true, 127, 127},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.a.IsInt64(); got != tc.isInt64 {
+			t.Errorf("Int8(%d).IsInt64() = %t, want %t", tc.a, got, tc.isInt64)
+		}
+		if got := tc.a.IsUint64(); got != tc.isUint64 {
+			t.Errorf("Int8(%d).IsUint64() = %t, want %t", tc.a, got, tc.isUint64)
+		}
+
+		got, ok := tc.a.Int()
+		want, wantOK := int(tc.i64), tc.isInt64 && int64(int(tc.i64)) == tc.i64
+		if got != want || ok != wantOK {
+			t.Errorf("Int8(%d).Int() = %d, %t, want %d, %t", tc.a, got, ok, want, wantOK)
+		}
+
+		gotU, ok := tc.a.Uint()
+		wantU, wantOK := uint(tc.u64), tc.isUint64 && uint64(uint(tc.u64)) == tc.u64
+		if gotU != wantU || ok != wantOK {
+			t.Errorf("Int8(%d).Uint() = %d, %t, want %d, %t", tc.a, gotU, ok, wantU, wantOK)
+		}
+	}
+}
+
+func TestInt8_Bits(t *testing.T) {
+	for i := math.MinInt8; i <= math.MaxInt8; i++ {
+		a := Int8(i)
+		u := uint8(i)
+		if got, want := a.LeadingZeros(), bits.LeadingZeros8(u); got != want {
+			t.Errorf("Int8(%d).LeadingZeros() = %d, want %d", a, got, want)
+		}
+		if got, want := a.TrailingZeros(), bits.TrailingZeros8(u); got != want {
+			t.Errorf("Int8(%d).TrailingZeros() = %d, want %d", a, got, want)
+		}
+		if got, want := a.BitLen(), big.NewInt(int64(i)).BitLen(); got != want {
+			t.Errorf("Int8(%d).BitLen() = %d, want %d", a, got, want)
+		}
+		if got, want := a.OnesCount(), bits.OnesCount8(u); got != want {
+			t.Errorf("Int8(%d).OnesCount() = %d, want %d", a, got, want)
+		}
+	}
+}
+
+func TestInt8_Bit(t *testing.T) {
+	testCases := []Int8{
+		0,
+		1,
+		-1,
+		5,
+		-6,
+		-128,
+		127,
+	}
+
+	for _, a := range testCases {
+		x := big.NewInt(int64(a))
+		for i := range 18 {
+			if got, want := a.Bit(i), x.Bit(i); got != want {
+				t.Errorf("Int8(%d).Bit(%d) = %d, want %d", a, i, got, want)
+			}
+		}
+	}
+}
+
+func TestInt8_RshRound(t *testing.T) {
+	values := []Int8{
+		0,
+		1,
+		2,
+		3,
+		5,
+		6,
+		8,
+		12,
+		127,
+		-128,
+		-127,
+		-80,
+		-1,
+		-12,
+		-2,
+		-3,
+		-5,
+		-6,
+		-8,
+		80,
+	}
+	shifts := []uint{0, 1, 2, 3, 4, 5, 7, 8, 9, 72}
+
+	for _, a := range values {
+		x := big.NewInt(int64(a))
+		for _, i := range shifts {
+			if got, want := a.RshRound(i), rshRoundBigInt(x, i); got.BigInt().Cmp(want) != 0 {
+				t.Errorf("Int8(%d).RshRound(%d) = %d, want %d", a, i, got, want)
+			}
+			if got, want := a.RshCeil(i), rshCeilBigInt(x, i); got.BigInt().Cmp(want) != 0 {
+				t.Errorf("Int8(%d).RshCeil(%d) = %d, want %d", a, i, got, want)
+			}
+			if got, want := a.URsh(i), new(big.Int).Rsh(new(big.Int).SetUint64(uint64(uint8(a))), i); Uint8(got).BigInt().Cmp(want) != 0 {
+				t.Errorf("Int8(%d).URsh(%d) = %d, want %d", a, i, got, want)
+			}
+		}
+	}
+}
+
+func TestInt8_Mul16(t *testing.T) {
+	for i := math.MinInt8; i <= math.MaxInt8; i++ {
+		for j := math.MinInt8; j <= math.MaxInt8; j++ {
+			a, b := Int8(i), Int8(j)
+			if got, want := a.Mul16(b), Int16(i*j); got != want {
+				t.Errorf("Int8(%d).Mul16(%d) = %d, want %d", a, b, got, want)
+			}
+		}
+	}
+}
+
+func TestInt8_Sqrt(t *testing.T) {
+	for i := range 128 {
+		a := Int8(i)
+		if got, want := a.Sqrt(), Uint8(i).Sqrt(); got != Int8(want) {
+			t.Errorf("Int8(%d).Sqrt() = %d, want %d", a, got, want)
+		}
+		for n := uint(1); n <= 9; n++ {
+			if got, want := a.Root(n), Uint8(i).Root(n); got != Int8(want) {
+				t.Errorf("Int8(%d).Root(%d) = %d, want %d", a, n, got, want)
+			}
+		}
+		if got, want := a.IsPerfectSquare(), Uint8(i).IsPerfectSquare(); got != want {
+			t.Errorf("Int8(%d).IsPerfectSquare() = %t, want %t", a, got, want)
+		}
+	}
+	for i := -128; i < 0; i++ {
+		if Int8(i).IsPerfectSquare() {
+			t.Errorf("Int8(%d).IsPerfectSquare() = true, want false", i)
+		}
+	}
+}
+
+func TestInt8_Sqrt_Panic(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Int8(-1).Sqrt() did not panic")
+		}
+	}()
+	Int8(-1).Sqrt()
+}
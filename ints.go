@@ -2,11 +2,56 @@ package ints
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"math/bits"
 	"strconv"
+	"strings"
 )
 
+// builtinInteger is the set of Go's built-in integer types.
+type builtinInteger interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// isSigned reports whether T is a signed integer type.
+func isSigned[T builtinInteger]() bool {
+	return ^T(0) < 0
+}
+
+// checkBitIndex panics if the bit index i is out of the range [0, n).
+func checkBitIndex(i, n int) {
+	if uint(i) >= uint(n) {
+		panic("ints: bit index out of range")
+	}
+}
+
+// panicDivideByZero panics with the same runtime error as [bits.Div64] for a zero divisor.
+func panicDivideByZero() {
+	var zero uint64
+	bits.Div64(0, 0, zero)
+}
+
+// panicDivideOverflow panics with the same runtime error as [bits.Div64] for a quotient overflow.
+func panicDivideOverflow() {
+	var one uint64 = 1
+	bits.Div64(one, 0, one)
+}
+
+// Zero returns the zero value of T.
+func Zero[T Integer[T]]() T {
+	var zero T
+	return zero
+}
+
+// One returns the value one of T.
+func One[T Integer[T]]() T {
+	// -^0 = -(-1) = 1 in two's complement, for every width.
+	var zero T
+	return zero.Not().Neg()
+}
+
 func formatInt(i int64, base int) string {
 	if base < 36 {
 		return strconv.FormatInt(i, base)
@@ -962,6 +1007,268 @@ func formatBits1024(dst []byte, u0, u1, u2, u3, u4, u5, u6, u7, u8, u9, u10, u11
 	return
 }
 
+func syntaxError(fn, str string) *strconv.NumError {
+	return &strconv.NumError{Func: fn, Num: strings.Clone(str), Err: strconv.ErrSyntax}
+}
+
+func rangeError(fn, str string) *strconv.NumError {
+	return &strconv.NumError{Func: fn, Num: strings.Clone(str), Err: strconv.ErrRange}
+}
+
+func baseError(fn, str string, base int) *strconv.NumError {
+	return &strconv.NumError{Func: fn, Num: strings.Clone(str), Err: errors.New("invalid base " + strconv.Itoa(base))}
+}
+
+// lower(c) is a lower-case letter if and only if
+// c is either that lower-case letter or the equivalent upper-case letter.
+// Instead of writing c == 'x' || c == 'X' one can write lower(c) == 'x'.
+// Note that lower of non-letters can produce other non-letters.
+func lower(c byte) byte {
+	return c | ('x' - 'X')
+}
+
+// parseUint parses s as an unsigned integer in the given base and stores the result into z.
+// z holds the limbs of the result in big-endian order, and bitSize is the number of bits of the result.
+// It is the multi-word version of strconv.ParseUint.
+// https://github.com/golang/go/blob/go1.24.1/src/strconv/atoi.go#L55-L157
+func parseUint(fn, s string, base, bitSize int, z []uint64) error {
+	clear(z)
+	if s == "" {
+		return syntaxError(fn, s)
+	}
+
+	base0 := base == 0
+
+	s0 := s
+	switch {
+	case 2 <= base && base <= len(digits):
+		// valid base; nothing to do
+
+	case base == 0:
+		// Look for octal, hex prefix.
+		base = 10
+		if s[0] == '0' {
+			switch {
+			case len(s) >= 3 && lower(s[1]) == 'b':
+				base = 2
+				s = s[2:]
+			case len(s) >= 3 && lower(s[1]) == 'o':
+				base = 8
+				s = s[2:]
+			case len(s) >= 3 && lower(s[1]) == 'x':
+				base = 16
+				s = s[2:]
+			default:
+				base = 8
+				s = s[1:]
+			}
+		}
+
+	default:
+		return baseError(fn, s0, base)
+	}
+
+	// mask of the valid bits in the most significant limb.
+	top := ^uint64(0) >> (64*len(z) - bitSize)
+
+	underscores := false
+	for _, c := range []byte(s) {
+		var d byte
+		switch {
+		case c == '_' && base0:
+			underscores = true
+			continue
+		case '0' <= c && c <= '9':
+			d = c - '0'
+		case 'a' <= c && c <= 'z':
+			d = c - 'a' + 10
+		case 'A' <= c && c <= 'Z':
+			if base <= 36 {
+				// case-insensitive for bases up to 36, like strconv.
+				d = c - 'A' + 10
+			} else {
+				d = c - 'A' + 36
+			}
+		default:
+			clear(z)
+			return syntaxError(fn, s0)
+		}
+
+		if d >= byte(base) {
+			clear(z)
+			return syntaxError(fn, s0)
+		}
+
+		// z = z*base + d
+		carry := uint64(d)
+		for i := len(z) - 1; i >= 0; i-- {
+			hi, lo := bits.Mul64(z[i], uint64(base))
+			var c uint64
+			z[i], c = bits.Add64(lo, carry, 0)
+			carry = hi + c
+		}
+		if carry != 0 || z[0] > top {
+			// overflow
+			maxUintLimbs(z, bitSize)
+			return rangeError(fn, s0)
+		}
+	}
+
+	if underscores && !underscoreOK(s0) {
+		clear(z)
+		return syntaxError(fn, s0)
+	}
+
+	return nil
+}
+
+// parseInt parses s as a signed integer in the given base and stores the result into z.
+// z holds the limbs of the result in two's complement big-endian order,
+// and bitSize is the number of bits of the result.
+// It is the multi-word version of strconv.ParseInt.
+// https://github.com/golang/go/blob/go1.24.1/src/strconv/atoi.go#L159-L222
+func parseInt(fn, s string, base, bitSize int, z []uint64) error {
+	if s == "" {
+		clear(z)
+		return syntaxError(fn, s)
+	}
+
+	// Pick off leading sign.
+	s0 := s
+	neg := false
+	if s[0] == '+' {
+		s = s[1:]
+	} else if s[0] == '-' {
+		neg = true
+		s = s[1:]
+	}
+
+	// Convert unsigned and check range.
+	err := parseUint(fn, s, base, bitSize, z)
+	if err != nil && err.(*strconv.NumError).Err != strconv.ErrRange {
+		err.(*strconv.NumError).Num = strings.Clone(s0)
+		return err
+	}
+
+	if err != nil || !fitsInt(z, bitSize, neg) {
+		if neg {
+			minIntLimbs(z, bitSize)
+		} else {
+			maxIntLimbs(z, bitSize)
+		}
+		return rangeError(fn, s0)
+	}
+
+	if neg {
+		negLimbs(z)
+	}
+	return nil
+}
+
+// maxUintLimbs sets z to the maximum value of bitSize-bit unsigned integers.
+func maxUintLimbs(z []uint64, bitSize int) {
+	for i := range z {
+		z[i] = ^uint64(0)
+	}
+	z[0] >>= 64*len(z) - bitSize
+}
+
+// maxIntLimbs sets z to the maximum value of bitSize-bit signed integers.
+func maxIntLimbs(z []uint64, bitSize int) {
+	for i := range z {
+		z[i] = ^uint64(0)
+	}
+	z[0] >>= 64*len(z) - bitSize + 1
+}
+
+// minIntLimbs sets z to the minimum value of bitSize-bit signed integers in two's complement.
+func minIntLimbs(z []uint64, bitSize int) {
+	clear(z)
+	z[0] = ^uint64(0) << ((bitSize - 1) % 64)
+}
+
+// negLimbs sets z to -z in two's complement.
+func negLimbs(z []uint64) {
+	var borrow uint64
+	for i := len(z) - 1; i >= 0; i-- {
+		z[i], borrow = bits.Sub64(0, z[i], borrow)
+	}
+}
+
+// fitsInt reports whether the magnitude z with the sign neg is representable as a bitSize-bit signed integer.
+// z holds the limbs of the magnitude in big-endian order.
+func fitsInt(z []uint64, bitSize int, neg bool) bool {
+	// the position of the sign bit in the most significant limb.
+	signBit := uint((bitSize - 1) % 64)
+	if z[0]>>signBit == 0 {
+		return true
+	}
+	if !neg {
+		return false
+	}
+
+	// -1<<(bitSize-1) is the only negative value whose magnitude has the sign bit set.
+	if z[0] != 1<<signBit {
+		return false
+	}
+	for _, v := range z[1:] {
+		if v != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// underscoreOK reports whether the underscores in s are allowed.
+// Checking them in this one function lets all the parsers skip over them simply.
+// Underscore must appear only between digits or between a base prefix and a digit.
+func underscoreOK(s string) bool {
+	// saw tracks the last character (class) we saw:
+	// ^ for beginning of number,
+	// 0 for a digit or base prefix,
+	// _ for an underscore,
+	// ! for none of the above.
+	saw := '^'
+	i := 0
+
+	// Optional sign.
+	if len(s) >= 1 && (s[0] == '-' || s[0] == '+') {
+		s = s[1:]
+	}
+
+	// Optional base prefix.
+	hex := false
+	if len(s) >= 2 && s[0] == '0' && (lower(s[1]) == 'b' || lower(s[1]) == 'o' || lower(s[1]) == 'x') {
+		i = 2
+		saw = '0' // base prefix counts as a digit for "underscore as digit separator"
+		hex = lower(s[1]) == 'x'
+	}
+
+	// Number proper.
+	for ; i < len(s); i++ {
+		// Digits are always okay.
+		if '0' <= s[i] && s[i] <= '9' || hex && 'a' <= lower(s[i]) && lower(s[i]) <= 'f' {
+			saw = '0'
+			continue
+		}
+		// Underscore must follow digit.
+		if s[i] == '_' {
+			if saw != '0' {
+				return false
+			}
+			saw = '_'
+			continue
+		}
+		// Underscore must also be followed by digit.
+		if saw == '_' {
+			return false
+		}
+		// Saw non-digit, non-underscore.
+		saw = '!'
+	}
+	return saw != '_'
+}
+
 func isPowerOfTwo(x int) bool {
 	return x&(x-1) == 0
 }
@@ -0,0 +1,38 @@
+package ints
+
+import (
+	"math/big"
+	"testing"
+)
+
+func testOneZero[T interface {
+	Integer[T]
+	BigInt() *big.Int
+}](t *testing.T) {
+	t.Helper()
+	if got := One[T]().BigInt(); got.Cmp(big.NewInt(1)) != 0 {
+		t.Errorf("One[%T]() = %d, want 1", Zero[T](), got)
+	}
+	if got := Zero[T]().BigInt(); got.Sign() != 0 {
+		t.Errorf("Zero[%T]() = %d, want 0", Zero[T](), got)
+	}
+}
+
+func TestOneZero(t *testing.T) {
+	testOneZero[Uint8](t)
+	testOneZero[Uint16](t)
+	testOneZero[Uint32](t)
+	testOneZero[Uint64](t)
+	testOneZero[Uint128](t)
+	testOneZero[Uint256](t)
+	testOneZero[Uint512](t)
+	testOneZero[Uint1024](t)
+	testOneZero[Int8](t)
+	testOneZero[Int16](t)
+	testOneZero[Int32](t)
+	testOneZero[Int64](t)
+	testOneZero[Int128](t)
+	testOneZero[Int256](t)
+	testOneZero[Int512](t)
+	testOneZero[Int1024](t)
+}
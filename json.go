@@ -0,0 +1,60 @@
+package ints
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// JSONString is a wrapper of an integer type that is encoded as a JSON string such as "123",
+// instead of a JSON number.
+// It is useful for JSON consumers that can't handle large integers as numbers, such as JavaScript.
+//
+// On decoding, both JSON numbers and JSON strings are accepted.
+type JSONString[T Integer[T]] struct {
+	Value T
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+// The result is the decimal representation of j.Value, quoted as a JSON string.
+func (j JSONString[T]) MarshalJSON() ([]byte, error) {
+	buf := []byte{'"'}
+	buf, err := j.Value.AppendText(buf)
+	if err != nil {
+		return nil, err
+	}
+	return append(buf, '"'), nil
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+// *T must implement [json.Unmarshaler], as all integer types in this package do.
+func (j *JSONString[T]) UnmarshalJSON(data []byte) error {
+	u, ok := any(&j.Value).(json.Unmarshaler)
+	if !ok {
+		return fmt.Errorf("ints: %T does not implement json.Unmarshaler", &j.Value)
+	}
+	return u.UnmarshalJSON(data)
+}
+
+// unmarshalJSON parses data, which is a JSON number or a JSON string, and stores the result into v.
+// JSON numbers are parsed in base 10, and JSON strings are parsed in the same syntax as Go integer literals.
+// By convention, the JSON null value is a no-op.
+func unmarshalJSON[T any](v *T, data []byte, parse func(s string, base int) (T, error)) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	s, base := string(data), 10
+	if len(data) > 0 && data[0] == '"' {
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		base = 0
+	}
+
+	x, err := parse(s, base)
+	if err != nil {
+		return err
+	}
+	*v = x
+	return nil
+}
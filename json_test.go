@@ -0,0 +1,54 @@
+package ints
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func TestJSONString_Marshal(t *testing.T) {
+	type T struct {
+		Balance JSONString[Uint128] `json:"balance"`
+		Delta   JSONString[Int256]  `json:"delta"`
+		Count   Uint64              `json:"count"`
+	}
+	v := T{
+		Balance: JSONString[Uint128]{Uint128{1, 0}},
+		Delta:   JSONString[Int256]{Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		Count:   42,
+	}
+
+	got, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"balance":"18446744073709551616","delta":"-1","count":42}`
+	if string(got) != want {
+		t.Errorf("json.Marshal() = %s, want %s", got, want)
+	}
+}
+
+func TestJSONString_Unmarshal(t *testing.T) {
+	testCases := []struct {
+		s       string
+		want    Int128
+		wantErr bool
+	}{
+		{`"123"`, Int128{0, 123}, false},
+		{`123`, Int128{0, 123}, false},
+		{`"-0x10"`, Int128{math.MaxUint64, math.MaxUint64 - 15}, false},
+		{`"170141183460469231731687303715884105728"`, Int128{}, true},
+		{`true`, Int128{}, true},
+	}
+
+	for _, tc := range testCases {
+		var got JSONString[Int128]
+		err := json.Unmarshal([]byte(tc.s), &got)
+		if (err != nil) != tc.wantErr {
+			t.Errorf("json.Unmarshal(%s) error = %v, wantErr %t", tc.s, err, tc.wantErr)
+		}
+		if got.Value != tc.want {
+			t.Errorf("json.Unmarshal(%s) = %d, want %d", tc.s, got.Value, tc.want)
+		}
+	}
+}
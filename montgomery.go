@@ -0,0 +1,431 @@
+package ints
+
+import (
+	"math"
+	"math/bits"
+)
+
+// montInverse returns -m0**-1 mod 2**64 for odd m0.
+func montInverse(m0 uint64) uint64 {
+	// Newton's method: each iteration doubles the number of correct low bits,
+	// and x = m0 is correct to 3 bits because m0*m0 = 1 mod 8 for any odd m0.
+	x := m0
+	for range 5 {
+		x *= 2 - m0*x
+	}
+	return -x
+}
+
+// montMul sets z = x*y/R mod m where R = 2**(64*len(m)), using the CIOS method.
+// x, y, z and m are big-endian limbs, and t is a scratch space of len(m)+2 limbs.
+// inv must be -m**-1 mod 2**64, and x*y must be less than m*R.
+// z must not overlap with x, y and m.
+//
+// This function's execution time does not depend on the values of x, y and m.
+func montMul(z, x, y, m, t []uint64, inv uint64) {
+	n := len(m)
+	clear(t)
+
+	// t is little-endian.
+	for i := n - 1; i >= 0; i-- {
+		// t += x * y[i]
+		var c uint64
+		for j := 0; j < n; j++ {
+			hi, lo := bits.Mul64(x[n-1-j], y[i])
+			lo, cc := bits.Add64(lo, t[j], 0)
+			hi += cc
+			lo, cc = bits.Add64(lo, c, 0)
+			hi += cc
+			t[j], c = lo, hi
+		}
+		t[n], c = bits.Add64(t[n], c, 0)
+		t[n+1] = c
+
+		// t = (t + mu*m) / 2**64, where mu is chosen so that t + mu*m is divisible by 2**64.
+		mu := t[0] * inv
+		hi, lo := bits.Mul64(mu, m[n-1])
+		_, cc := bits.Add64(lo, t[0], 0)
+		c = hi + cc
+		for j := 1; j < n; j++ {
+			hi, lo := bits.Mul64(mu, m[n-1-j])
+			lo, cc := bits.Add64(lo, t[j], 0)
+			hi += cc
+			lo, cc = bits.Add64(lo, c, 0)
+			hi += cc
+			t[j-1], c = lo, hi
+		}
+		t[n-1], c = bits.Add64(t[n], c, 0)
+		t[n] = t[n+1] + c
+	}
+
+	// t < 2m here. z = t - m if t >= m, otherwise z = t.
+	var borrow uint64
+	for j := 0; j < n; j++ {
+		z[n-1-j], borrow = bits.Sub64(t[j], m[n-1-j], borrow)
+	}
+	mask := -((t[n] ^ 1) & borrow)
+	for j := 0; j < n; j++ {
+		z[n-1-j] = z[n-1-j]&^mask | t[j]&mask
+	}
+}
+
+// montSelect sets z to table[k] without leaking k through memory access patterns.
+func montSelect(z []uint64, table [][]uint64, k uint64) {
+	clear(z)
+	for i, v := range table {
+		// mask is all ones if i == k, otherwise zero.
+		d := uint64(i) ^ k
+		mask := ((d | -d) >> 63) - 1
+		for j := range z {
+			z[j] |= v[j] & mask
+		}
+	}
+}
+
+// Montgomery128 is a context for Montgomery multiplication modulo an odd 128-bit modulus m.
+// Values in the Montgomery form are represented as a*R mod m where R = 2**128.
+// The zero value is not usable; use [NewMontgomery128] to create one.
+type Montgomery128 struct {
+	m   Uint128 // the modulus
+	one Uint128 // R mod m, which is 1 in the Montgomery form
+	r2  Uint128 // R**2 mod m
+	inv uint64  // -m**-1 mod 2**64
+}
+
+// NewMontgomery128 returns a new Montgomery context for the modulus m.
+// It panics if m is even.
+func NewMontgomery128(m Uint128) *Montgomery128 {
+	if m[1]&1 == 0 {
+		panic("ints: Montgomery modulus must be odd")
+	}
+	r := Uint128{math.MaxUint64, math.MaxUint64}.Mod(m).Add(Uint128{0, 1}).Mod(m)
+	return &Montgomery128{
+		m:   m,
+		one: r,
+		r2:  r.MulMod(r, m),
+		inv: montInverse(m[1]),
+	}
+}
+
+// Modulus returns the modulus m.
+func (mt *Montgomery128) Modulus() Uint128 {
+	return mt.m
+}
+
+// ToMont converts a to the Montgomery form a*R mod m.
+// a doesn't need to be reduced modulo m.
+//
+// This function's execution time does not depend on the inputs.
+func (mt *Montgomery128) ToMont(a Uint128) Uint128 {
+	return mt.Mul(a, mt.r2)
+}
+
+// FromMont converts a from the Montgomery form, returning a/R mod m.
+//
+// This function's execution time does not depend on the inputs.
+func (mt *Montgomery128) FromMont(a Uint128) Uint128 {
+	return mt.Mul(a, Uint128{0, 1})
+}
+
+// Mul returns the Montgomery product a*b/R mod m.
+// If a and b are in the Montgomery form, the result is the Montgomery form of their product.
+// At least one of a and b must be less than m.
+//
+// This function's execution time does not depend on the inputs.
+func (mt *Montgomery128) Mul(a, b Uint128) Uint128 {
+	var z Uint128
+	var t [4]uint64
+	montMul(z[:], a[:], b[:], mt.m[:], t[:], mt.inv)
+	return z
+}
+
+// Exp returns x**e in the Montgomery form, where x is in the Montgomery form.
+// It uses the fixed window method with constant-time table lookups.
+//
+// This function's execution time does not depend on the inputs.
+func (mt *Montgomery128) Exp(x, e Uint128) Uint128 {
+	// table[i] = x**i in the Montgomery form.
+	var table [16]Uint128
+	table[0] = mt.one
+	table[1] = mt.Mul(x, mt.one)
+	for i := 2; i < len(table); i++ {
+		table[i] = mt.Mul(table[i-1], table[1])
+	}
+	var rows [16][]uint64
+	for i := range table {
+		rows[i] = table[i][:]
+	}
+
+	z := mt.one
+	var w Uint128
+	for i := 124; i >= 0; i -= 4 {
+		z = mt.Mul(z, z)
+		z = mt.Mul(z, z)
+		z = mt.Mul(z, z)
+		z = mt.Mul(z, z)
+		montSelect(w[:], rows[:], e[1-i/64]>>(i%64)&15)
+		z = mt.Mul(z, w)
+	}
+	return z
+}
+
+// Montgomery256 is a context for Montgomery multiplication modulo an odd 256-bit modulus m.
+// Values in the Montgomery form are represented as a*R mod m where R = 2**256.
+// The zero value is not usable; use [NewMontgomery256] to create one.
+type Montgomery256 struct {
+	m   Uint256 // the modulus
+	one Uint256 // R mod m, which is 1 in the Montgomery form
+	r2  Uint256 // R**2 mod m
+	inv uint64  // -m**-1 mod 2**64
+}
+
+// NewMontgomery256 returns a new Montgomery context for the modulus m.
+// It panics if m is even.
+func NewMontgomery256(m Uint256) *Montgomery256 {
+	if m[3]&1 == 0 {
+		panic("ints: Montgomery modulus must be odd")
+	}
+	r := Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}.Mod(m).Add(Uint256{0, 0, 0, 1}).Mod(m)
+	return &Montgomery256{
+		m:   m,
+		one: r,
+		r2:  r.MulMod(r, m),
+		inv: montInverse(m[3]),
+	}
+}
+
+// Modulus returns the modulus m.
+func (mt *Montgomery256) Modulus() Uint256 {
+	return mt.m
+}
+
+// ToMont converts a to the Montgomery form a*R mod m.
+// a doesn't need to be reduced modulo m.
+//
+// This function's execution time does not depend on the inputs.
+func (mt *Montgomery256) ToMont(a Uint256) Uint256 {
+	return mt.Mul(a, mt.r2)
+}
+
+// FromMont converts a from the Montgomery form, returning a/R mod m.
+//
+// This function's execution time does not depend on the inputs.
+func (mt *Montgomery256) FromMont(a Uint256) Uint256 {
+	return mt.Mul(a, Uint256{0, 0, 0, 1})
+}
+
+// Mul returns the Montgomery product a*b/R mod m.
+// If a and b are in the Montgomery form, the result is the Montgomery form of their product.
+// At least one of a and b must be less than m.
+//
+// This function's execution time does not depend on the inputs.
+func (mt *Montgomery256) Mul(a, b Uint256) Uint256 {
+	var z Uint256
+	var t [6]uint64
+	montMul(z[:], a[:], b[:], mt.m[:], t[:], mt.inv)
+	return z
+}
+
+// Exp returns x**e in the Montgomery form, where x is in the Montgomery form.
+// It uses the fixed window method with constant-time table lookups.
+//
+// This function's execution time does not depend on the inputs.
+func (mt *Montgomery256) Exp(x, e Uint256) Uint256 {
+	// table[i] = x**i in the Montgomery form.
+	var table [16]Uint256
+	table[0] = mt.one
+	table[1] = mt.Mul(x, mt.one)
+	for i := 2; i < len(table); i++ {
+		table[i] = mt.Mul(table[i-1], table[1])
+	}
+	var rows [16][]uint64
+	for i := range table {
+		rows[i] = table[i][:]
+	}
+
+	z := mt.one
+	var w Uint256
+	for i := 252; i >= 0; i -= 4 {
+		z = mt.Mul(z, z)
+		z = mt.Mul(z, z)
+		z = mt.Mul(z, z)
+		z = mt.Mul(z, z)
+		montSelect(w[:], rows[:], e[3-i/64]>>(i%64)&15)
+		z = mt.Mul(z, w)
+	}
+	return z
+}
+
+// Montgomery512 is a context for Montgomery multiplication modulo an odd 512-bit modulus m.
+// Values in the Montgomery form are represented as a*R mod m where R = 2**512.
+// The zero value is not usable; use [NewMontgomery512] to create one.
+type Montgomery512 struct {
+	m   Uint512 // the modulus
+	one Uint512 // R mod m, which is 1 in the Montgomery form
+	r2  Uint512 // R**2 mod m
+	inv uint64  // -m**-1 mod 2**64
+}
+
+// NewMontgomery512 returns a new Montgomery context for the modulus m.
+// It panics if m is even.
+func NewMontgomery512(m Uint512) *Montgomery512 {
+	if m[7]&1 == 0 {
+		panic("ints: Montgomery modulus must be odd")
+	}
+	r := Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}.Mod(m).Add(Uint512{0, 0, 0, 0, 0, 0, 0, 1}).Mod(m)
+	return &Montgomery512{
+		m:   m,
+		one: r,
+		r2:  r.MulMod(r, m),
+		inv: montInverse(m[7]),
+	}
+}
+
+// Modulus returns the modulus m.
+func (mt *Montgomery512) Modulus() Uint512 {
+	return mt.m
+}
+
+// ToMont converts a to the Montgomery form a*R mod m.
+// a doesn't need to be reduced modulo m.
+//
+// This function's execution time does not depend on the inputs.
+func (mt *Montgomery512) ToMont(a Uint512) Uint512 {
+	return mt.Mul(a, mt.r2)
+}
+
+// FromMont converts a from the Montgomery form, returning a/R mod m.
+//
+// This function's execution time does not depend on the inputs.
+func (mt *Montgomery512) FromMont(a Uint512) Uint512 {
+	return mt.Mul(a, Uint512{0, 0, 0, 0, 0, 0, 0, 1})
+}
+
+// Mul returns the Montgomery product a*b/R mod m.
+// If a and b are in the Montgomery form, the result is the Montgomery form of their product.
+// At least one of a and b must be less than m.
+//
+// This function's execution time does not depend on the inputs.
+func (mt *Montgomery512) Mul(a, b Uint512) Uint512 {
+	var z Uint512
+	var t [10]uint64
+	montMul(z[:], a[:], b[:], mt.m[:], t[:], mt.inv)
+	return z
+}
+
+// Exp returns x**e in the Montgomery form, where x is in the Montgomery form.
+// It uses the fixed window method with constant-time table lookups.
+//
+// This function's execution time does not depend on the inputs.
+func (mt *Montgomery512) Exp(x, e Uint512) Uint512 {
+	// table[i] = x**i in the Montgomery form.
+	var table [16]Uint512
+	table[0] = mt.one
+	table[1] = mt.Mul(x, mt.one)
+	for i := 2; i < len(table); i++ {
+		table[i] = mt.Mul(table[i-1], table[1])
+	}
+	var rows [16][]uint64
+	for i := range table {
+		rows[i] = table[i][:]
+	}
+
+	z := mt.one
+	var w Uint512
+	for i := 508; i >= 0; i -= 4 {
+		z = mt.Mul(z, z)
+		z = mt.Mul(z, z)
+		z = mt.Mul(z, z)
+		z = mt.Mul(z, z)
+		montSelect(w[:], rows[:], e[7-i/64]>>(i%64)&15)
+		z = mt.Mul(z, w)
+	}
+	return z
+}
+
+// Montgomery1024 is a context for Montgomery multiplication modulo an odd 1024-bit modulus m.
+// Values in the Montgomery form are represented as a*R mod m where R = 2**1024.
+// The zero value is not usable; use [NewMontgomery1024] to create one.
+type Montgomery1024 struct {
+	m   Uint1024 // the modulus
+	one Uint1024 // R mod m, which is 1 in the Montgomery form
+	r2  Uint1024 // R**2 mod m
+	inv uint64   // -m**-1 mod 2**64
+}
+
+// NewMontgomery1024 returns a new Montgomery context for the modulus m.
+// It panics if m is even.
+func NewMontgomery1024(m Uint1024) *Montgomery1024 {
+	if m[15]&1 == 0 {
+		panic("ints: Montgomery modulus must be odd")
+	}
+	r := Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}.Mod(m).Add(Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1}).Mod(m)
+	return &Montgomery1024{
+		m:   m,
+		one: r,
+		r2:  r.MulMod(r, m),
+		inv: montInverse(m[15]),
+	}
+}
+
+// Modulus returns the modulus m.
+func (mt *Montgomery1024) Modulus() Uint1024 {
+	return mt.m
+}
+
+// ToMont converts a to the Montgomery form a*R mod m.
+// a doesn't need to be reduced modulo m.
+//
+// This function's execution time does not depend on the inputs.
+func (mt *Montgomery1024) ToMont(a Uint1024) Uint1024 {
+	return mt.Mul(a, mt.r2)
+}
+
+// FromMont converts a from the Montgomery form, returning a/R mod m.
+//
+// This function's execution time does not depend on the inputs.
+func (mt *Montgomery1024) FromMont(a Uint1024) Uint1024 {
+	return mt.Mul(a, Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1})
+}
+
+// Mul returns the Montgomery product a*b/R mod m.
+// If a and b are in the Montgomery form, the result is the Montgomery form of their product.
+// At least one of a and b must be less than m.
+//
+// This function's execution time does not depend on the inputs.
+func (mt *Montgomery1024) Mul(a, b Uint1024) Uint1024 {
+	var z Uint1024
+	var t [18]uint64
+	montMul(z[:], a[:], b[:], mt.m[:], t[:], mt.inv)
+	return z
+}
+
+// Exp returns x**e in the Montgomery form, where x is in the Montgomery form.
+// It uses the fixed window method with constant-time table lookups.
+//
+// This function's execution time does not depend on the inputs.
+func (mt *Montgomery1024) Exp(x, e Uint1024) Uint1024 {
+	// table[i] = x**i in the Montgomery form.
+	var table [16]Uint1024
+	table[0] = mt.one
+	table[1] = mt.Mul(x, mt.one)
+	for i := 2; i < len(table); i++ {
+		table[i] = mt.Mul(table[i-1], table[1])
+	}
+	var rows [16][]uint64
+	for i := range table {
+		rows[i] = table[i][:]
+	}
+
+	z := mt.one
+	var w Uint1024
+	for i := 1020; i >= 0; i -= 4 {
+		z = mt.Mul(z, z)
+		z = mt.Mul(z, z)
+		z = mt.Mul(z, z)
+		z = mt.Mul(z, z)
+		montSelect(w[:], rows[:], e[15-i/64]>>(i%64)&15)
+		z = mt.Mul(z, w)
+	}
+	return z
+}
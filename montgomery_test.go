@@ -0,0 +1,257 @@
+package ints
+
+import (
+	"math"
+	"math/big"
+	"runtime"
+	"testing"
+)
+
+func FuzzMontgomery128(f *testing.F) {
+	f.Add(
+		uint64(0x0), uint64(0x0),
+		uint64(0x0), uint64(0x0),
+		uint64(0x0), uint64(0x1),
+	)
+	f.Add(
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+	)
+	f.Add(
+		uint64(0x0), uint64(0x3),
+		uint64(0x0), uint64(0x10001),
+		uint64(0x0), uint64(0xd),
+	)
+	f.Add(
+		uint64(0x8000000000000000), uint64(0x0),
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0x8000000000000000), uint64(0x1),
+	)
+	f.Add(
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0x0), uint64(0x2),
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffc5),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, v0, v1, m0, m1 uint64) {
+		a := Uint128{u0, u1}
+		b := Uint128{v0, v1}
+		m := Uint128{m0, m1}
+		m[1] |= 1
+		mt := NewMontgomery128(m)
+		x, y, z := uint128ToBigInt(a), uint128ToBigInt(b), uint128ToBigInt(m)
+
+		if got := mt.Modulus(); got != m {
+			t.Errorf("NewMontgomery128(%d).Modulus() = %d, want %d", m, got, m)
+		}
+		am, bm := mt.ToMont(a), mt.ToMont(b)
+		if got, want := mt.FromMont(am), new(big.Int).Mod(x, z); uint128ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("NewMontgomery128(%d).FromMont(ToMont(%d)) = %d, want %d", m, a, got, want)
+		}
+		if got, want := mt.FromMont(mt.Mul(am, bm)), new(big.Int).Mod(new(big.Int).Mul(x, y), z); uint128ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("NewMontgomery128(%d).Mul(%d, %d) = %d, want %d", m, a, b, got, want)
+		}
+		if got, want := mt.FromMont(mt.Exp(am, b)), new(big.Int).Exp(x, y, z); uint128ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("NewMontgomery128(%d).Exp(%d, %d) = %d, want %d", m, a, b, got, want)
+		}
+	})
+}
+
+func TestNewMontgomery128_Panic(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("NewMontgomery128(2) did not panic")
+		}
+	}()
+	NewMontgomery128(Uint128FromUint64(2))
+}
+
+func FuzzMontgomery256(f *testing.F) {
+	f.Add(
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x1),
+	)
+	f.Add(
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+	)
+	f.Add(
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x3),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x10001),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0xd),
+	)
+	f.Add(
+		uint64(0x8000000000000000), uint64(0x0), uint64(0x0), uint64(0x0),
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0x8000000000000000), uint64(0x0), uint64(0x0), uint64(0x1),
+	)
+	f.Add(
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x2),
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffc5),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, v0, v1, v2, v3, m0, m1, m2, m3 uint64) {
+		a := Uint256{u0, u1, u2, u3}
+		b := Uint256{v0, v1, v2, v3}
+		m := Uint256{m0, m1, m2, m3}
+		m[3] |= 1
+		mt := NewMontgomery256(m)
+		x, y, z := uint256ToBigInt(a), uint256ToBigInt(b), uint256ToBigInt(m)
+
+		if got := mt.Modulus(); got != m {
+			t.Errorf("NewMontgomery256(%d).Modulus() = %d, want %d", m, got, m)
+		}
+		am, bm := mt.ToMont(a), mt.ToMont(b)
+		if got, want := mt.FromMont(am), new(big.Int).Mod(x, z); uint256ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("NewMontgomery256(%d).FromMont(ToMont(%d)) = %d, want %d", m, a, got, want)
+		}
+		if got, want := mt.FromMont(mt.Mul(am, bm)), new(big.Int).Mod(new(big.Int).Mul(x, y), z); uint256ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("NewMontgomery256(%d).Mul(%d, %d) = %d, want %d", m, a, b, got, want)
+		}
+		if got, want := mt.FromMont(mt.Exp(am, b)), new(big.Int).Exp(x, y, z); uint256ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("NewMontgomery256(%d).Exp(%d, %d) = %d, want %d", m, a, b, got, want)
+		}
+	})
+}
+
+func BenchmarkMontgomery256_Exp(b *testing.B) {
+	mt := NewMontgomery256(Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xffffffffffffffc5})
+	x := mt.ToMont(Uint256FromUint64(3))
+	e := mt.Modulus().Sub(Uint256FromUint64(2))
+	for b.Loop() {
+		runtime.KeepAlive(mt.Exp(x, e))
+	}
+}
+
+func BenchmarkUint256_ExpMod(b *testing.B) {
+	m := Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xffffffffffffffc5}
+	x := Uint256FromUint64(3)
+	e := m.Sub(Uint256FromUint64(2))
+	for b.Loop() {
+		runtime.KeepAlive(x.ExpMod(e, m))
+	}
+}
+
+func FuzzMontgomery512(f *testing.F) {
+	f.Add(
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x1),
+	)
+	f.Add(
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+	)
+	f.Add(
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x3),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x10001),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0xd),
+	)
+	f.Add(
+		uint64(0x8000000000000000), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0),
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0x8000000000000000), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x1),
+	)
+	f.Add(
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x2),
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffc5),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, u4, u5, u6, u7, v0, v1, v2, v3, v4, v5, v6, v7, m0, m1, m2, m3, m4, m5, m6, m7 uint64) {
+		a := Uint512{u0, u1, u2, u3, u4, u5, u6, u7}
+		b := Uint512{v0, v1, v2, v3, v4, v5, v6, v7}
+		m := Uint512{m0, m1, m2, m3, m4, m5, m6, m7}
+		m[7] |= 1
+		mt := NewMontgomery512(m)
+		x, y, z := uint512ToBigInt(a), uint512ToBigInt(b), uint512ToBigInt(m)
+
+		if got := mt.Modulus(); got != m {
+			t.Errorf("NewMontgomery512(%d).Modulus() = %d, want %d", m, got, m)
+		}
+		am, bm := mt.ToMont(a), mt.ToMont(b)
+		if got, want := mt.FromMont(am), new(big.Int).Mod(x, z); uint512ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("NewMontgomery512(%d).FromMont(ToMont(%d)) = %d, want %d", m, a, got, want)
+		}
+		if got, want := mt.FromMont(mt.Mul(am, bm)), new(big.Int).Mod(new(big.Int).Mul(x, y), z); uint512ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("NewMontgomery512(%d).Mul(%d, %d) = %d, want %d", m, a, b, got, want)
+		}
+		if got, want := mt.FromMont(mt.Exp(am, b)), new(big.Int).Exp(x, y, z); uint512ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("NewMontgomery512(%d).Exp(%d, %d) = %d, want %d", m, a, b, got, want)
+		}
+	})
+}
+
+func FuzzMontgomery1024(f *testing.F) {
+	f.Add(
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x1),
+	)
+	f.Add(
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+	)
+	f.Add(
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x3),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x10001),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0xd),
+	)
+	f.Add(
+		uint64(0x8000000000000000), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0),
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0x8000000000000000), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x1),
+	)
+	f.Add(
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x2),
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffc5),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, u4, u5, u6, u7, u8, u9, u10, u11, u12, u13, u14, u15, v0, v1, v2, v3, v4, v5, v6, v7, v8, v9, v10, v11, v12, v13, v14, v15, m0, m1, m2, m3, m4, m5, m6, m7, m8, m9, m10, m11, m12, m13, m14, m15 uint64) {
+		a := Uint1024{u0, u1, u2, u3, u4, u5, u6, u7, u8, u9, u10, u11, u12, u13, u14, u15}
+		b := Uint1024{v0, v1, v2, v3, v4, v5, v6, v7, v8, v9, v10, v11, v12, v13, v14, v15}
+		m := Uint1024{m0, m1, m2, m3, m4, m5, m6, m7, m8, m9, m10, m11, m12, m13, m14, m15}
+		m[15] |= 1
+		mt := NewMontgomery1024(m)
+		x, y, z := uint1024ToBigInt(a), uint1024ToBigInt(b), uint1024ToBigInt(m)
+
+		if got := mt.Modulus(); got != m {
+			t.Errorf("NewMontgomery1024(%d).Modulus() = %d, want %d", m, got, m)
+		}
+		am, bm := mt.ToMont(a), mt.ToMont(b)
+		if got, want := mt.FromMont(am), new(big.Int).Mod(x, z); uint1024ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("NewMontgomery1024(%d).FromMont(ToMont(%d)) = %d, want %d", m, a, got, want)
+		}
+		if got, want := mt.FromMont(mt.Mul(am, bm)), new(big.Int).Mod(new(big.Int).Mul(x, y), z); uint1024ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("NewMontgomery1024(%d).Mul(%d, %d) = %d, want %d", m, a, b, got, want)
+		}
+		if got, want := mt.FromMont(mt.Exp(am, b)), new(big.Int).Exp(x, y, z); uint1024ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("NewMontgomery1024(%d).Exp(%d, %d) = %d, want %d", m, a, b, got, want)
+		}
+	})
+}
+
+func BenchmarkMontgomery1024_Exp(b *testing.B) {
+	mt := NewMontgomery1024(Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xffffffffffffffc5})
+	x := mt.ToMont(Uint1024FromUint64(3))
+	e := mt.Modulus().Sub(Uint1024FromUint64(2))
+	for b.Loop() {
+		runtime.KeepAlive(mt.Exp(x, e))
+	}
+}
+
+func BenchmarkUint1024_ExpMod(b *testing.B) {
+	m := Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xffffffffffffffc5}
+	x := Uint1024FromUint64(3)
+	e := m.Sub(Uint1024FromUint64(2))
+	for b.Loop() {
+		runtime.KeepAlive(x.ExpMod(e, m))
+	}
+}
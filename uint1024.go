@@ -2,19 +2,66 @@ package ints
 
 import (
 	"cmp"
+	"encoding/binary"
 	"fmt"
+	"math"
+	"math/big"
 	"math/bits"
 )
 
 // Uint1024 is a type that represents an 1024-bit unsigned integer.
 type Uint1024 [16]uint64
 
+// MaxUint1024 is the maximum value of Uint1024.
+var MaxUint1024 = Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+
+// Uint1024FromUint64 returns v as a Uint1024.
+func Uint1024FromUint64(v uint64) Uint1024 {
+	return Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, v}
+}
+
+// Uint1024Of returns v as a Uint1024.
+// v is converted in the same way as Go's integer conversions:
+// it is sign-extended if T is a signed type, and zero-extended otherwise.
+func Uint1024Of[T builtinInteger](v T) Uint1024 {
+	if isSigned[T]() {
+		return Int64(v).Uint1024()
+	}
+	return Uint64(v).Uint1024()
+}
+
 // IsZero returns true if a is zero.
 func (a Uint1024) IsZero() bool {
 	var zero Uint1024
 	return a == zero
 }
 
+// IsInt64 reports whether a can be represented as an int64.
+func (a Uint1024) IsInt64() bool {
+	_, ok := a.TryInt64()
+	return ok
+}
+
+// IsUint64 reports whether a can be represented as a uint64.
+func (a Uint1024) IsUint64() bool {
+	_, ok := a.TryUint64()
+	return ok
+}
+
+// Int returns a as an int and reports whether a can be represented as an int.
+// If it can't, the result is a truncated to the size of int.
+func (a Uint1024) Int() (int, bool) {
+	v, ok := a.TryInt64()
+	return int(v), ok && int64(int(v)) == int64(v)
+}
+
+// Uint returns a as a uint and reports whether a can be represented as a uint.
+// If it can't, the result is a truncated to the size of uint.
+func (a Uint1024) Uint() (uint, bool) {
+	v, ok := a.TryUint64()
+	return uint(v), ok && uint64(uint(v)) == uint64(v)
+}
+
 // Add returns the sum a+b.
 //
 // This function's execution time does not depend on the inputs.
@@ -38,6 +85,64 @@ func (a Uint1024) Add(b Uint1024) Uint1024 {
 	return Uint1024{u0, u1, u2, u3, u4, u5, u6, u7, u8, u9, u10, u11, u12, u13, u14, u15}
 }
 
+// AddOverflow returns the sum a+b and reports whether the addition overflowed.
+//
+// This function's execution time does not depend on the inputs.
+func (a Uint1024) AddOverflow(b Uint1024) (Uint1024, bool) {
+	u15, carry := bits.Add64(a[15], b[15], 0)
+	u14, carry := bits.Add64(a[14], b[14], carry)
+	u13, carry := bits.Add64(a[13], b[13], carry)
+	u12, carry := bits.Add64(a[12], b[12], carry)
+	u11, carry := bits.Add64(a[11], b[11], carry)
+	u10, carry := bits.Add64(a[10], b[10], carry)
+	u9, carry := bits.Add64(a[9], b[9], carry)
+	u8, carry := bits.Add64(a[8], b[8], carry)
+	u7, carry := bits.Add64(a[7], b[7], carry)
+	u6, carry := bits.Add64(a[6], b[6], carry)
+	u5, carry := bits.Add64(a[5], b[5], carry)
+	u4, carry := bits.Add64(a[4], b[4], carry)
+	u3, carry := bits.Add64(a[3], b[3], carry)
+	u2, carry := bits.Add64(a[2], b[2], carry)
+	u1, carry := bits.Add64(a[1], b[1], carry)
+	u0, carry := bits.Add64(a[0], b[0], carry)
+	return Uint1024{u0, u1, u2, u3, u4, u5, u6, u7, u8, u9, u10, u11, u12, u13, u14, u15}, carry != 0
+}
+
+// SaturatingAdd returns the sum a+b.
+// If the result overflows, it is clamped to the maximum value.
+func (a Uint1024) SaturatingAdd(b Uint1024) Uint1024 {
+	c, overflow := a.AddOverflow(b)
+	if overflow {
+		return Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return c
+}
+
+// AddCarry returns the sum with carry of a, b and carry: sum = a + b + carry.
+// The carry input must be 0 or 1; otherwise the behavior is undefined.
+// The carryOut output is guaranteed to be 0 or 1.
+//
+// This function's execution time does not depend on the inputs.
+func (a Uint1024) AddCarry(b Uint1024, carry uint) (sum Uint1024, carryOut uint) {
+	u15, c := bits.Add64(a[15], b[15], uint64(carry))
+	u14, c := bits.Add64(a[14], b[14], c)
+	u13, c := bits.Add64(a[13], b[13], c)
+	u12, c := bits.Add64(a[12], b[12], c)
+	u11, c := bits.Add64(a[11], b[11], c)
+	u10, c := bits.Add64(a[10], b[10], c)
+	u9, c := bits.Add64(a[9], b[9], c)
+	u8, c := bits.Add64(a[8], b[8], c)
+	u7, c := bits.Add64(a[7], b[7], c)
+	u6, c := bits.Add64(a[6], b[6], c)
+	u5, c := bits.Add64(a[5], b[5], c)
+	u4, c := bits.Add64(a[4], b[4], c)
+	u3, c := bits.Add64(a[3], b[3], c)
+	u2, c := bits.Add64(a[2], b[2], c)
+	u1, c := bits.Add64(a[1], b[1], c)
+	u0, c := bits.Add64(a[0], b[0], c)
+	return Uint1024{u0, u1, u2, u3, u4, u5, u6, u7, u8, u9, u10, u11, u12, u13, u14, u15}, uint(c)
+}
+
 // Sub returns the difference a-b.
 //
 // This function's execution time does not depend on the inputs.
@@ -61,6 +166,64 @@ func (a Uint1024) Sub(b Uint1024) Uint1024 {
 	return Uint1024{u0, u1, u2, u3, u4, u5, u6, u7, u8, u9, u10, u11, u12, u13, u14, u15}
 }
 
+// SubOverflow returns the difference a-b and reports whether the subtraction overflowed.
+//
+// This function's execution time does not depend on the inputs.
+func (a Uint1024) SubOverflow(b Uint1024) (Uint1024, bool) {
+	u15, borrow := bits.Sub64(a[15], b[15], 0)
+	u14, borrow := bits.Sub64(a[14], b[14], borrow)
+	u13, borrow := bits.Sub64(a[13], b[13], borrow)
+	u12, borrow := bits.Sub64(a[12], b[12], borrow)
+	u11, borrow := bits.Sub64(a[11], b[11], borrow)
+	u10, borrow := bits.Sub64(a[10], b[10], borrow)
+	u9, borrow := bits.Sub64(a[9], b[9], borrow)
+	u8, borrow := bits.Sub64(a[8], b[8], borrow)
+	u7, borrow := bits.Sub64(a[7], b[7], borrow)
+	u6, borrow := bits.Sub64(a[6], b[6], borrow)
+	u5, borrow := bits.Sub64(a[5], b[5], borrow)
+	u4, borrow := bits.Sub64(a[4], b[4], borrow)
+	u3, borrow := bits.Sub64(a[3], b[3], borrow)
+	u2, borrow := bits.Sub64(a[2], b[2], borrow)
+	u1, borrow := bits.Sub64(a[1], b[1], borrow)
+	u0, borrow := bits.Sub64(a[0], b[0], borrow)
+	return Uint1024{u0, u1, u2, u3, u4, u5, u6, u7, u8, u9, u10, u11, u12, u13, u14, u15}, borrow != 0
+}
+
+// SaturatingSub returns the difference a-b.
+// If the result overflows, it is clamped to zero.
+func (a Uint1024) SaturatingSub(b Uint1024) Uint1024 {
+	c, overflow := a.SubOverflow(b)
+	if overflow {
+		return Uint1024{}
+	}
+	return c
+}
+
+// SubBorrow returns the difference of a, b and borrow: diff = a - b - borrow.
+// The borrow input must be 0 or 1; otherwise the behavior is undefined.
+// The borrowOut output is guaranteed to be 0 or 1.
+//
+// This function's execution time does not depend on the inputs.
+func (a Uint1024) SubBorrow(b Uint1024, borrow uint) (diff Uint1024, borrowOut uint) {
+	u15, c := bits.Sub64(a[15], b[15], uint64(borrow))
+	u14, c := bits.Sub64(a[14], b[14], c)
+	u13, c := bits.Sub64(a[13], b[13], c)
+	u12, c := bits.Sub64(a[12], b[12], c)
+	u11, c := bits.Sub64(a[11], b[11], c)
+	u10, c := bits.Sub64(a[10], b[10], c)
+	u9, c := bits.Sub64(a[9], b[9], c)
+	u8, c := bits.Sub64(a[8], b[8], c)
+	u7, c := bits.Sub64(a[7], b[7], c)
+	u6, c := bits.Sub64(a[6], b[6], c)
+	u5, c := bits.Sub64(a[5], b[5], c)
+	u4, c := bits.Sub64(a[4], b[4], c)
+	u3, c := bits.Sub64(a[3], b[3], c)
+	u2, c := bits.Sub64(a[2], b[2], c)
+	u1, c := bits.Sub64(a[1], b[1], c)
+	u0, c := bits.Sub64(a[0], b[0], c)
+	return Uint1024{u0, u1, u2, u3, u4, u5, u6, u7, u8, u9, u10, u11, u12, u13, u14, u15}, uint(c)
+}
+
 // Mul returns the product a*b.
 //
 // This function's execution time does not depend on the inputs.
@@ -800,6 +963,63 @@ func (a Uint1024) Mul(b Uint1024) Uint1024 {
 	return Uint1024{u0, u1, u2, u3, u4, u5, u6, u7, u8, u9, u10, u11, u12, u13, u14, u15}
 }
 
+// MulOverflow returns the product a*b and reports whether the multiplication overflowed.
+func (a Uint1024) MulOverflow(b Uint1024) (Uint1024, bool) {
+	c := a.Mul(b)
+	n := a.BitLen() + b.BitLen()
+	switch {
+	case n <= 1024:
+		return c, false
+	case n > 1025:
+		return c, true
+	}
+
+	// 2**1023 <= a*b < 2**1025.
+	// If the multiplication overflowed, c = a*b - 2**1024 and c/a < b.
+	return c, c.Div(a) != b
+}
+
+// SaturatingMul returns the product a*b.
+// If the result overflows, it is clamped to the maximum value.
+func (a Uint1024) SaturatingMul(b Uint1024) Uint1024 {
+	c, overflow := a.MulOverflow(b)
+	if overflow {
+		return Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return c
+}
+
+// MulFull returns the 2048-bit product of a and b: (hi, lo) = a * b
+// with the product bits' upper half returned in hi and the lower half returned in lo.
+func (a Uint1024) MulFull(b Uint1024) (hi, lo Uint1024) {
+	// split a and b into 512-bit halves, and multiply them.
+	aH, aL := Uint512(a[:8]), Uint512(a[8:])
+	bH, bL := Uint512(b[:8]), Uint512(b[8:])
+	hh := aH.Mul1024(bH)
+	hl := aH.Mul1024(bL)
+	lh := aL.Mul1024(bH)
+	ll := aL.Mul1024(bL)
+
+	// a*b = hh<<1024 + (hl+lh)<<512 + ll
+	mid, c0 := hl.AddCarry(lh, 0)
+	lo, c1 := ll.AddCarry(Uint1024{
+		mid[8], mid[9], mid[10], mid[11], mid[12], mid[13], mid[14], mid[15],
+		0, 0, 0, 0, 0, 0, 0, 0,
+	}, 0)
+	hi, _ = hh.AddCarry(Uint1024{
+		0, 0, 0, 0, 0, 0, 0, uint64(c0),
+		mid[0], mid[1], mid[2], mid[3], mid[4], mid[5], mid[6], mid[7],
+	}, c1)
+	return
+}
+
+// Mul2048 returns the 2048-bit product a*b.
+// The upper half of the product is returned in hi and the lower half is returned in lo.
+// It is the same as [Uint1024.MulFull], named after the widening multiplications of the other types.
+func (a Uint1024) Mul2048(b Uint1024) (hi, lo Uint1024) {
+	return a.MulFull(b)
+}
+
 // Div returns the quotient a/b for b != 0.
 // If b == 0, a division-by-zero run-time panic occurs.
 // Div implements Euclidean division (unlike Go); see [Uint1024.DivMod] for more details.
@@ -864,10 +1084,10 @@ func (a Uint1024) DivMod(b Uint1024) (Uint1024, Uint1024) {
 // https://github.com/golang/go/blob/c893e1cf821b06aa0602f7944ce52f0eb28fd7b5/src/math/bits/bits.go#L514-L568
 func div512(hi, lo, y Uint512) (quo, rem Uint512) {
 	if y.IsZero() {
-		panic("division by zero")
+		panicDivideByZero()
 	}
 	if y.Cmp(hi) <= 0 {
-		panic("division overflow")
+		panicDivideOverflow()
 	}
 
 	// If high part is zero, we can directly return the results.
@@ -911,6 +1131,57 @@ func div512(hi, lo, y Uint512) (quo, rem Uint512) {
 	return q1.Mul(two256).Add(q0), un21.Mul(two256).Add(un0).Sub(q0.Mul(y)).Rsh(s)
 }
 
+// 1024-bit of version of bits.Div64.
+// https://github.com/golang/go/blob/c893e1cf821b06aa0602f7944ce52f0eb28fd7b5/src/math/bits/bits.go#L514-L568
+func div1024(hi, lo, y Uint1024) (quo, rem Uint1024) {
+	if y.IsZero() {
+		panicDivideByZero()
+	}
+	if y.Cmp(hi) <= 0 {
+		panicDivideOverflow()
+	}
+
+	// If high part is zero, we can directly return the results.
+	if hi.IsZero() {
+		return lo.DivMod(y)
+	}
+
+	s := uint(y.LeadingZeros())
+	y = y.Lsh(s)
+
+	two512 := Uint1024{0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0}
+	yn1 := Uint1024{0, 0, 0, 0, 0, 0, 0, 0, y[0], y[1], y[2], y[3], y[4], y[5], y[6], y[7]}
+	yn0 := Uint1024{0, 0, 0, 0, 0, 0, 0, 0, y[8], y[9], y[10], y[11], y[12], y[13], y[14], y[15]}
+	un512 := hi.Lsh(s).Or(lo.Rsh(1024 - s))
+	un10 := lo.Lsh(s)
+	un1 := Uint1024{0, 0, 0, 0, 0, 0, 0, 0, un10[0], un10[1], un10[2], un10[3], un10[4], un10[5], un10[6], un10[7]}
+	un0 := Uint1024{0, 0, 0, 0, 0, 0, 0, 0, un10[8], un10[9], un10[10], un10[11], un10[12], un10[13], un10[14], un10[15]}
+	q1 := un512.Div(yn1)
+	rhat := un512.Sub(q1.Mul(yn1))
+
+	for q1.Cmp(two512) >= 0 || q1.Mul(yn0).Cmp(two512.Mul(rhat).Add(un1)) > 0 {
+		q1 = q1.Sub(Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1})
+		rhat = rhat.Add(yn1)
+		if rhat.Cmp(two512) >= 0 {
+			break
+		}
+	}
+
+	un21 := un512.Mul(two512).Add(un1).Sub(q1.Mul(y))
+	q0 := un21.Div(yn1)
+	rhat = un21.Sub(q0.Mul(yn1))
+
+	for q0.Cmp(two512) >= 0 || q0.Mul(yn0).Cmp(two512.Mul(rhat).Add(un0)) > 0 {
+		q0 = q0.Sub(Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1})
+		rhat = rhat.Add(yn1)
+		if rhat.Cmp(two512) >= 0 {
+			break
+		}
+	}
+
+	return q1.Mul(two512).Add(q0), un21.Mul(two512).Add(un0).Sub(q0.Mul(y)).Rsh(s)
+}
+
 // Quo returns the quotient a/b for b != 0.
 // If b == 0, a division-by-zero run-time panic occurs.
 // Quo implements T-division (like Go); see [Uint1024.QuoRem] for more details.
@@ -943,6 +1214,283 @@ func (a Uint1024) QuoRem(b Uint1024) (Uint1024, Uint1024) {
 	return a.DivMod(b)
 }
 
+// Div2048 returns the quotient and remainder of (hi, lo) divided by a:
+// quo = (hi, lo)/a, rem = (hi, lo)%a with the dividend bits' upper half
+// in parameter hi and the lower half in parameter lo.
+// Div2048 panics for a == 0 (division by zero) or a <= hi (quotient overflow).
+// Like [math/bits.Div64], it panics with a [runtime.Error].
+func (a Uint1024) Div2048(hi, lo Uint1024) (quo, rem Uint1024) {
+	return div1024(hi, lo, a)
+}
+
+// AddMod returns (a+b) mod m.
+// It panics if m == 0.
+func (a Uint1024) AddMod(b, m Uint1024) Uint1024 {
+	a, b = a.Mod(m), b.Mod(m)
+	s, carry := a.AddCarry(b, 0)
+	if carry != 0 || s.Cmp(m) >= 0 {
+		s = s.Sub(m)
+	}
+	return s
+}
+
+// SubMod returns (a-b) mod m.
+// The result is always in the range [0, m).
+// It panics if m == 0.
+func (a Uint1024) SubMod(b, m Uint1024) Uint1024 {
+	a, b = a.Mod(m), b.Mod(m)
+	d, borrow := a.SubBorrow(b, 0)
+	if borrow != 0 {
+		d = d.Add(m)
+	}
+	return d
+}
+
+// MulMod returns (a*b) mod m, computed without overflow.
+// It panics if m == 0.
+func (a Uint1024) MulMod(b, m Uint1024) Uint1024 {
+	hi, lo := a.MulFull(b)
+	_, r := m.Div2048(hi.Mod(m), lo)
+	return r
+}
+
+// ExpMod returns a**e mod m.
+// It panics if m == 0.
+func (a Uint1024) ExpMod(e, m Uint1024) Uint1024 {
+	r := Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1}.Mod(m)
+	a = a.Mod(m)
+	for i := e.BitLen() - 1; i >= 0; i-- {
+		r = r.MulMod(r, m)
+		if e.Bit(i) != 0 {
+			r = r.MulMod(a, m)
+		}
+	}
+	return r
+}
+
+// GCD returns the greatest common divisor of a and b.
+// GCD(a, 0) is a, and GCD(0, 0) is 0.
+// It uses the binary GCD algorithm.
+func (a Uint1024) GCD(b Uint1024) Uint1024 {
+	if a.IsZero() {
+		return b
+	}
+	if b.IsZero() {
+		return a
+	}
+	i, j := a.TrailingZeros(), b.TrailingZeros()
+	k := min(i, j)
+	a, b = a.Rsh(uint(i)), b.Rsh(uint(j))
+	for {
+		// a and b are odd here.
+		if a.Cmp(b) > 0 {
+			a, b = b, a
+		}
+		b = b.Sub(a)
+		if b.IsZero() {
+			return a.Lsh(uint(k))
+		}
+		b = b.Rsh(uint(b.TrailingZeros()))
+	}
+}
+
+// LCM returns the least common multiple of a and b.
+// LCM(a, 0) is 0. The result wraps around on overflow.
+func (a Uint1024) LCM(b Uint1024) Uint1024 {
+	if a.IsZero() || b.IsZero() {
+		return Uint1024{}
+	}
+	return a.Div(a.GCD(b)).Mul(b)
+}
+
+// ExtendedGCD returns the greatest common divisor g of a and b,
+// and the Bézout coefficients x and y that satisfy a*x + b*y = g.
+// The coefficients satisfy |x| <= b/(2g) + 1 and |y| <= a/(2g) + 1,
+// so they always fit in [Int1024].
+// It uses the binary GCD algorithm and doesn't perform any division.
+func (a Uint1024) ExtendedGCD(b Uint1024) (g Uint1024, x, y Int1024) {
+	if a.IsZero() {
+		return b, Int1024{}, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1}
+	}
+	if b.IsZero() {
+		return a, Int1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1}, Int1024{}
+	}
+
+	// Remove the common factors of 2, so that at least one of a and b is odd.
+	// The coefficients for a/2**k and b/2**k also work for a and b.
+	k := uint(min(a.TrailingZeros(), b.TrailingZeros()))
+	a, b = a.Rsh(k), b.Rsh(k)
+	if b[15]&1 == 0 {
+		g, y, x = b.ExtendedGCD(a)
+		return g.Lsh(k), x, y
+	}
+
+	// Divide a and b by their GCD h, which is odd because b is odd.
+	// The divisions are exact, so they are the multiplications by the inverse of h modulo 2**1024.
+	h := a.GCD(b)
+	hinv := inverseOdd1024(h)
+	a, b = a.Mul(hinv), b.Mul(hinv)
+
+	// Now a and b are coprime and b is odd, so x = a**-1 mod b exists.
+	// Choose x in the range (-b/2, b/2) to minimize |x| and |y|.
+	// y = (1 - a*x)/b is an exact division again.
+	u, _ := modInverseOdd1024(a, b)
+	if u.Cmp(b.Rsh(1)) > 0 {
+		u = u.Sub(b)
+	}
+	v := Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1}.Sub(a.Mul(u)).Mul(inverseOdd1024(b))
+	return h.Lsh(k), Int1024(u), Int1024(v)
+}
+
+// ModInverse returns the multiplicative inverse of a modulo m, in the range [0, m).
+// ok is false if a and m are not coprime, or m == 0.
+// It uses the binary GCD algorithm and doesn't perform any division.
+func (a Uint1024) ModInverse(m Uint1024) (x Uint1024, ok bool) {
+	if m.IsZero() {
+		return Uint1024{}, false
+	}
+	if m[15]&1 != 0 {
+		return modInverseOdd1024(a, m)
+	}
+
+	g, s, _ := a.ExtendedGCD(m)
+	if g != (Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1}) {
+		return Uint1024{}, false
+	}
+	if s.Sign() < 0 {
+		return Uint1024(s).Add(m), true
+	}
+	return Uint1024(s), true
+}
+
+// modInverseOdd1024 returns the multiplicative inverse of a modulo odd m, in the range [0, m).
+// ok is false if a and m are not coprime.
+func modInverseOdd1024(a, m Uint1024) (x Uint1024, ok bool) {
+	if m == (Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1}) {
+		return Uint1024{}, true
+	}
+
+	// It keeps the invariants a*x1 = u and a*x2 = v (mod m).
+	half := func(x Uint1024) Uint1024 {
+		if x[15]&1 == 0 {
+			return x.Rsh(1)
+		}
+		s, carry := x.AddCarry(m, 0)
+		s = s.Rsh(1)
+		s[0] |= uint64(carry) << 63
+		return s
+	}
+	sub := func(x, y Uint1024) Uint1024 {
+		d, borrow := x.SubBorrow(y, 0)
+		if borrow != 0 {
+			d = d.Add(m)
+		}
+		return d
+	}
+	u, v := a, m
+	x1, x2 := Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1}, Uint1024{}
+	for !u.IsZero() {
+		for u[15]&1 == 0 {
+			u, x1 = u.Rsh(1), half(x1)
+		}
+		for v[15]&1 == 0 {
+			v, x2 = v.Rsh(1), half(x2)
+		}
+		if u.Cmp(v) >= 0 {
+			u, x1 = u.Sub(v), sub(x1, x2)
+		} else {
+			v, x2 = v.Sub(u), sub(x2, x1)
+		}
+	}
+	if v != (Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1}) {
+		return Uint1024{}, false
+	}
+	return x2, true
+}
+
+// inverseOdd1024 returns the multiplicative inverse of odd a modulo 2**1024.
+func inverseOdd1024(a Uint1024) Uint1024 {
+	// Newton's method: each iteration doubles the number of correct low bits,
+	// and x = a is correct to 3 bits because a*a = 1 mod 8 for any odd a.
+	x := a
+	for i := 3; i < 1024; i *= 2 {
+		x = x.Mul(Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2}.Sub(a.Mul(x)))
+	}
+	return x
+}
+
+// Sqrt returns the floor square root of a, ⌊√a⌋.
+// It uses Newton's method seeded from [Uint1024.BitLen].
+func (a Uint1024) Sqrt() Uint1024 {
+	if a.Cmp(Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1}) <= 0 {
+		return a
+	}
+	x := Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1}.Lsh(uint(a.BitLen()+1) / 2)
+	for {
+		y := x.Add(a.Div(x)).Rsh(1)
+		if y.Cmp(x) >= 0 {
+			return x
+		}
+		x = y
+	}
+}
+
+// Cbrt returns the floor cube root of a, ⌊∛a⌋.
+func (a Uint1024) Cbrt() Uint1024 {
+	return a.Root(3)
+}
+
+// Root returns the floor n-th root of a, ⌊a**(1/n)⌋.
+// It uses Newton's method seeded from [Uint1024.BitLen].
+// It panics if n == 0.
+func (a Uint1024) Root(n uint) Uint1024 {
+	switch n {
+	case 0:
+		panic("ints: zeroth root")
+	case 1:
+		return a
+	case 2:
+		return a.Sqrt()
+	}
+	bitLen := uint(a.BitLen())
+	if bitLen <= n {
+		// a < 2**n, so the root is 0 or 1.
+		if a.IsZero() {
+			return a
+		}
+		return Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1}
+	}
+
+	x := Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1}.Lsh((bitLen + n - 1) / n)
+	n1, d := Uint1024FromUint64(uint64(n-1)), Uint1024FromUint64(uint64(n))
+	for {
+		// y = ((n-1)*x + a/x**(n-1)) / n
+		p, overflow := x, false
+		for i := uint(2); i < n && !overflow; i++ {
+			p, overflow = p.MulOverflow(x)
+		}
+		var q Uint1024
+		if !overflow {
+			q = a.Div(p)
+		}
+		y := x.Mul(n1).Add(q).Div(d)
+		if y.Cmp(x) >= 0 {
+			return x
+		}
+		x = y
+	}
+}
+
+// IsPerfectSquare reports whether a is the square of an integer.
+func (a Uint1024) IsPerfectSquare() bool {
+	// Squares modulo 16 are 0, 1, 4 or 9.
+	if (0x0213>>(a[15]&15))&1 == 0 {
+		return false
+	}
+	r := a.Sqrt()
+	return r.Mul(r) == a
+}
+
 // And returns the bitwise AND of a and b.
 func (a Uint1024) And(b Uint1024) Uint1024 {
 	return Uint1024{
@@ -1132,6 +1680,13 @@ func (a Uint1024) Lsh(i uint) Uint1024 {
 	}
 }
 
+// LshOverflow returns the left shift a<<i and reports whether the shift overflowed,
+// i.e. whether a<<i differs from a multiplied by 2**i.
+func (a Uint1024) LshOverflow(i uint) (Uint1024, bool) {
+	c := a.Lsh(i)
+	return c, c.Rsh(i) != a
+}
+
 // Rsh returns the logical right shift a>>i.
 //
 // This function's execution time does not depend on the inputs.
@@ -1211,6 +1766,142 @@ func (a Uint1024) Rsh(i uint) Uint1024 {
 	}
 }
 
+// URsh returns the logical right shift a>>i.
+// It is the same as [Uint1024.Rsh] because Uint1024 is unsigned.
+func (a Uint1024) URsh(i uint) Uint1024 {
+	return a.Rsh(i)
+}
+
+// RshRound returns a/2**i rounded to the nearest integer, with ties rounded to even.
+func (a Uint1024) RshRound(i uint) Uint1024 {
+	if i == 0 {
+		return a
+	}
+	q := a.Rsh(i)
+	half := a.Bit(int(min(i-1, 1024)))
+	sticky := !a.Extract(0, i-1).IsZero()
+	if half == 1 && (sticky || q.Bit(0) == 1) {
+		q = q.Add(Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1})
+	}
+	return q
+}
+
+// RshCeil returns a/2**i rounded toward positive infinity.
+func (a Uint1024) RshCeil(i uint) Uint1024 {
+	q := a.Rsh(i)
+	if !a.Extract(0, i).IsZero() {
+		q = q.Add(Uint1024{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1})
+	}
+	return q
+}
+
+// RotateLeft returns the value of a rotated left by (k mod 1024) bits.
+// To rotate a right by k bits, call a.RotateLeft(-k).
+//
+// This function's execution time does not depend on the inputs.
+func (a Uint1024) RotateLeft(k int) Uint1024 {
+	s := uint(k) & 1023
+	return a.Lsh(s).Or(a.Rsh(1024 - s))
+}
+
+// Reverse returns the value of a with its bits in reversed order.
+func (a Uint1024) Reverse() Uint1024 {
+	return Uint1024{
+		bits.Reverse64(a[15]),
+		bits.Reverse64(a[14]),
+		bits.Reverse64(a[13]),
+		bits.Reverse64(a[12]),
+		bits.Reverse64(a[11]),
+		bits.Reverse64(a[10]),
+		bits.Reverse64(a[9]),
+		bits.Reverse64(a[8]),
+		bits.Reverse64(a[7]),
+		bits.Reverse64(a[6]),
+		bits.Reverse64(a[5]),
+		bits.Reverse64(a[4]),
+		bits.Reverse64(a[3]),
+		bits.Reverse64(a[2]),
+		bits.Reverse64(a[1]),
+		bits.Reverse64(a[0]),
+	}
+}
+
+// ReverseBytes returns the value of a with its bytes in reversed order.
+func (a Uint1024) ReverseBytes() Uint1024 {
+	return Uint1024{
+		bits.ReverseBytes64(a[15]),
+		bits.ReverseBytes64(a[14]),
+		bits.ReverseBytes64(a[13]),
+		bits.ReverseBytes64(a[12]),
+		bits.ReverseBytes64(a[11]),
+		bits.ReverseBytes64(a[10]),
+		bits.ReverseBytes64(a[9]),
+		bits.ReverseBytes64(a[8]),
+		bits.ReverseBytes64(a[7]),
+		bits.ReverseBytes64(a[6]),
+		bits.ReverseBytes64(a[5]),
+		bits.ReverseBytes64(a[4]),
+		bits.ReverseBytes64(a[3]),
+		bits.ReverseBytes64(a[2]),
+		bits.ReverseBytes64(a[1]),
+		bits.ReverseBytes64(a[0]),
+	}
+}
+
+// Bit returns the value of the i'th bit of a. That is, it returns (a>>i)&1.
+// The bit index i must be >= 0.
+func (a Uint1024) Bit(i int) uint {
+	if i < 0 {
+		panic("ints: negative bit index")
+	}
+	if i >= 1024 {
+		return 0
+	}
+	return uint(a[15-i/64]>>(uint(i)%64)) & 1
+}
+
+// SetBit returns a with its i'th bit set to b (0 or 1).
+// It panics if i is out of the range [0, 1024) or b is not 0 or 1.
+func (a Uint1024) SetBit(i int, b uint) Uint1024 {
+	checkBitIndex(i, 1024)
+	if b > 1 {
+		panic("ints: set bit is not 0 or 1")
+	}
+	j, s := 15-i/64, uint(i)%64
+	a[j] = a[j]&^(1<<s) | uint64(b)<<s
+	return a
+}
+
+// ClearBit returns a with its i'th bit set to 0.
+// It panics if i is out of the range [0, 1024).
+func (a Uint1024) ClearBit(i int) Uint1024 {
+	checkBitIndex(i, 1024)
+	a[15-i/64] &^= 1 << (uint(i) % 64)
+	return a
+}
+
+// FlipBit returns a with its i'th bit inverted.
+// It panics if i is out of the range [0, 1024).
+func (a Uint1024) FlipBit(i int) Uint1024 {
+	checkBitIndex(i, 1024)
+	a[15-i/64] ^= 1 << (uint(i) % 64)
+	return a
+}
+
+// Extract returns the width bits of a starting at bit lo, shifted down to bit 0.
+// That is, it returns (a>>lo) & (1<<width - 1).
+func (a Uint1024) Extract(lo, width uint) Uint1024 {
+	width = min(width, 1024)
+	return a.Rsh(lo).And(Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}.Rsh(1024 - width))
+}
+
+// Insert returns a with the width bits starting at bit lo replaced by the low width bits of v.
+func (a Uint1024) Insert(v Uint1024, lo, width uint) Uint1024 {
+	width = min(width, 1024)
+	mask := Uint1024{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}.Rsh(1024 - width).Lsh(lo)
+	return a.AndNot(mask).Or(v.Lsh(lo).And(mask))
+}
+
 // LeadingZeros returns the number of leading zero bits in a; the result is 1024 for a == 0.
 func (a Uint1024) LeadingZeros() int {
 	if a[0] != 0 {
@@ -1361,6 +2052,26 @@ func (a Uint1024) BitLen() int {
 	return bits.Len64(a[15])
 }
 
+// OnesCount returns the number of one bits ("population count") in a.
+func (a Uint1024) OnesCount() int {
+	return bits.OnesCount64(a[0]) +
+		bits.OnesCount64(a[1]) +
+		bits.OnesCount64(a[2]) +
+		bits.OnesCount64(a[3]) +
+		bits.OnesCount64(a[4]) +
+		bits.OnesCount64(a[5]) +
+		bits.OnesCount64(a[6]) +
+		bits.OnesCount64(a[7]) +
+		bits.OnesCount64(a[8]) +
+		bits.OnesCount64(a[9]) +
+		bits.OnesCount64(a[10]) +
+		bits.OnesCount64(a[11]) +
+		bits.OnesCount64(a[12]) +
+		bits.OnesCount64(a[13]) +
+		bits.OnesCount64(a[14]) +
+		bits.OnesCount64(a[15])
+}
+
 // Sign returns the sign of a.
 // It returns 1 if a > 0, and 0 if a == 0.
 // It does not return -1 because Uint128 is unsigned.
@@ -1395,6 +2106,14 @@ func (a Uint1024) Neg() Uint1024 {
 	return Uint1024{u0, u1, u2, u3, u4, u5, u6, u7, u8, u9, u10, u11, u12, u13, u14, u15}
 }
 
+// NegOverflow returns the negation of a and reports whether the negation overflowed,
+// which happens if a is not zero.
+//
+// This function's execution time does not depend on the inputs.
+func (a Uint1024) NegOverflow() (Uint1024, bool) {
+	return a.Neg(), !a.IsZero()
+}
+
 // Cmp returns the comparison result of a and b.
 // It returns -1 if a < b, 0 if a == b, and 1 if a > b.
 func (a Uint1024) Cmp(b Uint1024) int {
@@ -1477,3 +2196,222 @@ func (a Uint1024) String() string {
 func (a Uint1024) Format(s fmt.State, verb rune) {
 	format(s, verb, a.Sign(), a)
 }
+
+// ParseUint1024 is like [ParseInt1024] but for unsigned numbers.
+// A sign prefix is not permitted.
+func ParseUint1024(s string, base int) (Uint1024, error) {
+	var z Uint1024
+	err := parseUint("ParseUint1024", s, base, 1024, z[:])
+	return z, err
+}
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+// The result is the decimal representation of a.
+func (a Uint1024) MarshalText() ([]byte, error) {
+	return a.AppendText(nil)
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+// It accepts the same syntax as Go integer literals, such as "0x" prefixes and underscores.
+// See [ParseUint1024] for more details.
+func (a *Uint1024) UnmarshalText(text []byte) error {
+	v, err := ParseUint1024(string(text), 0)
+	if err != nil {
+		return err
+	}
+	*a = v
+	return nil
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+// The result is a JSON number. Use [JSONString] to encode a as a JSON string.
+func (a Uint1024) MarshalJSON() ([]byte, error) {
+	return a.AppendText(nil)
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+// It accepts both a JSON number such as 123 and a JSON string such as "123" or "0x7b".
+// JSON strings are parsed in the same way as [Uint1024.UnmarshalText].
+func (a *Uint1024) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(a, data, ParseUint1024)
+}
+
+// BytesBE returns the big-endian representation of a.
+func (a Uint1024) BytesBE() [128]byte {
+	var b [128]byte
+	a.PutBytesBE(b[:])
+	return b
+}
+
+// BytesLE returns the little-endian representation of a.
+func (a Uint1024) BytesLE() [128]byte {
+	var b [128]byte
+	a.PutBytesLE(b[:])
+	return b
+}
+
+// PutBytesBE stores the big-endian representation of a into b.
+// It panics if len(b) < 128.
+func (a Uint1024) PutBytesBE(b []byte) {
+	_ = b[127] // bounds check hint to compiler; see golang.org/issue/14808
+	binary.BigEndian.PutUint64(b[0:], a[0])
+	binary.BigEndian.PutUint64(b[8:], a[1])
+	binary.BigEndian.PutUint64(b[16:], a[2])
+	binary.BigEndian.PutUint64(b[24:], a[3])
+	binary.BigEndian.PutUint64(b[32:], a[4])
+	binary.BigEndian.PutUint64(b[40:], a[5])
+	binary.BigEndian.PutUint64(b[48:], a[6])
+	binary.BigEndian.PutUint64(b[56:], a[7])
+	binary.BigEndian.PutUint64(b[64:], a[8])
+	binary.BigEndian.PutUint64(b[72:], a[9])
+	binary.BigEndian.PutUint64(b[80:], a[10])
+	binary.BigEndian.PutUint64(b[88:], a[11])
+	binary.BigEndian.PutUint64(b[96:], a[12])
+	binary.BigEndian.PutUint64(b[104:], a[13])
+	binary.BigEndian.PutUint64(b[112:], a[14])
+	binary.BigEndian.PutUint64(b[120:], a[15])
+}
+
+// PutBytesLE stores the little-endian representation of a into b.
+// It panics if len(b) < 128.
+func (a Uint1024) PutBytesLE(b []byte) {
+	_ = b[127] // bounds check hint to compiler; see golang.org/issue/14808
+	binary.LittleEndian.PutUint64(b[0:], a[15])
+	binary.LittleEndian.PutUint64(b[8:], a[14])
+	binary.LittleEndian.PutUint64(b[16:], a[13])
+	binary.LittleEndian.PutUint64(b[24:], a[12])
+	binary.LittleEndian.PutUint64(b[32:], a[11])
+	binary.LittleEndian.PutUint64(b[40:], a[10])
+	binary.LittleEndian.PutUint64(b[48:], a[9])
+	binary.LittleEndian.PutUint64(b[56:], a[8])
+	binary.LittleEndian.PutUint64(b[64:], a[7])
+	binary.LittleEndian.PutUint64(b[72:], a[6])
+	binary.LittleEndian.PutUint64(b[80:], a[5])
+	binary.LittleEndian.PutUint64(b[88:], a[4])
+	binary.LittleEndian.PutUint64(b[96:], a[3])
+	binary.LittleEndian.PutUint64(b[104:], a[2])
+	binary.LittleEndian.PutUint64(b[112:], a[1])
+	binary.LittleEndian.PutUint64(b[120:], a[0])
+}
+
+// Uint1024FromBytesBE returns the Uint1024 represented by the big-endian bytes b[:128].
+// It panics if len(b) < 128.
+func Uint1024FromBytesBE(b []byte) Uint1024 {
+	_ = b[127] // bounds check hint to compiler; see golang.org/issue/14808
+	return Uint1024{
+		binary.BigEndian.Uint64(b[0:]),
+		binary.BigEndian.Uint64(b[8:]),
+		binary.BigEndian.Uint64(b[16:]),
+		binary.BigEndian.Uint64(b[24:]),
+		binary.BigEndian.Uint64(b[32:]),
+		binary.BigEndian.Uint64(b[40:]),
+		binary.BigEndian.Uint64(b[48:]),
+		binary.BigEndian.Uint64(b[56:]),
+		binary.BigEndian.Uint64(b[64:]),
+		binary.BigEndian.Uint64(b[72:]),
+		binary.BigEndian.Uint64(b[80:]),
+		binary.BigEndian.Uint64(b[88:]),
+		binary.BigEndian.Uint64(b[96:]),
+		binary.BigEndian.Uint64(b[104:]),
+		binary.BigEndian.Uint64(b[112:]),
+		binary.BigEndian.Uint64(b[120:]),
+	}
+}
+
+// Uint1024FromBytesLE returns the Uint1024 represented by the little-endian bytes b[:128].
+// It panics if len(b) < 128.
+func Uint1024FromBytesLE(b []byte) Uint1024 {
+	_ = b[127] // bounds check hint to compiler; see golang.org/issue/14808
+	return Uint1024{
+		binary.LittleEndian.Uint64(b[120:]),
+		binary.LittleEndian.Uint64(b[112:]),
+		binary.LittleEndian.Uint64(b[104:]),
+		binary.LittleEndian.Uint64(b[96:]),
+		binary.LittleEndian.Uint64(b[88:]),
+		binary.LittleEndian.Uint64(b[80:]),
+		binary.LittleEndian.Uint64(b[72:]),
+		binary.LittleEndian.Uint64(b[64:]),
+		binary.LittleEndian.Uint64(b[56:]),
+		binary.LittleEndian.Uint64(b[48:]),
+		binary.LittleEndian.Uint64(b[40:]),
+		binary.LittleEndian.Uint64(b[32:]),
+		binary.LittleEndian.Uint64(b[24:]),
+		binary.LittleEndian.Uint64(b[16:]),
+		binary.LittleEndian.Uint64(b[8:]),
+		binary.LittleEndian.Uint64(b[0:]),
+	}
+}
+
+// AppendBinary implements the [encoding.BinaryAppender] interface.
+// It appends the big-endian representation of a to dst.
+func (a Uint1024) AppendBinary(dst []byte) ([]byte, error) {
+	b := a.BytesBE()
+	return append(dst, b[:]...), nil
+}
+
+// MarshalBinary implements the [encoding.BinaryMarshaler] interface.
+// The result is the 128-byte big-endian representation of a.
+func (a Uint1024) MarshalBinary() ([]byte, error) {
+	return a.AppendBinary(make([]byte, 0, 128))
+}
+
+// UnmarshalBinary implements the [encoding.BinaryUnmarshaler] interface.
+// data must be exactly 128 bytes of the big-endian representation, as generated by [Uint1024.MarshalBinary].
+func (a *Uint1024) UnmarshalBinary(data []byte) error {
+	if len(data) != 128 {
+		return fmt.Errorf("ints: Uint1024.UnmarshalBinary: invalid length %d, want 128", len(data))
+	}
+	*a = Uint1024FromBytesBE(data)
+	return nil
+}
+
+// BigInt returns a as a new [*big.Int].
+func (a Uint1024) BigInt() *big.Int {
+	var w [1024 / bits.UintSize]big.Word
+	return new(big.Int).SetBits(wordsFromLimbs(w[:], a[:]))
+}
+
+// Uint1024FromBigInt returns x as an Uint1024.
+// If x is not representable as an Uint1024, the result is the lower 1024 bits of x in two's complement,
+// and ok is false.
+func Uint1024FromBigInt(x *big.Int) (Uint1024, bool) {
+	var z Uint1024
+	ok := uintFromBigInt(z[:], 1024, x)
+	return z, ok
+}
+
+// Float64 returns the float64 value nearest to a, rounding ties to even, and an indication of any rounding error.
+// If a is too large to be represented by a float64, the result is ±Inf.
+func (a Uint1024) Float64() (float64, big.Accuracy) {
+	return float64FromLimbs(a[:])
+}
+
+// Uint1024FromFloat64 returns the integer value of f rounded according to mode,
+// and the accuracy of the result: [big.Below] if the result is less than f, [big.Above] if it is greater than f.
+// Use [big.ToZero] to truncate f like the Go conversion, and [big.ToNearestEven] to round f to the nearest integer.
+//
+// If f is NaN, the result is 0 and the error is [ErrNaN].
+// If the rounded value of f is not representable as an Uint1024, including ±Inf,
+// the result is clamped to 0 or the maximum value of Uint1024, and the error is [ErrOverflow].
+func Uint1024FromFloat64(f float64, mode big.RoundingMode) (Uint1024, big.Accuracy, error) {
+	var z Uint1024
+	acc, err := uintFromFloat64(z[:], 1024, f, mode)
+	return z, acc, err
+}
+
+// BigFloat returns a as a new [*big.Float].
+// The precision of the result is 1024 bits, so the conversion is always exact.
+func (a Uint1024) BigFloat() *big.Float {
+	return new(big.Float).SetPrec(1024).SetInt(a.BigInt())
+}
+
+// Uint1024FromBigFloat returns the integer value of x rounded according to mode,
+// and the accuracy of the result: [big.Below] if the result is less than x, [big.Above] if it is greater than x.
+//
+// If the rounded value of x is not representable as an Uint1024, including ±Inf,
+// the result is clamped to 0 or the maximum value of Uint1024, and the error is [ErrOverflow].
+func Uint1024FromBigFloat(x *big.Float, mode big.RoundingMode) (Uint1024, big.Accuracy, error) {
+	var z Uint1024
+	acc, err := uintFromBigFloat(z[:], 1024, x, mode)
+	return z, acc, err
+}
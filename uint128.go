@@ -2,19 +2,66 @@ package ints
 
 import (
 	"cmp"
+	"encoding/binary"
 	"fmt"
+	"math"
+	"math/big"
 	"math/bits"
 )
 
 // Uint128 is a type that represents an 128-bit unsigned integer.
 type Uint128 [2]uint64
 
+// MaxUint128 is the maximum value of Uint128.
+var MaxUint128 = Uint128{math.MaxUint64, math.MaxUint64}
+
+// Uint128FromUint64 returns v as a Uint128.
+func Uint128FromUint64(v uint64) Uint128 {
+	return Uint128{0, v}
+}
+
+// Uint128Of returns v as a Uint128.
+// v is converted in the same way as Go's integer conversions:
+// it is sign-extended if T is a signed type, and zero-extended otherwise.
+func Uint128Of[T builtinInteger](v T) Uint128 {
+	if isSigned[T]() {
+		return Int64(v).Uint128()
+	}
+	return Uint64(v).Uint128()
+}
+
 // IsZero returns true if a is zero.
 func (a Uint128) IsZero() bool {
 	var zero Uint128
 	return a == zero
 }
 
+// IsInt64 reports whether a can be represented as an int64.
+func (a Uint128) IsInt64() bool {
+	_, ok := a.TryInt64()
+	return ok
+}
+
+// IsUint64 reports whether a can be represented as a uint64.
+func (a Uint128) IsUint64() bool {
+	_, ok := a.TryUint64()
+	return ok
+}
+
+// Int returns a as an int and reports whether a can be represented as an int.
+// If it can't, the result is a truncated to the size of int.
+func (a Uint128) Int() (int, bool) {
+	v, ok := a.TryInt64()
+	return int(v), ok && int64(int(v)) == int64(v)
+}
+
+// Uint returns a as a uint and reports whether a can be represented as a uint.
+// If it can't, the result is a truncated to the size of uint.
+func (a Uint128) Uint() (uint, bool) {
+	v, ok := a.TryUint64()
+	return uint(v), ok && uint64(uint(v)) == uint64(v)
+}
+
 // Add returns the sum a+b.
 //
 // This function's execution time does not depend on the inputs.
@@ -24,6 +71,36 @@ func (a Uint128) Add(b Uint128) Uint128 {
 	return Uint128{u0, u1}
 }
 
+// AddOverflow returns the sum a+b and reports whether the addition overflowed.
+//
+// This function's execution time does not depend on the inputs.
+func (a Uint128) AddOverflow(b Uint128) (Uint128, bool) {
+	u1, carry := bits.Add64(a[1], b[1], 0)
+	u0, carry := bits.Add64(a[0], b[0], carry)
+	return Uint128{u0, u1}, carry != 0
+}
+
+// SaturatingAdd returns the sum a+b.
+// If the result overflows, it is clamped to the maximum value.
+func (a Uint128) SaturatingAdd(b Uint128) Uint128 {
+	c, overflow := a.AddOverflow(b)
+	if overflow {
+		return Uint128{math.MaxUint64, math.MaxUint64}
+	}
+	return c
+}
+
+// AddCarry returns the sum with carry of a, b and carry: sum = a + b + carry.
+// The carry input must be 0 or 1; otherwise the behavior is undefined.
+// The carryOut output is guaranteed to be 0 or 1.
+//
+// This function's execution time does not depend on the inputs.
+func (a Uint128) AddCarry(b Uint128, carry uint) (sum Uint128, carryOut uint) {
+	u1, c := bits.Add64(a[1], b[1], uint64(carry))
+	u0, c := bits.Add64(a[0], b[0], c)
+	return Uint128{u0, u1}, uint(c)
+}
+
 // Sub returns the difference a-b.
 //
 // This function's execution time does not depend on the inputs.
@@ -33,6 +110,36 @@ func (a Uint128) Sub(b Uint128) Uint128 {
 	return Uint128{u0, u1}
 }
 
+// SubOverflow returns the difference a-b and reports whether the subtraction overflowed.
+//
+// This function's execution time does not depend on the inputs.
+func (a Uint128) SubOverflow(b Uint128) (Uint128, bool) {
+	u1, borrow := bits.Sub64(a[1], b[1], 0)
+	u0, borrow := bits.Sub64(a[0], b[0], borrow)
+	return Uint128{u0, u1}, borrow != 0
+}
+
+// SaturatingSub returns the difference a-b.
+// If the result overflows, it is clamped to zero.
+func (a Uint128) SaturatingSub(b Uint128) Uint128 {
+	c, overflow := a.SubOverflow(b)
+	if overflow {
+		return Uint128{}
+	}
+	return c
+}
+
+// SubBorrow returns the difference of a, b and borrow: diff = a - b - borrow.
+// The borrow input must be 0 or 1; otherwise the behavior is undefined.
+// The borrowOut output is guaranteed to be 0 or 1.
+//
+// This function's execution time does not depend on the inputs.
+func (a Uint128) SubBorrow(b Uint128, borrow uint) (diff Uint128, borrowOut uint) {
+	u1, c := bits.Sub64(a[1], b[1], uint64(borrow))
+	u0, c := bits.Sub64(a[0], b[0], c)
+	return Uint128{u0, u1}, uint(c)
+}
+
 // Mul returns the product a*b.
 //
 // This function's execution time does not depend on the inputs.
@@ -43,6 +150,29 @@ func (a Uint128) Mul(b Uint128) Uint128 {
 	return Uint128{h + h1 + h2, l}
 }
 
+// MulOverflow returns the product a*b and reports whether the multiplication overflowed.
+func (a Uint128) MulOverflow(b Uint128) (Uint128, bool) {
+	c := a.Mul256(b)
+	return Uint128{c[2], c[3]}, c[0]|c[1] != 0
+}
+
+// SaturatingMul returns the product a*b.
+// If the result overflows, it is clamped to the maximum value.
+func (a Uint128) SaturatingMul(b Uint128) Uint128 {
+	c, overflow := a.MulOverflow(b)
+	if overflow {
+		return Uint128{math.MaxUint64, math.MaxUint64}
+	}
+	return c
+}
+
+// MulFull returns the 256-bit product of a and b: (hi, lo) = a * b
+// with the product bits' upper half returned in hi and the lower half returned in lo.
+func (a Uint128) MulFull(b Uint128) (hi, lo Uint128) {
+	c := a.Mul256(b)
+	return Uint128{c[0], c[1]}, Uint128{c[2], c[3]}
+}
+
 // Mul256 returns the product a*b, the result is a 256-bit integer.
 func (a Uint128) Mul256(b Uint128) Uint256 {
 	//              a0  a1
@@ -168,6 +298,283 @@ func (a Uint128) QuoRem(b Uint128) (Uint128, Uint128) {
 	return a.DivMod(b)
 }
 
+// Div256 returns the quotient and remainder of (hi, lo) divided by a:
+// quo = (hi, lo)/a, rem = (hi, lo)%a with the dividend bits' upper half
+// in parameter hi and the lower half in parameter lo.
+// Div256 panics for a == 0 (division by zero) or a <= hi (quotient overflow).
+// Like [math/bits.Div64], it panics with a [runtime.Error].
+func (a Uint128) Div256(hi, lo Uint128) (quo, rem Uint128) {
+	return div128(hi, lo, a)
+}
+
+// AddMod returns (a+b) mod m.
+// It panics if m == 0.
+func (a Uint128) AddMod(b, m Uint128) Uint128 {
+	a, b = a.Mod(m), b.Mod(m)
+	s, carry := a.AddCarry(b, 0)
+	if carry != 0 || s.Cmp(m) >= 0 {
+		s = s.Sub(m)
+	}
+	return s
+}
+
+// SubMod returns (a-b) mod m.
+// The result is always in the range [0, m).
+// It panics if m == 0.
+func (a Uint128) SubMod(b, m Uint128) Uint128 {
+	a, b = a.Mod(m), b.Mod(m)
+	d, borrow := a.SubBorrow(b, 0)
+	if borrow != 0 {
+		d = d.Add(m)
+	}
+	return d
+}
+
+// MulMod returns (a*b) mod m, computed without overflow.
+// It panics if m == 0.
+func (a Uint128) MulMod(b, m Uint128) Uint128 {
+	hi, lo := a.MulFull(b)
+	_, r := m.Div256(hi.Mod(m), lo)
+	return r
+}
+
+// ExpMod returns a**e mod m.
+// It panics if m == 0.
+func (a Uint128) ExpMod(e, m Uint128) Uint128 {
+	r := Uint128{0, 1}.Mod(m)
+	a = a.Mod(m)
+	for i := e.BitLen() - 1; i >= 0; i-- {
+		r = r.MulMod(r, m)
+		if e.Bit(i) != 0 {
+			r = r.MulMod(a, m)
+		}
+	}
+	return r
+}
+
+// GCD returns the greatest common divisor of a and b.
+// GCD(a, 0) is a, and GCD(0, 0) is 0.
+// It uses the binary GCD algorithm.
+func (a Uint128) GCD(b Uint128) Uint128 {
+	if a.IsZero() {
+		return b
+	}
+	if b.IsZero() {
+		return a
+	}
+	i, j := a.TrailingZeros(), b.TrailingZeros()
+	k := min(i, j)
+	a, b = a.Rsh(uint(i)), b.Rsh(uint(j))
+	for {
+		// a and b are odd here.
+		if a.Cmp(b) > 0 {
+			a, b = b, a
+		}
+		b = b.Sub(a)
+		if b.IsZero() {
+			return a.Lsh(uint(k))
+		}
+		b = b.Rsh(uint(b.TrailingZeros()))
+	}
+}
+
+// LCM returns the least common multiple of a and b.
+// LCM(a, 0) is 0. The result wraps around on overflow.
+func (a Uint128) LCM(b Uint128) Uint128 {
+	if a.IsZero() || b.IsZero() {
+		return Uint128{}
+	}
+	return a.Div(a.GCD(b)).Mul(b)
+}
+
+// ExtendedGCD returns the greatest common divisor g of a and b,
+// and the Bézout coefficients x and y that satisfy a*x + b*y = g.
+// The coefficients satisfy |x| <= b/(2g) + 1 and |y| <= a/(2g) + 1,
+// so they always fit in [Int128].
+// It uses the binary GCD algorithm and doesn't perform any division.
+func (a Uint128) ExtendedGCD(b Uint128) (g Uint128, x, y Int128) {
+	if a.IsZero() {
+		return b, Int128{}, Int128{0, 1}
+	}
+	if b.IsZero() {
+		return a, Int128{0, 1}, Int128{}
+	}
+
+	// Remove the common factors of 2, so that at least one of a and b is odd.
+	// The coefficients for a/2**k and b/2**k also work for a and b.
+	k := uint(min(a.TrailingZeros(), b.TrailingZeros()))
+	a, b = a.Rsh(k), b.Rsh(k)
+	if b[1]&1 == 0 {
+		g, y, x = b.ExtendedGCD(a)
+		return g.Lsh(k), x, y
+	}
+
+	// Divide a and b by their GCD h, which is odd because b is odd.
+	// The divisions are exact, so they are the multiplications by the inverse of h modulo 2**128.
+	h := a.GCD(b)
+	hinv := inverseOdd128(h)
+	a, b = a.Mul(hinv), b.Mul(hinv)
+
+	// Now a and b are coprime and b is odd, so x = a**-1 mod b exists.
+	// Choose x in the range (-b/2, b/2) to minimize |x| and |y|.
+	// y = (1 - a*x)/b is an exact division again.
+	u, _ := modInverseOdd128(a, b)
+	if u.Cmp(b.Rsh(1)) > 0 {
+		u = u.Sub(b)
+	}
+	v := Uint128{0, 1}.Sub(a.Mul(u)).Mul(inverseOdd128(b))
+	return h.Lsh(k), Int128(u), Int128(v)
+}
+
+// ModInverse returns the multiplicative inverse of a modulo m, in the range [0, m).
+// ok is false if a and m are not coprime, or m == 0.
+// It uses the binary GCD algorithm and doesn't perform any division.
+func (a Uint128) ModInverse(m Uint128) (x Uint128, ok bool) {
+	if m.IsZero() {
+		return Uint128{}, false
+	}
+	if m[1]&1 != 0 {
+		return modInverseOdd128(a, m)
+	}
+
+	g, s, _ := a.ExtendedGCD(m)
+	if g != (Uint128{0, 1}) {
+		return Uint128{}, false
+	}
+	if s.Sign() < 0 {
+		return Uint128(s).Add(m), true
+	}
+	return Uint128(s), true
+}
+
+// modInverseOdd128 returns the multiplicative inverse of a modulo odd m, in the range [0, m).
+// ok is false if a and m are not coprime.
+func modInverseOdd128(a, m Uint128) (x Uint128, ok bool) {
+	if m == (Uint128{0, 1}) {
+		return Uint128{}, true
+	}
+
+	// It keeps the invariants a*x1 = u and a*x2 = v (mod m).
+	half := func(x Uint128) Uint128 {
+		if x[1]&1 == 0 {
+			return x.Rsh(1)
+		}
+		s, carry := x.AddCarry(m, 0)
+		s = s.Rsh(1)
+		s[0] |= uint64(carry) << 63
+		return s
+	}
+	sub := func(x, y Uint128) Uint128 {
+		d, borrow := x.SubBorrow(y, 0)
+		if borrow != 0 {
+			d = d.Add(m)
+		}
+		return d
+	}
+	u, v := a, m
+	x1, x2 := Uint128{0, 1}, Uint128{}
+	for !u.IsZero() {
+		for u[1]&1 == 0 {
+			u, x1 = u.Rsh(1), half(x1)
+		}
+		for v[1]&1 == 0 {
+			v, x2 = v.Rsh(1), half(x2)
+		}
+		if u.Cmp(v) >= 0 {
+			u, x1 = u.Sub(v), sub(x1, x2)
+		} else {
+			v, x2 = v.Sub(u), sub(x2, x1)
+		}
+	}
+	if v != (Uint128{0, 1}) {
+		return Uint128{}, false
+	}
+	return x2, true
+}
+
+// inverseOdd128 returns the multiplicative inverse of odd a modulo 2**128.
+func inverseOdd128(a Uint128) Uint128 {
+	// Newton's method: each iteration doubles the number of correct low bits,
+	// and x = a is correct to 3 bits because a*a = 1 mod 8 for any odd a.
+	x := a
+	for i := 3; i < 128; i *= 2 {
+		x = x.Mul(Uint128{0, 2}.Sub(a.Mul(x)))
+	}
+	return x
+}
+
+// Sqrt returns the floor square root of a, ⌊√a⌋.
+// It uses Newton's method seeded from [Uint128.BitLen].
+func (a Uint128) Sqrt() Uint128 {
+	if a.Cmp(Uint128{0, 1}) <= 0 {
+		return a
+	}
+	x := Uint128{0, 1}.Lsh(uint(a.BitLen()+1) / 2)
+	for {
+		y := x.Add(a.Div(x)).Rsh(1)
+		if y.Cmp(x) >= 0 {
+			return x
+		}
+		x = y
+	}
+}
+
+// Cbrt returns the floor cube root of a, ⌊∛a⌋.
+func (a Uint128) Cbrt() Uint128 {
+	return a.Root(3)
+}
+
+// Root returns the floor n-th root of a, ⌊a**(1/n)⌋.
+// It uses Newton's method seeded from [Uint128.BitLen].
+// It panics if n == 0.
+func (a Uint128) Root(n uint) Uint128 {
+	switch n {
+	case 0:
+		panic("ints: zeroth root")
+	case 1:
+		return a
+	case 2:
+		return a.Sqrt()
+	}
+	bitLen := uint(a.BitLen())
+	if bitLen <= n {
+		// a < 2**n, so the root is 0 or 1.
+		if a.IsZero() {
+			return a
+		}
+		return Uint128{0, 1}
+	}
+
+	x := Uint128{0, 1}.Lsh((bitLen + n - 1) / n)
+	n1, d := Uint128FromUint64(uint64(n-1)), Uint128FromUint64(uint64(n))
+	for {
+		// y = ((n-1)*x + a/x**(n-1)) / n
+		p, overflow := x, false
+		for i := uint(2); i < n && !overflow; i++ {
+			p, overflow = p.MulOverflow(x)
+		}
+		var q Uint128
+		if !overflow {
+			q = a.Div(p)
+		}
+		y := x.Mul(n1).Add(q).Div(d)
+		if y.Cmp(x) >= 0 {
+			return x
+		}
+		x = y
+	}
+}
+
+// IsPerfectSquare reports whether a is the square of an integer.
+func (a Uint128) IsPerfectSquare() bool {
+	// Squares modulo 16 are 0, 1, 4 or 9.
+	if (0x0213>>(a[1]&15))&1 == 0 {
+		return false
+	}
+	r := a.Sqrt()
+	return r.Mul(r) == a
+}
+
 // And returns the bitwise AND of a and b.
 func (a Uint128) And(b Uint128) Uint128 {
 	return Uint128{a[0] & b[0], a[1] & b[1]}
@@ -209,6 +616,13 @@ func (a Uint128) Lsh(i uint) Uint128 {
 	}
 }
 
+// LshOverflow returns the left shift a<<i and reports whether the shift overflowed,
+// i.e. whether a<<i differs from a multiplied by 2**i.
+func (a Uint128) LshOverflow(i uint) (Uint128, bool) {
+	c := a.Lsh(i)
+	return c, c.Rsh(i) != a
+}
+
 // Rsh returns the logical right shift a>>i.
 //
 // This function's execution time does not depend on the inputs.
@@ -225,6 +639,114 @@ func (a Uint128) Rsh(i uint) Uint128 {
 	}
 }
 
+// URsh returns the logical right shift a>>i.
+// It is the same as [Uint128.Rsh] because Uint128 is unsigned.
+func (a Uint128) URsh(i uint) Uint128 {
+	return a.Rsh(i)
+}
+
+// RshRound returns a/2**i rounded to the nearest integer, with ties rounded to even.
+func (a Uint128) RshRound(i uint) Uint128 {
+	if i == 0 {
+		return a
+	}
+	q := a.Rsh(i)
+	half := a.Bit(int(min(i-1, 128)))
+	sticky := !a.Extract(0, i-1).IsZero()
+	if half == 1 && (sticky || q.Bit(0) == 1) {
+		q = q.Add(Uint128{0, 1})
+	}
+	return q
+}
+
+// RshCeil returns a/2**i rounded toward positive infinity.
+func (a Uint128) RshCeil(i uint) Uint128 {
+	q := a.Rsh(i)
+	if !a.Extract(0, i).IsZero() {
+		q = q.Add(Uint128{0, 1})
+	}
+	return q
+}
+
+// RotateLeft returns the value of a rotated left by (k mod 128) bits.
+// To rotate a right by k bits, call a.RotateLeft(-k).
+//
+// This function's execution time does not depend on the inputs.
+func (a Uint128) RotateLeft(k int) Uint128 {
+	s := uint(k) & 127
+	return a.Lsh(s).Or(a.Rsh(128 - s))
+}
+
+// Reverse returns the value of a with its bits in reversed order.
+func (a Uint128) Reverse() Uint128 {
+	return Uint128{
+		bits.Reverse64(a[1]),
+		bits.Reverse64(a[0]),
+	}
+}
+
+// ReverseBytes returns the value of a with its bytes in reversed order.
+func (a Uint128) ReverseBytes() Uint128 {
+	return Uint128{
+		bits.ReverseBytes64(a[1]),
+		bits.ReverseBytes64(a[0]),
+	}
+}
+
+// Bit returns the value of the i'th bit of a. That is, it returns (a>>i)&1.
+// The bit index i must be >= 0.
+func (a Uint128) Bit(i int) uint {
+	if i < 0 {
+		panic("ints: negative bit index")
+	}
+	if i >= 128 {
+		return 0
+	}
+	return uint(a[1-i/64]>>(uint(i)%64)) & 1
+}
+
+// SetBit returns a with its i'th bit set to b (0 or 1).
+// It panics if i is out of the range [0, 128) or b is not 0 or 1.
+func (a Uint128) SetBit(i int, b uint) Uint128 {
+	checkBitIndex(i, 128)
+	if b > 1 {
+		panic("ints: set bit is not 0 or 1")
+	}
+	j, s := 1-i/64, uint(i)%64
+	a[j] = a[j]&^(1<<s) | uint64(b)<<s
+	return a
+}
+
+// ClearBit returns a with its i'th bit set to 0.
+// It panics if i is out of the range [0, 128).
+func (a Uint128) ClearBit(i int) Uint128 {
+	checkBitIndex(i, 128)
+	a[1-i/64] &^= 1 << (uint(i) % 64)
+	return a
+}
+
+// FlipBit returns a with its i'th bit inverted.
+// It panics if i is out of the range [0, 128).
+func (a Uint128) FlipBit(i int) Uint128 {
+	checkBitIndex(i, 128)
+	a[1-i/64] ^= 1 << (uint(i) % 64)
+	return a
+}
+
+// Extract returns the width bits of a starting at bit lo, shifted down to bit 0.
+// That is, it returns (a>>lo) & (1<<width - 1).
+func (a Uint128) Extract(lo, width uint) Uint128 {
+	width = min(width, 128)
+	return a.Rsh(lo).And(Uint128{math.MaxUint64, math.MaxUint64}.Rsh(128 - width))
+}
+
+// Insert returns a with the width bits starting at bit lo replaced by the low width bits of v.
+func (a Uint128) Insert(v Uint128, lo, width uint) Uint128 {
+	width = min(width, 128)
+	mask := Uint128{math.MaxUint64, math.MaxUint64}.Rsh(128 - width).Lsh(lo)
+	return a.AndNot(mask).Or(v.Lsh(lo).And(mask))
+}
+
 // LeadingZeros returns the number of leading zero bits in x; the result is 128 for x == 0.
 func (a Uint128) LeadingZeros() int {
 	if a[0] != 0 {
@@ -249,6 +771,12 @@ func (a Uint128) BitLen() int {
 	return bits.Len64(a[1])
 }
 
+// OnesCount returns the number of one bits ("population count") in a.
+func (a Uint128) OnesCount() int {
+	return bits.OnesCount64(a[0]) +
+		bits.OnesCount64(a[1])
+}
+
 // Sign returns the sign of a.
 // It returns 1 if a > 0, and 0 if a == 0.
 // It does not return -1 because Uint128 is unsigned.
@@ -269,6 +797,14 @@ func (a Uint128) Neg() Uint128 {
 	return Uint128{u0, u1}
 }
 
+// NegOverflow returns the negation of a and reports whether the negation overflowed,
+// which happens if a is not zero.
+//
+// This function's execution time does not depend on the inputs.
+func (a Uint128) NegOverflow() (Uint128, bool) {
+	return a.Neg(), !a.IsZero()
+}
+
 // Cmp returns the comparison result of a and b.
 // It returns -1 if a < b, 0 if a == b, and 1 if a > b.
 func (a Uint128) Cmp(b Uint128) int {
@@ -309,3 +845,166 @@ func (a Uint128) String() string {
 func (a Uint128) Format(s fmt.State, verb rune) {
 	format(s, verb, a.Sign(), a)
 }
+
+// ParseUint128 is like [ParseInt128] but for unsigned numbers.
+// A sign prefix is not permitted.
+func ParseUint128(s string, base int) (Uint128, error) {
+	var z Uint128
+	err := parseUint("ParseUint128", s, base, 128, z[:])
+	return z, err
+}
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+// The result is the decimal representation of a.
+func (a Uint128) MarshalText() ([]byte, error) {
+	return a.AppendText(nil)
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+// It accepts the same syntax as Go integer literals, such as "0x" prefixes and underscores.
+// See [ParseUint128] for more details.
+func (a *Uint128) UnmarshalText(text []byte) error {
+	v, err := ParseUint128(string(text), 0)
+	if err != nil {
+		return err
+	}
+	*a = v
+	return nil
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+// The result is a JSON number. Use [JSONString] to encode a as a JSON string.
+func (a Uint128) MarshalJSON() ([]byte, error) {
+	return a.AppendText(nil)
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+// It accepts both a JSON number such as 123 and a JSON string such as "123" or "0x7b".
+// JSON strings are parsed in the same way as [Uint128.UnmarshalText].
+func (a *Uint128) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(a, data, ParseUint128)
+}
+
+// BytesBE returns the big-endian representation of a.
+func (a Uint128) BytesBE() [16]byte {
+	var b [16]byte
+	a.PutBytesBE(b[:])
+	return b
+}
+
+// BytesLE returns the little-endian representation of a.
+func (a Uint128) BytesLE() [16]byte {
+	var b [16]byte
+	a.PutBytesLE(b[:])
+	return b
+}
+
+// PutBytesBE stores the big-endian representation of a into b.
+// It panics if len(b) < 16.
+func (a Uint128) PutBytesBE(b []byte) {
+	_ = b[15] // bounds check hint to compiler; see golang.org/issue/14808
+	binary.BigEndian.PutUint64(b[0:], a[0])
+	binary.BigEndian.PutUint64(b[8:], a[1])
+}
+
+// PutBytesLE stores the little-endian representation of a into b.
+// It panics if len(b) < 16.
+func (a Uint128) PutBytesLE(b []byte) {
+	_ = b[15] // bounds check hint to compiler; see golang.org/issue/14808
+	binary.LittleEndian.PutUint64(b[0:], a[1])
+	binary.LittleEndian.PutUint64(b[8:], a[0])
+}
+
+// Uint128FromBytesBE returns the Uint128 represented by the big-endian bytes b[:16].
+// It panics if len(b) < 16.
+func Uint128FromBytesBE(b []byte) Uint128 {
+	_ = b[15] // bounds check hint to compiler; see golang.org/issue/14808
+	return Uint128{
+		binary.BigEndian.Uint64(b[0:]),
+		binary.BigEndian.Uint64(b[8:]),
+	}
+}
+
+// Uint128FromBytesLE returns the Uint128 represented by the little-endian bytes b[:16].
+// It panics if len(b) < 16.
+func Uint128FromBytesLE(b []byte) Uint128 {
+	_ = b[15] // bounds check hint to compiler; see golang.org/issue/14808
+	return Uint128{
+		binary.LittleEndian.Uint64(b[8:]),
+		binary.LittleEndian.Uint64(b[0:]),
+	}
+}
+
+// AppendBinary implements the [encoding.BinaryAppender] interface.
+// It appends the big-endian representation of a to dst.
+func (a Uint128) AppendBinary(dst []byte) ([]byte, error) {
+	b := a.BytesBE()
+	return append(dst, b[:]...), nil
+}
+
+// MarshalBinary implements the [encoding.BinaryMarshaler] interface.
+// The result is the 16-byte big-endian representation of a.
+func (a Uint128) MarshalBinary() ([]byte, error) {
+	return a.AppendBinary(make([]byte, 0, 16))
+}
+
+// UnmarshalBinary implements the [encoding.BinaryUnmarshaler] interface.
+// data must be exactly 16 bytes of the big-endian representation, as generated by [Uint128.MarshalBinary].
+func (a *Uint128) UnmarshalBinary(data []byte) error {
+	if len(data) != 16 {
+		return fmt.Errorf("ints: Uint128.UnmarshalBinary: invalid length %d, want 16", len(data))
+	}
+	*a = Uint128FromBytesBE(data)
+	return nil
+}
+
+// BigInt returns a as a new [*big.Int].
+func (a Uint128) BigInt() *big.Int {
+	var w [128 / bits.UintSize]big.Word
+	return new(big.Int).SetBits(wordsFromLimbs(w[:], a[:]))
+}
+
+// Uint128FromBigInt returns x as an Uint128.
+// If x is not representable as an Uint128, the result is the lower 128 bits of x in two's complement,
+// and ok is false.
+func Uint128FromBigInt(x *big.Int) (Uint128, bool) {
+	var z Uint128
+	ok := uintFromBigInt(z[:], 128, x)
+	return z, ok
+}
+
+// Float64 returns the float64 value nearest to a, rounding ties to even, and an indication of any rounding error.
+// If a is too large to be represented by a float64, the result is ±Inf.
+func (a Uint128) Float64() (float64, big.Accuracy) {
+	return float64FromLimbs(a[:])
+}
+
+// Uint128FromFloat64 returns the integer value of f rounded according to mode,
+// and the accuracy of the result: [big.Below] if the result is less than f, [big.Above] if it is greater than f.
+// Use [big.ToZero] to truncate f like the Go conversion, and [big.ToNearestEven] to round f to the nearest integer.
+//
+// If f is NaN, the result is 0 and the error is [ErrNaN].
+// If the rounded value of f is not representable as an Uint128, including ±Inf,
+// the result is clamped to 0 or the maximum value of Uint128, and the error is [ErrOverflow].
+func Uint128FromFloat64(f float64, mode big.RoundingMode) (Uint128, big.Accuracy, error) {
+	var z Uint128
+	acc, err := uintFromFloat64(z[:], 128, f, mode)
+	return z, acc, err
+}
+
+// BigFloat returns a as a new [*big.Float].
+// The precision of the result is 128 bits, so the conversion is always exact.
+func (a Uint128) BigFloat() *big.Float {
+	return new(big.Float).SetPrec(128).SetInt(a.BigInt())
+}
+
+// Uint128FromBigFloat returns the integer value of x rounded according to mode,
+// and the accuracy of the result: [big.Below] if the result is less than x, [big.Above] if it is greater than x.
+//
+// If the rounded value of x is not representable as an Uint128, including ±Inf,
+// the result is clamped to 0 or the maximum value of Uint128, and the error is [ErrOverflow].
+func Uint128FromBigFloat(x *big.Float, mode big.RoundingMode) (Uint128, big.Accuracy, error) {
+	var z Uint128
+	acc, err := uintFromBigFloat(z[:], 128, x, mode)
+	return z, acc, err
+}
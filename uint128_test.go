@@ -1,10 +1,15 @@
 package ints
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
 	"math/big"
 	"runtime"
+	"slices"
+	"strconv"
 	"testing"
 )
 
@@ -637,3 +642,1274 @@ func TestUint128_Format(t *testing.T) {
 		}
 	}
 }
+
+func TestParseUint128(t *testing.T) {
+	testCases := []struct {
+		s    string
+		base int
+		want Uint128
+		err  error
+	}{
+		{"0", 10, Uint128{0, 0}, nil},
+		{"1", 10, Uint128{0, 0x1}, nil},
+		{"340282366920938463463374607431768211455", 10, Uint128{math.MaxUint64, math.MaxUint64}, nil},
+		{"340282366920938463463374607431768211456", 10, Uint128{math.MaxUint64, math.MaxUint64}, strconv.ErrRange},
+		{"0xffffffffffffffffffffffffffffffff", 0, Uint128{math.MaxUint64, math.MaxUint64}, nil},
+		{"0b101", 0, Uint128{0, 0x5}, nil},
+		{"0o17", 0, Uint128{0, 0xf}, nil},
+		{"017", 0, Uint128{0, 0xf}, nil},
+		{"1_000", 0, Uint128{0, 0x3e8}, nil},
+		{"1_000", 10, Uint128{0, 0}, strconv.ErrSyntax},
+		{"_1", 0, Uint128{0, 0}, strconv.ErrSyntax},
+		{"Z", 62, Uint128{0, 0x3d}, nil},
+		{"z", 36, Uint128{0, 0x23}, nil},
+		{"Z", 36, Uint128{0, 0x23}, nil},
+		{"", 10, Uint128{0, 0}, strconv.ErrSyntax},
+		{"12a", 10, Uint128{0, 0}, strconv.ErrSyntax},
+		{"-1", 10, Uint128{0, 0}, strconv.ErrSyntax},
+		{"+1", 10, Uint128{0, 0}, strconv.ErrSyntax},
+	}
+
+	for _, tc := range testCases {
+		got, err := ParseUint128(tc.s, tc.base)
+		if got != tc.want {
+			t.Errorf("ParseUint128(%q, %d) = %d, want %d", tc.s, tc.base, got, tc.want)
+		}
+		if !errors.Is(err, tc.err) {
+			t.Errorf("ParseUint128(%q, %d) error = %v, want %v", tc.s, tc.base, err, tc.err)
+		}
+		if err != nil {
+			var numErr *strconv.NumError
+			if !errors.As(err, &numErr) || numErr.Func != "ParseUint128" || numErr.Num != tc.s {
+				t.Errorf("ParseUint128(%q, %d) error = %#v, want *strconv.NumError", tc.s, tc.base, err)
+			}
+		}
+	}
+
+	if _, err := ParseUint128("1", 63); err == nil {
+		t.Errorf("ParseUint128(%q, %d) should fail", "1", 63)
+	}
+}
+
+func FuzzParseUint128(f *testing.F) {
+	f.Add(uint64(0), uint64(0), 10)
+	f.Add(uint64(math.MaxUint64), uint64(math.MaxUint64), 16)
+	f.Add(uint64(math.MaxUint64), uint64(math.MaxUint64), 62)
+
+	f.Fuzz(func(t *testing.T, u0, u1 uint64, base int) {
+		if base < 2 || base > 62 {
+			return
+		}
+		a := Uint128{u0, u1}
+		s := uint128ToBigInt(a).Text(base)
+		got, err := ParseUint128(s, base)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != a {
+			t.Errorf("ParseUint128(%q, %d) = %d, want %d", s, base, got, a)
+		}
+	})
+}
+
+func TestUint128_MarshalText(t *testing.T) {
+	testCases := []struct {
+		x    Uint128
+		want string
+	}{
+		{Uint128{0, 0}, "0"},
+		{Uint128{0, 0x1}, "1"},
+		{Uint128{math.MaxUint64, math.MaxUint64}, "340282366920938463463374607431768211455"},
+	}
+
+	for _, tc := range testCases {
+		got, err := tc.x.MarshalText()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != tc.want {
+			t.Errorf("Uint128(%d).MarshalText() = %q, want %q", tc.x, got, tc.want)
+		}
+	}
+}
+
+func TestUint128_UnmarshalText(t *testing.T) {
+	testCases := []struct {
+		s    string
+		want Uint128
+		err  error
+	}{
+		{"0", Uint128{0, 0}, nil},
+		{"0x10", Uint128{0, 0x10}, nil},
+		{"0b1_0", Uint128{0, 0x2}, nil},
+		{"010", Uint128{0, 0x8}, nil},
+		{"340282366920938463463374607431768211455", Uint128{math.MaxUint64, math.MaxUint64}, nil},
+		{"340282366920938463463374607431768211456", Uint128{0, 0}, strconv.ErrRange},
+		{"1.0", Uint128{0, 0}, strconv.ErrSyntax},
+	}
+
+	for _, tc := range testCases {
+		var got Uint128
+		err := got.UnmarshalText([]byte(tc.s))
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Uint128.UnmarshalText(%q) error = %v, want %v", tc.s, err, tc.err)
+		}
+		if got != tc.want {
+			t.Errorf("Uint128.UnmarshalText(%q) = %d, want %d", tc.s, got, tc.want)
+		}
+	}
+}
+
+func TestUint128_MarshalJSON(t *testing.T) {
+	testCases := []struct {
+		x    Uint128
+		want string
+	}{
+		{Uint128{0, 0}, "0"},
+		{Uint128{0, 0x1}, "1"},
+		{Uint128{math.MaxUint64, math.MaxUint64}, "340282366920938463463374607431768211455"},
+	}
+
+	for _, tc := range testCases {
+		got, err := json.Marshal(tc.x)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != tc.want {
+			t.Errorf("json.Marshal(Uint128(%d)) = %s, want %s", tc.x, got, tc.want)
+		}
+	}
+}
+
+func TestUint128_UnmarshalJSON(t *testing.T) {
+	testCases := []struct {
+		s       string
+		want    Uint128
+		wantErr bool
+	}{
+		{"0", Uint128{0, 0}, false},
+		{"123", Uint128{0, 0x7b}, false},
+		{"\"123\"", Uint128{0, 0x7b}, false},
+		{"\"0x7f\"", Uint128{0, 0x7f}, false},
+		{"340282366920938463463374607431768211455", Uint128{math.MaxUint64, math.MaxUint64}, false},
+		{"\"340282366920938463463374607431768211455\"", Uint128{math.MaxUint64, math.MaxUint64}, false},
+		{"340282366920938463463374607431768211456", Uint128{0, 0}, true},
+		{"0x7f", Uint128{0, 0}, true},
+		{"\"abc\"", Uint128{0, 0}, true},
+		{"1.5", Uint128{0, 0}, true},
+		{"null", Uint128{0, 0}, false},
+	}
+
+	for _, tc := range testCases {
+		var got Uint128
+		err := json.Unmarshal([]byte(tc.s), &got)
+		if (err != nil) != tc.wantErr {
+			t.Errorf("json.Unmarshal(%s) error = %v, wantErr %t", tc.s, err, tc.wantErr)
+		}
+		if got != tc.want {
+			t.Errorf("json.Unmarshal(%s) = %d, want %d", tc.s, got, tc.want)
+		}
+	}
+}
+
+func TestUint128_Bytes(t *testing.T) {
+	testCases := []struct {
+		x  Uint128
+		be [16]byte
+	}{
+		{
+			Uint128{0x102030405060708, 0x90a0b0c0d0e0f10},
+			[16]byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10},
+		},
+		{
+			Uint128{math.MaxUint64, 0xfffffffffffffffe},
+			[16]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xfe},
+		},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.x.BytesBE(); got != tc.be {
+			t.Errorf("Uint128(%d).BytesBE() = %x, want %x", tc.x, got, tc.be)
+		}
+		if got := Uint128FromBytesBE(tc.be[:]); got != tc.x {
+			t.Errorf("Uint128FromBytesBE(%x) = %d, want %d", tc.be, got, tc.x)
+		}
+
+		le := tc.be
+		slices.Reverse(le[:])
+		if got := tc.x.BytesLE(); got != le {
+			t.Errorf("Uint128(%d).BytesLE() = %x, want %x", tc.x, got, le)
+		}
+		if got := Uint128FromBytesLE(le[:]); got != tc.x {
+			t.Errorf("Uint128FromBytesLE(%x) = %d, want %d", le, got, tc.x)
+		}
+
+		data, err := tc.x.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(data, tc.be[:]) {
+			t.Errorf("Uint128(%d).MarshalBinary() = %x, want %x", tc.x, data, tc.be)
+		}
+		var got Uint128
+		if err := got.UnmarshalBinary(data); err != nil {
+			t.Fatal(err)
+		}
+		if got != tc.x {
+			t.Errorf("Uint128.UnmarshalBinary(%x) = %d, want %d", data, got, tc.x)
+		}
+	}
+}
+
+func TestUint128_UnmarshalBinary_InvalidLength(t *testing.T) {
+	var a Uint128
+	if err := a.UnmarshalBinary(make([]byte, 15)); err == nil {
+		t.Error("want error, got nil")
+	}
+	if err := a.UnmarshalBinary(make([]byte, 17)); err == nil {
+		t.Error("want error, got nil")
+	}
+}
+
+func TestUint128FromBigInt(t *testing.T) {
+	testCases := []struct {
+		x    *big.Int
+		want Uint128
+		ok   bool
+	}{
+		{big.NewInt(0), Uint128{0, 0}, true},
+		{big.NewInt(1), Uint128{0, 0x1}, true},
+		{bigIntFromString("340282366920938463463374607431768211455"), Uint128{math.MaxUint64, math.MaxUint64}, true},
+		{bigIntFromString("340282366920938463463374607431768211456"), Uint128{0, 0}, false},
+		{bigIntFromString("340282366920938463463374607431768211456"), Uint128{0, 0}, false},
+		{big.NewInt(-1), Uint128{math.MaxUint64, math.MaxUint64}, false},
+	}
+
+	for _, tc := range testCases {
+		got, ok := Uint128FromBigInt(tc.x)
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint128FromBigInt(%s) = %d, %t, want %d, %t", tc.x, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func FuzzUint128_BigInt(f *testing.F) {
+	f.Add(uint64(0), uint64(0))
+	f.Add(uint64(math.MaxUint64), uint64(math.MaxUint64))
+	f.Add(uint64(1), uint64(0))
+
+	f.Fuzz(func(t *testing.T, u0, u1 uint64) {
+		a := Uint128{u0, u1}
+		got := a.BigInt()
+		want := uint128ToBigInt(a)
+		if got.Cmp(want) != 0 {
+			t.Errorf("Uint128(%d).BigInt() = %s, want %s", a, got, want)
+		}
+		if b, ok := Uint128FromBigInt(got); b != a || !ok {
+			t.Errorf("Uint128FromBigInt(%s) = %d, %t, want %d, true", got, b, ok, a)
+		}
+	})
+}
+
+func TestUint128FromFloat64(t *testing.T) {
+	testCases := []struct {
+		f    float64
+		mode big.RoundingMode
+		want Uint128
+		acc  big.Accuracy
+		err  error
+	}{
+		{0, big.ToNearestEven, Uint128{0, 0}, big.Exact, nil},
+		{2.5, big.ToNearestEven, Uint128{0, 0x2}, big.Below, nil},
+		{3.5, big.ToNearestEven, Uint128{0, 0x4}, big.Above, nil},
+		{2.5, big.ToNearestAway, Uint128{0, 0x3}, big.Above, nil},
+		{2.5, big.ToZero, Uint128{0, 0x2}, big.Below, nil},
+		{2.5, big.AwayFromZero, Uint128{0, 0x3}, big.Above, nil},
+		{2.5, big.ToNegativeInf, Uint128{0, 0x2}, big.Below, nil},
+		{2.5, big.ToPositiveInf, Uint128{0, 0x3}, big.Above, nil},
+		{math.NaN(), big.ToNearestEven, Uint128{0, 0}, big.Exact, ErrNaN},
+		{math.Inf(1), big.ToNearestEven, Uint128{math.MaxUint64, math.MaxUint64}, big.Below, ErrOverflow},
+		{math.Inf(-1), big.ToNearestEven, Uint128{0, 0}, big.Above, ErrOverflow},
+		{0x1p128, big.ToNearestEven, Uint128{math.MaxUint64, math.MaxUint64}, big.Below, ErrOverflow},
+		{0x1p127, big.ToNearestEven, Uint128{0x8000000000000000, 0}, big.Exact, nil},
+		{-0.5, big.ToZero, Uint128{0, 0}, big.Above, nil},
+		{-1, big.ToNearestEven, Uint128{0, 0}, big.Above, ErrOverflow},
+	}
+
+	for _, tc := range testCases {
+		got, acc, err := Uint128FromFloat64(tc.f, tc.mode)
+		if got != tc.want || acc != tc.acc || err != tc.err {
+			t.Errorf("Uint128FromFloat64(%v, %s) = %d, %s, %v, want %d, %s, %v", tc.f, tc.mode, got, acc, err, tc.want, tc.acc, tc.err)
+		}
+
+		if math.IsNaN(tc.f) {
+			continue
+		}
+		got, acc, err = Uint128FromBigFloat(big.NewFloat(tc.f), tc.mode)
+		if got != tc.want || acc != tc.acc || err != tc.err {
+			t.Errorf("Uint128FromBigFloat(%v, %s) = %d, %s, %v, want %d, %s, %v", tc.f, tc.mode, got, acc, err, tc.want, tc.acc, tc.err)
+		}
+	}
+}
+
+func FuzzUint128_Float64(f *testing.F) {
+	f.Add(uint64(0), uint64(0))
+	f.Add(uint64(math.MaxUint64), uint64(math.MaxUint64))
+	f.Add(uint64(0), uint64(1<<53+1))
+	f.Add(uint64(1), uint64(1<<11|1<<10))
+
+	f.Fuzz(func(t *testing.T, u0, u1 uint64) {
+		a := Uint128{u0, u1}
+		b := uint128ToBigInt(a)
+		got, acc := a.Float64()
+		want, wantAcc := new(big.Float).SetInt(b).Float64()
+		if got != want || acc != wantAcc {
+			t.Errorf("Uint128(%d).Float64() = %v, %s, want %v, %s", a, got, acc, want, wantAcc)
+		}
+
+		bf := a.BigFloat()
+		if c, _ := bf.Int(nil); c.Cmp(b) != 0 {
+			t.Errorf("Uint128(%d).BigFloat() = %v, want %v", a, bf, b)
+		}
+		if c, acc, err := Uint128FromBigFloat(bf, big.ToNearestEven); c != a || acc != big.Exact || err != nil {
+			t.Errorf("Uint128FromBigFloat(%v) = %d, %s, %v, want %d, %s, nil", bf, c, acc, err, a, big.Exact)
+		}
+	})
+}
+
+func FuzzUint128FromFloat64(f *testing.F) {
+	f.Add(0.0, uint8(big.ToNearestEven))
+	f.Add(-2.5, uint8(big.ToNearestEven))
+	f.Add(0x1p127, uint8(big.ToZero))
+	f.Add(0x1.fffffffffffffp127, uint8(big.ToPositiveInf))
+
+	f.Fuzz(func(t *testing.T, x float64, mode uint8) {
+		if math.IsNaN(x) || mode > uint8(big.ToPositiveInf) {
+			return
+		}
+		m := big.RoundingMode(mode)
+		got, acc, err := Uint128FromFloat64(x, m)
+		want, wantAcc, wantErr := Uint128FromBigFloat(big.NewFloat(x), m)
+		if got != want || acc != wantAcc || err != wantErr {
+			t.Errorf("Uint128FromFloat64(%v, %s) = %d, %s, %v, want %d, %s, %v", x, m, got, acc, err, want, wantAcc, wantErr)
+		}
+	})
+}
+
+func FuzzUint128_AddOverflow(f *testing.F) {
+	f.Add(
+		uint64(0), uint64(0),
+		uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(0), uint64(1),
+	)
+	f.Add(
+		uint64(0), uint64(1),
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(1), uint64(0),
+		uint64(1), uint64(0),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0),
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, v0, v1 uint64) {
+		a := Uint128{u0, u1}
+		b := Uint128{v0, v1}
+		got, overflow := a.AddOverflow(b)
+
+		want, ok := Uint128FromBigInt(new(big.Int).Add(uint128ToBigInt(a), uint128ToBigInt(b)))
+		if got != want || overflow == ok {
+			t.Errorf("Uint128(%d).AddOverflow(%d) = %d, %t, want %d, %t", a, b, got, overflow, want, !ok)
+		}
+	})
+}
+
+func FuzzUint128_SubOverflow(f *testing.F) {
+	f.Add(
+		uint64(0), uint64(0),
+		uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(0), uint64(1),
+	)
+	f.Add(
+		uint64(0), uint64(1),
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(1), uint64(0),
+		uint64(1), uint64(0),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0),
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, v0, v1 uint64) {
+		a := Uint128{u0, u1}
+		b := Uint128{v0, v1}
+		got, overflow := a.SubOverflow(b)
+
+		want, ok := Uint128FromBigInt(new(big.Int).Sub(uint128ToBigInt(a), uint128ToBigInt(b)))
+		if got != want || overflow == ok {
+			t.Errorf("Uint128(%d).SubOverflow(%d) = %d, %t, want %d, %t", a, b, got, overflow, want, !ok)
+		}
+	})
+}
+
+func FuzzUint128_MulOverflow(f *testing.F) {
+	f.Add(
+		uint64(0), uint64(0),
+		uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(0), uint64(1),
+	)
+	f.Add(
+		uint64(0), uint64(1),
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(1), uint64(0),
+		uint64(1), uint64(0),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0),
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, v0, v1 uint64) {
+		a := Uint128{u0, u1}
+		b := Uint128{v0, v1}
+		got, overflow := a.MulOverflow(b)
+
+		want, ok := Uint128FromBigInt(new(big.Int).Mul(uint128ToBigInt(a), uint128ToBigInt(b)))
+		if got != want || overflow == ok {
+			t.Errorf("Uint128(%d).MulOverflow(%d) = %d, %t, want %d, %t", a, b, got, overflow, want, !ok)
+		}
+	})
+}
+
+func TestUint128_NegOverflow(t *testing.T) {
+	testCases := []struct {
+		x        Uint128
+		want     Uint128
+		overflow bool
+	}{
+		{Uint128{0, 0}, Uint128{0, 0}, false},
+		{Uint128{0, 0x1}, Uint128{math.MaxUint64, math.MaxUint64}, true},
+		{Uint128{math.MaxUint64, math.MaxUint64}, Uint128{0, 0x1}, true},
+	}
+
+	for _, tc := range testCases {
+		got, overflow := tc.x.NegOverflow()
+		if got != tc.want || overflow != tc.overflow {
+			t.Errorf("Uint128(%d).NegOverflow() = %d, %t, want %d, %t", tc.x, got, overflow, tc.want, tc.overflow)
+		}
+	}
+}
+
+func TestUint128_LshOverflow(t *testing.T) {
+	testCases := []struct {
+		x        Uint128
+		i        uint
+		want     Uint128
+		overflow bool
+	}{
+		{Uint128{0, 0x1}, 0, Uint128{0, 0x1}, false},
+		{Uint128{0, 0x1}, 126, Uint128{0x4000000000000000, 0}, false},
+		{Uint128{0, 0x1}, 127, Uint128{0x8000000000000000, 0}, false},
+		{Uint128{0, 0x1}, 128, Uint128{0, 0}, true},
+		{Uint128{0, 0x3}, 126, Uint128{0xc000000000000000, 0}, false},
+		{Uint128{math.MaxUint64, math.MaxUint64}, 1, Uint128{math.MaxUint64, 0xfffffffffffffffe}, true},
+		{Uint128{0, 0}, 138, Uint128{0, 0}, false},
+	}
+
+	for _, tc := range testCases {
+		got, overflow := tc.x.LshOverflow(tc.i)
+		if got != tc.want || overflow != tc.overflow {
+			t.Errorf("Uint128(%d).LshOverflow(%d) = %d, %t, want %d, %t", tc.x, tc.i, got, overflow, tc.want, tc.overflow)
+		}
+	}
+}
+
+func FuzzUint128_AddCarry(f *testing.F) {
+	f.Add(
+		uint64(0), uint64(0),
+		uint64(0), uint64(0), uint(1),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(0), uint64(1), uint(1),
+	)
+	f.Add(
+		uint64(0), uint64(1),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint(1),
+	)
+	f.Add(
+		uint64(1), uint64(0),
+		uint64(1), uint64(0), uint(1),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint(1),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, v0, v1 uint64, carry uint) {
+		carry &= 1
+		a := Uint128{u0, u1}
+		b := Uint128{v0, v1}
+		sum, carryOut := a.AddCarry(b, carry)
+
+		want := new(big.Int).Add(uint128ToBigInt(a), uint128ToBigInt(b))
+		want.Add(want, new(big.Int).SetUint64(uint64(carry)))
+		wantSum, _ := Uint128FromBigInt(want)
+		wantCarry := want.Bit(128)
+		if sum != wantSum || carryOut != wantCarry {
+			t.Errorf("Uint128(%d).AddCarry(%d, %d) = %d, %d, want %d, %d", a, b, carry, sum, carryOut, wantSum, wantCarry)
+		}
+	})
+}
+
+func FuzzUint128_SubBorrow(f *testing.F) {
+	f.Add(
+		uint64(0), uint64(0),
+		uint64(0), uint64(0), uint(1),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(0), uint64(1), uint(1),
+	)
+	f.Add(
+		uint64(0), uint64(1),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint(1),
+	)
+	f.Add(
+		uint64(1), uint64(0),
+		uint64(1), uint64(0), uint(1),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint(1),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, v0, v1 uint64, borrow uint) {
+		borrow &= 1
+		a := Uint128{u0, u1}
+		b := Uint128{v0, v1}
+		diff, borrowOut := a.SubBorrow(b, borrow)
+
+		want := new(big.Int).Sub(uint128ToBigInt(a), uint128ToBigInt(b))
+		want.Sub(want, new(big.Int).SetUint64(uint64(borrow)))
+		wantDiff, _ := Uint128FromBigInt(want)
+		var wantBorrow uint
+		if want.Sign() < 0 {
+			wantBorrow = 1
+		}
+		if diff != wantDiff || borrowOut != wantBorrow {
+			t.Errorf("Uint128(%d).SubBorrow(%d, %d) = %d, %d, want %d, %d", a, b, borrow, diff, borrowOut, wantDiff, wantBorrow)
+		}
+	})
+}
+
+func FuzzUint128_MulFull(f *testing.F) {
+	f.Add(
+		uint64(0), uint64(0),
+		uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(0), uint64(1),
+	)
+	f.Add(
+		uint64(0), uint64(1),
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(1), uint64(0),
+		uint64(1), uint64(0),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, v0, v1 uint64) {
+		a := Uint128{u0, u1}
+		b := Uint128{v0, v1}
+		hi, lo := a.MulFull(b)
+
+		want := new(big.Int).Mul(uint128ToBigInt(a), uint128ToBigInt(b))
+		wantLo, _ := Uint128FromBigInt(want)
+		wantHi, _ := Uint128FromBigInt(want.Rsh(want, 128))
+		if hi != wantHi || lo != wantLo {
+			t.Errorf("Uint128(%d).MulFull(%d) = %d, %d, want %d, %d", a, b, hi, lo, wantHi, wantLo)
+		}
+	})
+}
+
+func FuzzUint128_SaturatingAdd(f *testing.F) {
+	f.Add(
+		uint64(0), uint64(0),
+		uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(0), uint64(1),
+	)
+	f.Add(
+		uint64(0), uint64(1),
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(1), uint64(0),
+		uint64(1), uint64(0),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0),
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0),
+		uint64(1<<63), uint64(0),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, v0, v1 uint64) {
+		a := Uint128{u0, u1}
+		b := Uint128{v0, v1}
+		got := a.SaturatingAdd(b)
+
+		want := saturateBigInt(new(big.Int).Add(uint128ToBigInt(a), uint128ToBigInt(b)), 128, false)
+		if uint128ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("Uint128(%d).SaturatingAdd(%d) = %d, want %d", a, b, got, want)
+		}
+	})
+}
+
+func FuzzUint128_SaturatingSub(f *testing.F) {
+	f.Add(
+		uint64(0), uint64(0),
+		uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(0), uint64(1),
+	)
+	f.Add(
+		uint64(0), uint64(1),
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(1), uint64(0),
+		uint64(1), uint64(0),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0),
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0),
+		uint64(1<<63), uint64(0),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, v0, v1 uint64) {
+		a := Uint128{u0, u1}
+		b := Uint128{v0, v1}
+		got := a.SaturatingSub(b)
+
+		want := saturateBigInt(new(big.Int).Sub(uint128ToBigInt(a), uint128ToBigInt(b)), 128, false)
+		if uint128ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("Uint128(%d).SaturatingSub(%d) = %d, want %d", a, b, got, want)
+		}
+	})
+}
+
+func FuzzUint128_SaturatingMul(f *testing.F) {
+	f.Add(
+		uint64(0), uint64(0),
+		uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(0), uint64(1),
+	)
+	f.Add(
+		uint64(0), uint64(1),
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(1), uint64(0),
+		uint64(1), uint64(0),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0),
+		uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0),
+		uint64(1<<63), uint64(0),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, v0, v1 uint64) {
+		a := Uint128{u0, u1}
+		b := Uint128{v0, v1}
+		got := a.SaturatingMul(b)
+
+		want := saturateBigInt(new(big.Int).Mul(uint128ToBigInt(a), uint128ToBigInt(b)), 128, false)
+		if uint128ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("Uint128(%d).SaturatingMul(%d) = %d, want %d", a, b, got, want)
+		}
+	})
+}
+
+func TestMaxUint128(t *testing.T) {
+	max := new(big.Int).Lsh(big.NewInt(1), 128)
+	max.Sub(max, big.NewInt(1))
+	if got := uint128ToBigInt(MaxUint128); got.Cmp(max) != 0 {
+		t.Errorf("MaxUint128 = %d, want %d", got, max)
+	}
+}
+
+func TestUint128FromUint64(t *testing.T) {
+	testCases := []struct {
+		v    uint64
+		want Uint128
+	}{
+		{0, Uint128{0, 0}},
+		{1, Uint128{0, 0x1}},
+		{math.MaxUint64, Uint128{0, math.MaxUint64}},
+	}
+
+	for _, tc := range testCases {
+		got := Uint128FromUint64(tc.v)
+		if got != tc.want {
+			t.Errorf("Uint128FromUint64(%d) = %d, want %d", tc.v, got, tc.want)
+		}
+	}
+}
+
+func TestUint128Of(t *testing.T) {
+	testCases := []struct {
+		v    string
+		got  Uint128
+		want Uint128
+	}{
+		{"int8(-1)", Uint128Of(int8(-1)), Uint128{math.MaxUint64, math.MaxUint64}},
+		{"int(42)", Uint128Of(int(42)), Uint128{0, 0x2a}},
+		{"uint64(math.MaxUint64)", Uint128Of(uint64(math.MaxUint64)), Uint128{0, math.MaxUint64}},
+		{"int64(math.MinInt64)", Uint128Of(int64(math.MinInt64)), Uint128{math.MaxUint64, 0x8000000000000000}},
+		{"uintptr(7)", Uint128Of(uintptr(7)), Uint128{0, 0x7}},
+	}
+
+	for _, tc := range testCases {
+		if tc.got != tc.want {
+			t.Errorf("Uint128Of(%s) = %d, want %d", tc.v, tc.got, tc.want)
+		}
+	}
+}
+
+func TestUint128_IsInt64(t *testing.T) {
+	testCases := []struct {
+		a        Uint128
+		isInt64  bool
+		isUint64 bool
+		i64      int64
+		u64      uint64
+	}{
+		{Uint128{0, 0}, true, true, 0, 0},
+		{Uint128{0, 0x1}, true, true, 1, 1},
+		{Uint128{0, 0x7fffffffffffffff}, true, true, 9223372036854775807, 9223372036854775807},
+		{Uint128{0, 0x8000000000000000}, false, true, math.MinInt64, 9223372036854775808},
+		{Uint128{0, math.MaxUint64}, false, true, -1, math.MaxUint64},
+		{Uint128{0x1, 0}, false, false, 0, 0},
+		{Uint128{math.MaxUint64, math.MaxUint64}, false, false, -1, math.MaxUint64},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.a.IsInt64(); got != tc.isInt64 {
+			t.Errorf("Uint128(%d).IsInt64() = %t, want %t", tc.a, got, tc.isInt64)
+		}
+		if got := tc.a.IsUint64(); got != tc.isUint64 {
+			t.Errorf("Uint128(%d).IsUint64() = %t, want %t", tc.a, got, tc.isUint64)
+		}
+
+		got, ok := tc.a.Int()
+		want, wantOK := int(tc.i64), tc.isInt64 && int64(int(tc.i64)) == tc.i64
+		if got != want || ok != wantOK {
+			t.Errorf("Uint128(%d).Int() = %d, %t, want %d, %t", tc.a, got, ok, want, wantOK)
+		}
+
+		gotU, ok := tc.a.Uint()
+		wantU, wantOK := uint(tc.u64), tc.isUint64 && uint64(uint(tc.u64)) == tc.u64
+		if gotU != wantU || ok != wantOK {
+			t.Errorf("Uint128(%d).Uint() = %d, %t, want %d, %t", tc.a, gotU, ok, wantU, wantOK)
+		}
+	}
+}
+
+func TestUint128_OnesCount(t *testing.T) {
+	testCases := []struct {
+		x    Uint128
+		want int
+	}{
+		{Uint128{0, 0}, 0},
+		{Uint128{0, 0x1}, 1},
+		{Uint128{math.MaxUint64, math.MaxUint64}, 128},
+		{Uint128{0x8000000000000000, 0}, 1},
+		{Uint128{0, 0x5555555555555555}, 32},
+		{Uint128{0xf0000000000000, 0}, 4},
+	}
+
+	for _, tc := range testCases {
+		got := tc.x.OnesCount()
+		if got != tc.want {
+			t.Errorf("Uint128(%d).OnesCount() = %d, want %d", tc.x, got, tc.want)
+		}
+	}
+}
+
+func FuzzUint128_RotateLeft(f *testing.F) {
+	f.Add(uint64(0), uint64(0), 0)
+	f.Add(uint64(0), uint64(1), 0)
+	f.Add(uint64(math.MaxUint64), uint64(math.MaxUint64), 0)
+	f.Add(uint64(1<<63), uint64(0), 0)
+	f.Add(uint64(0), uint64(0), 1)
+	f.Add(uint64(0), uint64(1), 1)
+	f.Add(uint64(math.MaxUint64), uint64(math.MaxUint64), 1)
+	f.Add(uint64(1<<63), uint64(0), 1)
+	f.Add(uint64(0), uint64(0), -1)
+	f.Add(uint64(0), uint64(1), -1)
+	f.Add(uint64(math.MaxUint64), uint64(math.MaxUint64), -1)
+	f.Add(uint64(1<<63), uint64(0), -1)
+	f.Add(uint64(0), uint64(0), 193)
+	f.Add(uint64(0), uint64(1), 193)
+	f.Add(uint64(math.MaxUint64), uint64(math.MaxUint64), 193)
+	f.Add(uint64(1<<63), uint64(0), 193)
+
+	f.Fuzz(func(t *testing.T, u0, u1 uint64, k int) {
+		a := Uint128{u0, u1}
+		got := a.RotateLeft(k)
+
+		s := uint(k) % 128
+		x := uint128ToBigInt(a)
+		want := new(big.Int).Lsh(x, s)
+		want.Or(want, new(big.Int).Rsh(x, 128-s))
+		wantV, _ := Uint128FromBigInt(want)
+		if got != wantV {
+			t.Errorf("Uint128(%d).RotateLeft(%d) = %d, want %d", a, k, got, wantV)
+		}
+	})
+}
+
+func FuzzUint128_Reverse(f *testing.F) {
+	f.Add(uint64(0), uint64(0))
+	f.Add(uint64(0), uint64(1))
+	f.Add(uint64(math.MaxUint64), uint64(math.MaxUint64))
+	f.Add(uint64(1<<63), uint64(0))
+
+	f.Fuzz(func(t *testing.T, u0, u1 uint64) {
+		a := Uint128{u0, u1}
+
+		x := uint128ToBigInt(a)
+		want := new(big.Int)
+		for i := range 128 {
+			want.SetBit(want, 127-i, x.Bit(i))
+		}
+		if got := a.Reverse(); uint128ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("Uint128(%d).Reverse() = %d, want %d", a, got, want)
+		}
+
+		le := a.BytesLE()
+		if got := a.ReverseBytes(); got != Uint128FromBytesBE(le[:]) {
+			t.Errorf("Uint128(%d).ReverseBytes() = %d, want %d", a, got, Uint128FromBytesBE(le[:]))
+		}
+	})
+}
+
+func FuzzUint128_Bit(f *testing.F) {
+	f.Add(uint64(0), uint64(0))
+	f.Add(uint64(0), uint64(1))
+	f.Add(uint64(math.MaxUint64), uint64(math.MaxUint64))
+	f.Add(uint64(1<<63), uint64(0))
+
+	f.Fuzz(func(t *testing.T, u0, u1 uint64) {
+		a := Uint128{u0, u1}
+		x := uint128ToBigInt(a)
+		for i := range 138 {
+			if got, want := a.Bit(i), x.Bit(i); got != want {
+				t.Errorf("Uint128(%d).Bit(%d) = %d, want %d", a, i, got, want)
+			}
+		}
+		for _, i := range []int{0, 1, 63, 64, 65, 64, 127} {
+			if got, want := uint128ToBigInt(a.SetBit(i, 1)), new(big.Int).SetBit(x, i, 1); got.Cmp(want) != 0 {
+				t.Errorf("Uint128(%d).SetBit(%d, 1) = %d, want %d", a, i, got, want)
+			}
+			if got, want := uint128ToBigInt(a.SetBit(i, 0)), new(big.Int).SetBit(x, i, 0); got.Cmp(want) != 0 {
+				t.Errorf("Uint128(%d).SetBit(%d, 0) = %d, want %d", a, i, got, want)
+			}
+			if got, want := uint128ToBigInt(a.ClearBit(i)), new(big.Int).SetBit(x, i, 0); got.Cmp(want) != 0 {
+				t.Errorf("Uint128(%d).ClearBit(%d) = %d, want %d", a, i, got, want)
+			}
+			if got, want := uint128ToBigInt(a.FlipBit(i)), new(big.Int).SetBit(x, i, x.Bit(i)^1); got.Cmp(want) != 0 {
+				t.Errorf("Uint128(%d).FlipBit(%d) = %d, want %d", a, i, got, want)
+			}
+		}
+	})
+}
+
+func TestUint128_SetBit_Panic(t *testing.T) {
+	testCases := []struct {
+		i int
+		b uint
+	}{
+		{-1, 0},
+		{128, 0},
+		{0, 2},
+	}
+
+	for _, tc := range testCases {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Uint128{}.SetBit(%d, %d) did not panic", tc.i, tc.b)
+				}
+			}()
+			Uint128{}.SetBit(tc.i, tc.b)
+		}()
+	}
+}
+
+func FuzzUint128_ExtractInsert(f *testing.F) {
+	f.Add(uint64(0), uint64(0), uint64(math.MaxUint64), uint64(math.MaxUint64), uint(0), uint(128))
+	f.Add(uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(0), uint64(0), uint(3), uint(64))
+	f.Add(uint64(0), uint64(1), uint64(1<<63), uint64(0), uint(63), uint(2))
+	f.Add(uint64(1<<63), uint64(0), uint64(0), uint64(1), uint(127), uint(5))
+	f.Add(uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(0), uint64(1), uint(0), uint(129))
+
+	f.Fuzz(func(t *testing.T, u0, u1, v0, v1 uint64, lo, width uint) {
+		a := Uint128{u0, u1}
+		v := Uint128{v0, v1}
+		x := uint128ToBigInt(a)
+		y := uint128ToBigInt(v)
+
+		// mask = 1<<min(width, 128) - 1
+		mask := new(big.Int).Lsh(big.NewInt(1), min(width, 128))
+		mask.Sub(mask, big.NewInt(1))
+
+		want := new(big.Int).Rsh(x, lo)
+		want.And(want, mask)
+		if got := uint128ToBigInt(a.Extract(lo, width)); got.Cmp(want) != 0 {
+			t.Errorf("Uint128(%d).Extract(%d, %d) = %d, want %d", a, lo, width, got, want)
+		}
+
+		// (x &^ (mask << lo)) | ((y & mask) << lo), truncated to 128 bits
+		mask.Lsh(mask, lo)
+		want = new(big.Int).AndNot(x, mask)
+		want.Or(want, new(big.Int).And(new(big.Int).Lsh(y, lo), mask))
+		wantV, _ := Uint128FromBigInt(want)
+		if got := a.Insert(v, lo, width); got != wantV {
+			t.Errorf("Uint128(%d).Insert(%d, %d, %d) = %d, want %d", a, v, lo, width, got, wantV)
+		}
+	})
+}
+
+func TestUint128_RshRound(t *testing.T) {
+	values := []Uint128{
+		Uint128{0, 0},
+		Uint128{0, 0x1},
+		Uint128{0, 0x2},
+		Uint128{0, 0x3},
+		Uint128{0, 0x5},
+		Uint128{0, 0x6},
+		Uint128{0, math.MaxUint64},
+		Uint128{0x1, 0x8000000000000000},
+		Uint128{0x1, 0x8000000000000001},
+		Uint128{0x7fffffffffffffff, math.MaxUint64},
+		Uint128{0x8000000000000000, 0},
+		Uint128{0x8000000000000000, 0x1},
+		Uint128{0xb000000000000000, 0},
+		Uint128{0xc000000000000001, 0},
+		Uint128{math.MaxUint64, math.MaxUint64},
+	}
+	shifts := []uint{0, 1, 2, 3, 63, 64, 65, 127, 128, 129, 192}
+
+	for _, a := range values {
+		x := uint128ToBigInt(a)
+		for _, i := range shifts {
+			if got, want := a.RshRound(i), rshRoundBigInt(x, i); uint128ToBigInt(got).Cmp(want) != 0 {
+				t.Errorf("Uint128(%d).RshRound(%d) = %d, want %d", a, i, got, want)
+			}
+			if got, want := a.RshCeil(i), rshCeilBigInt(x, i); uint128ToBigInt(got).Cmp(want) != 0 {
+				t.Errorf("Uint128(%d).RshCeil(%d) = %d, want %d", a, i, got, want)
+			}
+			if got, want := a.URsh(i), new(big.Int).Rsh(uint128ToBigInt(a), i); uint128ToBigInt(got).Cmp(want) != 0 {
+				t.Errorf("Uint128(%d).URsh(%d) = %d, want %d", a, i, got, want)
+			}
+		}
+	}
+}
+
+func FuzzUint128_Div256(f *testing.F) {
+	f.Add(
+		uint64(0x0), uint64(0x0),
+		uint64(0x0), uint64(0x0),
+		uint64(0x0), uint64(0x1),
+	)
+	f.Add(
+		uint64(0x0), uint64(0x0),
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0x0), uint64(0x7),
+	)
+	f.Add(
+		uint64(0x0), uint64(0x1),
+		uint64(0x0), uint64(0x0),
+		uint64(0x0), uint64(0x2),
+	)
+	f.Add(
+		uint64(0xffffffffffffffff), uint64(0xfffffffffffffffe),
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+	)
+	f.Add(
+		uint64(0x0), uint64(0x5),
+		uint64(0x0), uint64(0x3039),
+		uint64(0x0), uint64(0x6),
+	)
+	f.Add(
+		uint64(0x4000000000000000), uint64(0x0),
+		uint64(0x0), uint64(0x3),
+		uint64(0x8000000000000000), uint64(0x0),
+	)
+
+	f.Fuzz(func(t *testing.T, h0, h1, l0, l1, y0, y1 uint64) {
+		hi := Uint128{h0, h1}
+		lo := Uint128{l0, l1}
+		y := Uint128{y0, y1}
+		if y.Cmp(hi) <= 0 {
+			return
+		}
+		quo, rem := y.Div256(hi, lo)
+
+		x := new(big.Int).Lsh(uint128ToBigInt(hi), 128)
+		x.Or(x, uint128ToBigInt(lo))
+		wantQuo, wantRem := new(big.Int).QuoRem(x, uint128ToBigInt(y), new(big.Int))
+		if uint128ToBigInt(quo).Cmp(wantQuo) != 0 || uint128ToBigInt(rem).Cmp(wantRem) != 0 {
+			t.Errorf("Uint128(%d).Div256(%d, %d) = %d, %d, want %d, %d", y, hi, lo, quo, rem, wantQuo, wantRem)
+		}
+	})
+}
+
+func TestUint128_Div256_Panic(t *testing.T) {
+	testCases := []struct {
+		hi, lo, y Uint128
+		want      string
+	}{
+		{Uint128{0, 0}, Uint128{0, 0x1}, Uint128{0, 0}, "runtime error: integer divide by zero"},
+		{Uint128{0, 0x1}, Uint128{0, 0}, Uint128{0, 0x1}, "runtime error: integer overflow"},
+		{MaxUint128, Uint128{0, 0}, Uint128{0, 0x2}, "runtime error: integer overflow"},
+	}
+
+	for _, tc := range testCases {
+		func() {
+			defer func() {
+				err, ok := recover().(runtime.Error)
+				if !ok {
+					t.Errorf("Uint128(%d).Div256(%d, %d) did not panic with a runtime.Error", tc.y, tc.hi, tc.lo)
+					return
+				}
+				if err.Error() != tc.want {
+					t.Errorf("Uint128(%d).Div256(%d, %d) panicked with %q, want %q", tc.y, tc.hi, tc.lo, err.Error(), tc.want)
+				}
+			}()
+			tc.y.Div256(tc.hi, tc.lo)
+		}()
+	}
+}
+
+func FuzzUint128_ModArith(f *testing.F) {
+	f.Add(
+		uint64(0x0), uint64(0x0),
+		uint64(0x0), uint64(0x0),
+		uint64(0x0), uint64(0x1),
+	)
+	f.Add(
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+	)
+	f.Add(
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0xffffffffffffffff), uint64(0xfffffffffffffffe),
+	)
+	f.Add(
+		uint64(0x0), uint64(0x3),
+		uint64(0x8000000000000000), uint64(0x0),
+		uint64(0x8000000000000000), uint64(0x1),
+	)
+	f.Add(
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0x0), uint64(0x10001),
+		uint64(0x1), uint64(0xd),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, v0, v1, m0, m1 uint64) {
+		a := Uint128{u0, u1}
+		b := Uint128{v0, v1}
+		m := Uint128{m0, m1}
+		if m.IsZero() {
+			return
+		}
+		x, y, z := uint128ToBigInt(a), uint128ToBigInt(b), uint128ToBigInt(m)
+
+		if got, want := a.AddMod(b, m), new(big.Int).Mod(new(big.Int).Add(x, y), z); uint128ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("Uint128(%d).AddMod(%d, %d) = %d, want %d", a, b, m, got, want)
+		}
+		if got, want := a.SubMod(b, m), new(big.Int).Mod(new(big.Int).Sub(x, y), z); uint128ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("Uint128(%d).SubMod(%d, %d) = %d, want %d", a, b, m, got, want)
+		}
+		if got, want := a.MulMod(b, m), new(big.Int).Mod(new(big.Int).Mul(x, y), z); uint128ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("Uint128(%d).MulMod(%d, %d) = %d, want %d", a, b, m, got, want)
+		}
+		if got, want := a.ExpMod(b, m), new(big.Int).Exp(x, y, z); uint128ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("Uint128(%d).ExpMod(%d, %d) = %d, want %d", a, b, m, got, want)
+		}
+	})
+}
+
+func FuzzUint128_GCD(f *testing.F) {
+	f.Add(
+		uint64(0x0), uint64(0x0),
+		uint64(0x0), uint64(0x0),
+	)
+	f.Add(
+		uint64(0x0), uint64(0xc),
+		uint64(0x0), uint64(0x12),
+	)
+	f.Add(
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0xffffffffffffffff), uint64(0xfffffffffffffffe),
+	)
+	f.Add(
+		uint64(0xffffffffffffffff), uint64(0xfffffffffffffffe),
+		uint64(0x8000000000000000), uint64(0x0),
+	)
+	f.Add(
+		uint64(0x0), uint64(0x3),
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffc5),
+	)
+	f.Add(
+		uint64(0x8000000000000000), uint64(0x0),
+		uint64(0x0), uint64(0x10),
+	)
+	f.Add(
+		uint64(0x0), uint64(0x7),
+		uint64(0x0), uint64(0x1),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, v0, v1 uint64) {
+		a := Uint128{u0, u1}
+		b := Uint128{v0, v1}
+		x, y := uint128ToBigInt(a), uint128ToBigInt(b)
+		want := new(big.Int).GCD(nil, nil, x, y)
+
+		if got := a.GCD(b); uint128ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("Uint128(%d).GCD(%d) = %d, want %d", a, b, got, want)
+		}
+		if !a.IsZero() && !b.IsZero() {
+			wantLCM := new(big.Int).Mul(new(big.Int).Quo(x, want), y)
+			wantLCM.Mod(wantLCM, new(big.Int).Lsh(big.NewInt(1), 128))
+			if got := a.LCM(b); uint128ToBigInt(got).Cmp(wantLCM) != 0 {
+				t.Errorf("Uint128(%d).LCM(%d) = %d, want %d", a, b, got, wantLCM)
+			}
+		}
+		g, s, r := a.ExtendedGCD(b)
+		sum := new(big.Int).Add(new(big.Int).Mul(x, int128ToBigInt(s)), new(big.Int).Mul(y, int128ToBigInt(r)))
+		if uint128ToBigInt(g).Cmp(want) != 0 || sum.Cmp(want) != 0 {
+			t.Errorf("Uint128(%d).ExtendedGCD(%d) = (%d, %d, %d), want g = %d", a, b, g, s, r, want)
+		}
+		if want.Sign() != 0 {
+			// |s| <= b/(2g) + 1 and |r| <= a/(2g) + 1
+			sMax := new(big.Int).Add(new(big.Int).Quo(y, new(big.Int).Lsh(want, 1)), big.NewInt(1))
+			rMax := new(big.Int).Add(new(big.Int).Quo(x, new(big.Int).Lsh(want, 1)), big.NewInt(1))
+			if new(big.Int).Abs(int128ToBigInt(s)).Cmp(sMax) > 0 || new(big.Int).Abs(int128ToBigInt(r)).Cmp(rMax) > 0 {
+				t.Errorf("Uint128(%d).ExtendedGCD(%d) = (%d, %d, %d), coefficients are too large", a, b, g, s, r)
+			}
+		}
+
+		if b.IsZero() {
+			return
+		}
+		inv, ok := a.ModInverse(b)
+		wantInv := new(big.Int).ModInverse(x, y)
+		if b == (Uint128{0, 1}) {
+			wantInv = big.NewInt(0)
+		}
+		if wantInv == nil {
+			if ok {
+				t.Errorf("Uint128(%d).ModInverse(%d) = (%d, true), want (0, false)", a, b, inv)
+			}
+		} else if !ok || uint128ToBigInt(inv).Cmp(wantInv) != 0 {
+			t.Errorf("Uint128(%d).ModInverse(%d) = (%d, %t), want (%d, true)", a, b, inv, ok, wantInv)
+		}
+	})
+}
+
+func FuzzUint128_Sqrt(f *testing.F) {
+	f.Add(uint64(0x0), uint64(0x0), uint8(2))
+	f.Add(uint64(0x0), uint64(0x1), uint8(3))
+	f.Add(uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint8(2))
+	f.Add(uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint8(3))
+	f.Add(uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint8(17))
+	f.Add(uint64(0x7fffffffffffffff), uint64(0xffffffffffffffff), uint8(7))
+	f.Add(uint64(0x0), uint64(0xffffffffffffffff), uint8(4))
+	f.Add(uint64(0x1), uint64(0x0), uint8(63))
+
+	f.Fuzz(func(t *testing.T, u0, u1 uint64, k uint8) {
+		a := Uint128{u0, u1}
+		x := uint128ToBigInt(a)
+
+		want := new(big.Int).Sqrt(x)
+		if got := a.Sqrt(); uint128ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("Uint128(%d).Sqrt() = %d, want %d", a, got, want)
+		}
+		isSquare := new(big.Int).Mul(want, want).Cmp(x) == 0
+		if got := a.IsPerfectSquare(); got != isSquare {
+			t.Errorf("Uint128(%d).IsPerfectSquare() = %t, want %t", a, got, isSquare)
+		}
+
+		// r is the floor n-th root of a if r**n <= a < (r+1)**n.
+		for _, n := range []uint{3, uint(k)%128 + 1} {
+			got := a.Root(n)
+			r := uint128ToBigInt(got)
+			lo := new(big.Int).Exp(r, big.NewInt(int64(n)), nil)
+			hi := new(big.Int).Exp(r.Add(r, big.NewInt(1)), big.NewInt(int64(n)), nil)
+			if lo.Cmp(x) > 0 || hi.Cmp(x) <= 0 {
+				t.Errorf("Uint128(%d).Root(%d) = %d", a, n, got)
+			}
+		}
+		if got, want := a.Cbrt(), a.Root(3); got != want {
+			t.Errorf("Uint128(%d).Cbrt() = %d, want %d", a, got, want)
+		}
+	})
+}
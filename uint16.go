@@ -2,7 +2,10 @@ package ints
 
 import (
 	"cmp"
+	"encoding/binary"
 	"fmt"
+	"math"
+	"math/big"
 	"math/bits"
 )
 
@@ -10,11 +13,46 @@ import (
 // It is an alias for the built-in uint16 type.
 type Uint16 uint16
 
+// MaxUint16 is the maximum value of Uint16.
+const MaxUint16 Uint16 = math.MaxUint16
+
+// Uint16Of returns v as a Uint16.
+// v is converted in the same way as Go's integer conversions.
+func Uint16Of[T builtinInteger](v T) Uint16 {
+	return Uint16(v)
+}
+
 // IsZero returns true if a is zero.
 func (a Uint16) IsZero() bool {
 	return a == 0
 }
 
+// IsInt64 reports whether a can be represented as an int64.
+func (a Uint16) IsInt64() bool {
+	_, ok := a.TryInt64()
+	return ok
+}
+
+// IsUint64 reports whether a can be represented as a uint64.
+func (a Uint16) IsUint64() bool {
+	_, ok := a.TryUint64()
+	return ok
+}
+
+// Int returns a as an int and reports whether a can be represented as an int.
+// If it can't, the result is a truncated to the size of int.
+func (a Uint16) Int() (int, bool) {
+	v, ok := a.TryInt64()
+	return int(v), ok && int64(int(v)) == int64(v)
+}
+
+// Uint returns a as a uint and reports whether a can be represented as a uint.
+// If it can't, the result is a truncated to the size of uint.
+func (a Uint16) Uint() (uint, bool) {
+	v, ok := a.TryUint64()
+	return uint(v), ok && uint64(uint(v)) == uint64(v)
+}
+
 // Add returns the sum a+b.
 //
 // This function's execution time does not depend on the inputs.
@@ -22,6 +60,34 @@ func (a Uint16) Add(b Uint16) Uint16 {
 	return a + b
 }
 
+// AddOverflow returns the sum a+b and reports whether the addition overflowed.
+//
+// This function's execution time does not depend on the inputs.
+func (a Uint16) AddOverflow(b Uint16) (Uint16, bool) {
+	c := a + b
+	return c, c < a
+}
+
+// SaturatingAdd returns the sum a+b.
+// If the result overflows, it is clamped to the maximum value.
+func (a Uint16) SaturatingAdd(b Uint16) Uint16 {
+	c, overflow := a.AddOverflow(b)
+	if overflow {
+		return math.MaxUint16
+	}
+	return c
+}
+
+// AddCarry returns the sum with carry of a, b and carry: sum = a + b + carry.
+// The carry input must be 0 or 1; otherwise the behavior is undefined.
+// The carryOut output is guaranteed to be 0 or 1.
+//
+// This function's execution time does not depend on the inputs.
+func (a Uint16) AddCarry(b Uint16, carry uint) (sum Uint16, carryOut uint) {
+	s := uint64(a) + uint64(b) + uint64(carry)
+	return Uint16(s), uint(s >> 16)
+}
+
 // Sub returns the difference a-b.
 //
 // This function's execution time does not depend on the inputs.
@@ -29,11 +95,61 @@ func (a Uint16) Sub(b Uint16) Uint16 {
 	return a - b
 }
 
+// SubOverflow returns the difference a-b and reports whether the subtraction overflowed.
+//
+// This function's execution time does not depend on the inputs.
+func (a Uint16) SubOverflow(b Uint16) (Uint16, bool) {
+	return a - b, a < b
+}
+
+// SaturatingSub returns the difference a-b.
+// If the result overflows, it is clamped to zero.
+func (a Uint16) SaturatingSub(b Uint16) Uint16 {
+	c, overflow := a.SubOverflow(b)
+	if overflow {
+		return 0
+	}
+	return c
+}
+
+// SubBorrow returns the difference of a, b and borrow: diff = a - b - borrow.
+// The borrow input must be 0 or 1; otherwise the behavior is undefined.
+// The borrowOut output is guaranteed to be 0 or 1.
+//
+// This function's execution time does not depend on the inputs.
+func (a Uint16) SubBorrow(b Uint16, borrow uint) (diff Uint16, borrowOut uint) {
+	d := uint64(a) - uint64(b) - uint64(borrow)
+	return Uint16(d), uint(d >> 63)
+}
+
 // Mul returns the product a*b.
 func (a Uint16) Mul(b Uint16) Uint16 {
 	return a * b
 }
 
+// MulOverflow returns the product a*b and reports whether the multiplication overflowed.
+func (a Uint16) MulOverflow(b Uint16) (Uint16, bool) {
+	c := uint32(a) * uint32(b)
+	return Uint16(c), c>>16 != 0
+}
+
+// SaturatingMul returns the product a*b.
+// If the result overflows, it is clamped to the maximum value.
+func (a Uint16) SaturatingMul(b Uint16) Uint16 {
+	c, overflow := a.MulOverflow(b)
+	if overflow {
+		return math.MaxUint16
+	}
+	return c
+}
+
+// MulFull returns the 32-bit product of a and b: (hi, lo) = a * b
+// with the product bits' upper half returned in hi and the lower half returned in lo.
+func (a Uint16) MulFull(b Uint16) (hi, lo Uint16) {
+	c := a.Mul32(b)
+	return Uint16(c >> 16), Uint16(c)
+}
+
 // Mul32 returns the product a*b, the result is a 32-bit integer.
 func (a Uint16) Mul32(b Uint16) Uint32 {
 	return Uint32(a) * Uint32(b)
@@ -100,6 +216,202 @@ func (a Uint16) QuoRem(b Uint16) (Uint16, Uint16) {
 	return a / b, a % b
 }
 
+// Div32 returns the quotient and remainder of (hi, lo) divided by a:
+// quo = (hi, lo)/a, rem = (hi, lo)%a with the dividend bits' upper half
+// in parameter hi and the lower half in parameter lo.
+// Div32 panics for a == 0 (division by zero) or a <= hi (quotient overflow).
+// Like [math/bits.Div64], it panics with a [runtime.Error].
+func (a Uint16) Div32(hi, lo Uint16) (quo, rem Uint16) {
+	if a == 0 {
+		panicDivideByZero()
+	}
+	if a <= hi {
+		panicDivideOverflow()
+	}
+	x := uint32(hi)<<16 | uint32(lo)
+	return Uint16(x / uint32(a)), Uint16(x % uint32(a))
+}
+
+// AddMod returns (a+b) mod m.
+// It panics if m == 0.
+func (a Uint16) AddMod(b, m Uint16) Uint16 {
+	return Uint16((uint32(a) + uint32(b)) % uint32(m))
+}
+
+// SubMod returns (a-b) mod m.
+// The result is always in the range [0, m).
+// It panics if m == 0.
+func (a Uint16) SubMod(b, m Uint16) Uint16 {
+	a, b = a%m, b%m
+	if a < b {
+		return a + (m - b)
+	}
+	return a - b
+}
+
+// MulMod returns (a*b) mod m, computed without overflow.
+// It panics if m == 0.
+func (a Uint16) MulMod(b, m Uint16) Uint16 {
+	return Uint16(uint32(a) * uint32(b) % uint32(m))
+}
+
+// ExpMod returns a**e mod m.
+// It panics if m == 0.
+func (a Uint16) ExpMod(e, m Uint16) Uint16 {
+	r := Uint16(1).Mod(m)
+	a = a.Mod(m)
+	for i := e.BitLen() - 1; i >= 0; i-- {
+		r = r.MulMod(r, m)
+		if e.Bit(i) != 0 {
+			r = r.MulMod(a, m)
+		}
+	}
+	return r
+}
+
+// GCD returns the greatest common divisor of a and b.
+// GCD(a, 0) is a, and GCD(0, 0) is 0.
+// It uses the binary GCD algorithm.
+func (a Uint16) GCD(b Uint16) Uint16 {
+	if a == 0 {
+		return b
+	}
+	if b == 0 {
+		return a
+	}
+	i, j := bits.TrailingZeros16(uint16(a)), bits.TrailingZeros16(uint16(b))
+	k := min(i, j)
+	a, b = a>>i, b>>j
+	for {
+		// a and b are odd here.
+		if a > b {
+			a, b = b, a
+		}
+		b -= a
+		if b == 0 {
+			return a << k
+		}
+		b >>= bits.TrailingZeros16(uint16(b))
+	}
+}
+
+// LCM returns the least common multiple of a and b.
+// LCM(a, 0) is 0. The result wraps around on overflow.
+func (a Uint16) LCM(b Uint16) Uint16 {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return a / a.GCD(b) * b
+}
+
+// ExtendedGCD returns the greatest common divisor g of a and b,
+// and the Bézout coefficients x and y that satisfy a*x + b*y = g.
+// The coefficients are the ones given by the extended Euclidean algorithm,
+// which are small enough to always fit in [Int16].
+func (a Uint16) ExtendedGCD(b Uint16) (g Uint16, x, y Int16) {
+	r0, r1 := a, b
+	s0, s1 := Uint16(1), Uint16(0)
+	t0, t1 := Uint16(0), Uint16(1)
+	// The coefficients alternate in sign, so only their magnitudes are tracked.
+	neg := false
+	for r1 != 0 {
+		q := r0 / r1
+		r0, r1 = r1, r0-q*r1
+		s0, s1 = s1, s0+q*s1
+		t0, t1 = t1, t0+q*t1
+		neg = !neg
+	}
+	x, y = Int16(s0), -Int16(t0)
+	if neg {
+		x, y = -x, -y
+	}
+	return r0, x, y
+}
+
+// ModInverse returns the multiplicative inverse of a modulo m, in the range [0, m).
+// ok is false if a and m are not coprime, or m == 0.
+func (a Uint16) ModInverse(m Uint16) (x Uint16, ok bool) {
+	if m == 0 {
+		return 0, false
+	}
+	g, s, _ := (a % m).ExtendedGCD(m)
+	if g != 1 {
+		return 0, false
+	}
+	if s < 0 {
+		return Uint16(s) + m, true
+	}
+	return Uint16(s), true
+}
+
+// Sqrt returns the floor square root of a, ⌊√a⌋.
+// It uses Newton's method seeded from [Uint16.BitLen].
+func (a Uint16) Sqrt() Uint16 {
+	if a <= 1 {
+		return a
+	}
+	x := Uint16(1) << ((a.BitLen() + 1) / 2)
+	for {
+		y := (x + a/x) >> 1
+		if y >= x {
+			return x
+		}
+		x = y
+	}
+}
+
+// Cbrt returns the floor cube root of a, ⌊∛a⌋.
+func (a Uint16) Cbrt() Uint16 {
+	return a.Root(3)
+}
+
+// Root returns the floor n-th root of a, ⌊a**(1/n)⌋.
+// It uses Newton's method seeded from [Uint16.BitLen].
+// It panics if n == 0.
+func (a Uint16) Root(n uint) Uint16 {
+	switch n {
+	case 0:
+		panic("ints: zeroth root")
+	case 1:
+		return a
+	case 2:
+		return a.Sqrt()
+	}
+	bitLen := uint(a.BitLen())
+	if bitLen <= n {
+		// a < 2**n, so the root is 0 or 1.
+		return min(a, 1)
+	}
+
+	x := Uint16(1) << ((bitLen + n - 1) / n)
+	for {
+		// y = ((n-1)*x + a/x**(n-1)) / n
+		p, overflow := x, false
+		for i := uint(2); i < n && !overflow; i++ {
+			p, overflow = p.MulOverflow(x)
+		}
+		var q Uint16
+		if !overflow {
+			q = a / p
+		}
+		y := (Uint16(n-1)*x + q) / Uint16(n)
+		if y >= x {
+			return x
+		}
+		x = y
+	}
+}
+
+// IsPerfectSquare reports whether a is the square of an integer.
+func (a Uint16) IsPerfectSquare() bool {
+	// Squares modulo 16 are 0, 1, 4 or 9.
+	if (0x0213>>(a&15))&1 == 0 {
+		return false
+	}
+	r := a.Sqrt()
+	return r.Mul(r) == a
+}
+
 // And returns the bitwise AND of a and b.
 func (a Uint16) And(b Uint16) Uint16 {
 	return a & b
@@ -132,6 +444,13 @@ func (a Uint16) Lsh(i uint) Uint16 {
 	return a << i
 }
 
+// LshOverflow returns the left shift a<<i and reports whether the shift overflowed,
+// i.e. whether a<<i differs from a multiplied by 2**i.
+func (a Uint16) LshOverflow(i uint) (Uint16, bool) {
+	c := a << i
+	return c, c>>i != a
+}
+
 // Rsh returns the logical right shift a>>i.
 //
 // This function's execution time does not depend on the inputs.
@@ -139,6 +458,100 @@ func (a Uint16) Rsh(i uint) Uint16 {
 	return a >> i
 }
 
+// URsh returns the logical right shift a>>i.
+// It is the same as [Uint16.Rsh] because Uint16 is unsigned.
+func (a Uint16) URsh(i uint) Uint16 {
+	return a.Rsh(i)
+}
+
+// RshRound returns a/2**i rounded to the nearest integer, with ties rounded to even.
+func (a Uint16) RshRound(i uint) Uint16 {
+	if i == 0 {
+		return a
+	}
+	q := a.Rsh(i)
+	half := a.Bit(int(min(i-1, 16)))
+	sticky := a.Extract(0, i-1) != 0
+	if half == 1 && (sticky || q.Bit(0) == 1) {
+		q++
+	}
+	return q
+}
+
+// RshCeil returns a/2**i rounded toward positive infinity.
+func (a Uint16) RshCeil(i uint) Uint16 {
+	q := a.Rsh(i)
+	if a.Extract(0, i) != 0 {
+		q++
+	}
+	return q
+}
+
+// RotateLeft returns the value of a rotated left by (k mod 16) bits.
+// To rotate a right by k bits, call a.RotateLeft(-k).
+//
+// This function's execution time does not depend on the inputs.
+func (a Uint16) RotateLeft(k int) Uint16 {
+	return Uint16(bits.RotateLeft16(uint16(a), k))
+}
+
+// Reverse returns the value of a with its bits in reversed order.
+func (a Uint16) Reverse() Uint16 {
+	return Uint16(bits.Reverse16(uint16(a)))
+}
+
+// ReverseBytes returns the value of a with its bytes in reversed order.
+func (a Uint16) ReverseBytes() Uint16 {
+	return Uint16(bits.ReverseBytes16(uint16(a)))
+}
+
+// Bit returns the value of the i'th bit of a. That is, it returns (a>>i)&1.
+// The bit index i must be >= 0.
+func (a Uint16) Bit(i int) uint {
+	if i < 0 {
+		panic("ints: negative bit index")
+	}
+	return uint(a>>uint(i)) & 1
+}
+
+// SetBit returns a with its i'th bit set to b (0 or 1).
+// It panics if i is out of the range [0, 16) or b is not 0 or 1.
+func (a Uint16) SetBit(i int, b uint) Uint16 {
+	checkBitIndex(i, 16)
+	if b > 1 {
+		panic("ints: set bit is not 0 or 1")
+	}
+	return a&^(1<<i) | Uint16(b)<<i
+}
+
+// ClearBit returns a with its i'th bit set to 0.
+// It panics if i is out of the range [0, 16).
+func (a Uint16) ClearBit(i int) Uint16 {
+	checkBitIndex(i, 16)
+	return a &^ (1 << i)
+}
+
+// FlipBit returns a with its i'th bit inverted.
+// It panics if i is out of the range [0, 16).
+func (a Uint16) FlipBit(i int) Uint16 {
+	checkBitIndex(i, 16)
+	return a ^ (1 << i)
+}
+
+// Extract returns the width bits of a starting at bit lo, shifted down to bit 0.
+// That is, it returns (a>>lo) & (1<<width - 1).
+func (a Uint16) Extract(lo, width uint) Uint16 {
+	width = min(width, 16)
+	return a >> lo & (MaxUint16 >> (16 - width))
+}
+
+// Insert returns a with the width bits starting at bit lo replaced by the low width bits of v.
+func (a Uint16) Insert(v Uint16, lo, width uint) Uint16 {
+	width = min(width, 16)
+	mask := MaxUint16 >> (16 - width) << lo
+	return a&^mask | v<<lo&mask
+}
+
 // LeadingZeros returns the number of leading zero bits in a; the result is 16 for a == 0.
 func (a Uint16) LeadingZeros() int {
 	return bits.LeadingZeros16(uint16(a))
@@ -154,6 +567,11 @@ func (a Uint16) BitLen() int {
 	return bits.Len16(uint16(a))
 }
 
+// OnesCount returns the number of one bits ("population count") in a.
+func (a Uint16) OnesCount() int {
+	return bits.OnesCount16(uint16(a))
+}
+
 // Sign returns the sign of a.
 // It returns 1 if a > 0, and 0 if a == 0.
 // It does not return -1 because Uint16 is unsigned.
@@ -171,6 +589,14 @@ func (a Uint16) Neg() Uint16 {
 	return -a
 }
 
+// NegOverflow returns the negation of a and reports whether the negation overflowed,
+// which happens if a is not zero.
+//
+// This function's execution time does not depend on the inputs.
+func (a Uint16) NegOverflow() (Uint16, bool) {
+	return -a, a != 0
+}
+
 // Cmp returns the comparison result of a and b.
 // It returns -1 if a < b, 0 if a == b, and 1 if a > b.
 func (a Uint16) Cmp(b Uint16) int {
@@ -204,3 +630,153 @@ func (a Uint16) String() string {
 func (a Uint16) Format(s fmt.State, verb rune) {
 	format(s, verb, a.Sign(), a)
 }
+
+// ParseUint16 is like [ParseInt16] but for unsigned numbers.
+// A sign prefix is not permitted.
+func ParseUint16(s string, base int) (Uint16, error) {
+	var z [1]uint64
+	err := parseUint("ParseUint16", s, base, 16, z[:])
+	return Uint16(z[0]), err
+}
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+// The result is the decimal representation of a.
+func (a Uint16) MarshalText() ([]byte, error) {
+	return a.AppendText(nil)
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+// It accepts the same syntax as Go integer literals, such as "0x" prefixes and underscores.
+// See [ParseUint16] for more details.
+func (a *Uint16) UnmarshalText(text []byte) error {
+	v, err := ParseUint16(string(text), 0)
+	if err != nil {
+		return err
+	}
+	*a = v
+	return nil
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+// The result is a JSON number. Use [JSONString] to encode a as a JSON string.
+func (a Uint16) MarshalJSON() ([]byte, error) {
+	return a.AppendText(nil)
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+// It accepts both a JSON number such as 123 and a JSON string such as "123" or "0x7b".
+// JSON strings are parsed in the same way as [Uint16.UnmarshalText].
+func (a *Uint16) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(a, data, ParseUint16)
+}
+
+// BytesBE returns the big-endian representation of a.
+func (a Uint16) BytesBE() [2]byte {
+	var b [2]byte
+	a.PutBytesBE(b[:])
+	return b
+}
+
+// BytesLE returns the little-endian representation of a.
+func (a Uint16) BytesLE() [2]byte {
+	var b [2]byte
+	a.PutBytesLE(b[:])
+	return b
+}
+
+// PutBytesBE stores the big-endian representation of a into b.
+// It panics if len(b) < 2.
+func (a Uint16) PutBytesBE(b []byte) {
+	binary.BigEndian.PutUint16(b, uint16(a))
+}
+
+// PutBytesLE stores the little-endian representation of a into b.
+// It panics if len(b) < 2.
+func (a Uint16) PutBytesLE(b []byte) {
+	binary.LittleEndian.PutUint16(b, uint16(a))
+}
+
+// Uint16FromBytesBE returns the Uint16 represented by the big-endian bytes b[:2].
+// It panics if len(b) < 2.
+func Uint16FromBytesBE(b []byte) Uint16 {
+	return Uint16(binary.BigEndian.Uint16(b))
+}
+
+// Uint16FromBytesLE returns the Uint16 represented by the little-endian bytes b[:2].
+// It panics if len(b) < 2.
+func Uint16FromBytesLE(b []byte) Uint16 {
+	return Uint16(binary.LittleEndian.Uint16(b))
+}
+
+// AppendBinary implements the [encoding.BinaryAppender] interface.
+// It appends the big-endian representation of a to dst.
+func (a Uint16) AppendBinary(dst []byte) ([]byte, error) {
+	b := a.BytesBE()
+	return append(dst, b[:]...), nil
+}
+
+// MarshalBinary implements the [encoding.BinaryMarshaler] interface.
+// The result is the 2-byte big-endian representation of a.
+func (a Uint16) MarshalBinary() ([]byte, error) {
+	return a.AppendBinary(make([]byte, 0, 2))
+}
+
+// UnmarshalBinary implements the [encoding.BinaryUnmarshaler] interface.
+// data must be exactly 2 bytes of the big-endian representation, as generated by [Uint16.MarshalBinary].
+func (a *Uint16) UnmarshalBinary(data []byte) error {
+	if len(data) != 2 {
+		return fmt.Errorf("ints: Uint16.UnmarshalBinary: invalid length %d, want 2", len(data))
+	}
+	*a = Uint16FromBytesBE(data)
+	return nil
+}
+
+// BigInt returns a as a new [*big.Int].
+func (a Uint16) BigInt() *big.Int {
+	return new(big.Int).SetUint64(uint64(a))
+}
+
+// Uint16FromBigInt returns x as an Uint16.
+// If x is not representable as an Uint16, the result is the lower 16 bits of x in two's complement,
+// and ok is false.
+func Uint16FromBigInt(x *big.Int) (Uint16, bool) {
+	var z [1]uint64
+	ok := uintFromBigInt(z[:], 16, x)
+	return Uint16(z[0]), ok
+}
+
+// Float64 returns the float64 value nearest to a, rounding ties to even, and an indication of any rounding error.
+// If a is too large to be represented by a float64, the result is ±Inf.
+func (a Uint16) Float64() (float64, big.Accuracy) {
+	return float64(a), big.Exact
+}
+
+// Uint16FromFloat64 returns the integer value of f rounded according to mode,
+// and the accuracy of the result: [big.Below] if the result is less than f, [big.Above] if it is greater than f.
+// Use [big.ToZero] to truncate f like the Go conversion, and [big.ToNearestEven] to round f to the nearest integer.
+//
+// If f is NaN, the result is 0 and the error is [ErrNaN].
+// If the rounded value of f is not representable as an Uint16, including ±Inf,
+// the result is clamped to 0 or the maximum value of Uint16, and the error is [ErrOverflow].
+func Uint16FromFloat64(f float64, mode big.RoundingMode) (Uint16, big.Accuracy, error) {
+	var z [1]uint64
+	acc, err := uintFromFloat64(z[:], 16, f, mode)
+	return Uint16(z[0]), acc, err
+}
+
+// BigFloat returns a as a new [*big.Float].
+// The precision of the result is 16 bits, so the conversion is always exact.
+func (a Uint16) BigFloat() *big.Float {
+	return new(big.Float).SetPrec(16).SetUint64(uint64(a))
+}
+
+// Uint16FromBigFloat returns the integer value of x rounded according to mode,
+// and the accuracy of the result: [big.Below] if the result is less than x, [big.Above] if it is greater than x.
+//
+// If the rounded value of x is not representable as an Uint16, including ±Inf,
+// the result is clamped to 0 or the maximum value of Uint16, and the error is [ErrOverflow].
+func Uint16FromBigFloat(x *big.Float, mode big.RoundingMode) (Uint16, big.Accuracy, error) {
+	var z [1]uint64
+	acc, err := uintFromBigFloat(z[:], 16, x, mode)
+	return Uint16(z[0]), acc, err
+}
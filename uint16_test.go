@@ -1,9 +1,14 @@
 package ints
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
 	"math/big"
+	"runtime"
+	"slices"
 	"strconv"
 	"testing"
 )
@@ -467,3 +472,969 @@ func TestUint16_Format(t *testing.T) {
 		}
 	}
 }
+
+func TestParseUint16(t *testing.T) {
+	testCases := []struct {
+		s    string
+		base int
+		want Uint16
+		err  error
+	}{
+		{"0", 10, 0, nil},
+		{"1", 10, 1, nil},
+		{"65535", 10, 65535, nil},
+		{"65536", 10, 65535, strconv.ErrRange},
+		{"0xffff", 0, 65535, nil},
+		{"0b101", 0, 5, nil},
+		{"0o17", 0, 15, nil},
+		{"017", 0, 15, nil},
+		{"1_000", 0, 1000, nil},
+		{"1_000", 10, 0, strconv.ErrSyntax},
+		{"_1", 0, 0, strconv.ErrSyntax},
+		{"Z", 62, 61, nil},
+		{"z", 36, 35, nil},
+		{"Z", 36, 35, nil},
+		{"", 10, 0, strconv.ErrSyntax},
+		{"12a", 10, 0, strconv.ErrSyntax},
+		{"-1", 10, 0, strconv.ErrSyntax},
+		{"+1", 10, 0, strconv.ErrSyntax},
+	}
+
+	for _, tc := range testCases {
+		got, err := ParseUint16(tc.s, tc.base)
+		if got != tc.want {
+			t.Errorf("ParseUint16(%q, %d) = %d, want %d", tc.s, tc.base, got, tc.want)
+		}
+		if !errors.Is(err, tc.err) {
+			t.Errorf("ParseUint16(%q, %d) error = %v, want %v", tc.s, tc.base, err, tc.err)
+		}
+		if err != nil {
+			var numErr *strconv.NumError
+			if !errors.As(err, &numErr) || numErr.Func != "ParseUint16" || numErr.Num != tc.s {
+				t.Errorf("ParseUint16(%q, %d) error = %#v, want *strconv.NumError", tc.s, tc.base, err)
+			}
+		}
+	}
+
+	if _, err := ParseUint16("1", 63); err == nil {
+		t.Errorf("ParseUint16(%q, %d) should fail", "1", 63)
+	}
+}
+
+func TestUint16_MarshalText(t *testing.T) {
+	testCases := []struct {
+		x    Uint16
+		want string
+	}{
+		{0, "0"},
+		{1, "1"},
+		{65535, "65535"},
+	}
+
+	for _, tc := range testCases {
+		got, err := tc.x.MarshalText()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != tc.want {
+			t.Errorf("Uint16(%d).MarshalText() = %q, want %q", tc.x, got, tc.want)
+		}
+	}
+}
+
+func TestUint16_UnmarshalText(t *testing.T) {
+	testCases := []struct {
+		s    string
+		want Uint16
+		err  error
+	}{
+		{"0", 0, nil},
+		{"0x10", 16, nil},
+		{"0b1_0", 2, nil},
+		{"010", 8, nil},
+		{"65535", 65535, nil},
+		{"65536", 0, strconv.ErrRange},
+		{"1.0", 0, strconv.ErrSyntax},
+	}
+
+	for _, tc := range testCases {
+		var got Uint16
+		err := got.UnmarshalText([]byte(tc.s))
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Uint16.UnmarshalText(%q) error = %v, want %v", tc.s, err, tc.err)
+		}
+		if got != tc.want {
+			t.Errorf("Uint16.UnmarshalText(%q) = %d, want %d", tc.s, got, tc.want)
+		}
+	}
+}
+
+func TestUint16_MarshalJSON(t *testing.T) {
+	testCases := []struct {
+		x    Uint16
+		want string
+	}{
+		{0, "0"},
+		{1, "1"},
+		{65535, "65535"},
+	}
+
+	for _, tc := range testCases {
+		got, err := json.Marshal(tc.x)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != tc.want {
+			t.Errorf("json.Marshal(Uint16(%d)) = %s, want %s", tc.x, got, tc.want)
+		}
+	}
+}
+
+func TestUint16_UnmarshalJSON(t *testing.T) {
+	testCases := []struct {
+		s       string
+		want    Uint16
+		wantErr bool
+	}{
+		{"0", 0, false},
+		{"123", 123, false},
+		{"\"123\"", 123, false},
+		{"\"0x7f\"", 127, false},
+		{"65535", 65535, false},
+		{"\"65535\"", 65535, false},
+		{"65536", 0, true},
+		{"0x7f", 0, true},
+		{"\"abc\"", 0, true},
+		{"1.5", 0, true},
+		{"null", 0, false},
+	}
+
+	for _, tc := range testCases {
+		var got Uint16
+		err := json.Unmarshal([]byte(tc.s), &got)
+		if (err != nil) != tc.wantErr {
+			t.Errorf("json.Unmarshal(%s) error = %v, wantErr %t", tc.s, err, tc.wantErr)
+		}
+		if got != tc.want {
+			t.Errorf("json.Unmarshal(%s) = %d, want %d", tc.s, got, tc.want)
+		}
+	}
+}
+
+func TestUint16_Bytes(t *testing.T) {
+	testCases := []struct {
+		x  Uint16
+		be [2]byte
+	}{
+		{
+			258,
+			[2]byte{0x01, 0x02},
+		},
+		{
+			65534,
+			[2]byte{0xff, 0xfe},
+		},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.x.BytesBE(); got != tc.be {
+			t.Errorf("Uint16(%d).BytesBE() = %x, want %x", tc.x, got, tc.be)
+		}
+		if got := Uint16FromBytesBE(tc.be[:]); got != tc.x {
+			t.Errorf("Uint16FromBytesBE(%x) = %d, want %d", tc.be, got, tc.x)
+		}
+
+		le := tc.be
+		slices.Reverse(le[:])
+		if got := tc.x.BytesLE(); got != le {
+			t.Errorf("Uint16(%d).BytesLE() = %x, want %x", tc.x, got, le)
+		}
+		if got := Uint16FromBytesLE(le[:]); got != tc.x {
+			t.Errorf("Uint16FromBytesLE(%x) = %d, want %d", le, got, tc.x)
+		}
+
+		data, err := tc.x.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(data, tc.be[:]) {
+			t.Errorf("Uint16(%d).MarshalBinary() = %x, want %x", tc.x, data, tc.be)
+		}
+		var got Uint16
+		if err := got.UnmarshalBinary(data); err != nil {
+			t.Fatal(err)
+		}
+		if got != tc.x {
+			t.Errorf("Uint16.UnmarshalBinary(%x) = %d, want %d", data, got, tc.x)
+		}
+	}
+}
+
+func TestUint16_UnmarshalBinary_InvalidLength(t *testing.T) {
+	var a Uint16
+	if err := a.UnmarshalBinary(make([]byte, 1)); err == nil {
+		t.Error("want error, got nil")
+	}
+	if err := a.UnmarshalBinary(make([]byte, 3)); err == nil {
+		t.Error("want error, got nil")
+	}
+}
+
+func TestUint16FromBigInt(t *testing.T) {
+	testCases := []struct {
+		x    *big.Int
+		want Uint16
+		ok   bool
+	}{
+		{big.NewInt(0), 0, true},
+		{big.NewInt(1), 1, true},
+		{big.NewInt(65535), 65535, true},
+		{big.NewInt(65536), 0, false},
+		{big.NewInt(65536), 0, false},
+		{big.NewInt(-1), 65535, false},
+	}
+
+	for _, tc := range testCases {
+		got, ok := Uint16FromBigInt(tc.x)
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint16FromBigInt(%s) = %d, %t, want %d, %t", tc.x, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint16_BigInt(t *testing.T) {
+	testCases := []Uint16{0, 1, 65535}
+
+	for _, a := range testCases {
+		got := a.BigInt()
+		if got.String() != a.String() {
+			t.Errorf("Uint16(%d).BigInt() = %s, want %s", a, got, a)
+		}
+		if b, ok := Uint16FromBigInt(got); b != a || !ok {
+			t.Errorf("Uint16FromBigInt(%s) = %d, %t, want %d, true", got, b, ok, a)
+		}
+	}
+}
+
+func TestUint16FromFloat64(t *testing.T) {
+	testCases := []struct {
+		f    float64
+		mode big.RoundingMode
+		want Uint16
+		acc  big.Accuracy
+		err  error
+	}{
+		{0, big.ToNearestEven, 0, big.Exact, nil},
+		{2.5, big.ToNearestEven, 2, big.Below, nil},
+		{3.5, big.ToNearestEven, 4, big.Above, nil},
+		{2.5, big.ToNearestAway, 3, big.Above, nil},
+		{2.5, big.ToZero, 2, big.Below, nil},
+		{2.5, big.AwayFromZero, 3, big.Above, nil},
+		{2.5, big.ToNegativeInf, 2, big.Below, nil},
+		{2.5, big.ToPositiveInf, 3, big.Above, nil},
+		{math.NaN(), big.ToNearestEven, 0, big.Exact, ErrNaN},
+		{math.Inf(1), big.ToNearestEven, 65535, big.Below, ErrOverflow},
+		{math.Inf(-1), big.ToNearestEven, 0, big.Above, ErrOverflow},
+		{0x1p16, big.ToNearestEven, 65535, big.Below, ErrOverflow},
+		{0x1p15, big.ToNearestEven, 32768, big.Exact, nil},
+		{-0.5, big.ToZero, 0, big.Above, nil},
+		{-1, big.ToNearestEven, 0, big.Above, ErrOverflow},
+	}
+
+	for _, tc := range testCases {
+		got, acc, err := Uint16FromFloat64(tc.f, tc.mode)
+		if got != tc.want || acc != tc.acc || err != tc.err {
+			t.Errorf("Uint16FromFloat64(%v, %s) = %d, %s, %v, want %d, %s, %v", tc.f, tc.mode, got, acc, err, tc.want, tc.acc, tc.err)
+		}
+
+		if math.IsNaN(tc.f) {
+			continue
+		}
+		got, acc, err = Uint16FromBigFloat(big.NewFloat(tc.f), tc.mode)
+		if got != tc.want || acc != tc.acc || err != tc.err {
+			t.Errorf("Uint16FromBigFloat(%v, %s) = %d, %s, %v, want %d, %s, %v", tc.f, tc.mode, got, acc, err, tc.want, tc.acc, tc.err)
+		}
+	}
+}
+
+func TestUint16_Float64(t *testing.T) {
+	testCases := []Uint16{0, 1, 65535}
+
+	for _, a := range testCases {
+		b := a.BigInt()
+		got, acc := a.Float64()
+		want, wantAcc := new(big.Float).SetInt(b).Float64()
+		if got != want || acc != wantAcc {
+			t.Errorf("Uint16(%d).Float64() = %v, %s, want %v, %s", a, got, acc, want, wantAcc)
+		}
+		if c, acc, err := Uint16FromBigFloat(a.BigFloat(), big.ToNearestEven); c != a || acc != big.Exact || err != nil {
+			t.Errorf("Uint16FromBigFloat(%v) = %d, %s, %v, want %d, %s, nil", a.BigFloat(), c, acc, err, a, big.Exact)
+		}
+	}
+}
+
+func TestUint16_AddOverflow(t *testing.T) {
+	testCases := []struct {
+		x, y     Uint16
+		want     Uint16
+		overflow bool
+	}{
+		{0, 0, 0, false},
+		{1, 1, 2, false},
+		{65535, 1, 0, true},
+		{65535, 65535, 65534, true},
+		{0, 1, 1, false},
+		{2, 32767, 32769, false},
+		{2, 32768, 32770, false},
+	}
+
+	for _, tc := range testCases {
+		got, overflow := tc.x.AddOverflow(tc.y)
+		if got != tc.want || overflow != tc.overflow {
+			t.Errorf("Uint16(%d).AddOverflow(%d) = %d, %t, want %d, %t", tc.x, tc.y, got, overflow, tc.want, tc.overflow)
+		}
+	}
+}
+
+func TestUint16_SubOverflow(t *testing.T) {
+	testCases := []struct {
+		x, y     Uint16
+		want     Uint16
+		overflow bool
+	}{
+		{0, 0, 0, false},
+		{1, 1, 0, false},
+		{65535, 1, 65534, false},
+		{65535, 65535, 0, false},
+		{0, 1, 65535, true},
+		{2, 32767, 32771, true},
+		{2, 32768, 32770, true},
+	}
+
+	for _, tc := range testCases {
+		got, overflow := tc.x.SubOverflow(tc.y)
+		if got != tc.want || overflow != tc.overflow {
+			t.Errorf("Uint16(%d).SubOverflow(%d) = %d, %t, want %d, %t", tc.x, tc.y, got, overflow, tc.want, tc.overflow)
+		}
+	}
+}
+
+func TestUint16_MulOverflow(t *testing.T) {
+	testCases := []struct {
+		x, y     Uint16
+		want     Uint16
+		overflow bool
+	}{
+		{0, 0, 0, false},
+		{1, 1, 1, false},
+		{65535, 1, 65535, false},
+		{65535, 65535, 1, true},
+		{0, 1, 0, false},
+		{2, 32767, 65534, false},
+		{2, 32768, 0, true},
+	}
+
+	for _, tc := range testCases {
+		got, overflow := tc.x.MulOverflow(tc.y)
+		if got != tc.want || overflow != tc.overflow {
+			t.Errorf("Uint16(%d).MulOverflow(%d) = %d, %t, want %d, %t", tc.x, tc.y, got, overflow, tc.want, tc.overflow)
+		}
+	}
+}
+
+func TestUint16_NegOverflow(t *testing.T) {
+	testCases := []struct {
+		x        Uint16
+		want     Uint16
+		overflow bool
+	}{
+		{0, 0, false},
+		{1, 65535, true},
+		{65535, 1, true},
+	}
+
+	for _, tc := range testCases {
+		got, overflow := tc.x.NegOverflow()
+		if got != tc.want || overflow != tc.overflow {
+			t.Errorf("Uint16(%d).NegOverflow() = %d, %t, want %d, %t", tc.x, got, overflow, tc.want, tc.overflow)
+		}
+	}
+}
+
+func TestUint16_LshOverflow(t *testing.T) {
+	testCases := []struct {
+		x        Uint16
+		i        uint
+		want     Uint16
+		overflow bool
+	}{
+		{1, 0, 1, false},
+		{1, 14, 16384, false},
+		{1, 15, 32768, false},
+		{1, 16, 0, true},
+		{3, 14, 49152, false},
+		{65535, 1, 65534, true},
+		{0, 26, 0, false},
+	}
+
+	for _, tc := range testCases {
+		got, overflow := tc.x.LshOverflow(tc.i)
+		if got != tc.want || overflow != tc.overflow {
+			t.Errorf("Uint16(%d).LshOverflow(%d) = %d, %t, want %d, %t", tc.x, tc.i, got, overflow, tc.want, tc.overflow)
+		}
+	}
+}
+
+func TestUint16_AddCarry(t *testing.T) {
+	testCases := []struct {
+		x, y     Uint16
+		carry    uint
+		want     Uint16
+		carryOut uint
+	}{
+		{0, 0, 0, 0, 0},
+		{0, 0, 1, 1, 0},
+		{1, 2, 0, 3, 0},
+		{65535, 0, 1, 0, 1},
+		{65535, 1, 0, 0, 1},
+		{65535, 65535, 0, 65534, 1},
+		{65535, 65535, 1, 65535, 1},
+	}
+
+	for _, tc := range testCases {
+		got, carry := tc.x.AddCarry(tc.y, tc.carry)
+		if got != tc.want || carry != tc.carryOut {
+			t.Errorf("Uint16(%d).AddCarry(%d, %d) = %d, %d, want %d, %d", tc.x, tc.y, tc.carry, got, carry, tc.want, tc.carryOut)
+		}
+	}
+}
+
+func TestUint16_SubBorrow(t *testing.T) {
+	testCases := []struct {
+		x, y      Uint16
+		borrow    uint
+		want      Uint16
+		borrowOut uint
+	}{
+		{0, 0, 0, 0, 0},
+		{0, 0, 1, 65535, 1},
+		{3, 2, 0, 1, 0},
+		{3, 2, 1, 0, 0},
+		{0, 1, 0, 65535, 1},
+		{65535, 65535, 1, 65535, 1},
+		{0, 65535, 1, 0, 1},
+	}
+
+	for _, tc := range testCases {
+		got, borrow := tc.x.SubBorrow(tc.y, tc.borrow)
+		if got != tc.want || borrow != tc.borrowOut {
+			t.Errorf("Uint16(%d).SubBorrow(%d, %d) = %d, %d, want %d, %d", tc.x, tc.y, tc.borrow, got, borrow, tc.want, tc.borrowOut)
+		}
+	}
+}
+
+func TestUint16_MulFull(t *testing.T) {
+	testCases := []struct {
+		x, y   Uint16
+		hi, lo Uint16
+	}{
+		{0, 0, 0, 0},
+		{1, 1, 0, 1},
+		{65535, 2, 1, 65534},
+		{65535, 65535, 65534, 1},
+		{32768, 2, 1, 0},
+		{21845, 6, 1, 65534},
+	}
+
+	for _, tc := range testCases {
+		hi, lo := tc.x.MulFull(tc.y)
+		if hi != tc.hi || lo != tc.lo {
+			t.Errorf("Uint16(%d).MulFull(%d) = %d, %d, want %d, %d", tc.x, tc.y, hi, lo, tc.hi, tc.lo)
+		}
+	}
+}
+
+func TestUint16_SaturatingAdd(t *testing.T) {
+	testCases := []struct {
+		x, y Uint16
+		want Uint16
+	}{
+		{0, 0, 0},
+		{1, 2, 3},
+		{65535, 1, 65535},
+		{65535, 65535, 65535},
+		{0, 1, 1},
+		{2, 32768, 32770},
+		{3, 2, 5},
+	}
+
+	for _, tc := range testCases {
+		got := tc.x.SaturatingAdd(tc.y)
+		if got != tc.want {
+			t.Errorf("Uint16(%d).SaturatingAdd(%d) = %d, want %d", tc.x, tc.y, got, tc.want)
+		}
+	}
+}
+
+func TestUint16_SaturatingSub(t *testing.T) {
+	testCases := []struct {
+		x, y Uint16
+		want Uint16
+	}{
+		{0, 0, 0},
+		{1, 2, 0},
+		{65535, 1, 65534},
+		{65535, 65535, 0},
+		{0, 1, 0},
+		{2, 32768, 0},
+		{3, 2, 1},
+	}
+
+	for _, tc := range testCases {
+		got := tc.x.SaturatingSub(tc.y)
+		if got != tc.want {
+			t.Errorf("Uint16(%d).SaturatingSub(%d) = %d, want %d", tc.x, tc.y, got, tc.want)
+		}
+	}
+}
+
+func TestUint16_SaturatingMul(t *testing.T) {
+	testCases := []struct {
+		x, y Uint16
+		want Uint16
+	}{
+		{0, 0, 0},
+		{1, 2, 2},
+		{65535, 1, 65535},
+		{65535, 65535, 65535},
+		{0, 1, 0},
+		{2, 32768, 65535},
+		{3, 2, 6},
+	}
+
+	for _, tc := range testCases {
+		got := tc.x.SaturatingMul(tc.y)
+		if got != tc.want {
+			t.Errorf("Uint16(%d).SaturatingMul(%d) = %d, want %d", tc.x, tc.y, got, tc.want)
+		}
+	}
+}
+
+func TestUint16Of(t *testing.T) {
+	testCases := []struct {
+		v    string
+		got  Uint16
+		want Uint16
+	}{
+		{"int8(-1)", Uint16Of(int8(-1)), 65535},
+		{"int(42)", Uint16Of(int(42)), 42},
+		{"uint64(math.MaxUint64)", Uint16Of(uint64(math.MaxUint64)), 65535},
+		{"int64(math.MinInt64)", Uint16Of(int64(math.MinInt64)), 0},
+		{"uintptr(7)", Uint16Of(uintptr(7)), 7},
+	}
+
+	for _, tc := range testCases {
+		if tc.got != tc.want {
+			t.Errorf("Uint16Of(%s) = %d, want %d", tc.v, tc.got, tc.want)
+		}
+	}
+}
+
+func TestUint16_IsInt64(t *testing.T) {
+	testCases := []struct {
+		a        Uint16
+		isInt64  bool
+		isUint64 bool
+		i64      int64
+		u64      uint64
+	}{
+		{0, true, true, 0, 0},
+		{1, true, true, 1, 1},
+		{65535, true, true, 65535, 65535},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.a.IsInt64(); got != tc.isInt64 {
+			t.Errorf("Uint16(%d).IsInt64() = %t, want %t", tc.a, got, tc.isInt64)
+		}
+		if got := tc.a.IsUint64(); got != tc.isUint64 {
+			t.Errorf("Uint16(%d).IsUint64() = %t, want %t", tc.a, got, tc.isUint64)
+		}
+
+		got, ok := tc.a.Int()
+		want, wantOK := int(tc.i64), tc.isInt64 && int64(int(tc.i64)) == tc.i64
+		if got != want || ok != wantOK {
+			t.Errorf("Uint16(%d).Int() = %d, %t, want %d, %t", tc.a, got, ok, want, wantOK)
+		}
+
+		gotU, ok := tc.a.Uint()
+		wantU, wantOK := uint(tc.u64), tc.isUint64 && uint64(uint(tc.u64)) == tc.u64
+		if gotU != wantU || ok != wantOK {
+			t.Errorf("Uint16(%d).Uint() = %d, %t, want %d, %t", tc.a, gotU, ok, wantU, wantOK)
+		}
+	}
+}
+
+func TestUint16_OnesCount(t *testing.T) {
+	testCases := []struct {
+		x    Uint16
+		want int
+	}{
+		{0, 0},
+		{1, 1},
+		{65535, 16},
+		{32768, 1},
+		{21845, 8},
+		{240, 4},
+	}
+
+	for _, tc := range testCases {
+		got := tc.x.OnesCount()
+		if got != tc.want {
+			t.Errorf("Uint16(%d).OnesCount() = %d, want %d", tc.x, got, tc.want)
+		}
+	}
+}
+
+func TestUint16_RotateLeft(t *testing.T) {
+	testCases := []struct {
+		x    Uint16
+		k    int
+		want Uint16
+	}{
+		{1, 0, 1},
+		{1, 1, 2},
+		{1, -1, 32768},
+		{1, 16, 1},
+		{1, 19, 8},
+		{129, 1, 258},
+		{65534, -3, 57343},
+		{18, -17, 9},
+	}
+
+	for _, tc := range testCases {
+		got := tc.x.RotateLeft(tc.k)
+		if got != tc.want {
+			t.Errorf("Uint16(%#x).RotateLeft(%d) = %#x, want %#x", tc.x, tc.k, got, tc.want)
+		}
+	}
+}
+
+func TestUint16_Reverse(t *testing.T) {
+	testCases := []struct {
+		x            Uint16
+		reverse      Uint16
+		reverseBytes Uint16
+	}{
+		{0, 0, 0},
+		{1, 32768, 256},
+		{65535, 65535, 65535},
+		{32768, 1, 128},
+		{258, 16512, 513},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.x.Reverse(); got != tc.reverse {
+			t.Errorf("Uint16(%#x).Reverse() = %#x, want %#x", tc.x, got, tc.reverse)
+		}
+		if got := tc.x.ReverseBytes(); got != tc.reverseBytes {
+			t.Errorf("Uint16(%#x).ReverseBytes() = %#x, want %#x", tc.x, got, tc.reverseBytes)
+		}
+	}
+}
+
+func TestUint16_Bit(t *testing.T) {
+	testCases := []Uint16{
+		0,
+		1,
+		65535,
+		165,
+		32768,
+	}
+
+	for _, a := range testCases {
+		for i := range 16 {
+			want := uint(a>>i) & 1
+			if got := a.Bit(i); got != want {
+				t.Errorf("Uint16(%d).Bit(%d) = %d, want %d", a, i, got, want)
+			}
+			if got, want := a.SetBit(i, 1), a|1<<i; got != want {
+				t.Errorf("Uint16(%d).SetBit(%d, 1) = %d, want %d", a, i, got, want)
+			}
+			if got, want := a.SetBit(i, 0), a&^(1<<i); got != want {
+				t.Errorf("Uint16(%d).SetBit(%d, 0) = %d, want %d", a, i, got, want)
+			}
+			if got, want := a.ClearBit(i), a&^(1<<i); got != want {
+				t.Errorf("Uint16(%d).ClearBit(%d) = %d, want %d", a, i, got, want)
+			}
+			if got, want := a.FlipBit(i), a^(1<<i); got != want {
+				t.Errorf("Uint16(%d).FlipBit(%d) = %d, want %d", a, i, got, want)
+			}
+		}
+		if got := a.Bit(16); got != 0 {
+			t.Errorf("Uint16(%d).Bit(16) = %d, want 0", a, got)
+		}
+	}
+}
+
+func TestUint16_SetBit_Panic(t *testing.T) {
+	testCases := []struct {
+		i int
+		b uint
+	}{
+		{-1, 0},
+		{16, 0},
+		{0, 2},
+	}
+
+	for _, tc := range testCases {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Uint16(0).SetBit(%d, %d) did not panic", tc.i, tc.b)
+				}
+			}()
+			Uint16(0).SetBit(tc.i, tc.b)
+		}()
+	}
+}
+
+func TestUint16_Extract(t *testing.T) {
+	testCases := []struct {
+		x         Uint16
+		lo, width uint
+		want      Uint16
+	}{
+		{65535, 0, 16, 65535},
+		{65535, 0, 0, 0},
+		{165, 4, 4, 10},
+		{165, 1, 3, 2},
+		{65535, 15, 8, 1},
+		{90, 0, 26, 90},
+		{65535, 16, 4, 0},
+	}
+
+	for _, tc := range testCases {
+		got := tc.x.Extract(tc.lo, tc.width)
+		if got != tc.want {
+			t.Errorf("Uint16(%#x).Extract(%d, %d) = %#x, want %#x", tc.x, tc.lo, tc.width, got, tc.want)
+		}
+	}
+}
+
+func TestUint16_Insert(t *testing.T) {
+	testCases := []struct {
+		x, v      Uint16
+		lo, width uint
+		want      Uint16
+	}{
+		{0, 65535, 0, 16, 65535},
+		{65535, 0, 4, 4, 65295},
+		{165, 15, 1, 3, 175},
+		{0, 255, 14, 8, 49152},
+		{65535, 0, 0, 0, 65535},
+		{90, 3, 0, 26, 3},
+	}
+
+	for _, tc := range testCases {
+		got := tc.x.Insert(tc.v, tc.lo, tc.width)
+		if got != tc.want {
+			t.Errorf("Uint16(%#x).Insert(%#x, %d, %d) = %#x, want %#x", tc.x, tc.v, tc.lo, tc.width, got, tc.want)
+		}
+	}
+}
+
+func TestUint16_RshRound(t *testing.T) {
+	values := []Uint16{
+		0,
+		1,
+		2,
+		3,
+		5,
+		6,
+		128,
+		192,
+		32767,
+		32768,
+		32769,
+		45056,
+		65535,
+	}
+	shifts := []uint{0, 1, 2, 3, 7, 8, 9, 15, 16, 17, 80}
+
+	for _, a := range values {
+		x := new(big.Int).SetUint64(uint64(a))
+		for _, i := range shifts {
+			if got, want := a.RshRound(i), rshRoundBigInt(x, i); got.BigInt().Cmp(want) != 0 {
+				t.Errorf("Uint16(%d).RshRound(%d) = %d, want %d", a, i, got, want)
+			}
+			if got, want := a.RshCeil(i), rshCeilBigInt(x, i); got.BigInt().Cmp(want) != 0 {
+				t.Errorf("Uint16(%d).RshCeil(%d) = %d, want %d", a, i, got, want)
+			}
+			if got, want := a.URsh(i), new(big.Int).Rsh(new(big.Int).SetUint64(uint64(a)), i); got.BigInt().Cmp(want) != 0 {
+				t.Errorf("Uint16(%d).URsh(%d) = %d, want %d", a, i, got, want)
+			}
+		}
+	}
+}
+
+func TestUint16_Div32(t *testing.T) {
+	testCases := []struct {
+		hi, lo, y Uint16
+		quo, rem  Uint16
+	}{
+		{0, 0, 1, 0, 0},
+		{0, 65535, 7, 9362, 1},
+		{1, 0, 2, 32768, 0},
+		{65534, 65535, 65535, 65535, 65534},
+		{5, 12345, 6, 56670, 5},
+		{16384, 3, 32768, 32768, 3},
+	}
+
+	for _, tc := range testCases {
+		quo, rem := tc.y.Div32(tc.hi, tc.lo)
+		if quo != tc.quo || rem != tc.rem {
+			t.Errorf("Uint16(%d).Div32(%d, %d) = %d, %d, want %d, %d", tc.y, tc.hi, tc.lo, quo, rem, tc.quo, tc.rem)
+		}
+	}
+}
+
+func TestUint16_Div32_Panic(t *testing.T) {
+	testCases := []struct {
+		hi, lo, y Uint16
+		want      string
+	}{
+		{0, 1, 0, "runtime error: integer divide by zero"},
+		{1, 0, 1, "runtime error: integer overflow"},
+		{65535, 0, 2, "runtime error: integer overflow"},
+	}
+
+	for _, tc := range testCases {
+		func() {
+			defer func() {
+				err, ok := recover().(runtime.Error)
+				if !ok {
+					t.Errorf("Uint16(%d).Div32(%d, %d) did not panic with a runtime.Error", tc.y, tc.hi, tc.lo)
+					return
+				}
+				if err.Error() != tc.want {
+					t.Errorf("Uint16(%d).Div32(%d, %d) panicked with %q, want %q", tc.y, tc.hi, tc.lo, err.Error(), tc.want)
+				}
+			}()
+			tc.y.Div32(tc.hi, tc.lo)
+		}()
+	}
+}
+
+func TestUint16_ModArith(t *testing.T) {
+	testCases := []struct {
+		a, b, m Uint16
+		add     Uint16
+		sub     Uint16
+		mul     Uint16
+		exp     Uint16
+	}{
+		{0, 0, 1, 0, 0, 0, 0},
+		{65535, 65535, 65535, 0, 0, 0, 0},
+		{65535, 65535, 65534, 2, 0, 1, 1},
+		{65534, 3, 32768, 1, 32763, 32762, 32760},
+		{12345, 54321, 97, 27, 25, 26, 63},
+		{1, 65535, 2, 0, 0, 1, 1},
+		{21845, 13107, 9363, 6863, 8738, 1875, 3113},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.a.AddMod(tc.b, tc.m); got != tc.add {
+			t.Errorf("Uint16(%d).AddMod(%d, %d) = %d, want %d", tc.a, tc.b, tc.m, got, tc.add)
+		}
+		if got := tc.a.SubMod(tc.b, tc.m); got != tc.sub {
+			t.Errorf("Uint16(%d).SubMod(%d, %d) = %d, want %d", tc.a, tc.b, tc.m, got, tc.sub)
+		}
+		if got := tc.a.MulMod(tc.b, tc.m); got != tc.mul {
+			t.Errorf("Uint16(%d).MulMod(%d, %d) = %d, want %d", tc.a, tc.b, tc.m, got, tc.mul)
+		}
+		if got := tc.a.ExpMod(tc.b, tc.m); got != tc.exp {
+			t.Errorf("Uint16(%d).ExpMod(%d, %d) = %d, want %d", tc.a, tc.b, tc.m, got, tc.exp)
+		}
+	}
+}
+
+func TestUint16_GCD(t *testing.T) {
+	testCases := []struct {
+		a, b Uint16
+		gcd  Uint16
+		lcm  Uint16
+		inv  Uint16
+		ok   bool
+	}{
+		{0, 0, 0, 0, 0, false},
+		{0, 5, 5, 0, 0, false},
+		{5, 0, 5, 0, 0, false},
+		{12, 18, 6, 36, 0, false},
+		{65535, 65535, 65535, 65535, 0, false},
+		{65535, 65534, 1, 2, 1, true},
+		{32768, 16384, 16384, 32768, 0, false},
+		{21845, 13107, 4369, 65535, 0, false},
+		{240, 46, 2, 5520, 0, false},
+		{65534, 32768, 2, 32768, 0, false},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.a.GCD(tc.b); got != tc.gcd {
+			t.Errorf("Uint16(%d).GCD(%d) = %d, want %d", tc.a, tc.b, got, tc.gcd)
+		}
+		if got := tc.a.LCM(tc.b); got != tc.lcm {
+			t.Errorf("Uint16(%d).LCM(%d) = %d, want %d", tc.a, tc.b, got, tc.lcm)
+		}
+		g, x, y := tc.a.ExtendedGCD(tc.b)
+		if g != tc.gcd || int64(tc.a)*int64(x)+int64(tc.b)*int64(y) != int64(g) {
+			t.Errorf("Uint16(%d).ExtendedGCD(%d) = (%d, %d, %d), want g = %d", tc.a, tc.b, g, x, y, tc.gcd)
+		}
+		if inv, ok := tc.a.ModInverse(tc.b); inv != tc.inv || ok != tc.ok {
+			t.Errorf("Uint16(%d).ModInverse(%d) = (%d, %t), want (%d, %t)", tc.a, tc.b, inv, ok, tc.inv, tc.ok)
+		}
+	}
+}
+
+func TestUint16_Sqrt(t *testing.T) {
+	testCases := []struct {
+		a        Uint16
+		sqrt     Uint16
+		cbrt     Uint16
+		root5    Uint16
+		isSquare bool
+	}{
+		{0, 0, 0, 0, true},
+		{1, 1, 1, 1, true},
+		{2, 1, 1, 1, false},
+		{3, 1, 1, 1, false},
+		{4, 2, 1, 1, true},
+		{15, 3, 2, 1, false},
+		{16, 4, 2, 1, true},
+		{17, 4, 2, 1, false},
+		{26, 5, 2, 1, false},
+		{27, 5, 3, 1, false},
+		{28, 5, 3, 1, false},
+		{243, 15, 6, 3, false},
+		{1000, 31, 10, 3, false},
+		{1024, 32, 10, 4, true},
+		{24910, 157, 29, 7, false},
+		{65534, 255, 40, 9, false},
+		{65535, 255, 40, 9, false},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.a.Sqrt(); got != tc.sqrt {
+			t.Errorf("Uint16(%d).Sqrt() = %d, want %d", tc.a, got, tc.sqrt)
+		}
+		if got := tc.a.Cbrt(); got != tc.cbrt {
+			t.Errorf("Uint16(%d).Cbrt() = %d, want %d", tc.a, got, tc.cbrt)
+		}
+		if got := tc.a.Root(5); got != tc.root5 {
+			t.Errorf("Uint16(%d).Root(5) = %d, want %d", tc.a, got, tc.root5)
+		}
+		if got := tc.a.IsPerfectSquare(); got != tc.isSquare {
+			t.Errorf("Uint16(%d).IsPerfectSquare() = %t, want %t", tc.a, got, tc.isSquare)
+		}
+	}
+}
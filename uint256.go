@@ -2,19 +2,66 @@ package ints
 
 import (
 	"cmp"
+	"encoding/binary"
 	"fmt"
+	"math"
+	"math/big"
 	"math/bits"
 )
 
 // Uint256 is a type that represents an 256-bit unsigned integer.
 type Uint256 [4]uint64
 
+// MaxUint256 is the maximum value of Uint256.
+var MaxUint256 = Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+
+// Uint256FromUint64 returns v as a Uint256.
+func Uint256FromUint64(v uint64) Uint256 {
+	return Uint256{0, 0, 0, v}
+}
+
+// Uint256Of returns v as a Uint256.
+// v is converted in the same way as Go's integer conversions:
+// it is sign-extended if T is a signed type, and zero-extended otherwise.
+func Uint256Of[T builtinInteger](v T) Uint256 {
+	if isSigned[T]() {
+		return Int64(v).Uint256()
+	}
+	return Uint64(v).Uint256()
+}
+
 // IsZero returns true if a is zero.
 func (a Uint256) IsZero() bool {
 	var zero Uint256
 	return a == zero
 }
 
+// IsInt64 reports whether a can be represented as an int64.
+func (a Uint256) IsInt64() bool {
+	_, ok := a.TryInt64()
+	return ok
+}
+
+// IsUint64 reports whether a can be represented as a uint64.
+func (a Uint256) IsUint64() bool {
+	_, ok := a.TryUint64()
+	return ok
+}
+
+// Int returns a as an int and reports whether a can be represented as an int.
+// If it can't, the result is a truncated to the size of int.
+func (a Uint256) Int() (int, bool) {
+	v, ok := a.TryInt64()
+	return int(v), ok && int64(int(v)) == int64(v)
+}
+
+// Uint returns a as a uint and reports whether a can be represented as a uint.
+// If it can't, the result is a truncated to the size of uint.
+func (a Uint256) Uint() (uint, bool) {
+	v, ok := a.TryUint64()
+	return uint(v), ok && uint64(uint(v)) == uint64(v)
+}
+
 // Add returns the sum a+b.
 //
 // This function's execution time does not depend on the inputs.
@@ -26,6 +73,40 @@ func (a Uint256) Add(b Uint256) Uint256 {
 	return Uint256{u0, u1, u2, u3}
 }
 
+// AddOverflow returns the sum a+b and reports whether the addition overflowed.
+//
+// This function's execution time does not depend on the inputs.
+func (a Uint256) AddOverflow(b Uint256) (Uint256, bool) {
+	u3, carry := bits.Add64(a[3], b[3], 0)
+	u2, carry := bits.Add64(a[2], b[2], carry)
+	u1, carry := bits.Add64(a[1], b[1], carry)
+	u0, carry := bits.Add64(a[0], b[0], carry)
+	return Uint256{u0, u1, u2, u3}, carry != 0
+}
+
+// SaturatingAdd returns the sum a+b.
+// If the result overflows, it is clamped to the maximum value.
+func (a Uint256) SaturatingAdd(b Uint256) Uint256 {
+	c, overflow := a.AddOverflow(b)
+	if overflow {
+		return Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return c
+}
+
+// AddCarry returns the sum with carry of a, b and carry: sum = a + b + carry.
+// The carry input must be 0 or 1; otherwise the behavior is undefined.
+// The carryOut output is guaranteed to be 0 or 1.
+//
+// This function's execution time does not depend on the inputs.
+func (a Uint256) AddCarry(b Uint256, carry uint) (sum Uint256, carryOut uint) {
+	u3, c := bits.Add64(a[3], b[3], uint64(carry))
+	u2, c := bits.Add64(a[2], b[2], c)
+	u1, c := bits.Add64(a[1], b[1], c)
+	u0, c := bits.Add64(a[0], b[0], c)
+	return Uint256{u0, u1, u2, u3}, uint(c)
+}
+
 // Sub returns the difference a-b.
 //
 // This function's execution time does not depend on the inputs.
@@ -37,6 +118,40 @@ func (a Uint256) Sub(b Uint256) Uint256 {
 	return Uint256{u0, u1, u2, u3}
 }
 
+// SubOverflow returns the difference a-b and reports whether the subtraction overflowed.
+//
+// This function's execution time does not depend on the inputs.
+func (a Uint256) SubOverflow(b Uint256) (Uint256, bool) {
+	u3, borrow := bits.Sub64(a[3], b[3], 0)
+	u2, borrow := bits.Sub64(a[2], b[2], borrow)
+	u1, borrow := bits.Sub64(a[1], b[1], borrow)
+	u0, borrow := bits.Sub64(a[0], b[0], borrow)
+	return Uint256{u0, u1, u2, u3}, borrow != 0
+}
+
+// SaturatingSub returns the difference a-b.
+// If the result overflows, it is clamped to zero.
+func (a Uint256) SaturatingSub(b Uint256) Uint256 {
+	c, overflow := a.SubOverflow(b)
+	if overflow {
+		return Uint256{}
+	}
+	return c
+}
+
+// SubBorrow returns the difference of a, b and borrow: diff = a - b - borrow.
+// The borrow input must be 0 or 1; otherwise the behavior is undefined.
+// The borrowOut output is guaranteed to be 0 or 1.
+//
+// This function's execution time does not depend on the inputs.
+func (a Uint256) SubBorrow(b Uint256, borrow uint) (diff Uint256, borrowOut uint) {
+	u3, c := bits.Sub64(a[3], b[3], uint64(borrow))
+	u2, c := bits.Sub64(a[2], b[2], c)
+	u1, c := bits.Sub64(a[1], b[1], c)
+	u0, c := bits.Sub64(a[0], b[0], c)
+	return Uint256{u0, u1, u2, u3}, uint(c)
+}
+
 // Mul returns the product a*b.
 func (a Uint256) Mul(b Uint256) Uint256 {
 	//                  a0  a1  a2  a3
@@ -105,6 +220,29 @@ func (a Uint256) Mul(b Uint256) Uint256 {
 	return Uint256{u0, u1, u2, u3}
 }
 
+// MulOverflow returns the product a*b and reports whether the multiplication overflowed.
+func (a Uint256) MulOverflow(b Uint256) (Uint256, bool) {
+	c := a.Mul512(b)
+	return Uint256{c[4], c[5], c[6], c[7]}, c[0]|c[1]|c[2]|c[3] != 0
+}
+
+// SaturatingMul returns the product a*b.
+// If the result overflows, it is clamped to the maximum value.
+func (a Uint256) SaturatingMul(b Uint256) Uint256 {
+	c, overflow := a.MulOverflow(b)
+	if overflow {
+		return Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return c
+}
+
+// MulFull returns the 512-bit product of a and b: (hi, lo) = a * b
+// with the product bits' upper half returned in hi and the lower half returned in lo.
+func (a Uint256) MulFull(b Uint256) (hi, lo Uint256) {
+	c := a.Mul512(b)
+	return Uint256{c[0], c[1], c[2], c[3]}, Uint256{c[4], c[5], c[6], c[7]}
+}
+
 // Mul512 returns the product a*b, the result is a 512-bit integer.
 func (a Uint256) Mul512(b Uint256) Uint512 {
 	//                  a0  a1  a2  a3
@@ -263,10 +401,10 @@ func (a Uint256) DivMod(b Uint256) (Uint256, Uint256) {
 // https://github.com/golang/go/blob/c893e1cf821b06aa0602f7944ce52f0eb28fd7b5/src/math/bits/bits.go#L514-L568
 func div128(hi, lo, y Uint128) (quo, rem Uint128) {
 	if y.IsZero() {
-		panic("division by zero")
+		panicDivideByZero()
 	}
 	if y.Cmp(hi) <= 0 {
-		panic("division overflow")
+		panicDivideOverflow()
 	}
 
 	// If high part is zero, we can directly return the results.
@@ -340,6 +478,283 @@ func (a Uint256) QuoRem(b Uint256) (Uint256, Uint256) {
 	return a.DivMod(b)
 }
 
+// Div512 returns the quotient and remainder of (hi, lo) divided by a:
+// quo = (hi, lo)/a, rem = (hi, lo)%a with the dividend bits' upper half
+// in parameter hi and the lower half in parameter lo.
+// Div512 panics for a == 0 (division by zero) or a <= hi (quotient overflow).
+// Like [math/bits.Div64], it panics with a [runtime.Error].
+func (a Uint256) Div512(hi, lo Uint256) (quo, rem Uint256) {
+	return div256(hi, lo, a)
+}
+
+// AddMod returns (a+b) mod m.
+// It panics if m == 0.
+func (a Uint256) AddMod(b, m Uint256) Uint256 {
+	a, b = a.Mod(m), b.Mod(m)
+	s, carry := a.AddCarry(b, 0)
+	if carry != 0 || s.Cmp(m) >= 0 {
+		s = s.Sub(m)
+	}
+	return s
+}
+
+// SubMod returns (a-b) mod m.
+// The result is always in the range [0, m).
+// It panics if m == 0.
+func (a Uint256) SubMod(b, m Uint256) Uint256 {
+	a, b = a.Mod(m), b.Mod(m)
+	d, borrow := a.SubBorrow(b, 0)
+	if borrow != 0 {
+		d = d.Add(m)
+	}
+	return d
+}
+
+// MulMod returns (a*b) mod m, computed without overflow.
+// It panics if m == 0.
+func (a Uint256) MulMod(b, m Uint256) Uint256 {
+	hi, lo := a.MulFull(b)
+	_, r := m.Div512(hi.Mod(m), lo)
+	return r
+}
+
+// ExpMod returns a**e mod m.
+// It panics if m == 0.
+func (a Uint256) ExpMod(e, m Uint256) Uint256 {
+	r := Uint256{0, 0, 0, 1}.Mod(m)
+	a = a.Mod(m)
+	for i := e.BitLen() - 1; i >= 0; i-- {
+		r = r.MulMod(r, m)
+		if e.Bit(i) != 0 {
+			r = r.MulMod(a, m)
+		}
+	}
+	return r
+}
+
+// GCD returns the greatest common divisor of a and b.
+// GCD(a, 0) is a, and GCD(0, 0) is 0.
+// It uses the binary GCD algorithm.
+func (a Uint256) GCD(b Uint256) Uint256 {
+	if a.IsZero() {
+		return b
+	}
+	if b.IsZero() {
+		return a
+	}
+	i, j := a.TrailingZeros(), b.TrailingZeros()
+	k := min(i, j)
+	a, b = a.Rsh(uint(i)), b.Rsh(uint(j))
+	for {
+		// a and b are odd here.
+		if a.Cmp(b) > 0 {
+			a, b = b, a
+		}
+		b = b.Sub(a)
+		if b.IsZero() {
+			return a.Lsh(uint(k))
+		}
+		b = b.Rsh(uint(b.TrailingZeros()))
+	}
+}
+
+// LCM returns the least common multiple of a and b.
+// LCM(a, 0) is 0. The result wraps around on overflow.
+func (a Uint256) LCM(b Uint256) Uint256 {
+	if a.IsZero() || b.IsZero() {
+		return Uint256{}
+	}
+	return a.Div(a.GCD(b)).Mul(b)
+}
+
+// ExtendedGCD returns the greatest common divisor g of a and b,
+// and the Bézout coefficients x and y that satisfy a*x + b*y = g.
+// The coefficients satisfy |x| <= b/(2g) + 1 and |y| <= a/(2g) + 1,
+// so they always fit in [Int256].
+// It uses the binary GCD algorithm and doesn't perform any division.
+func (a Uint256) ExtendedGCD(b Uint256) (g Uint256, x, y Int256) {
+	if a.IsZero() {
+		return b, Int256{}, Int256{0, 0, 0, 1}
+	}
+	if b.IsZero() {
+		return a, Int256{0, 0, 0, 1}, Int256{}
+	}
+
+	// Remove the common factors of 2, so that at least one of a and b is odd.
+	// The coefficients for a/2**k and b/2**k also work for a and b.
+	k := uint(min(a.TrailingZeros(), b.TrailingZeros()))
+	a, b = a.Rsh(k), b.Rsh(k)
+	if b[3]&1 == 0 {
+		g, y, x = b.ExtendedGCD(a)
+		return g.Lsh(k), x, y
+	}
+
+	// Divide a and b by their GCD h, which is odd because b is odd.
+	// The divisions are exact, so they are the multiplications by the inverse of h modulo 2**256.
+	h := a.GCD(b)
+	hinv := inverseOdd256(h)
+	a, b = a.Mul(hinv), b.Mul(hinv)
+
+	// Now a and b are coprime and b is odd, so x = a**-1 mod b exists.
+	// Choose x in the range (-b/2, b/2) to minimize |x| and |y|.
+	// y = (1 - a*x)/b is an exact division again.
+	u, _ := modInverseOdd256(a, b)
+	if u.Cmp(b.Rsh(1)) > 0 {
+		u = u.Sub(b)
+	}
+	v := Uint256{0, 0, 0, 1}.Sub(a.Mul(u)).Mul(inverseOdd256(b))
+	return h.Lsh(k), Int256(u), Int256(v)
+}
+
+// ModInverse returns the multiplicative inverse of a modulo m, in the range [0, m).
+// ok is false if a and m are not coprime, or m == 0.
+// It uses the binary GCD algorithm and doesn't perform any division.
+func (a Uint256) ModInverse(m Uint256) (x Uint256, ok bool) {
+	if m.IsZero() {
+		return Uint256{}, false
+	}
+	if m[3]&1 != 0 {
+		return modInverseOdd256(a, m)
+	}
+
+	g, s, _ := a.ExtendedGCD(m)
+	if g != (Uint256{0, 0, 0, 1}) {
+		return Uint256{}, false
+	}
+	if s.Sign() < 0 {
+		return Uint256(s).Add(m), true
+	}
+	return Uint256(s), true
+}
+
+// modInverseOdd256 returns the multiplicative inverse of a modulo odd m, in the range [0, m).
+// ok is false if a and m are not coprime.
+func modInverseOdd256(a, m Uint256) (x Uint256, ok bool) {
+	if m == (Uint256{0, 0, 0, 1}) {
+		return Uint256{}, true
+	}
+
+	// It keeps the invariants a*x1 = u and a*x2 = v (mod m).
+	half := func(x Uint256) Uint256 {
+		if x[3]&1 == 0 {
+			return x.Rsh(1)
+		}
+		s, carry := x.AddCarry(m, 0)
+		s = s.Rsh(1)
+		s[0] |= uint64(carry) << 63
+		return s
+	}
+	sub := func(x, y Uint256) Uint256 {
+		d, borrow := x.SubBorrow(y, 0)
+		if borrow != 0 {
+			d = d.Add(m)
+		}
+		return d
+	}
+	u, v := a, m
+	x1, x2 := Uint256{0, 0, 0, 1}, Uint256{}
+	for !u.IsZero() {
+		for u[3]&1 == 0 {
+			u, x1 = u.Rsh(1), half(x1)
+		}
+		for v[3]&1 == 0 {
+			v, x2 = v.Rsh(1), half(x2)
+		}
+		if u.Cmp(v) >= 0 {
+			u, x1 = u.Sub(v), sub(x1, x2)
+		} else {
+			v, x2 = v.Sub(u), sub(x2, x1)
+		}
+	}
+	if v != (Uint256{0, 0, 0, 1}) {
+		return Uint256{}, false
+	}
+	return x2, true
+}
+
+// inverseOdd256 returns the multiplicative inverse of odd a modulo 2**256.
+func inverseOdd256(a Uint256) Uint256 {
+	// Newton's method: each iteration doubles the number of correct low bits,
+	// and x = a is correct to 3 bits because a*a = 1 mod 8 for any odd a.
+	x := a
+	for i := 3; i < 256; i *= 2 {
+		x = x.Mul(Uint256{0, 0, 0, 2}.Sub(a.Mul(x)))
+	}
+	return x
+}
+
+// Sqrt returns the floor square root of a, ⌊√a⌋.
+// It uses Newton's method seeded from [Uint256.BitLen].
+func (a Uint256) Sqrt() Uint256 {
+	if a.Cmp(Uint256{0, 0, 0, 1}) <= 0 {
+		return a
+	}
+	x := Uint256{0, 0, 0, 1}.Lsh(uint(a.BitLen()+1) / 2)
+	for {
+		y := x.Add(a.Div(x)).Rsh(1)
+		if y.Cmp(x) >= 0 {
+			return x
+		}
+		x = y
+	}
+}
+
+// Cbrt returns the floor cube root of a, ⌊∛a⌋.
+func (a Uint256) Cbrt() Uint256 {
+	return a.Root(3)
+}
+
+// Root returns the floor n-th root of a, ⌊a**(1/n)⌋.
+// It uses Newton's method seeded from [Uint256.BitLen].
+// It panics if n == 0.
+func (a Uint256) Root(n uint) Uint256 {
+	switch n {
+	case 0:
+		panic("ints: zeroth root")
+	case 1:
+		return a
+	case 2:
+		return a.Sqrt()
+	}
+	bitLen := uint(a.BitLen())
+	if bitLen <= n {
+		// a < 2**n, so the root is 0 or 1.
+		if a.IsZero() {
+			return a
+		}
+		return Uint256{0, 0, 0, 1}
+	}
+
+	x := Uint256{0, 0, 0, 1}.Lsh((bitLen + n - 1) / n)
+	n1, d := Uint256FromUint64(uint64(n-1)), Uint256FromUint64(uint64(n))
+	for {
+		// y = ((n-1)*x + a/x**(n-1)) / n
+		p, overflow := x, false
+		for i := uint(2); i < n && !overflow; i++ {
+			p, overflow = p.MulOverflow(x)
+		}
+		var q Uint256
+		if !overflow {
+			q = a.Div(p)
+		}
+		y := x.Mul(n1).Add(q).Div(d)
+		if y.Cmp(x) >= 0 {
+			return x
+		}
+		x = y
+	}
+}
+
+// IsPerfectSquare reports whether a is the square of an integer.
+func (a Uint256) IsPerfectSquare() bool {
+	// Squares modulo 16 are 0, 1, 4 or 9.
+	if (0x0213>>(a[3]&15))&1 == 0 {
+		return false
+	}
+	r := a.Sqrt()
+	return r.Mul(r) == a
+}
+
 // And returns the bitwise AND of a and b.
 func (a Uint256) And(b Uint256) Uint256 {
 	return Uint256{
@@ -412,6 +827,13 @@ func (a Uint256) Lsh(i uint) Uint256 {
 	}
 }
 
+// LshOverflow returns the left shift a<<i and reports whether the shift overflowed,
+// i.e. whether a<<i differs from a multiplied by 2**i.
+func (a Uint256) LshOverflow(i uint) (Uint256, bool) {
+	c := a.Lsh(i)
+	return c, c.Rsh(i) != a
+}
+
 // Rsh returns the logical right shift a>>i.
 //
 // This function's execution time does not depend on the inputs.
@@ -434,6 +856,118 @@ func (a Uint256) Rsh(i uint) Uint256 {
 	}
 }
 
+// URsh returns the logical right shift a>>i.
+// It is the same as [Uint256.Rsh] because Uint256 is unsigned.
+func (a Uint256) URsh(i uint) Uint256 {
+	return a.Rsh(i)
+}
+
+// RshRound returns a/2**i rounded to the nearest integer, with ties rounded to even.
+func (a Uint256) RshRound(i uint) Uint256 {
+	if i == 0 {
+		return a
+	}
+	q := a.Rsh(i)
+	half := a.Bit(int(min(i-1, 256)))
+	sticky := !a.Extract(0, i-1).IsZero()
+	if half == 1 && (sticky || q.Bit(0) == 1) {
+		q = q.Add(Uint256{0, 0, 0, 1})
+	}
+	return q
+}
+
+// RshCeil returns a/2**i rounded toward positive infinity.
+func (a Uint256) RshCeil(i uint) Uint256 {
+	q := a.Rsh(i)
+	if !a.Extract(0, i).IsZero() {
+		q = q.Add(Uint256{0, 0, 0, 1})
+	}
+	return q
+}
+
+// RotateLeft returns the value of a rotated left by (k mod 256) bits.
+// To rotate a right by k bits, call a.RotateLeft(-k).
+//
+// This function's execution time does not depend on the inputs.
+func (a Uint256) RotateLeft(k int) Uint256 {
+	s := uint(k) & 255
+	return a.Lsh(s).Or(a.Rsh(256 - s))
+}
+
+// Reverse returns the value of a with its bits in reversed order.
+func (a Uint256) Reverse() Uint256 {
+	return Uint256{
+		bits.Reverse64(a[3]),
+		bits.Reverse64(a[2]),
+		bits.Reverse64(a[1]),
+		bits.Reverse64(a[0]),
+	}
+}
+
+// ReverseBytes returns the value of a with its bytes in reversed order.
+func (a Uint256) ReverseBytes() Uint256 {
+	return Uint256{
+		bits.ReverseBytes64(a[3]),
+		bits.ReverseBytes64(a[2]),
+		bits.ReverseBytes64(a[1]),
+		bits.ReverseBytes64(a[0]),
+	}
+}
+
+// Bit returns the value of the i'th bit of a. That is, it returns (a>>i)&1.
+// The bit index i must be >= 0.
+func (a Uint256) Bit(i int) uint {
+	if i < 0 {
+		panic("ints: negative bit index")
+	}
+	if i >= 256 {
+		return 0
+	}
+	return uint(a[3-i/64]>>(uint(i)%64)) & 1
+}
+
+// SetBit returns a with its i'th bit set to b (0 or 1).
+// It panics if i is out of the range [0, 256) or b is not 0 or 1.
+func (a Uint256) SetBit(i int, b uint) Uint256 {
+	checkBitIndex(i, 256)
+	if b > 1 {
+		panic("ints: set bit is not 0 or 1")
+	}
+	j, s := 3-i/64, uint(i)%64
+	a[j] = a[j]&^(1<<s) | uint64(b)<<s
+	return a
+}
+
+// ClearBit returns a with its i'th bit set to 0.
+// It panics if i is out of the range [0, 256).
+func (a Uint256) ClearBit(i int) Uint256 {
+	checkBitIndex(i, 256)
+	a[3-i/64] &^= 1 << (uint(i) % 64)
+	return a
+}
+
+// FlipBit returns a with its i'th bit inverted.
+// It panics if i is out of the range [0, 256).
+func (a Uint256) FlipBit(i int) Uint256 {
+	checkBitIndex(i, 256)
+	a[3-i/64] ^= 1 << (uint(i) % 64)
+	return a
+}
+
+// Extract returns the width bits of a starting at bit lo, shifted down to bit 0.
+// That is, it returns (a>>lo) & (1<<width - 1).
+func (a Uint256) Extract(lo, width uint) Uint256 {
+	width = min(width, 256)
+	return a.Rsh(lo).And(Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}.Rsh(256 - width))
+}
+
+// Insert returns a with the width bits starting at bit lo replaced by the low width bits of v.
+func (a Uint256) Insert(v Uint256, lo, width uint) Uint256 {
+	width = min(width, 256)
+	mask := Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}.Rsh(256 - width).Lsh(lo)
+	return a.AndNot(mask).Or(v.Lsh(lo).And(mask))
+}
+
 // LeadingZeros returns the number of leading zero bits in a; the result is 256 for a == 0.
 func (a Uint256) LeadingZeros() int {
 	if a[0] != 0 {
@@ -476,6 +1010,14 @@ func (a Uint256) BitLen() int {
 	return bits.Len64(a[3])
 }
 
+// OnesCount returns the number of one bits ("population count") in a.
+func (a Uint256) OnesCount() int {
+	return bits.OnesCount64(a[0]) +
+		bits.OnesCount64(a[1]) +
+		bits.OnesCount64(a[2]) +
+		bits.OnesCount64(a[3])
+}
+
 // Sign returns the sign of a.
 // It returns 1 if a > 0, and 0 if a == 0.
 // It does not return -1 because Uint256 is unsigned.
@@ -498,6 +1040,14 @@ func (a Uint256) Neg() Uint256 {
 	return Uint256{u0, u1, u2, u3}
 }
 
+// NegOverflow returns the negation of a and reports whether the negation overflowed,
+// which happens if a is not zero.
+//
+// This function's execution time does not depend on the inputs.
+func (a Uint256) NegOverflow() (Uint256, bool) {
+	return a.Neg(), !a.IsZero()
+}
+
 // Cmp returns the comparison result of a and b.
 // It returns -1 if a < b, 0 if a == b, and 1 if a > b.
 func (a Uint256) Cmp(b Uint256) int {
@@ -544,3 +1094,174 @@ func (a Uint256) String() string {
 func (a Uint256) Format(s fmt.State, verb rune) {
 	format(s, verb, a.Sign(), a)
 }
+
+// ParseUint256 is like [ParseInt256] but for unsigned numbers.
+// A sign prefix is not permitted.
+func ParseUint256(s string, base int) (Uint256, error) {
+	var z Uint256
+	err := parseUint("ParseUint256", s, base, 256, z[:])
+	return z, err
+}
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+// The result is the decimal representation of a.
+func (a Uint256) MarshalText() ([]byte, error) {
+	return a.AppendText(nil)
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+// It accepts the same syntax as Go integer literals, such as "0x" prefixes and underscores.
+// See [ParseUint256] for more details.
+func (a *Uint256) UnmarshalText(text []byte) error {
+	v, err := ParseUint256(string(text), 0)
+	if err != nil {
+		return err
+	}
+	*a = v
+	return nil
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+// The result is a JSON number. Use [JSONString] to encode a as a JSON string.
+func (a Uint256) MarshalJSON() ([]byte, error) {
+	return a.AppendText(nil)
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+// It accepts both a JSON number such as 123 and a JSON string such as "123" or "0x7b".
+// JSON strings are parsed in the same way as [Uint256.UnmarshalText].
+func (a *Uint256) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(a, data, ParseUint256)
+}
+
+// BytesBE returns the big-endian representation of a.
+func (a Uint256) BytesBE() [32]byte {
+	var b [32]byte
+	a.PutBytesBE(b[:])
+	return b
+}
+
+// BytesLE returns the little-endian representation of a.
+func (a Uint256) BytesLE() [32]byte {
+	var b [32]byte
+	a.PutBytesLE(b[:])
+	return b
+}
+
+// PutBytesBE stores the big-endian representation of a into b.
+// It panics if len(b) < 32.
+func (a Uint256) PutBytesBE(b []byte) {
+	_ = b[31] // bounds check hint to compiler; see golang.org/issue/14808
+	binary.BigEndian.PutUint64(b[0:], a[0])
+	binary.BigEndian.PutUint64(b[8:], a[1])
+	binary.BigEndian.PutUint64(b[16:], a[2])
+	binary.BigEndian.PutUint64(b[24:], a[3])
+}
+
+// PutBytesLE stores the little-endian representation of a into b.
+// It panics if len(b) < 32.
+func (a Uint256) PutBytesLE(b []byte) {
+	_ = b[31] // bounds check hint to compiler; see golang.org/issue/14808
+	binary.LittleEndian.PutUint64(b[0:], a[3])
+	binary.LittleEndian.PutUint64(b[8:], a[2])
+	binary.LittleEndian.PutUint64(b[16:], a[1])
+	binary.LittleEndian.PutUint64(b[24:], a[0])
+}
+
+// Uint256FromBytesBE returns the Uint256 represented by the big-endian bytes b[:32].
+// It panics if len(b) < 32.
+func Uint256FromBytesBE(b []byte) Uint256 {
+	_ = b[31] // bounds check hint to compiler; see golang.org/issue/14808
+	return Uint256{
+		binary.BigEndian.Uint64(b[0:]),
+		binary.BigEndian.Uint64(b[8:]),
+		binary.BigEndian.Uint64(b[16:]),
+		binary.BigEndian.Uint64(b[24:]),
+	}
+}
+
+// Uint256FromBytesLE returns the Uint256 represented by the little-endian bytes b[:32].
+// It panics if len(b) < 32.
+func Uint256FromBytesLE(b []byte) Uint256 {
+	_ = b[31] // bounds check hint to compiler; see golang.org/issue/14808
+	return Uint256{
+		binary.LittleEndian.Uint64(b[24:]),
+		binary.LittleEndian.Uint64(b[16:]),
+		binary.LittleEndian.Uint64(b[8:]),
+		binary.LittleEndian.Uint64(b[0:]),
+	}
+}
+
+// AppendBinary implements the [encoding.BinaryAppender] interface.
+// It appends the big-endian representation of a to dst.
+func (a Uint256) AppendBinary(dst []byte) ([]byte, error) {
+	b := a.BytesBE()
+	return append(dst, b[:]...), nil
+}
+
+// MarshalBinary implements the [encoding.BinaryMarshaler] interface.
+// The result is the 32-byte big-endian representation of a.
+func (a Uint256) MarshalBinary() ([]byte, error) {
+	return a.AppendBinary(make([]byte, 0, 32))
+}
+
+// UnmarshalBinary implements the [encoding.BinaryUnmarshaler] interface.
+// data must be exactly 32 bytes of the big-endian representation, as generated by [Uint256.MarshalBinary].
+func (a *Uint256) UnmarshalBinary(data []byte) error {
+	if len(data) != 32 {
+		return fmt.Errorf("ints: Uint256.UnmarshalBinary: invalid length %d, want 32", len(data))
+	}
+	*a = Uint256FromBytesBE(data)
+	return nil
+}
+
+// BigInt returns a as a new [*big.Int].
+func (a Uint256) BigInt() *big.Int {
+	var w [256 / bits.UintSize]big.Word
+	return new(big.Int).SetBits(wordsFromLimbs(w[:], a[:]))
+}
+
+// Uint256FromBigInt returns x as an Uint256.
+// If x is not representable as an Uint256, the result is the lower 256 bits of x in two's complement,
+// and ok is false.
+func Uint256FromBigInt(x *big.Int) (Uint256, bool) {
+	var z Uint256
+	ok := uintFromBigInt(z[:], 256, x)
+	return z, ok
+}
+
+// Float64 returns the float64 value nearest to a, rounding ties to even, and an indication of any rounding error.
+// If a is too large to be represented by a float64, the result is ±Inf.
+func (a Uint256) Float64() (float64, big.Accuracy) {
+	return float64FromLimbs(a[:])
+}
+
+// Uint256FromFloat64 returns the integer value of f rounded according to mode,
+// and the accuracy of the result: [big.Below] if the result is less than f, [big.Above] if it is greater than f.
+// Use [big.ToZero] to truncate f like the Go conversion, and [big.ToNearestEven] to round f to the nearest integer.
+//
+// If f is NaN, the result is 0 and the error is [ErrNaN].
+// If the rounded value of f is not representable as an Uint256, including ±Inf,
+// the result is clamped to 0 or the maximum value of Uint256, and the error is [ErrOverflow].
+func Uint256FromFloat64(f float64, mode big.RoundingMode) (Uint256, big.Accuracy, error) {
+	var z Uint256
+	acc, err := uintFromFloat64(z[:], 256, f, mode)
+	return z, acc, err
+}
+
+// BigFloat returns a as a new [*big.Float].
+// The precision of the result is 256 bits, so the conversion is always exact.
+func (a Uint256) BigFloat() *big.Float {
+	return new(big.Float).SetPrec(256).SetInt(a.BigInt())
+}
+
+// Uint256FromBigFloat returns the integer value of x rounded according to mode,
+// and the accuracy of the result: [big.Below] if the result is less than x, [big.Above] if it is greater than x.
+//
+// If the rounded value of x is not representable as an Uint256, including ±Inf,
+// the result is clamped to 0 or the maximum value of Uint256, and the error is [ErrOverflow].
+func Uint256FromBigFloat(x *big.Float, mode big.RoundingMode) (Uint256, big.Accuracy, error) {
+	var z Uint256
+	acc, err := uintFromBigFloat(z[:], 256, x, mode)
+	return z, acc, err
+}
@@ -1,10 +1,15 @@
 package ints
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
 	"math/big"
 	"runtime"
+	"slices"
+	"strconv"
 	"testing"
 )
 
@@ -773,3 +778,1274 @@ func TestUint256_Format(t *testing.T) {
 		}
 	}
 }
+
+func TestParseUint256(t *testing.T) {
+	testCases := []struct {
+		s    string
+		base int
+		want Uint256
+		err  error
+	}{
+		{"0", 10, Uint256{0, 0, 0, 0}, nil},
+		{"1", 10, Uint256{0, 0, 0, 0x1}, nil},
+		{"115792089237316195423570985008687907853269984665640564039457584007913129639935", 10, Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, nil},
+		{"115792089237316195423570985008687907853269984665640564039457584007913129639936", 10, Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, strconv.ErrRange},
+		{"0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", 0, Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, nil},
+		{"0b101", 0, Uint256{0, 0, 0, 0x5}, nil},
+		{"0o17", 0, Uint256{0, 0, 0, 0xf}, nil},
+		{"017", 0, Uint256{0, 0, 0, 0xf}, nil},
+		{"1_000", 0, Uint256{0, 0, 0, 0x3e8}, nil},
+		{"1_000", 10, Uint256{0, 0, 0, 0}, strconv.ErrSyntax},
+		{"_1", 0, Uint256{0, 0, 0, 0}, strconv.ErrSyntax},
+		{"Z", 62, Uint256{0, 0, 0, 0x3d}, nil},
+		{"z", 36, Uint256{0, 0, 0, 0x23}, nil},
+		{"Z", 36, Uint256{0, 0, 0, 0x23}, nil},
+		{"", 10, Uint256{0, 0, 0, 0}, strconv.ErrSyntax},
+		{"12a", 10, Uint256{0, 0, 0, 0}, strconv.ErrSyntax},
+		{"-1", 10, Uint256{0, 0, 0, 0}, strconv.ErrSyntax},
+		{"+1", 10, Uint256{0, 0, 0, 0}, strconv.ErrSyntax},
+	}
+
+	for _, tc := range testCases {
+		got, err := ParseUint256(tc.s, tc.base)
+		if got != tc.want {
+			t.Errorf("ParseUint256(%q, %d) = %d, want %d", tc.s, tc.base, got, tc.want)
+		}
+		if !errors.Is(err, tc.err) {
+			t.Errorf("ParseUint256(%q, %d) error = %v, want %v", tc.s, tc.base, err, tc.err)
+		}
+		if err != nil {
+			var numErr *strconv.NumError
+			if !errors.As(err, &numErr) || numErr.Func != "ParseUint256" || numErr.Num != tc.s {
+				t.Errorf("ParseUint256(%q, %d) error = %#v, want *strconv.NumError", tc.s, tc.base, err)
+			}
+		}
+	}
+
+	if _, err := ParseUint256("1", 63); err == nil {
+		t.Errorf("ParseUint256(%q, %d) should fail", "1", 63)
+	}
+}
+
+func FuzzParseUint256(f *testing.F) {
+	f.Add(uint64(0), uint64(0), uint64(0), uint64(0), 10)
+	f.Add(uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), 16)
+	f.Add(uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), 62)
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3 uint64, base int) {
+		if base < 2 || base > 62 {
+			return
+		}
+		a := Uint256{u0, u1, u2, u3}
+		s := uint256ToBigInt(a).Text(base)
+		got, err := ParseUint256(s, base)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != a {
+			t.Errorf("ParseUint256(%q, %d) = %d, want %d", s, base, got, a)
+		}
+	})
+}
+
+func TestUint256_MarshalText(t *testing.T) {
+	testCases := []struct {
+		x    Uint256
+		want string
+	}{
+		{Uint256{0, 0, 0, 0}, "0"},
+		{Uint256{0, 0, 0, 0x1}, "1"},
+		{Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, "115792089237316195423570985008687907853269984665640564039457584007913129639935"},
+	}
+
+	for _, tc := range testCases {
+		got, err := tc.x.MarshalText()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != tc.want {
+			t.Errorf("Uint256(%d).MarshalText() = %q, want %q", tc.x, got, tc.want)
+		}
+	}
+}
+
+func TestUint256_UnmarshalText(t *testing.T) {
+	testCases := []struct {
+		s    string
+		want Uint256
+		err  error
+	}{
+		{"0", Uint256{0, 0, 0, 0}, nil},
+		{"0x10", Uint256{0, 0, 0, 0x10}, nil},
+		{"0b1_0", Uint256{0, 0, 0, 0x2}, nil},
+		{"010", Uint256{0, 0, 0, 0x8}, nil},
+		{"115792089237316195423570985008687907853269984665640564039457584007913129639935", Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, nil},
+		{"115792089237316195423570985008687907853269984665640564039457584007913129639936", Uint256{0, 0, 0, 0}, strconv.ErrRange},
+		{"1.0", Uint256{0, 0, 0, 0}, strconv.ErrSyntax},
+	}
+
+	for _, tc := range testCases {
+		var got Uint256
+		err := got.UnmarshalText([]byte(tc.s))
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Uint256.UnmarshalText(%q) error = %v, want %v", tc.s, err, tc.err)
+		}
+		if got != tc.want {
+			t.Errorf("Uint256.UnmarshalText(%q) = %d, want %d", tc.s, got, tc.want)
+		}
+	}
+}
+
+func TestUint256_MarshalJSON(t *testing.T) {
+	testCases := []struct {
+		x    Uint256
+		want string
+	}{
+		{Uint256{0, 0, 0, 0}, "0"},
+		{Uint256{0, 0, 0, 0x1}, "1"},
+		{Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, "115792089237316195423570985008687907853269984665640564039457584007913129639935"},
+	}
+
+	for _, tc := range testCases {
+		got, err := json.Marshal(tc.x)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != tc.want {
+			t.Errorf("json.Marshal(Uint256(%d)) = %s, want %s", tc.x, got, tc.want)
+		}
+	}
+}
+
+func TestUint256_UnmarshalJSON(t *testing.T) {
+	testCases := []struct {
+		s       string
+		want    Uint256
+		wantErr bool
+	}{
+		{"0", Uint256{0, 0, 0, 0}, false},
+		{"123", Uint256{0, 0, 0, 0x7b}, false},
+		{"\"123\"", Uint256{0, 0, 0, 0x7b}, false},
+		{"\"0x7f\"", Uint256{0, 0, 0, 0x7f}, false},
+		{"115792089237316195423570985008687907853269984665640564039457584007913129639935", Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false},
+		{"\"115792089237316195423570985008687907853269984665640564039457584007913129639935\"", Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false},
+		{"115792089237316195423570985008687907853269984665640564039457584007913129639936", Uint256{0, 0, 0, 0}, true},
+		{"0x7f", Uint256{0, 0, 0, 0}, true},
+		{"\"abc\"", Uint256{0, 0, 0, 0}, true},
+		{"1.5", Uint256{0, 0, 0, 0}, true},
+		{"null", Uint256{0, 0, 0, 0}, false},
+	}
+
+	for _, tc := range testCases {
+		var got Uint256
+		err := json.Unmarshal([]byte(tc.s), &got)
+		if (err != nil) != tc.wantErr {
+			t.Errorf("json.Unmarshal(%s) error = %v, wantErr %t", tc.s, err, tc.wantErr)
+		}
+		if got != tc.want {
+			t.Errorf("json.Unmarshal(%s) = %d, want %d", tc.s, got, tc.want)
+		}
+	}
+}
+
+func TestUint256_Bytes(t *testing.T) {
+	testCases := []struct {
+		x  Uint256
+		be [32]byte
+	}{
+		{
+			Uint256{0x102030405060708, 0x90a0b0c0d0e0f10, 0x1112131415161718, 0x191a1b1c1d1e1f20},
+			[32]byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10, 0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18, 0x19, 0x1a, 0x1b, 0x1c, 0x1d, 0x1e, 0x1f, 0x20},
+		},
+		{
+			Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xfffffffffffffffe},
+			[32]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xfe},
+		},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.x.BytesBE(); got != tc.be {
+			t.Errorf("Uint256(%d).BytesBE() = %x, want %x", tc.x, got, tc.be)
+		}
+		if got := Uint256FromBytesBE(tc.be[:]); got != tc.x {
+			t.Errorf("Uint256FromBytesBE(%x) = %d, want %d", tc.be, got, tc.x)
+		}
+
+		le := tc.be
+		slices.Reverse(le[:])
+		if got := tc.x.BytesLE(); got != le {
+			t.Errorf("Uint256(%d).BytesLE() = %x, want %x", tc.x, got, le)
+		}
+		if got := Uint256FromBytesLE(le[:]); got != tc.x {
+			t.Errorf("Uint256FromBytesLE(%x) = %d, want %d", le, got, tc.x)
+		}
+
+		data, err := tc.x.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(data, tc.be[:]) {
+			t.Errorf("Uint256(%d).MarshalBinary() = %x, want %x", tc.x, data, tc.be)
+		}
+		var got Uint256
+		if err := got.UnmarshalBinary(data); err != nil {
+			t.Fatal(err)
+		}
+		if got != tc.x {
+			t.Errorf("Uint256.UnmarshalBinary(%x) = %d, want %d", data, got, tc.x)
+		}
+	}
+}
+
+func TestUint256_UnmarshalBinary_InvalidLength(t *testing.T) {
+	var a Uint256
+	if err := a.UnmarshalBinary(make([]byte, 31)); err == nil {
+		t.Error("want error, got nil")
+	}
+	if err := a.UnmarshalBinary(make([]byte, 33)); err == nil {
+		t.Error("want error, got nil")
+	}
+}
+
+func TestUint256FromBigInt(t *testing.T) {
+	testCases := []struct {
+		x    *big.Int
+		want Uint256
+		ok   bool
+	}{
+		{big.NewInt(0), Uint256{0, 0, 0, 0}, true},
+		{big.NewInt(1), Uint256{0, 0, 0, 0x1}, true},
+		{bigIntFromString("115792089237316195423570985008687907853269984665640564039457584007913129639935"), Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, true},
+		{bigIntFromString("115792089237316195423570985008687907853269984665640564039457584007913129639936"), Uint256{0, 0, 0, 0}, false},
+		{bigIntFromString("115792089237316195423570985008687907853269984665640564039457584007913129639936"), Uint256{0, 0, 0, 0}, false},
+		{big.NewInt(-1), Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false},
+	}
+
+	for _, tc := range testCases {
+		got, ok := Uint256FromBigInt(tc.x)
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint256FromBigInt(%s) = %d, %t, want %d, %t", tc.x, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func FuzzUint256_BigInt(f *testing.F) {
+	f.Add(uint64(0), uint64(0), uint64(0), uint64(0))
+	f.Add(uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64))
+	f.Add(uint64(1), uint64(0), uint64(0), uint64(0))
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3 uint64) {
+		a := Uint256{u0, u1, u2, u3}
+		got := a.BigInt()
+		want := uint256ToBigInt(a)
+		if got.Cmp(want) != 0 {
+			t.Errorf("Uint256(%d).BigInt() = %s, want %s", a, got, want)
+		}
+		if b, ok := Uint256FromBigInt(got); b != a || !ok {
+			t.Errorf("Uint256FromBigInt(%s) = %d, %t, want %d, true", got, b, ok, a)
+		}
+	})
+}
+
+func TestUint256FromFloat64(t *testing.T) {
+	testCases := []struct {
+		f    float64
+		mode big.RoundingMode
+		want Uint256
+		acc  big.Accuracy
+		err  error
+	}{
+		{0, big.ToNearestEven, Uint256{0, 0, 0, 0}, big.Exact, nil},
+		{2.5, big.ToNearestEven, Uint256{0, 0, 0, 0x2}, big.Below, nil},
+		{3.5, big.ToNearestEven, Uint256{0, 0, 0, 0x4}, big.Above, nil},
+		{2.5, big.ToNearestAway, Uint256{0, 0, 0, 0x3}, big.Above, nil},
+		{2.5, big.ToZero, Uint256{0, 0, 0, 0x2}, big.Below, nil},
+		{2.5, big.AwayFromZero, Uint256{0, 0, 0, 0x3}, big.Above, nil},
+		{2.5, big.ToNegativeInf, Uint256{0, 0, 0, 0x2}, big.Below, nil},
+		{2.5, big.ToPositiveInf, Uint256{0, 0, 0, 0x3}, big.Above, nil},
+		{math.NaN(), big.ToNearestEven, Uint256{0, 0, 0, 0}, big.Exact, ErrNaN},
+		{math.Inf(1), big.ToNearestEven, Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, big.Below, ErrOverflow},
+		{math.Inf(-1), big.ToNearestEven, Uint256{0, 0, 0, 0}, big.Above, ErrOverflow},
+		{0x1p256, big.ToNearestEven, Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, big.Below, ErrOverflow},
+		{0x1p255, big.ToNearestEven, Uint256{0x8000000000000000, 0, 0, 0}, big.Exact, nil},
+		{-0.5, big.ToZero, Uint256{0, 0, 0, 0}, big.Above, nil},
+		{-1, big.ToNearestEven, Uint256{0, 0, 0, 0}, big.Above, ErrOverflow},
+	}
+
+	for _, tc := range testCases {
+		got, acc, err := Uint256FromFloat64(tc.f, tc.mode)
+		if got != tc.want || acc != tc.acc || err != tc.err {
+			t.Errorf("Uint256FromFloat64(%v, %s) = %d, %s, %v, want %d, %s, %v", tc.f, tc.mode, got, acc, err, tc.want, tc.acc, tc.err)
+		}
+
+		if math.IsNaN(tc.f) {
+			continue
+		}
+		got, acc, err = Uint256FromBigFloat(big.NewFloat(tc.f), tc.mode)
+		if got != tc.want || acc != tc.acc || err != tc.err {
+			t.Errorf("Uint256FromBigFloat(%v, %s) = %d, %s, %v, want %d, %s, %v", tc.f, tc.mode, got, acc, err, tc.want, tc.acc, tc.err)
+		}
+	}
+}
+
+func FuzzUint256_Float64(f *testing.F) {
+	f.Add(uint64(0), uint64(0), uint64(0), uint64(0))
+	f.Add(uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64))
+	f.Add(uint64(0), uint64(0), uint64(0), uint64(1<<53+1))
+	f.Add(uint64(0), uint64(0), uint64(1), uint64(1<<11|1<<10))
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3 uint64) {
+		a := Uint256{u0, u1, u2, u3}
+		b := uint256ToBigInt(a)
+		got, acc := a.Float64()
+		want, wantAcc := new(big.Float).SetInt(b).Float64()
+		if got != want || acc != wantAcc {
+			t.Errorf("Uint256(%d).Float64() = %v, %s, want %v, %s", a, got, acc, want, wantAcc)
+		}
+
+		bf := a.BigFloat()
+		if c, _ := bf.Int(nil); c.Cmp(b) != 0 {
+			t.Errorf("Uint256(%d).BigFloat() = %v, want %v", a, bf, b)
+		}
+		if c, acc, err := Uint256FromBigFloat(bf, big.ToNearestEven); c != a || acc != big.Exact || err != nil {
+			t.Errorf("Uint256FromBigFloat(%v) = %d, %s, %v, want %d, %s, nil", bf, c, acc, err, a, big.Exact)
+		}
+	})
+}
+
+func FuzzUint256FromFloat64(f *testing.F) {
+	f.Add(0.0, uint8(big.ToNearestEven))
+	f.Add(-2.5, uint8(big.ToNearestEven))
+	f.Add(0x1p255, uint8(big.ToZero))
+	f.Add(0x1.fffffffffffffp255, uint8(big.ToPositiveInf))
+
+	f.Fuzz(func(t *testing.T, x float64, mode uint8) {
+		if math.IsNaN(x) || mode > uint8(big.ToPositiveInf) {
+			return
+		}
+		m := big.RoundingMode(mode)
+		got, acc, err := Uint256FromFloat64(x, m)
+		want, wantAcc, wantErr := Uint256FromBigFloat(big.NewFloat(x), m)
+		if got != want || acc != wantAcc || err != wantErr {
+			t.Errorf("Uint256FromFloat64(%v, %s) = %d, %s, %v, want %d, %s, %v", x, m, got, acc, err, want, wantAcc, wantErr)
+		}
+	})
+}
+
+func FuzzUint256_AddOverflow(f *testing.F) {
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(0), uint64(0), uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(0), uint64(0), uint64(0), uint64(1),
+	)
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(1),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(0), uint64(1), uint64(0), uint64(0),
+		uint64(0), uint64(1), uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0), uint64(0), uint64(0),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, v0, v1, v2, v3 uint64) {
+		a := Uint256{u0, u1, u2, u3}
+		b := Uint256{v0, v1, v2, v3}
+		got, overflow := a.AddOverflow(b)
+
+		want, ok := Uint256FromBigInt(new(big.Int).Add(uint256ToBigInt(a), uint256ToBigInt(b)))
+		if got != want || overflow == ok {
+			t.Errorf("Uint256(%d).AddOverflow(%d) = %d, %t, want %d, %t", a, b, got, overflow, want, !ok)
+		}
+	})
+}
+
+func FuzzUint256_SubOverflow(f *testing.F) {
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(0), uint64(0), uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(0), uint64(0), uint64(0), uint64(1),
+	)
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(1),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(0), uint64(1), uint64(0), uint64(0),
+		uint64(0), uint64(1), uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0), uint64(0), uint64(0),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, v0, v1, v2, v3 uint64) {
+		a := Uint256{u0, u1, u2, u3}
+		b := Uint256{v0, v1, v2, v3}
+		got, overflow := a.SubOverflow(b)
+
+		want, ok := Uint256FromBigInt(new(big.Int).Sub(uint256ToBigInt(a), uint256ToBigInt(b)))
+		if got != want || overflow == ok {
+			t.Errorf("Uint256(%d).SubOverflow(%d) = %d, %t, want %d, %t", a, b, got, overflow, want, !ok)
+		}
+	})
+}
+
+func FuzzUint256_MulOverflow(f *testing.F) {
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(0), uint64(0), uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(0), uint64(0), uint64(0), uint64(1),
+	)
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(1),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(0), uint64(1), uint64(0), uint64(0),
+		uint64(0), uint64(1), uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0), uint64(0), uint64(0),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, v0, v1, v2, v3 uint64) {
+		a := Uint256{u0, u1, u2, u3}
+		b := Uint256{v0, v1, v2, v3}
+		got, overflow := a.MulOverflow(b)
+
+		want, ok := Uint256FromBigInt(new(big.Int).Mul(uint256ToBigInt(a), uint256ToBigInt(b)))
+		if got != want || overflow == ok {
+			t.Errorf("Uint256(%d).MulOverflow(%d) = %d, %t, want %d, %t", a, b, got, overflow, want, !ok)
+		}
+	})
+}
+
+func TestUint256_NegOverflow(t *testing.T) {
+	testCases := []struct {
+		x        Uint256
+		want     Uint256
+		overflow bool
+	}{
+		{Uint256{0, 0, 0, 0}, Uint256{0, 0, 0, 0}, false},
+		{Uint256{0, 0, 0, 0x1}, Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, true},
+		{Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint256{0, 0, 0, 0x1}, true},
+	}
+
+	for _, tc := range testCases {
+		got, overflow := tc.x.NegOverflow()
+		if got != tc.want || overflow != tc.overflow {
+			t.Errorf("Uint256(%d).NegOverflow() = %d, %t, want %d, %t", tc.x, got, overflow, tc.want, tc.overflow)
+		}
+	}
+}
+
+func TestUint256_LshOverflow(t *testing.T) {
+	testCases := []struct {
+		x        Uint256
+		i        uint
+		want     Uint256
+		overflow bool
+	}{
+		{Uint256{0, 0, 0, 0x1}, 0, Uint256{0, 0, 0, 0x1}, false},
+		{Uint256{0, 0, 0, 0x1}, 254, Uint256{0x4000000000000000, 0, 0, 0}, false},
+		{Uint256{0, 0, 0, 0x1}, 255, Uint256{0x8000000000000000, 0, 0, 0}, false},
+		{Uint256{0, 0, 0, 0x1}, 256, Uint256{0, 0, 0, 0}, true},
+		{Uint256{0, 0, 0, 0x3}, 254, Uint256{0xc000000000000000, 0, 0, 0}, false},
+		{Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 1, Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xfffffffffffffffe}, true},
+		{Uint256{0, 0, 0, 0}, 266, Uint256{0, 0, 0, 0}, false},
+	}
+
+	for _, tc := range testCases {
+		got, overflow := tc.x.LshOverflow(tc.i)
+		if got != tc.want || overflow != tc.overflow {
+			t.Errorf("Uint256(%d).LshOverflow(%d) = %d, %t, want %d, %t", tc.x, tc.i, got, overflow, tc.want, tc.overflow)
+		}
+	}
+}
+
+func FuzzUint256_AddCarry(f *testing.F) {
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(0), uint64(0), uint64(0), uint64(0), uint(1),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(0), uint64(0), uint64(0), uint64(1), uint(1),
+	)
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(1),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint(1),
+	)
+	f.Add(
+		uint64(0), uint64(1), uint64(0), uint64(0),
+		uint64(0), uint64(1), uint64(0), uint64(0), uint(1),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint(1),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, v0, v1, v2, v3 uint64, carry uint) {
+		carry &= 1
+		a := Uint256{u0, u1, u2, u3}
+		b := Uint256{v0, v1, v2, v3}
+		sum, carryOut := a.AddCarry(b, carry)
+
+		want := new(big.Int).Add(uint256ToBigInt(a), uint256ToBigInt(b))
+		want.Add(want, new(big.Int).SetUint64(uint64(carry)))
+		wantSum, _ := Uint256FromBigInt(want)
+		wantCarry := want.Bit(256)
+		if sum != wantSum || carryOut != wantCarry {
+			t.Errorf("Uint256(%d).AddCarry(%d, %d) = %d, %d, want %d, %d", a, b, carry, sum, carryOut, wantSum, wantCarry)
+		}
+	})
+}
+
+func FuzzUint256_SubBorrow(f *testing.F) {
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(0), uint64(0), uint64(0), uint64(0), uint(1),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(0), uint64(0), uint64(0), uint64(1), uint(1),
+	)
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(1),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint(1),
+	)
+	f.Add(
+		uint64(0), uint64(1), uint64(0), uint64(0),
+		uint64(0), uint64(1), uint64(0), uint64(0), uint(1),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint(1),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, v0, v1, v2, v3 uint64, borrow uint) {
+		borrow &= 1
+		a := Uint256{u0, u1, u2, u3}
+		b := Uint256{v0, v1, v2, v3}
+		diff, borrowOut := a.SubBorrow(b, borrow)
+
+		want := new(big.Int).Sub(uint256ToBigInt(a), uint256ToBigInt(b))
+		want.Sub(want, new(big.Int).SetUint64(uint64(borrow)))
+		wantDiff, _ := Uint256FromBigInt(want)
+		var wantBorrow uint
+		if want.Sign() < 0 {
+			wantBorrow = 1
+		}
+		if diff != wantDiff || borrowOut != wantBorrow {
+			t.Errorf("Uint256(%d).SubBorrow(%d, %d) = %d, %d, want %d, %d", a, b, borrow, diff, borrowOut, wantDiff, wantBorrow)
+		}
+	})
+}
+
+func FuzzUint256_MulFull(f *testing.F) {
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(0), uint64(0), uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(0), uint64(0), uint64(0), uint64(1),
+	)
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(1),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(0), uint64(1), uint64(0), uint64(0),
+		uint64(0), uint64(1), uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, v0, v1, v2, v3 uint64) {
+		a := Uint256{u0, u1, u2, u3}
+		b := Uint256{v0, v1, v2, v3}
+		hi, lo := a.MulFull(b)
+
+		want := new(big.Int).Mul(uint256ToBigInt(a), uint256ToBigInt(b))
+		wantLo, _ := Uint256FromBigInt(want)
+		wantHi, _ := Uint256FromBigInt(want.Rsh(want, 256))
+		if hi != wantHi || lo != wantLo {
+			t.Errorf("Uint256(%d).MulFull(%d) = %d, %d, want %d, %d", a, b, hi, lo, wantHi, wantLo)
+		}
+	})
+}
+
+func FuzzUint256_SaturatingAdd(f *testing.F) {
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(0), uint64(0), uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(0), uint64(0), uint64(0), uint64(1),
+	)
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(1),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(0), uint64(1), uint64(0), uint64(0),
+		uint64(0), uint64(1), uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0), uint64(0), uint64(0),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0), uint64(0), uint64(0),
+		uint64(1<<63), uint64(0), uint64(0), uint64(0),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, v0, v1, v2, v3 uint64) {
+		a := Uint256{u0, u1, u2, u3}
+		b := Uint256{v0, v1, v2, v3}
+		got := a.SaturatingAdd(b)
+
+		want := saturateBigInt(new(big.Int).Add(uint256ToBigInt(a), uint256ToBigInt(b)), 256, false)
+		if uint256ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("Uint256(%d).SaturatingAdd(%d) = %d, want %d", a, b, got, want)
+		}
+	})
+}
+
+func FuzzUint256_SaturatingSub(f *testing.F) {
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(0), uint64(0), uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(0), uint64(0), uint64(0), uint64(1),
+	)
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(1),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(0), uint64(1), uint64(0), uint64(0),
+		uint64(0), uint64(1), uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0), uint64(0), uint64(0),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0), uint64(0), uint64(0),
+		uint64(1<<63), uint64(0), uint64(0), uint64(0),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, v0, v1, v2, v3 uint64) {
+		a := Uint256{u0, u1, u2, u3}
+		b := Uint256{v0, v1, v2, v3}
+		got := a.SaturatingSub(b)
+
+		want := saturateBigInt(new(big.Int).Sub(uint256ToBigInt(a), uint256ToBigInt(b)), 256, false)
+		if uint256ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("Uint256(%d).SaturatingSub(%d) = %d, want %d", a, b, got, want)
+		}
+	})
+}
+
+func FuzzUint256_SaturatingMul(f *testing.F) {
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(0), uint64(0), uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(0), uint64(0), uint64(0), uint64(1),
+	)
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(1),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(0), uint64(1), uint64(0), uint64(0),
+		uint64(0), uint64(1), uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0), uint64(0), uint64(0),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0), uint64(0), uint64(0),
+		uint64(1<<63), uint64(0), uint64(0), uint64(0),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, v0, v1, v2, v3 uint64) {
+		a := Uint256{u0, u1, u2, u3}
+		b := Uint256{v0, v1, v2, v3}
+		got := a.SaturatingMul(b)
+
+		want := saturateBigInt(new(big.Int).Mul(uint256ToBigInt(a), uint256ToBigInt(b)), 256, false)
+		if uint256ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("Uint256(%d).SaturatingMul(%d) = %d, want %d", a, b, got, want)
+		}
+	})
+}
+
+func TestMaxUint256(t *testing.T) {
+	max := new(big.Int).Lsh(big.NewInt(1), 256)
+	max.Sub(max, big.NewInt(1))
+	if got := uint256ToBigInt(MaxUint256); got.Cmp(max) != 0 {
+		t.Errorf("MaxUint256 = %d, want %d", got, max)
+	}
+}
+
+func TestUint256FromUint64(t *testing.T) {
+	testCases := []struct {
+		v    uint64
+		want Uint256
+	}{
+		{0, Uint256{0, 0, 0, 0}},
+		{1, Uint256{0, 0, 0, 0x1}},
+		{math.MaxUint64, Uint256{0, 0, 0, math.MaxUint64}},
+	}
+
+	for _, tc := range testCases {
+		got := Uint256FromUint64(tc.v)
+		if got != tc.want {
+			t.Errorf("Uint256FromUint64(%d) = %d, want %d", tc.v, got, tc.want)
+		}
+	}
+}
+
+func TestUint256Of(t *testing.T) {
+	testCases := []struct {
+		v    string
+		got  Uint256
+		want Uint256
+	}{
+		{"int8(-1)", Uint256Of(int8(-1)), Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{"int(42)", Uint256Of(int(42)), Uint256{0, 0, 0, 0x2a}},
+		{"uint64(math.MaxUint64)", Uint256Of(uint64(math.MaxUint64)), Uint256{0, 0, 0, math.MaxUint64}},
+		{"int64(math.MinInt64)", Uint256Of(int64(math.MinInt64)), Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, 0x8000000000000000}},
+		{"uintptr(7)", Uint256Of(uintptr(7)), Uint256{0, 0, 0, 0x7}},
+	}
+
+	for _, tc := range testCases {
+		if tc.got != tc.want {
+			t.Errorf("Uint256Of(%s) = %d, want %d", tc.v, tc.got, tc.want)
+		}
+	}
+}
+
+func TestUint256_IsInt64(t *testing.T) {
+	testCases := []struct {
+		a        Uint256
+		isInt64  bool
+		isUint64 bool
+		i64      int64
+		u64      uint64
+	}{
+		{Uint256{0, 0, 0, 0}, true, true, 0, 0},
+		{Uint256{0, 0, 0, 0x1}, true, true, 1, 1},
+		{Uint256{0, 0, 0, 0x7fffffffffffffff}, true, true, 9223372036854775807, 9223372036854775807},
+		{Uint256{0, 0, 0, 0x8000000000000000}, false, true, math.MinInt64, 9223372036854775808},
+		{Uint256{0, 0, 0, math.MaxUint64}, false, true, -1, math.MaxUint64},
+		{Uint256{0, 0, 0x1, 0}, false, false, 0, 0},
+		{Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false, false, -1, math.MaxUint64},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.a.IsInt64(); got != tc.isInt64 {
+			t.Errorf("Uint256(%d).IsInt64() = %t, want %t", tc.a, got, tc.isInt64)
+		}
+		if got := tc.a.IsUint64(); got != tc.isUint64 {
+			t.Errorf("Uint256(%d).IsUint64() = %t, want %t", tc.a, got, tc.isUint64)
+		}
+
+		got, ok := tc.a.Int()
+		want, wantOK := int(tc.i64), tc.isInt64 && int64(int(tc.i64)) == tc.i64
+		if got != want || ok != wantOK {
+			t.Errorf("Uint256(%d).Int() = %d, %t, want %d, %t", tc.a, got, ok, want, wantOK)
+		}
+
+		gotU, ok := tc.a.Uint()
+		wantU, wantOK := uint(tc.u64), tc.isUint64 && uint64(uint(tc.u64)) == tc.u64
+		if gotU != wantU || ok != wantOK {
+			t.Errorf("Uint256(%d).Uint() = %d, %t, want %d, %t", tc.a, gotU, ok, wantU, wantOK)
+		}
+	}
+}
+
+func TestUint256_OnesCount(t *testing.T) {
+	testCases := []struct {
+		x    Uint256
+		want int
+	}{
+		{Uint256{0, 0, 0, 0}, 0},
+		{Uint256{0, 0, 0, 0x1}, 1},
+		{Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 256},
+		{Uint256{0x8000000000000000, 0, 0, 0}, 1},
+		{Uint256{0, 0, 0, 0x5555555555555555}, 32},
+		{Uint256{0xf0000000000000, 0, 0, 0}, 4},
+	}
+
+	for _, tc := range testCases {
+		got := tc.x.OnesCount()
+		if got != tc.want {
+			t.Errorf("Uint256(%d).OnesCount() = %d, want %d", tc.x, got, tc.want)
+		}
+	}
+}
+
+func FuzzUint256_RotateLeft(f *testing.F) {
+	f.Add(uint64(0), uint64(0), uint64(0), uint64(0), 0)
+	f.Add(uint64(0), uint64(0), uint64(0), uint64(1), 0)
+	f.Add(uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), 0)
+	f.Add(uint64(1<<63), uint64(0), uint64(0), uint64(0), 0)
+	f.Add(uint64(0), uint64(0), uint64(0), uint64(0), 1)
+	f.Add(uint64(0), uint64(0), uint64(0), uint64(1), 1)
+	f.Add(uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), 1)
+	f.Add(uint64(1<<63), uint64(0), uint64(0), uint64(0), 1)
+	f.Add(uint64(0), uint64(0), uint64(0), uint64(0), -1)
+	f.Add(uint64(0), uint64(0), uint64(0), uint64(1), -1)
+	f.Add(uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), -1)
+	f.Add(uint64(1<<63), uint64(0), uint64(0), uint64(0), -1)
+	f.Add(uint64(0), uint64(0), uint64(0), uint64(0), 321)
+	f.Add(uint64(0), uint64(0), uint64(0), uint64(1), 321)
+	f.Add(uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), 321)
+	f.Add(uint64(1<<63), uint64(0), uint64(0), uint64(0), 321)
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3 uint64, k int) {
+		a := Uint256{u0, u1, u2, u3}
+		got := a.RotateLeft(k)
+
+		s := uint(k) % 256
+		x := uint256ToBigInt(a)
+		want := new(big.Int).Lsh(x, s)
+		want.Or(want, new(big.Int).Rsh(x, 256-s))
+		wantV, _ := Uint256FromBigInt(want)
+		if got != wantV {
+			t.Errorf("Uint256(%d).RotateLeft(%d) = %d, want %d", a, k, got, wantV)
+		}
+	})
+}
+
+func FuzzUint256_Reverse(f *testing.F) {
+	f.Add(uint64(0), uint64(0), uint64(0), uint64(0))
+	f.Add(uint64(0), uint64(0), uint64(0), uint64(1))
+	f.Add(uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64))
+	f.Add(uint64(1<<63), uint64(0), uint64(0), uint64(0))
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3 uint64) {
+		a := Uint256{u0, u1, u2, u3}
+
+		x := uint256ToBigInt(a)
+		want := new(big.Int)
+		for i := range 256 {
+			want.SetBit(want, 255-i, x.Bit(i))
+		}
+		if got := a.Reverse(); uint256ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("Uint256(%d).Reverse() = %d, want %d", a, got, want)
+		}
+
+		le := a.BytesLE()
+		if got := a.ReverseBytes(); got != Uint256FromBytesBE(le[:]) {
+			t.Errorf("Uint256(%d).ReverseBytes() = %d, want %d", a, got, Uint256FromBytesBE(le[:]))
+		}
+	})
+}
+
+func FuzzUint256_Bit(f *testing.F) {
+	f.Add(uint64(0), uint64(0), uint64(0), uint64(0))
+	f.Add(uint64(0), uint64(0), uint64(0), uint64(1))
+	f.Add(uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64))
+	f.Add(uint64(1<<63), uint64(0), uint64(0), uint64(0))
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3 uint64) {
+		a := Uint256{u0, u1, u2, u3}
+		x := uint256ToBigInt(a)
+		for i := range 266 {
+			if got, want := a.Bit(i), x.Bit(i); got != want {
+				t.Errorf("Uint256(%d).Bit(%d) = %d, want %d", a, i, got, want)
+			}
+		}
+		for _, i := range []int{0, 1, 63, 64, 65, 128, 255} {
+			if got, want := uint256ToBigInt(a.SetBit(i, 1)), new(big.Int).SetBit(x, i, 1); got.Cmp(want) != 0 {
+				t.Errorf("Uint256(%d).SetBit(%d, 1) = %d, want %d", a, i, got, want)
+			}
+			if got, want := uint256ToBigInt(a.SetBit(i, 0)), new(big.Int).SetBit(x, i, 0); got.Cmp(want) != 0 {
+				t.Errorf("Uint256(%d).SetBit(%d, 0) = %d, want %d", a, i, got, want)
+			}
+			if got, want := uint256ToBigInt(a.ClearBit(i)), new(big.Int).SetBit(x, i, 0); got.Cmp(want) != 0 {
+				t.Errorf("Uint256(%d).ClearBit(%d) = %d, want %d", a, i, got, want)
+			}
+			if got, want := uint256ToBigInt(a.FlipBit(i)), new(big.Int).SetBit(x, i, x.Bit(i)^1); got.Cmp(want) != 0 {
+				t.Errorf("Uint256(%d).FlipBit(%d) = %d, want %d", a, i, got, want)
+			}
+		}
+	})
+}
+
+func TestUint256_SetBit_Panic(t *testing.T) {
+	testCases := []struct {
+		i int
+		b uint
+	}{
+		{-1, 0},
+		{256, 0},
+		{0, 2},
+	}
+
+	for _, tc := range testCases {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Uint256{}.SetBit(%d, %d) did not panic", tc.i, tc.b)
+				}
+			}()
+			Uint256{}.SetBit(tc.i, tc.b)
+		}()
+	}
+}
+
+func FuzzUint256_ExtractInsert(f *testing.F) {
+	f.Add(uint64(0), uint64(0), uint64(0), uint64(0), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint(0), uint(256))
+	f.Add(uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(0), uint64(0), uint64(0), uint64(0), uint(3), uint(64))
+	f.Add(uint64(0), uint64(0), uint64(0), uint64(1), uint64(1<<63), uint64(0), uint64(0), uint64(0), uint(63), uint(2))
+	f.Add(uint64(1<<63), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(1), uint(255), uint(5))
+	f.Add(uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(0), uint64(0), uint64(0), uint64(1), uint(0), uint(257))
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, v0, v1, v2, v3 uint64, lo, width uint) {
+		a := Uint256{u0, u1, u2, u3}
+		v := Uint256{v0, v1, v2, v3}
+		x := uint256ToBigInt(a)
+		y := uint256ToBigInt(v)
+
+		// mask = 1<<min(width, 256) - 1
+		mask := new(big.Int).Lsh(big.NewInt(1), min(width, 256))
+		mask.Sub(mask, big.NewInt(1))
+
+		want := new(big.Int).Rsh(x, lo)
+		want.And(want, mask)
+		if got := uint256ToBigInt(a.Extract(lo, width)); got.Cmp(want) != 0 {
+			t.Errorf("Uint256(%d).Extract(%d, %d) = %d, want %d", a, lo, width, got, want)
+		}
+
+		// (x &^ (mask << lo)) | ((y & mask) << lo), truncated to 256 bits
+		mask.Lsh(mask, lo)
+		want = new(big.Int).AndNot(x, mask)
+		want.Or(want, new(big.Int).And(new(big.Int).Lsh(y, lo), mask))
+		wantV, _ := Uint256FromBigInt(want)
+		if got := a.Insert(v, lo, width); got != wantV {
+			t.Errorf("Uint256(%d).Insert(%d, %d, %d) = %d, want %d", a, v, lo, width, got, wantV)
+		}
+	})
+}
+
+func TestUint256_RshRound(t *testing.T) {
+	values := []Uint256{
+		Uint256{0, 0, 0, 0},
+		Uint256{0, 0, 0, 0x1},
+		Uint256{0, 0, 0, 0x2},
+		Uint256{0, 0, 0, 0x3},
+		Uint256{0, 0, 0, 0x5},
+		Uint256{0, 0, 0, 0x6},
+		Uint256{0, 0, 0, math.MaxUint64},
+		Uint256{0, 0, 0x1, 0x8000000000000000},
+		Uint256{0, 0, 0x1, 0x8000000000000001},
+		Uint256{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64},
+		Uint256{0x8000000000000000, 0, 0, 0},
+		Uint256{0x8000000000000000, 0, 0, 0x1},
+		Uint256{0xb000000000000000, 0, 0, 0},
+		Uint256{0xc000000000000000, 0, 0x1, 0},
+		Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64},
+	}
+	shifts := []uint{0, 1, 2, 3, 63, 64, 65, 127, 128, 129, 191, 192, 193, 255, 256, 257, 320}
+
+	for _, a := range values {
+		x := uint256ToBigInt(a)
+		for _, i := range shifts {
+			if got, want := a.RshRound(i), rshRoundBigInt(x, i); uint256ToBigInt(got).Cmp(want) != 0 {
+				t.Errorf("Uint256(%d).RshRound(%d) = %d, want %d", a, i, got, want)
+			}
+			if got, want := a.RshCeil(i), rshCeilBigInt(x, i); uint256ToBigInt(got).Cmp(want) != 0 {
+				t.Errorf("Uint256(%d).RshCeil(%d) = %d, want %d", a, i, got, want)
+			}
+			if got, want := a.URsh(i), new(big.Int).Rsh(uint256ToBigInt(a), i); uint256ToBigInt(got).Cmp(want) != 0 {
+				t.Errorf("Uint256(%d).URsh(%d) = %d, want %d", a, i, got, want)
+			}
+		}
+	}
+}
+
+func FuzzUint256_Div512(f *testing.F) {
+	f.Add(
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x1),
+	)
+	f.Add(
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0),
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x7),
+	)
+	f.Add(
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x1),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x2),
+	)
+	f.Add(
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xfffffffffffffffe),
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+	)
+	f.Add(
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x5),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x3039),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x6),
+	)
+	f.Add(
+		uint64(0x4000000000000000), uint64(0x0), uint64(0x0), uint64(0x0),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x3),
+		uint64(0x8000000000000000), uint64(0x0), uint64(0x0), uint64(0x0),
+	)
+
+	f.Fuzz(func(t *testing.T, h0, h1, h2, h3, l0, l1, l2, l3, y0, y1, y2, y3 uint64) {
+		hi := Uint256{h0, h1, h2, h3}
+		lo := Uint256{l0, l1, l2, l3}
+		y := Uint256{y0, y1, y2, y3}
+		if y.Cmp(hi) <= 0 {
+			return
+		}
+		quo, rem := y.Div512(hi, lo)
+
+		x := new(big.Int).Lsh(uint256ToBigInt(hi), 256)
+		x.Or(x, uint256ToBigInt(lo))
+		wantQuo, wantRem := new(big.Int).QuoRem(x, uint256ToBigInt(y), new(big.Int))
+		if uint256ToBigInt(quo).Cmp(wantQuo) != 0 || uint256ToBigInt(rem).Cmp(wantRem) != 0 {
+			t.Errorf("Uint256(%d).Div512(%d, %d) = %d, %d, want %d, %d", y, hi, lo, quo, rem, wantQuo, wantRem)
+		}
+	})
+}
+
+func TestUint256_Div512_Panic(t *testing.T) {
+	testCases := []struct {
+		hi, lo, y Uint256
+		want      string
+	}{
+		{Uint256{0, 0, 0, 0}, Uint256{0, 0, 0, 0x1}, Uint256{0, 0, 0, 0}, "runtime error: integer divide by zero"},
+		{Uint256{0, 0, 0, 0x1}, Uint256{0, 0, 0, 0}, Uint256{0, 0, 0, 0x1}, "runtime error: integer overflow"},
+		{MaxUint256, Uint256{0, 0, 0, 0}, Uint256{0, 0, 0, 0x2}, "runtime error: integer overflow"},
+	}
+
+	for _, tc := range testCases {
+		func() {
+			defer func() {
+				err, ok := recover().(runtime.Error)
+				if !ok {
+					t.Errorf("Uint256(%d).Div512(%d, %d) did not panic with a runtime.Error", tc.y, tc.hi, tc.lo)
+					return
+				}
+				if err.Error() != tc.want {
+					t.Errorf("Uint256(%d).Div512(%d, %d) panicked with %q, want %q", tc.y, tc.hi, tc.lo, err.Error(), tc.want)
+				}
+			}()
+			tc.y.Div512(tc.hi, tc.lo)
+		}()
+	}
+}
+
+func FuzzUint256_ModArith(f *testing.F) {
+	f.Add(
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x1),
+	)
+	f.Add(
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+	)
+	f.Add(
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xfffffffffffffffe),
+	)
+	f.Add(
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x3),
+		uint64(0x8000000000000000), uint64(0x0), uint64(0x0), uint64(0x0),
+		uint64(0x8000000000000000), uint64(0x0), uint64(0x0), uint64(0x1),
+	)
+	f.Add(
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x10001),
+		uint64(0x0), uint64(0x0), uint64(0x1), uint64(0xd),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, v0, v1, v2, v3, m0, m1, m2, m3 uint64) {
+		a := Uint256{u0, u1, u2, u3}
+		b := Uint256{v0, v1, v2, v3}
+		m := Uint256{m0, m1, m2, m3}
+		if m.IsZero() {
+			return
+		}
+		x, y, z := uint256ToBigInt(a), uint256ToBigInt(b), uint256ToBigInt(m)
+
+		if got, want := a.AddMod(b, m), new(big.Int).Mod(new(big.Int).Add(x, y), z); uint256ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("Uint256(%d).AddMod(%d, %d) = %d, want %d", a, b, m, got, want)
+		}
+		if got, want := a.SubMod(b, m), new(big.Int).Mod(new(big.Int).Sub(x, y), z); uint256ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("Uint256(%d).SubMod(%d, %d) = %d, want %d", a, b, m, got, want)
+		}
+		if got, want := a.MulMod(b, m), new(big.Int).Mod(new(big.Int).Mul(x, y), z); uint256ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("Uint256(%d).MulMod(%d, %d) = %d, want %d", a, b, m, got, want)
+		}
+		if got, want := a.ExpMod(b, m), new(big.Int).Exp(x, y, z); uint256ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("Uint256(%d).ExpMod(%d, %d) = %d, want %d", a, b, m, got, want)
+		}
+	})
+}
+
+func FuzzUint256_GCD(f *testing.F) {
+	f.Add(
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0),
+	)
+	f.Add(
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0xc),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x12),
+	)
+	f.Add(
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xfffffffffffffffe),
+	)
+	f.Add(
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xfffffffffffffffe),
+		uint64(0x8000000000000000), uint64(0x0), uint64(0x0), uint64(0x0),
+	)
+	f.Add(
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x3),
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffc5),
+	)
+	f.Add(
+		uint64(0x8000000000000000), uint64(0x0), uint64(0x0), uint64(0x0),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x10),
+	)
+	f.Add(
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x7),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x1),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, v0, v1, v2, v3 uint64) {
+		a := Uint256{u0, u1, u2, u3}
+		b := Uint256{v0, v1, v2, v3}
+		x, y := uint256ToBigInt(a), uint256ToBigInt(b)
+		want := new(big.Int).GCD(nil, nil, x, y)
+
+		if got := a.GCD(b); uint256ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("Uint256(%d).GCD(%d) = %d, want %d", a, b, got, want)
+		}
+		if !a.IsZero() && !b.IsZero() {
+			wantLCM := new(big.Int).Mul(new(big.Int).Quo(x, want), y)
+			wantLCM.Mod(wantLCM, new(big.Int).Lsh(big.NewInt(1), 256))
+			if got := a.LCM(b); uint256ToBigInt(got).Cmp(wantLCM) != 0 {
+				t.Errorf("Uint256(%d).LCM(%d) = %d, want %d", a, b, got, wantLCM)
+			}
+		}
+		g, s, r := a.ExtendedGCD(b)
+		sum := new(big.Int).Add(new(big.Int).Mul(x, int256ToBigInt(s)), new(big.Int).Mul(y, int256ToBigInt(r)))
+		if uint256ToBigInt(g).Cmp(want) != 0 || sum.Cmp(want) != 0 {
+			t.Errorf("Uint256(%d).ExtendedGCD(%d) = (%d, %d, %d), want g = %d", a, b, g, s, r, want)
+		}
+		if want.Sign() != 0 {
+			// |s| <= b/(2g) + 1 and |r| <= a/(2g) + 1
+			sMax := new(big.Int).Add(new(big.Int).Quo(y, new(big.Int).Lsh(want, 1)), big.NewInt(1))
+			rMax := new(big.Int).Add(new(big.Int).Quo(x, new(big.Int).Lsh(want, 1)), big.NewInt(1))
+			if new(big.Int).Abs(int256ToBigInt(s)).Cmp(sMax) > 0 || new(big.Int).Abs(int256ToBigInt(r)).Cmp(rMax) > 0 {
+				t.Errorf("Uint256(%d).ExtendedGCD(%d) = (%d, %d, %d), coefficients are too large", a, b, g, s, r)
+			}
+		}
+
+		if b.IsZero() {
+			return
+		}
+		inv, ok := a.ModInverse(b)
+		wantInv := new(big.Int).ModInverse(x, y)
+		if b == (Uint256{0, 0, 0, 1}) {
+			wantInv = big.NewInt(0)
+		}
+		if wantInv == nil {
+			if ok {
+				t.Errorf("Uint256(%d).ModInverse(%d) = (%d, true), want (0, false)", a, b, inv)
+			}
+		} else if !ok || uint256ToBigInt(inv).Cmp(wantInv) != 0 {
+			t.Errorf("Uint256(%d).ModInverse(%d) = (%d, %t), want (%d, true)", a, b, inv, ok, wantInv)
+		}
+	})
+}
+
+func FuzzUint256_Sqrt(f *testing.F) {
+	f.Add(uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint8(2))
+	f.Add(uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x1), uint8(3))
+	f.Add(uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint8(2))
+	f.Add(uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint8(3))
+	f.Add(uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint8(17))
+	f.Add(uint64(0x7fffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint8(7))
+	f.Add(uint64(0x0), uint64(0x0), uint64(0x0), uint64(0xffffffffffffffff), uint8(4))
+	f.Add(uint64(0x1), uint64(0x0), uint64(0x0), uint64(0x0), uint8(63))
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3 uint64, k uint8) {
+		a := Uint256{u0, u1, u2, u3}
+		x := uint256ToBigInt(a)
+
+		want := new(big.Int).Sqrt(x)
+		if got := a.Sqrt(); uint256ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("Uint256(%d).Sqrt() = %d, want %d", a, got, want)
+		}
+		isSquare := new(big.Int).Mul(want, want).Cmp(x) == 0
+		if got := a.IsPerfectSquare(); got != isSquare {
+			t.Errorf("Uint256(%d).IsPerfectSquare() = %t, want %t", a, got, isSquare)
+		}
+
+		// r is the floor n-th root of a if r**n <= a < (r+1)**n.
+		for _, n := range []uint{3, uint(k)%256 + 1} {
+			got := a.Root(n)
+			r := uint256ToBigInt(got)
+			lo := new(big.Int).Exp(r, big.NewInt(int64(n)), nil)
+			hi := new(big.Int).Exp(r.Add(r, big.NewInt(1)), big.NewInt(int64(n)), nil)
+			if lo.Cmp(x) > 0 || hi.Cmp(x) <= 0 {
+				t.Errorf("Uint256(%d).Root(%d) = %d", a, n, got)
+			}
+		}
+		if got, want := a.Cbrt(), a.Root(3); got != want {
+			t.Errorf("Uint256(%d).Cbrt() = %d, want %d", a, got, want)
+		}
+	})
+}
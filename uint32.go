@@ -2,7 +2,10 @@ package ints
 
 import (
 	"cmp"
+	"encoding/binary"
 	"fmt"
+	"math"
+	"math/big"
 	"math/bits"
 )
 
@@ -10,11 +13,46 @@ import (
 // It is an alias for the built-in uint32 type.
 type Uint32 uint32
 
+// MaxUint32 is the maximum value of Uint32.
+const MaxUint32 Uint32 = math.MaxUint32
+
+// Uint32Of returns v as a Uint32.
+// v is converted in the same way as Go's integer conversions.
+func Uint32Of[T builtinInteger](v T) Uint32 {
+	return Uint32(v)
+}
+
 // IsZero returns true if a is zero.
 func (a Uint32) IsZero() bool {
 	return a == 0
 }
 
+// IsInt64 reports whether a can be represented as an int64.
+func (a Uint32) IsInt64() bool {
+	_, ok := a.TryInt64()
+	return ok
+}
+
+// IsUint64 reports whether a can be represented as a uint64.
+func (a Uint32) IsUint64() bool {
+	_, ok := a.TryUint64()
+	return ok
+}
+
+// Int returns a as an int and reports whether a can be represented as an int.
+// If it can't, the result is a truncated to the size of int.
+func (a Uint32) Int() (int, bool) {
+	v, ok := a.TryInt64()
+	return int(v), ok && int64(int(v)) == int64(v)
+}
+
+// Uint returns a as a uint and reports whether a can be represented as a uint.
+// If it can't, the result is a truncated to the size of uint.
+func (a Uint32) Uint() (uint, bool) {
+	v, ok := a.TryUint64()
+	return uint(v), ok && uint64(uint(v)) == uint64(v)
+}
+
 // Add returns the sum a+b.
 //
 // This function's execution time does not depend on the inputs.
@@ -22,6 +60,34 @@ func (a Uint32) Add(b Uint32) Uint32 {
 	return a + b
 }
 
+// AddOverflow returns the sum a+b and reports whether the addition overflowed.
+//
+// This function's execution time does not depend on the inputs.
+func (a Uint32) AddOverflow(b Uint32) (Uint32, bool) {
+	c := a + b
+	return c, c < a
+}
+
+// SaturatingAdd returns the sum a+b.
+// If the result overflows, it is clamped to the maximum value.
+func (a Uint32) SaturatingAdd(b Uint32) Uint32 {
+	c, overflow := a.AddOverflow(b)
+	if overflow {
+		return math.MaxUint32
+	}
+	return c
+}
+
+// AddCarry returns the sum with carry of a, b and carry: sum = a + b + carry.
+// The carry input must be 0 or 1; otherwise the behavior is undefined.
+// The carryOut output is guaranteed to be 0 or 1.
+//
+// This function's execution time does not depend on the inputs.
+func (a Uint32) AddCarry(b Uint32, carry uint) (sum Uint32, carryOut uint) {
+	s := uint64(a) + uint64(b) + uint64(carry)
+	return Uint32(s), uint(s >> 32)
+}
+
 // Sub returns the difference a-b.
 //
 // This function's execution time does not depend on the inputs.
@@ -29,11 +95,61 @@ func (a Uint32) Sub(b Uint32) Uint32 {
 	return a - b
 }
 
+// SubOverflow returns the difference a-b and reports whether the subtraction overflowed.
+//
+// This function's execution time does not depend on the inputs.
+func (a Uint32) SubOverflow(b Uint32) (Uint32, bool) {
+	return a - b, a < b
+}
+
+// SaturatingSub returns the difference a-b.
+// If the result overflows, it is clamped to zero.
+func (a Uint32) SaturatingSub(b Uint32) Uint32 {
+	c, overflow := a.SubOverflow(b)
+	if overflow {
+		return 0
+	}
+	return c
+}
+
+// SubBorrow returns the difference of a, b and borrow: diff = a - b - borrow.
+// The borrow input must be 0 or 1; otherwise the behavior is undefined.
+// The borrowOut output is guaranteed to be 0 or 1.
+//
+// This function's execution time does not depend on the inputs.
+func (a Uint32) SubBorrow(b Uint32, borrow uint) (diff Uint32, borrowOut uint) {
+	d := uint64(a) - uint64(b) - uint64(borrow)
+	return Uint32(d), uint(d >> 63)
+}
+
 // Mul returns the product a*b.
 func (a Uint32) Mul(b Uint32) Uint32 {
 	return a * b
 }
 
+// MulOverflow returns the product a*b and reports whether the multiplication overflowed.
+func (a Uint32) MulOverflow(b Uint32) (Uint32, bool) {
+	c := uint64(a) * uint64(b)
+	return Uint32(c), c>>32 != 0
+}
+
+// SaturatingMul returns the product a*b.
+// If the result overflows, it is clamped to the maximum value.
+func (a Uint32) SaturatingMul(b Uint32) Uint32 {
+	c, overflow := a.MulOverflow(b)
+	if overflow {
+		return math.MaxUint32
+	}
+	return c
+}
+
+// MulFull returns the 64-bit product of a and b: (hi, lo) = a * b
+// with the product bits' upper half returned in hi and the lower half returned in lo.
+func (a Uint32) MulFull(b Uint32) (hi, lo Uint32) {
+	c := a.Mul64(b)
+	return Uint32(c >> 32), Uint32(c)
+}
+
 // Mul64 returns the product a*b, the result is a 64-bit integer.
 func (a Uint32) Mul64(b Uint32) Uint64 {
 	h, l := bits.Mul32(uint32(a), uint32(b))
@@ -103,6 +219,196 @@ func (a Uint32) QuoRem(b Uint32) (Uint32, Uint32) {
 	return Uint32(q), Uint32(r)
 }
 
+// Div64 returns the quotient and remainder of (hi, lo) divided by a:
+// quo = (hi, lo)/a, rem = (hi, lo)%a with the dividend bits' upper half
+// in parameter hi and the lower half in parameter lo.
+// Div64 panics for a == 0 (division by zero) or a <= hi (quotient overflow).
+// Like [math/bits.Div64], it panics with a [runtime.Error].
+func (a Uint32) Div64(hi, lo Uint32) (quo, rem Uint32) {
+	q, r := bits.Div32(uint32(hi), uint32(lo), uint32(a))
+	return Uint32(q), Uint32(r)
+}
+
+// AddMod returns (a+b) mod m.
+// It panics if m == 0.
+func (a Uint32) AddMod(b, m Uint32) Uint32 {
+	return Uint32((uint64(a) + uint64(b)) % uint64(m))
+}
+
+// SubMod returns (a-b) mod m.
+// The result is always in the range [0, m).
+// It panics if m == 0.
+func (a Uint32) SubMod(b, m Uint32) Uint32 {
+	a, b = a%m, b%m
+	if a < b {
+		return a + (m - b)
+	}
+	return a - b
+}
+
+// MulMod returns (a*b) mod m, computed without overflow.
+// It panics if m == 0.
+func (a Uint32) MulMod(b, m Uint32) Uint32 {
+	return Uint32(uint64(a) * uint64(b) % uint64(m))
+}
+
+// ExpMod returns a**e mod m.
+// It panics if m == 0.
+func (a Uint32) ExpMod(e, m Uint32) Uint32 {
+	r := Uint32(1).Mod(m)
+	a = a.Mod(m)
+	for i := e.BitLen() - 1; i >= 0; i-- {
+		r = r.MulMod(r, m)
+		if e.Bit(i) != 0 {
+			r = r.MulMod(a, m)
+		}
+	}
+	return r
+}
+
+// GCD returns the greatest common divisor of a and b.
+// GCD(a, 0) is a, and GCD(0, 0) is 0.
+// It uses the binary GCD algorithm.
+func (a Uint32) GCD(b Uint32) Uint32 {
+	if a == 0 {
+		return b
+	}
+	if b == 0 {
+		return a
+	}
+	i, j := bits.TrailingZeros32(uint32(a)), bits.TrailingZeros32(uint32(b))
+	k := min(i, j)
+	a, b = a>>i, b>>j
+	for {
+		// a and b are odd here.
+		if a > b {
+			a, b = b, a
+		}
+		b -= a
+		if b == 0 {
+			return a << k
+		}
+		b >>= bits.TrailingZeros32(uint32(b))
+	}
+}
+
+// LCM returns the least common multiple of a and b.
+// LCM(a, 0) is 0. The result wraps around on overflow.
+func (a Uint32) LCM(b Uint32) Uint32 {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return a / a.GCD(b) * b
+}
+
+// ExtendedGCD returns the greatest common divisor g of a and b,
+// and the Bézout coefficients x and y that satisfy a*x + b*y = g.
+// The coefficients are the ones given by the extended Euclidean algorithm,
+// which are small enough to always fit in [Int32].
+func (a Uint32) ExtendedGCD(b Uint32) (g Uint32, x, y Int32) {
+	r0, r1 := a, b
+	s0, s1 := Uint32(1), Uint32(0)
+	t0, t1 := Uint32(0), Uint32(1)
+	// The coefficients alternate in sign, so only their magnitudes are tracked.
+	neg := false
+	for r1 != 0 {
+		q := r0 / r1
+		r0, r1 = r1, r0-q*r1
+		s0, s1 = s1, s0+q*s1
+		t0, t1 = t1, t0+q*t1
+		neg = !neg
+	}
+	x, y = Int32(s0), -Int32(t0)
+	if neg {
+		x, y = -x, -y
+	}
+	return r0, x, y
+}
+
+// ModInverse returns the multiplicative inverse of a modulo m, in the range [0, m).
+// ok is false if a and m are not coprime, or m == 0.
+func (a Uint32) ModInverse(m Uint32) (x Uint32, ok bool) {
+	if m == 0 {
+		return 0, false
+	}
+	g, s, _ := (a % m).ExtendedGCD(m)
+	if g != 1 {
+		return 0, false
+	}
+	if s < 0 {
+		return Uint32(s) + m, true
+	}
+	return Uint32(s), true
+}
+
+// Sqrt returns the floor square root of a, ⌊√a⌋.
+// It uses Newton's method seeded from [Uint32.BitLen].
+func (a Uint32) Sqrt() Uint32 {
+	if a <= 1 {
+		return a
+	}
+	x := Uint32(1) << ((a.BitLen() + 1) / 2)
+	for {
+		y := (x + a/x) >> 1
+		if y >= x {
+			return x
+		}
+		x = y
+	}
+}
+
+// Cbrt returns the floor cube root of a, ⌊∛a⌋.
+func (a Uint32) Cbrt() Uint32 {
+	return a.Root(3)
+}
+
+// Root returns the floor n-th root of a, ⌊a**(1/n)⌋.
+// It uses Newton's method seeded from [Uint32.BitLen].
+// It panics if n == 0.
+func (a Uint32) Root(n uint) Uint32 {
+	switch n {
+	case 0:
+		panic("ints: zeroth root")
+	case 1:
+		return a
+	case 2:
+		return a.Sqrt()
+	}
+	bitLen := uint(a.BitLen())
+	if bitLen <= n {
+		// a < 2**n, so the root is 0 or 1.
+		return min(a, 1)
+	}
+
+	x := Uint32(1) << ((bitLen + n - 1) / n)
+	for {
+		// y = ((n-1)*x + a/x**(n-1)) / n
+		p, overflow := x, false
+		for i := uint(2); i < n && !overflow; i++ {
+			p, overflow = p.MulOverflow(x)
+		}
+		var q Uint32
+		if !overflow {
+			q = a / p
+		}
+		y := (Uint32(n-1)*x + q) / Uint32(n)
+		if y >= x {
+			return x
+		}
+		x = y
+	}
+}
+
+// IsPerfectSquare reports whether a is the square of an integer.
+func (a Uint32) IsPerfectSquare() bool {
+	// Squares modulo 16 are 0, 1, 4 or 9.
+	if (0x0213>>(a&15))&1 == 0 {
+		return false
+	}
+	r := a.Sqrt()
+	return r.Mul(r) == a
+}
+
 // And returns the bitwise AND of a and b.
 func (a Uint32) And(b Uint32) Uint32 {
 	return a & b
@@ -135,6 +441,13 @@ func (a Uint32) Lsh(i uint) Uint32 {
 	return a << i
 }
 
+// LshOverflow returns the left shift a<<i and reports whether the shift overflowed,
+// i.e. whether a<<i differs from a multiplied by 2**i.
+func (a Uint32) LshOverflow(i uint) (Uint32, bool) {
+	c := a << i
+	return c, c>>i != a
+}
+
 // Rsh returns the logical right shift a>>i.
 //
 // This function's execution time does not depend on the inputs.
@@ -142,6 +455,100 @@ func (a Uint32) Rsh(i uint) Uint32 {
 	return a >> i
 }
 
+// URsh returns the logical right shift a>>i.
+// It is the same as [Uint32.Rsh] because Uint32 is unsigned.
+func (a Uint32) URsh(i uint) Uint32 {
+	return a.Rsh(i)
+}
+
+// RshRound returns a/2**i rounded to the nearest integer, with ties rounded to even.
+func (a Uint32) RshRound(i uint) Uint32 {
+	if i == 0 {
+		return a
+	}
+	q := a.Rsh(i)
+	half := a.Bit(int(min(i-1, 32)))
+	sticky := a.Extract(0, i-1) != 0
+	if half == 1 && (sticky || q.Bit(0) == 1) {
+		q++
+	}
+	return q
+}
+
+// RshCeil returns a/2**i rounded toward positive infinity.
+func (a Uint32) RshCeil(i uint) Uint32 {
+	q := a.Rsh(i)
+	if a.Extract(0, i) != 0 {
+		q++
+	}
+	return q
+}
+
+// RotateLeft returns the value of a rotated left by (k mod 32) bits.
+// To rotate a right by k bits, call a.RotateLeft(-k).
+//
+// This function's execution time does not depend on the inputs.
+func (a Uint32) RotateLeft(k int) Uint32 {
+	return Uint32(bits.RotateLeft32(uint32(a), k))
+}
+
+// Reverse returns the value of a with its bits in reversed order.
+func (a Uint32) Reverse() Uint32 {
+	return Uint32(bits.Reverse32(uint32(a)))
+}
+
+// ReverseBytes returns the value of a with its bytes in reversed order.
+func (a Uint32) ReverseBytes() Uint32 {
+	return Uint32(bits.ReverseBytes32(uint32(a)))
+}
+
+// Bit returns the value of the i'th bit of a. That is, it returns (a>>i)&1.
+// The bit index i must be >= 0.
+func (a Uint32) Bit(i int) uint {
+	if i < 0 {
+		panic("ints: negative bit index")
+	}
+	return uint(a>>uint(i)) & 1
+}
+
+// SetBit returns a with its i'th bit set to b (0 or 1).
+// It panics if i is out of the range [0, 32) or b is not 0 or 1.
+func (a Uint32) SetBit(i int, b uint) Uint32 {
+	checkBitIndex(i, 32)
+	if b > 1 {
+		panic("ints: set bit is not 0 or 1")
+	}
+	return a&^(1<<i) | Uint32(b)<<i
+}
+
+// ClearBit returns a with its i'th bit set to 0.
+// It panics if i is out of the range [0, 32).
+func (a Uint32) ClearBit(i int) Uint32 {
+	checkBitIndex(i, 32)
+	return a &^ (1 << i)
+}
+
+// FlipBit returns a with its i'th bit inverted.
+// It panics if i is out of the range [0, 32).
+func (a Uint32) FlipBit(i int) Uint32 {
+	checkBitIndex(i, 32)
+	return a ^ (1 << i)
+}
+
+// Extract returns the width bits of a starting at bit lo, shifted down to bit 0.
+// That is, it returns (a>>lo) & (1<<width - 1).
+func (a Uint32) Extract(lo, width uint) Uint32 {
+	width = min(width, 32)
+	return a >> lo & (MaxUint32 >> (32 - width))
+}
+
+// Insert returns a with the width bits starting at bit lo replaced by the low width bits of v.
+func (a Uint32) Insert(v Uint32, lo, width uint) Uint32 {
+	width = min(width, 32)
+	mask := MaxUint32 >> (32 - width) << lo
+	return a&^mask | v<<lo&mask
+}
+
 // LeadingZeros returns the number of leading zero bits in a; the result is 32 for a == 0.
 func (a Uint32) LeadingZeros() int {
 	return bits.LeadingZeros32(uint32(a))
@@ -157,6 +564,11 @@ func (a Uint32) BitLen() int {
 	return bits.Len32(uint32(a))
 }
 
+// OnesCount returns the number of one bits ("population count") in a.
+func (a Uint32) OnesCount() int {
+	return bits.OnesCount32(uint32(a))
+}
+
 // Sign returns the sign of a.
 // It returns 1 if a > 0, and 0 if a == 0.
 // It does not return -1 because Uint32 is unsigned.
@@ -174,6 +586,14 @@ func (a Uint32) Neg() Uint32 {
 	return -a
 }
 
+// NegOverflow returns the negation of a and reports whether the negation overflowed,
+// which happens if a is not zero.
+//
+// This function's execution time does not depend on the inputs.
+func (a Uint32) NegOverflow() (Uint32, bool) {
+	return -a, a != 0
+}
+
 // Cmp returns the comparison result of a and b.
 // It returns -1 if a < b, 0 if a == b, and 1 if a > b.
 func (a Uint32) Cmp(b Uint32) int {
@@ -207,3 +627,153 @@ func (a Uint32) String() string {
 func (a Uint32) Format(s fmt.State, verb rune) {
 	format(s, verb, a.Sign(), a)
 }
+
+// ParseUint32 is like [ParseInt32] but for unsigned numbers.
+// A sign prefix is not permitted.
+func ParseUint32(s string, base int) (Uint32, error) {
+	var z [1]uint64
+	err := parseUint("ParseUint32", s, base, 32, z[:])
+	return Uint32(z[0]), err
+}
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+// The result is the decimal representation of a.
+func (a Uint32) MarshalText() ([]byte, error) {
+	return a.AppendText(nil)
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+// It accepts the same syntax as Go integer literals, such as "0x" prefixes and underscores.
+// See [ParseUint32] for more details.
+func (a *Uint32) UnmarshalText(text []byte) error {
+	v, err := ParseUint32(string(text), 0)
+	if err != nil {
+		return err
+	}
+	*a = v
+	return nil
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+// The result is a JSON number. Use [JSONString] to encode a as a JSON string.
+func (a Uint32) MarshalJSON() ([]byte, error) {
+	return a.AppendText(nil)
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+// It accepts both a JSON number such as 123 and a JSON string such as "123" or "0x7b".
+// JSON strings are parsed in the same way as [Uint32.UnmarshalText].
+func (a *Uint32) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(a, data, ParseUint32)
+}
+
+// BytesBE returns the big-endian representation of a.
+func (a Uint32) BytesBE() [4]byte {
+	var b [4]byte
+	a.PutBytesBE(b[:])
+	return b
+}
+
+// BytesLE returns the little-endian representation of a.
+func (a Uint32) BytesLE() [4]byte {
+	var b [4]byte
+	a.PutBytesLE(b[:])
+	return b
+}
+
+// PutBytesBE stores the big-endian representation of a into b.
+// It panics if len(b) < 4.
+func (a Uint32) PutBytesBE(b []byte) {
+	binary.BigEndian.PutUint32(b, uint32(a))
+}
+
+// PutBytesLE stores the little-endian representation of a into b.
+// It panics if len(b) < 4.
+func (a Uint32) PutBytesLE(b []byte) {
+	binary.LittleEndian.PutUint32(b, uint32(a))
+}
+
+// Uint32FromBytesBE returns the Uint32 represented by the big-endian bytes b[:4].
+// It panics if len(b) < 4.
+func Uint32FromBytesBE(b []byte) Uint32 {
+	return Uint32(binary.BigEndian.Uint32(b))
+}
+
+// Uint32FromBytesLE returns the Uint32 represented by the little-endian bytes b[:4].
+// It panics if len(b) < 4.
+func Uint32FromBytesLE(b []byte) Uint32 {
+	return Uint32(binary.LittleEndian.Uint32(b))
+}
+
+// AppendBinary implements the [encoding.BinaryAppender] interface.
+// It appends the big-endian representation of a to dst.
+func (a Uint32) AppendBinary(dst []byte) ([]byte, error) {
+	b := a.BytesBE()
+	return append(dst, b[:]...), nil
+}
+
+// MarshalBinary implements the [encoding.BinaryMarshaler] interface.
+// The result is the 4-byte big-endian representation of a.
+func (a Uint32) MarshalBinary() ([]byte, error) {
+	return a.AppendBinary(make([]byte, 0, 4))
+}
+
+// UnmarshalBinary implements the [encoding.BinaryUnmarshaler] interface.
+// data must be exactly 4 bytes of the big-endian representation, as generated by [Uint32.MarshalBinary].
+func (a *Uint32) UnmarshalBinary(data []byte) error {
+	if len(data) != 4 {
+		return fmt.Errorf("ints: Uint32.UnmarshalBinary: invalid length %d, want 4", len(data))
+	}
+	*a = Uint32FromBytesBE(data)
+	return nil
+}
+
+// BigInt returns a as a new [*big.Int].
+func (a Uint32) BigInt() *big.Int {
+	return new(big.Int).SetUint64(uint64(a))
+}
+
+// Uint32FromBigInt returns x as an Uint32.
+// If x is not representable as an Uint32, the result is the lower 32 bits of x in two's complement,
+// and ok is false.
+func Uint32FromBigInt(x *big.Int) (Uint32, bool) {
+	var z [1]uint64
+	ok := uintFromBigInt(z[:], 32, x)
+	return Uint32(z[0]), ok
+}
+
+// Float64 returns the float64 value nearest to a, rounding ties to even, and an indication of any rounding error.
+// If a is too large to be represented by a float64, the result is ±Inf.
+func (a Uint32) Float64() (float64, big.Accuracy) {
+	return float64(a), big.Exact
+}
+
+// Uint32FromFloat64 returns the integer value of f rounded according to mode,
+// and the accuracy of the result: [big.Below] if the result is less than f, [big.Above] if it is greater than f.
+// Use [big.ToZero] to truncate f like the Go conversion, and [big.ToNearestEven] to round f to the nearest integer.
+//
+// If f is NaN, the result is 0 and the error is [ErrNaN].
+// If the rounded value of f is not representable as an Uint32, including ±Inf,
+// the result is clamped to 0 or the maximum value of Uint32, and the error is [ErrOverflow].
+func Uint32FromFloat64(f float64, mode big.RoundingMode) (Uint32, big.Accuracy, error) {
+	var z [1]uint64
+	acc, err := uintFromFloat64(z[:], 32, f, mode)
+	return Uint32(z[0]), acc, err
+}
+
+// BigFloat returns a as a new [*big.Float].
+// The precision of the result is 32 bits, so the conversion is always exact.
+func (a Uint32) BigFloat() *big.Float {
+	return new(big.Float).SetPrec(32).SetUint64(uint64(a))
+}
+
+// Uint32FromBigFloat returns the integer value of x rounded according to mode,
+// and the accuracy of the result: [big.Below] if the result is less than x, [big.Above] if it is greater than x.
+//
+// If the rounded value of x is not representable as an Uint32, including ±Inf,
+// the result is clamped to 0 or the maximum value of Uint32, and the error is [ErrOverflow].
+func Uint32FromBigFloat(x *big.Float, mode big.RoundingMode) (Uint32, big.Accuracy, error) {
+	var z [1]uint64
+	acc, err := uintFromBigFloat(z[:], 32, x, mode)
+	return Uint32(z[0]), acc, err
+}
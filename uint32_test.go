@@ -1,9 +1,15 @@
 package ints
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
 	"math/big"
+	"runtime"
+	"slices"
+	"strconv"
 	"testing"
 )
 
@@ -476,3 +482,969 @@ func TestUint32_Format(t *testing.T) {
 		}
 	}
 }
+
+func TestParseUint32(t *testing.T) {
+	testCases := []struct {
+		s    string
+		base int
+		want Uint32
+		err  error
+	}{
+		{"0", 10, 0, nil},
+		{"1", 10, 1, nil},
+		{"4294967295", 10, 4294967295, nil},
+		{"4294967296", 10, 4294967295, strconv.ErrRange},
+		{"0xffffffff", 0, 4294967295, nil},
+		{"0b101", 0, 5, nil},
+		{"0o17", 0, 15, nil},
+		{"017", 0, 15, nil},
+		{"1_000", 0, 1000, nil},
+		{"1_000", 10, 0, strconv.ErrSyntax},
+		{"_1", 0, 0, strconv.ErrSyntax},
+		{"Z", 62, 61, nil},
+		{"z", 36, 35, nil},
+		{"Z", 36, 35, nil},
+		{"", 10, 0, strconv.ErrSyntax},
+		{"12a", 10, 0, strconv.ErrSyntax},
+		{"-1", 10, 0, strconv.ErrSyntax},
+		{"+1", 10, 0, strconv.ErrSyntax},
+	}
+
+	for _, tc := range testCases {
+		got, err := ParseUint32(tc.s, tc.base)
+		if got != tc.want {
+			t.Errorf("ParseUint32(%q, %d) = %d, want %d", tc.s, tc.base, got, tc.want)
+		}
+		if !errors.Is(err, tc.err) {
+			t.Errorf("ParseUint32(%q, %d) error = %v, want %v", tc.s, tc.base, err, tc.err)
+		}
+		if err != nil {
+			var numErr *strconv.NumError
+			if !errors.As(err, &numErr) || numErr.Func != "ParseUint32" || numErr.Num != tc.s {
+				t.Errorf("ParseUint32(%q, %d) error = %#v, want *strconv.NumError", tc.s, tc.base, err)
+			}
+		}
+	}
+
+	if _, err := ParseUint32("1", 63); err == nil {
+		t.Errorf("ParseUint32(%q, %d) should fail", "1", 63)
+	}
+}
+
+func TestUint32_MarshalText(t *testing.T) {
+	testCases := []struct {
+		x    Uint32
+		want string
+	}{
+		{0, "0"},
+		{1, "1"},
+		{4294967295, "4294967295"},
+	}
+
+	for _, tc := range testCases {
+		got, err := tc.x.MarshalText()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != tc.want {
+			t.Errorf("Uint32(%d).MarshalText() = %q, want %q", tc.x, got, tc.want)
+		}
+	}
+}
+
+func TestUint32_UnmarshalText(t *testing.T) {
+	testCases := []struct {
+		s    string
+		want Uint32
+		err  error
+	}{
+		{"0", 0, nil},
+		{"0x10", 16, nil},
+		{"0b1_0", 2, nil},
+		{"010", 8, nil},
+		{"4294967295", 4294967295, nil},
+		{"4294967296", 0, strconv.ErrRange},
+		{"1.0", 0, strconv.ErrSyntax},
+	}
+
+	for _, tc := range testCases {
+		var got Uint32
+		err := got.UnmarshalText([]byte(tc.s))
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Uint32.UnmarshalText(%q) error = %v, want %v", tc.s, err, tc.err)
+		}
+		if got != tc.want {
+			t.Errorf("Uint32.UnmarshalText(%q) = %d, want %d", tc.s, got, tc.want)
+		}
+	}
+}
+
+func TestUint32_MarshalJSON(t *testing.T) {
+	testCases := []struct {
+		x    Uint32
+		want string
+	}{
+		{0, "0"},
+		{1, "1"},
+		{4294967295, "4294967295"},
+	}
+
+	for _, tc := range testCases {
+		got, err := json.Marshal(tc.x)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != tc.want {
+			t.Errorf("json.Marshal(Uint32(%d)) = %s, want %s", tc.x, got, tc.want)
+		}
+	}
+}
+
+func TestUint32_UnmarshalJSON(t *testing.T) {
+	testCases := []struct {
+		s       string
+		want    Uint32
+		wantErr bool
+	}{
+		{"0", 0, false},
+		{"123", 123, false},
+		{"\"123\"", 123, false},
+		{"\"0x7f\"", 127, false},
+		{"4294967295", 4294967295, false},
+		{"\"4294967295\"", 4294967295, false},
+		{"4294967296", 0, true},
+		{"0x7f", 0, true},
+		{"\"abc\"", 0, true},
+		{"1.5", 0, true},
+		{"null", 0, false},
+	}
+
+	for _, tc := range testCases {
+		var got Uint32
+		err := json.Unmarshal([]byte(tc.s), &got)
+		if (err != nil) != tc.wantErr {
+			t.Errorf("json.Unmarshal(%s) error = %v, wantErr %t", tc.s, err, tc.wantErr)
+		}
+		if got != tc.want {
+			t.Errorf("json.Unmarshal(%s) = %d, want %d", tc.s, got, tc.want)
+		}
+	}
+}
+
+func TestUint32_Bytes(t *testing.T) {
+	testCases := []struct {
+		x  Uint32
+		be [4]byte
+	}{
+		{
+			16909060,
+			[4]byte{0x01, 0x02, 0x03, 0x04},
+		},
+		{
+			4294967294,
+			[4]byte{0xff, 0xff, 0xff, 0xfe},
+		},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.x.BytesBE(); got != tc.be {
+			t.Errorf("Uint32(%d).BytesBE() = %x, want %x", tc.x, got, tc.be)
+		}
+		if got := Uint32FromBytesBE(tc.be[:]); got != tc.x {
+			t.Errorf("Uint32FromBytesBE(%x) = %d, want %d", tc.be, got, tc.x)
+		}
+
+		le := tc.be
+		slices.Reverse(le[:])
+		if got := tc.x.BytesLE(); got != le {
+			t.Errorf("Uint32(%d).BytesLE() = %x, want %x", tc.x, got, le)
+		}
+		if got := Uint32FromBytesLE(le[:]); got != tc.x {
+			t.Errorf("Uint32FromBytesLE(%x) = %d, want %d", le, got, tc.x)
+		}
+
+		data, err := tc.x.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(data, tc.be[:]) {
+			t.Errorf("Uint32(%d).MarshalBinary() = %x, want %x", tc.x, data, tc.be)
+		}
+		var got Uint32
+		if err := got.UnmarshalBinary(data); err != nil {
+			t.Fatal(err)
+		}
+		if got != tc.x {
+			t.Errorf("Uint32.UnmarshalBinary(%x) = %d, want %d", data, got, tc.x)
+		}
+	}
+}
+
+func TestUint32_UnmarshalBinary_InvalidLength(t *testing.T) {
+	var a Uint32
+	if err := a.UnmarshalBinary(make([]byte, 3)); err == nil {
+		t.Error("want error, got nil")
+	}
+	if err := a.UnmarshalBinary(make([]byte, 5)); err == nil {
+		t.Error("want error, got nil")
+	}
+}
+
+func TestUint32FromBigInt(t *testing.T) {
+	testCases := []struct {
+		x    *big.Int
+		want Uint32
+		ok   bool
+	}{
+		{big.NewInt(0), 0, true},
+		{big.NewInt(1), 1, true},
+		{big.NewInt(4294967295), 4294967295, true},
+		{big.NewInt(4294967296), 0, false},
+		{big.NewInt(4294967296), 0, false},
+		{big.NewInt(-1), 4294967295, false},
+	}
+
+	for _, tc := range testCases {
+		got, ok := Uint32FromBigInt(tc.x)
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint32FromBigInt(%s) = %d, %t, want %d, %t", tc.x, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint32_BigInt(t *testing.T) {
+	testCases := []Uint32{0, 1, 4294967295}
+
+	for _, a := range testCases {
+		got := a.BigInt()
+		if got.String() != a.String() {
+			t.Errorf("Uint32(%d).BigInt() = %s, want %s", a, got, a)
+		}
+		if b, ok := Uint32FromBigInt(got); b != a || !ok {
+			t.Errorf("Uint32FromBigInt(%s) = %d, %t, want %d, true", got, b, ok, a)
+		}
+	}
+}
+
+func TestUint32FromFloat64(t *testing.T) {
+	testCases := []struct {
+		f    float64
+		mode big.RoundingMode
+		want Uint32
+		acc  big.Accuracy
+		err  error
+	}{
+		{0, big.ToNearestEven, 0, big.Exact, nil},
+		{2.5, big.ToNearestEven, 2, big.Below, nil},
+		{3.5, big.ToNearestEven, 4, big.Above, nil},
+		{2.5, big.ToNearestAway, 3, big.Above, nil},
+		{2.5, big.ToZero, 2, big.Below, nil},
+		{2.5, big.AwayFromZero, 3, big.Above, nil},
+		{2.5, big.ToNegativeInf, 2, big.Below, nil},
+		{2.5, big.ToPositiveInf, 3, big.Above, nil},
+		{math.NaN(), big.ToNearestEven, 0, big.Exact, ErrNaN},
+		{math.Inf(1), big.ToNearestEven, 4294967295, big.Below, ErrOverflow},
+		{math.Inf(-1), big.ToNearestEven, 0, big.Above, ErrOverflow},
+		{0x1p32, big.ToNearestEven, 4294967295, big.Below, ErrOverflow},
+		{0x1p31, big.ToNearestEven, 2147483648, big.Exact, nil},
+		{-0.5, big.ToZero, 0, big.Above, nil},
+		{-1, big.ToNearestEven, 0, big.Above, ErrOverflow},
+	}
+
+	for _, tc := range testCases {
+		got, acc, err := Uint32FromFloat64(tc.f, tc.mode)
+		if got != tc.want || acc != tc.acc || err != tc.err {
+			t.Errorf("Uint32FromFloat64(%v, %s) = %d, %s, %v, want %d, %s, %v", tc.f, tc.mode, got, acc, err, tc.want, tc.acc, tc.err)
+		}
+
+		if math.IsNaN(tc.f) {
+			continue
+		}
+		got, acc, err = Uint32FromBigFloat(big.NewFloat(tc.f), tc.mode)
+		if got != tc.want || acc != tc.acc || err != tc.err {
+			t.Errorf("Uint32FromBigFloat(%v, %s) = %d, %s, %v, want %d, %s, %v", tc.f, tc.mode, got, acc, err, tc.want, tc.acc, tc.err)
+		}
+	}
+}
+
+func TestUint32_Float64(t *testing.T) {
+	testCases := []Uint32{0, 1, 4294967295}
+
+	for _, a := range testCases {
+		b := a.BigInt()
+		got, acc := a.Float64()
+		want, wantAcc := new(big.Float).SetInt(b).Float64()
+		if got != want || acc != wantAcc {
+			t.Errorf("Uint32(%d).Float64() = %v, %s, want %v, %s", a, got, acc, want, wantAcc)
+		}
+		if c, acc, err := Uint32FromBigFloat(a.BigFloat(), big.ToNearestEven); c != a || acc != big.Exact || err != nil {
+			t.Errorf("Uint32FromBigFloat(%v) = %d, %s, %v, want %d, %s, nil", a.BigFloat(), c, acc, err, a, big.Exact)
+		}
+	}
+}
+
+func TestUint32_AddOverflow(t *testing.T) {
+	testCases := []struct {
+		x, y     Uint32
+		want     Uint32
+		overflow bool
+	}{
+		{0, 0, 0, false},
+		{1, 1, 2, false},
+		{4294967295, 1, 0, true},
+		{4294967295, 4294967295, 4294967294, true},
+		{0, 1, 1, false},
+		{2, 2147483647, 2147483649, false},
+		{2, 2147483648, 2147483650, false},
+	}
+
+	for _, tc := range testCases {
+		got, overflow := tc.x.AddOverflow(tc.y)
+		if got != tc.want || overflow != tc.overflow {
+			t.Errorf("Uint32(%d).AddOverflow(%d) = %d, %t, want %d, %t", tc.x, tc.y, got, overflow, tc.want, tc.overflow)
+		}
+	}
+}
+
+func TestUint32_SubOverflow(t *testing.T) {
+	testCases := []struct {
+		x, y     Uint32
+		want     Uint32
+		overflow bool
+	}{
+		{0, 0, 0, false},
+		{1, 1, 0, false},
+		{4294967295, 1, 4294967294, false},
+		{4294967295, 4294967295, 0, false},
+		{0, 1, 4294967295, true},
+		{2, 2147483647, 2147483651, true},
+		{2, 2147483648, 2147483650, true},
+	}
+
+	for _, tc := range testCases {
+		got, overflow := tc.x.SubOverflow(tc.y)
+		if got != tc.want || overflow != tc.overflow {
+			t.Errorf("Uint32(%d).SubOverflow(%d) = %d, %t, want %d, %t", tc.x, tc.y, got, overflow, tc.want, tc.overflow)
+		}
+	}
+}
+
+func TestUint32_MulOverflow(t *testing.T) {
+	testCases := []struct {
+		x, y     Uint32
+		want     Uint32
+		overflow bool
+	}{
+		{0, 0, 0, false},
+		{1, 1, 1, false},
+		{4294967295, 1, 4294967295, false},
+		{4294967295, 4294967295, 1, true},
+		{0, 1, 0, false},
+		{2, 2147483647, 4294967294, false},
+		{2, 2147483648, 0, true},
+	}
+
+	for _, tc := range testCases {
+		got, overflow := tc.x.MulOverflow(tc.y)
+		if got != tc.want || overflow != tc.overflow {
+			t.Errorf("Uint32(%d).MulOverflow(%d) = %d, %t, want %d, %t", tc.x, tc.y, got, overflow, tc.want, tc.overflow)
+		}
+	}
+}
+
+func TestUint32_NegOverflow(t *testing.T) {
+	testCases := []struct {
+		x        Uint32
+		want     Uint32
+		overflow bool
+	}{
+		{0, 0, false},
+		{1, 4294967295, true},
+		{4294967295, 1, true},
+	}
+
+	for _, tc := range testCases {
+		got, overflow := tc.x.NegOverflow()
+		if got != tc.want || overflow != tc.overflow {
+			t.Errorf("Uint32(%d).NegOverflow() = %d, %t, want %d, %t", tc.x, got, overflow, tc.want, tc.overflow)
+		}
+	}
+}
+
+func TestUint32_LshOverflow(t *testing.T) {
+	testCases := []struct {
+		x        Uint32
+		i        uint
+		want     Uint32
+		overflow bool
+	}{
+		{1, 0, 1, false},
+		{1, 30, 1073741824, false},
+		{1, 31, 2147483648, false},
+		{1, 32, 0, true},
+		{3, 30, 3221225472, false},
+		{4294967295, 1, 4294967294, true},
+		{0, 42, 0, false},
+	}
+
+	for _, tc := range testCases {
+		got, overflow := tc.x.LshOverflow(tc.i)
+		if got != tc.want || overflow != tc.overflow {
+			t.Errorf("Uint32(%d).LshOverflow(%d) = %d, %t, want %d, %t", tc.x, tc.i, got, overflow, tc.want, tc.overflow)
+		}
+	}
+}
+
+func TestUint32_AddCarry(t *testing.T) {
+	testCases := []struct {
+		x, y     Uint32
+		carry    uint
+		want     Uint32
+		carryOut uint
+	}{
+		{0, 0, 0, 0, 0},
+		{0, 0, 1, 1, 0},
+		{1, 2, 0, 3, 0},
+		{4294967295, 0, 1, 0, 1},
+		{4294967295, 1, 0, 0, 1},
+		{4294967295, 4294967295, 0, 4294967294, 1},
+		{4294967295, 4294967295, 1, 4294967295, 1},
+	}
+
+	for _, tc := range testCases {
+		got, carry := tc.x.AddCarry(tc.y, tc.carry)
+		if got != tc.want || carry != tc.carryOut {
+			t.Errorf("Uint32(%d).AddCarry(%d, %d) = %d, %d, want %d, %d", tc.x, tc.y, tc.carry, got, carry, tc.want, tc.carryOut)
+		}
+	}
+}
+
+func TestUint32_SubBorrow(t *testing.T) {
+	testCases := []struct {
+		x, y      Uint32
+		borrow    uint
+		want      Uint32
+		borrowOut uint
+	}{
+		{0, 0, 0, 0, 0},
+		{0, 0, 1, 4294967295, 1},
+		{3, 2, 0, 1, 0},
+		{3, 2, 1, 0, 0},
+		{0, 1, 0, 4294967295, 1},
+		{4294967295, 4294967295, 1, 4294967295, 1},
+		{0, 4294967295, 1, 0, 1},
+	}
+
+	for _, tc := range testCases {
+		got, borrow := tc.x.SubBorrow(tc.y, tc.borrow)
+		if got != tc.want || borrow != tc.borrowOut {
+			t.Errorf("Uint32(%d).SubBorrow(%d, %d) = %d, %d, want %d, %d", tc.x, tc.y, tc.borrow, got, borrow, tc.want, tc.borrowOut)
+		}
+	}
+}
+
+func TestUint32_MulFull(t *testing.T) {
+	testCases := []struct {
+		x, y   Uint32
+		hi, lo Uint32
+	}{
+		{0, 0, 0, 0},
+		{1, 1, 0, 1},
+		{4294967295, 2, 1, 4294967294},
+		{4294967295, 4294967295, 4294967294, 1},
+		{2147483648, 2, 1, 0},
+		{1431655765, 6, 1, 4294967294},
+	}
+
+	for _, tc := range testCases {
+		hi, lo := tc.x.MulFull(tc.y)
+		if hi != tc.hi || lo != tc.lo {
+			t.Errorf("Uint32(%d).MulFull(%d) = %d, %d, want %d, %d", tc.x, tc.y, hi, lo, tc.hi, tc.lo)
+		}
+	}
+}
+
+func TestUint32_SaturatingAdd(t *testing.T) {
+	testCases := []struct {
+		x, y Uint32
+		want Uint32
+	}{
+		{0, 0, 0},
+		{1, 2, 3},
+		{4294967295, 1, 4294967295},
+		{4294967295, 4294967295, 4294967295},
+		{0, 1, 1},
+		{2, 2147483648, 2147483650},
+		{3, 2, 5},
+	}
+
+	for _, tc := range testCases {
+		got := tc.x.SaturatingAdd(tc.y)
+		if got != tc.want {
+			t.Errorf("Uint32(%d).SaturatingAdd(%d) = %d, want %d", tc.x, tc.y, got, tc.want)
+		}
+	}
+}
+
+func TestUint32_SaturatingSub(t *testing.T) {
+	testCases := []struct {
+		x, y Uint32
+		want Uint32
+	}{
+		{0, 0, 0},
+		{1, 2, 0},
+		{4294967295, 1, 4294967294},
+		{4294967295, 4294967295, 0},
+		{0, 1, 0},
+		{2, 2147483648, 0},
+		{3, 2, 1},
+	}
+
+	for _, tc := range testCases {
+		got := tc.x.SaturatingSub(tc.y)
+		if got != tc.want {
+			t.Errorf("Uint32(%d).SaturatingSub(%d) = %d, want %d", tc.x, tc.y, got, tc.want)
+		}
+	}
+}
+
+func TestUint32_SaturatingMul(t *testing.T) {
+	testCases := []struct {
+		x, y Uint32
+		want Uint32
+	}{
+		{0, 0, 0},
+		{1, 2, 2},
+		{4294967295, 1, 4294967295},
+		{4294967295, 4294967295, 4294967295},
+		{0, 1, 0},
+		{2, 2147483648, 4294967295},
+		{3, 2, 6},
+	}
+
+	for _, tc := range testCases {
+		got := tc.x.SaturatingMul(tc.y)
+		if got != tc.want {
+			t.Errorf("Uint32(%d).SaturatingMul(%d) = %d, want %d", tc.x, tc.y, got, tc.want)
+		}
+	}
+}
+
+func TestUint32Of(t *testing.T) {
+	testCases := []struct {
+		v    string
+		got  Uint32
+		want Uint32
+	}{
+		{"int8(-1)", Uint32Of(int8(-1)), 4294967295},
+		{"int(42)", Uint32Of(int(42)), 42},
+		{"uint64(math.MaxUint64)", Uint32Of(uint64(math.MaxUint64)), 4294967295},
+		{"int64(math.MinInt64)", Uint32Of(int64(math.MinInt64)), 0},
+		{"uintptr(7)", Uint32Of(uintptr(7)), 7},
+	}
+
+	for _, tc := range testCases {
+		if tc.got != tc.want {
+			t.Errorf("Uint32Of(%s) = %d, want %d", tc.v, tc.got, tc.want)
+		}
+	}
+}
+
+func TestUint32_IsInt64(t *testing.T) {
+	testCases := []struct {
+		a        Uint32
+		isInt64  bool
+		isUint64 bool
+		i64      int64
+		u64      uint64
+	}{
+		{0, true, true, 0, 0},
+		{1, true, true, 1, 1},
+		{4294967295, true, true, 4294967295, 4294967295},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.a.IsInt64(); got != tc.isInt64 {
+			t.Errorf("Uint32(%d).IsInt64() = %t, want %t", tc.a, got, tc.isInt64)
+		}
+		if got := tc.a.IsUint64(); got != tc.isUint64 {
+			t.Errorf("Uint32(%d).IsUint64() = %t, want %t", tc.a, got, tc.isUint64)
+		}
+
+		got, ok := tc.a.Int()
+		want, wantOK := int(tc.i64), tc.isInt64 && int64(int(tc.i64)) == tc.i64
+		if got != want || ok != wantOK {
+			t.Errorf("Uint32(%d).Int() = %d, %t, want %d, %t", tc.a, got, ok, want, wantOK)
+		}
+
+		gotU, ok := tc.a.Uint()
+		wantU, wantOK := uint(tc.u64), tc.isUint64 && uint64(uint(tc.u64)) == tc.u64
+		if gotU != wantU || ok != wantOK {
+			t.Errorf("Uint32(%d).Uint() = %d, %t, want %d, %t", tc.a, gotU, ok, wantU, wantOK)
+		}
+	}
+}
+
+func TestUint32_OnesCount(t *testing.T) {
+	testCases := []struct {
+		x    Uint32
+		want int
+	}{
+		{0, 0},
+		{1, 1},
+		{4294967295, 32},
+		{2147483648, 1},
+		{1431655765, 16},
+		{15728640, 4},
+	}
+
+	for _, tc := range testCases {
+		got := tc.x.OnesCount()
+		if got != tc.want {
+			t.Errorf("Uint32(%d).OnesCount() = %d, want %d", tc.x, got, tc.want)
+		}
+	}
+}
+
+func TestUint32_RotateLeft(t *testing.T) {
+	testCases := []struct {
+		x    Uint32
+		k    int
+		want Uint32
+	}{
+		{1, 0, 1},
+		{1, 1, 2},
+		{1, -1, 2147483648},
+		{1, 32, 1},
+		{1, 35, 8},
+		{129, 1, 258},
+		{4294967294, -3, 3758096383},
+		{18, -33, 9},
+	}
+
+	for _, tc := range testCases {
+		got := tc.x.RotateLeft(tc.k)
+		if got != tc.want {
+			t.Errorf("Uint32(%#x).RotateLeft(%d) = %#x, want %#x", tc.x, tc.k, got, tc.want)
+		}
+	}
+}
+
+func TestUint32_Reverse(t *testing.T) {
+	testCases := []struct {
+		x            Uint32
+		reverse      Uint32
+		reverseBytes Uint32
+	}{
+		{0, 0, 0},
+		{1, 2147483648, 16777216},
+		{4294967295, 4294967295, 4294967295},
+		{2147483648, 1, 128},
+		{16909060, 549470336, 67305985},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.x.Reverse(); got != tc.reverse {
+			t.Errorf("Uint32(%#x).Reverse() = %#x, want %#x", tc.x, got, tc.reverse)
+		}
+		if got := tc.x.ReverseBytes(); got != tc.reverseBytes {
+			t.Errorf("Uint32(%#x).ReverseBytes() = %#x, want %#x", tc.x, got, tc.reverseBytes)
+		}
+	}
+}
+
+func TestUint32_Bit(t *testing.T) {
+	testCases := []Uint32{
+		0,
+		1,
+		4294967295,
+		165,
+		2147483648,
+	}
+
+	for _, a := range testCases {
+		for i := range 32 {
+			want := uint(a>>i) & 1
+			if got := a.Bit(i); got != want {
+				t.Errorf("Uint32(%d).Bit(%d) = %d, want %d", a, i, got, want)
+			}
+			if got, want := a.SetBit(i, 1), a|1<<i; got != want {
+				t.Errorf("Uint32(%d).SetBit(%d, 1) = %d, want %d", a, i, got, want)
+			}
+			if got, want := a.SetBit(i, 0), a&^(1<<i); got != want {
+				t.Errorf("Uint32(%d).SetBit(%d, 0) = %d, want %d", a, i, got, want)
+			}
+			if got, want := a.ClearBit(i), a&^(1<<i); got != want {
+				t.Errorf("Uint32(%d).ClearBit(%d) = %d, want %d", a, i, got, want)
+			}
+			if got, want := a.FlipBit(i), a^(1<<i); got != want {
+				t.Errorf("Uint32(%d).FlipBit(%d) = %d, want %d", a, i, got, want)
+			}
+		}
+		if got := a.Bit(32); got != 0 {
+			t.Errorf("Uint32(%d).Bit(32) = %d, want 0", a, got)
+		}
+	}
+}
+
+func TestUint32_SetBit_Panic(t *testing.T) {
+	testCases := []struct {
+		i int
+		b uint
+	}{
+		{-1, 0},
+		{32, 0},
+		{0, 2},
+	}
+
+	for _, tc := range testCases {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Uint32(0).SetBit(%d, %d) did not panic", tc.i, tc.b)
+				}
+			}()
+			Uint32(0).SetBit(tc.i, tc.b)
+		}()
+	}
+}
+
+func TestUint32_Extract(t *testing.T) {
+	testCases := []struct {
+		x         Uint32
+		lo, width uint
+		want      Uint32
+	}{
+		{4294967295, 0, 32, 4294967295},
+		{4294967295, 0, 0, 0},
+		{165, 4, 4, 10},
+		{165, 1, 3, 2},
+		{4294967295, 31, 8, 1},
+		{90, 0, 42, 90},
+		{4294967295, 32, 4, 0},
+	}
+
+	for _, tc := range testCases {
+		got := tc.x.Extract(tc.lo, tc.width)
+		if got != tc.want {
+			t.Errorf("Uint32(%#x).Extract(%d, %d) = %#x, want %#x", tc.x, tc.lo, tc.width, got, tc.want)
+		}
+	}
+}
+
+func TestUint32_Insert(t *testing.T) {
+	testCases := []struct {
+		x, v      Uint32
+		lo, width uint
+		want      Uint32
+	}{
+		{0, 4294967295, 0, 32, 4294967295},
+		{4294967295, 0, 4, 4, 4294967055},
+		{165, 15, 1, 3, 175},
+		{0, 255, 30, 8, 3221225472},
+		{4294967295, 0, 0, 0, 4294967295},
+		{90, 3, 0, 42, 3},
+	}
+
+	for _, tc := range testCases {
+		got := tc.x.Insert(tc.v, tc.lo, tc.width)
+		if got != tc.want {
+			t.Errorf("Uint32(%#x).Insert(%#x, %d, %d) = %#x, want %#x", tc.x, tc.v, tc.lo, tc.width, got, tc.want)
+		}
+	}
+}
+
+func TestUint32_RshRound(t *testing.T) {
+	values := []Uint32{
+		0,
+		1,
+		2,
+		3,
+		5,
+		6,
+		32768,
+		49152,
+		2147483647,
+		2147483648,
+		2147483649,
+		2952790016,
+		4294967295,
+	}
+	shifts := []uint{0, 1, 2, 3, 15, 16, 17, 31, 32, 33, 96}
+
+	for _, a := range values {
+		x := new(big.Int).SetUint64(uint64(a))
+		for _, i := range shifts {
+			if got, want := a.RshRound(i), rshRoundBigInt(x, i); got.BigInt().Cmp(want) != 0 {
+				t.Errorf("Uint32(%d).RshRound(%d) = %d, want %d", a, i, got, want)
+			}
+			if got, want := a.RshCeil(i), rshCeilBigInt(x, i); got.BigInt().Cmp(want) != 0 {
+				t.Errorf("Uint32(%d).RshCeil(%d) = %d, want %d", a, i, got, want)
+			}
+			if got, want := a.URsh(i), new(big.Int).Rsh(new(big.Int).SetUint64(uint64(a)), i); got.BigInt().Cmp(want) != 0 {
+				t.Errorf("Uint32(%d).URsh(%d) = %d, want %d", a, i, got, want)
+			}
+		}
+	}
+}
+
+func TestUint32_Div64(t *testing.T) {
+	testCases := []struct {
+		hi, lo, y Uint32
+		quo, rem  Uint32
+	}{
+		{0, 0, 1, 0, 0},
+		{0, 4294967295, 7, 613566756, 3},
+		{1, 0, 2, 2147483648, 0},
+		{4294967294, 4294967295, 4294967295, 4294967295, 4294967294},
+		{5, 12345, 6, 3579141470, 5},
+		{1073741824, 3, 2147483648, 2147483648, 3},
+	}
+
+	for _, tc := range testCases {
+		quo, rem := tc.y.Div64(tc.hi, tc.lo)
+		if quo != tc.quo || rem != tc.rem {
+			t.Errorf("Uint32(%d).Div64(%d, %d) = %d, %d, want %d, %d", tc.y, tc.hi, tc.lo, quo, rem, tc.quo, tc.rem)
+		}
+	}
+}
+
+func TestUint32_Div64_Panic(t *testing.T) {
+	testCases := []struct {
+		hi, lo, y Uint32
+		want      string
+	}{
+		{0, 1, 0, "runtime error: integer divide by zero"},
+		{1, 0, 1, "runtime error: integer overflow"},
+		{4294967295, 0, 2, "runtime error: integer overflow"},
+	}
+
+	for _, tc := range testCases {
+		func() {
+			defer func() {
+				err, ok := recover().(runtime.Error)
+				if !ok {
+					t.Errorf("Uint32(%d).Div64(%d, %d) did not panic with a runtime.Error", tc.y, tc.hi, tc.lo)
+					return
+				}
+				if err.Error() != tc.want {
+					t.Errorf("Uint32(%d).Div64(%d, %d) panicked with %q, want %q", tc.y, tc.hi, tc.lo, err.Error(), tc.want)
+				}
+			}()
+			tc.y.Div64(tc.hi, tc.lo)
+		}()
+	}
+}
+
+func TestUint32_ModArith(t *testing.T) {
+	testCases := []struct {
+		a, b, m Uint32
+		add     Uint32
+		sub     Uint32
+		mul     Uint32
+		exp     Uint32
+	}{
+		{0, 0, 1, 0, 0, 0, 0},
+		{4294967295, 4294967295, 4294967295, 0, 0, 0, 0},
+		{4294967295, 4294967295, 4294967294, 2, 0, 1, 1},
+		{4294967294, 3, 2147483648, 1, 2147483643, 2147483642, 2147483640},
+		{12345, 54321, 97, 27, 25, 26, 63},
+		{1, 4294967295, 2, 0, 0, 1, 1},
+		{1431655765, 858993459, 613566757, 449948953, 572662306, 81808902, 204516791},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.a.AddMod(tc.b, tc.m); got != tc.add {
+			t.Errorf("Uint32(%d).AddMod(%d, %d) = %d, want %d", tc.a, tc.b, tc.m, got, tc.add)
+		}
+		if got := tc.a.SubMod(tc.b, tc.m); got != tc.sub {
+			t.Errorf("Uint32(%d).SubMod(%d, %d) = %d, want %d", tc.a, tc.b, tc.m, got, tc.sub)
+		}
+		if got := tc.a.MulMod(tc.b, tc.m); got != tc.mul {
+			t.Errorf("Uint32(%d).MulMod(%d, %d) = %d, want %d", tc.a, tc.b, tc.m, got, tc.mul)
+		}
+		if got := tc.a.ExpMod(tc.b, tc.m); got != tc.exp {
+			t.Errorf("Uint32(%d).ExpMod(%d, %d) = %d, want %d", tc.a, tc.b, tc.m, got, tc.exp)
+		}
+	}
+}
+
+func TestUint32_GCD(t *testing.T) {
+	testCases := []struct {
+		a, b Uint32
+		gcd  Uint32
+		lcm  Uint32
+		inv  Uint32
+		ok   bool
+	}{
+		{0, 0, 0, 0, 0, false},
+		{0, 5, 5, 0, 0, false},
+		{5, 0, 5, 0, 0, false},
+		{12, 18, 6, 36, 0, false},
+		{4294967295, 4294967295, 4294967295, 4294967295, 0, false},
+		{4294967295, 4294967294, 1, 2, 1, true},
+		{2147483648, 1073741824, 1073741824, 2147483648, 0, false},
+		{1431655765, 858993459, 286331153, 4294967295, 0, false},
+		{240, 46, 2, 5520, 0, false},
+		{4294967294, 2147483648, 2, 2147483648, 0, false},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.a.GCD(tc.b); got != tc.gcd {
+			t.Errorf("Uint32(%d).GCD(%d) = %d, want %d", tc.a, tc.b, got, tc.gcd)
+		}
+		if got := tc.a.LCM(tc.b); got != tc.lcm {
+			t.Errorf("Uint32(%d).LCM(%d) = %d, want %d", tc.a, tc.b, got, tc.lcm)
+		}
+		g, x, y := tc.a.ExtendedGCD(tc.b)
+		if g != tc.gcd || int64(tc.a)*int64(x)+int64(tc.b)*int64(y) != int64(g) {
+			t.Errorf("Uint32(%d).ExtendedGCD(%d) = (%d, %d, %d), want g = %d", tc.a, tc.b, g, x, y, tc.gcd)
+		}
+		if inv, ok := tc.a.ModInverse(tc.b); inv != tc.inv || ok != tc.ok {
+			t.Errorf("Uint32(%d).ModInverse(%d) = (%d, %t), want (%d, %t)", tc.a, tc.b, inv, ok, tc.inv, tc.ok)
+		}
+	}
+}
+
+func TestUint32_Sqrt(t *testing.T) {
+	testCases := []struct {
+		a        Uint32
+		sqrt     Uint32
+		cbrt     Uint32
+		root5    Uint32
+		isSquare bool
+	}{
+		{0, 0, 0, 0, true},
+		{1, 1, 1, 1, true},
+		{2, 1, 1, 1, false},
+		{3, 1, 1, 1, false},
+		{4, 2, 1, 1, true},
+		{15, 3, 2, 1, false},
+		{16, 4, 2, 1, true},
+		{17, 4, 2, 1, false},
+		{26, 5, 2, 1, false},
+		{27, 5, 3, 1, false},
+		{28, 5, 3, 1, false},
+		{1000, 31, 10, 3, false},
+		{1024, 32, 10, 4, true},
+		{59049, 243, 38, 9, true},
+		{12345678, 3513, 231, 26, false},
+		{4294967294, 65535, 1625, 84, false},
+		{4294967295, 65535, 1625, 84, false},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.a.Sqrt(); got != tc.sqrt {
+			t.Errorf("Uint32(%d).Sqrt() = %d, want %d", tc.a, got, tc.sqrt)
+		}
+		if got := tc.a.Cbrt(); got != tc.cbrt {
+			t.Errorf("Uint32(%d).Cbrt() = %d, want %d", tc.a, got, tc.cbrt)
+		}
+		if got := tc.a.Root(5); got != tc.root5 {
+			t.Errorf("Uint32(%d).Root(5) = %d, want %d", tc.a, got, tc.root5)
+		}
+		if got := tc.a.IsPerfectSquare(); got != tc.isSquare {
+			t.Errorf("Uint32(%d).IsPerfectSquare() = %t, want %t", tc.a, got, tc.isSquare)
+		}
+	}
+}
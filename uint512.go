@@ -2,19 +2,66 @@ package ints
 
 import (
 	"cmp"
+	"encoding/binary"
 	"fmt"
+	"math"
+	"math/big"
 	"math/bits"
 )
 
 // Uint512 is a type that represents an 512-bit unsigned integer.
 type Uint512 [8]uint64
 
+// MaxUint512 is the maximum value of Uint512.
+var MaxUint512 = Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+
+// Uint512FromUint64 returns v as a Uint512.
+func Uint512FromUint64(v uint64) Uint512 {
+	return Uint512{0, 0, 0, 0, 0, 0, 0, v}
+}
+
+// Uint512Of returns v as a Uint512.
+// v is converted in the same way as Go's integer conversions:
+// it is sign-extended if T is a signed type, and zero-extended otherwise.
+func Uint512Of[T builtinInteger](v T) Uint512 {
+	if isSigned[T]() {
+		return Int64(v).Uint512()
+	}
+	return Uint64(v).Uint512()
+}
+
 // IsZero returns true if a is zero.
 func (a Uint512) IsZero() bool {
 	var zero Uint512
 	return a == zero
 }
 
+// IsInt64 reports whether a can be represented as an int64.
+func (a Uint512) IsInt64() bool {
+	_, ok := a.TryInt64()
+	return ok
+}
+
+// IsUint64 reports whether a can be represented as a uint64.
+func (a Uint512) IsUint64() bool {
+	_, ok := a.TryUint64()
+	return ok
+}
+
+// Int returns a as an int and reports whether a can be represented as an int.
+// If it can't, the result is a truncated to the size of int.
+func (a Uint512) Int() (int, bool) {
+	v, ok := a.TryInt64()
+	return int(v), ok && int64(int(v)) == int64(v)
+}
+
+// Uint returns a as a uint and reports whether a can be represented as a uint.
+// If it can't, the result is a truncated to the size of uint.
+func (a Uint512) Uint() (uint, bool) {
+	v, ok := a.TryUint64()
+	return uint(v), ok && uint64(uint(v)) == uint64(v)
+}
+
 // Add returns the sum a+b.
 //
 // This function's execution time does not depend on the inputs.
@@ -30,6 +77,48 @@ func (a Uint512) Add(b Uint512) Uint512 {
 	return Uint512{u0, u1, u2, u3, u4, u5, u6, u7}
 }
 
+// AddOverflow returns the sum a+b and reports whether the addition overflowed.
+//
+// This function's execution time does not depend on the inputs.
+func (a Uint512) AddOverflow(b Uint512) (Uint512, bool) {
+	u7, carry := bits.Add64(a[7], b[7], 0)
+	u6, carry := bits.Add64(a[6], b[6], carry)
+	u5, carry := bits.Add64(a[5], b[5], carry)
+	u4, carry := bits.Add64(a[4], b[4], carry)
+	u3, carry := bits.Add64(a[3], b[3], carry)
+	u2, carry := bits.Add64(a[2], b[2], carry)
+	u1, carry := bits.Add64(a[1], b[1], carry)
+	u0, carry := bits.Add64(a[0], b[0], carry)
+	return Uint512{u0, u1, u2, u3, u4, u5, u6, u7}, carry != 0
+}
+
+// SaturatingAdd returns the sum a+b.
+// If the result overflows, it is clamped to the maximum value.
+func (a Uint512) SaturatingAdd(b Uint512) Uint512 {
+	c, overflow := a.AddOverflow(b)
+	if overflow {
+		return Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return c
+}
+
+// AddCarry returns the sum with carry of a, b and carry: sum = a + b + carry.
+// The carry input must be 0 or 1; otherwise the behavior is undefined.
+// The carryOut output is guaranteed to be 0 or 1.
+//
+// This function's execution time does not depend on the inputs.
+func (a Uint512) AddCarry(b Uint512, carry uint) (sum Uint512, carryOut uint) {
+	u7, c := bits.Add64(a[7], b[7], uint64(carry))
+	u6, c := bits.Add64(a[6], b[6], c)
+	u5, c := bits.Add64(a[5], b[5], c)
+	u4, c := bits.Add64(a[4], b[4], c)
+	u3, c := bits.Add64(a[3], b[3], c)
+	u2, c := bits.Add64(a[2], b[2], c)
+	u1, c := bits.Add64(a[1], b[1], c)
+	u0, c := bits.Add64(a[0], b[0], c)
+	return Uint512{u0, u1, u2, u3, u4, u5, u6, u7}, uint(c)
+}
+
 // Sub returns the difference a-b.
 //
 // This function's execution time does not depend on the inputs.
@@ -45,6 +134,48 @@ func (a Uint512) Sub(b Uint512) Uint512 {
 	return Uint512{u0, u1, u2, u3, u4, u5, u6, u7}
 }
 
+// SubOverflow returns the difference a-b and reports whether the subtraction overflowed.
+//
+// This function's execution time does not depend on the inputs.
+func (a Uint512) SubOverflow(b Uint512) (Uint512, bool) {
+	u7, borrow := bits.Sub64(a[7], b[7], 0)
+	u6, borrow := bits.Sub64(a[6], b[6], borrow)
+	u5, borrow := bits.Sub64(a[5], b[5], borrow)
+	u4, borrow := bits.Sub64(a[4], b[4], borrow)
+	u3, borrow := bits.Sub64(a[3], b[3], borrow)
+	u2, borrow := bits.Sub64(a[2], b[2], borrow)
+	u1, borrow := bits.Sub64(a[1], b[1], borrow)
+	u0, borrow := bits.Sub64(a[0], b[0], borrow)
+	return Uint512{u0, u1, u2, u3, u4, u5, u6, u7}, borrow != 0
+}
+
+// SaturatingSub returns the difference a-b.
+// If the result overflows, it is clamped to zero.
+func (a Uint512) SaturatingSub(b Uint512) Uint512 {
+	c, overflow := a.SubOverflow(b)
+	if overflow {
+		return Uint512{}
+	}
+	return c
+}
+
+// SubBorrow returns the difference of a, b and borrow: diff = a - b - borrow.
+// The borrow input must be 0 or 1; otherwise the behavior is undefined.
+// The borrowOut output is guaranteed to be 0 or 1.
+//
+// This function's execution time does not depend on the inputs.
+func (a Uint512) SubBorrow(b Uint512, borrow uint) (diff Uint512, borrowOut uint) {
+	u7, c := bits.Sub64(a[7], b[7], uint64(borrow))
+	u6, c := bits.Sub64(a[6], b[6], c)
+	u5, c := bits.Sub64(a[5], b[5], c)
+	u4, c := bits.Sub64(a[4], b[4], c)
+	u3, c := bits.Sub64(a[3], b[3], c)
+	u2, c := bits.Sub64(a[2], b[2], c)
+	u1, c := bits.Sub64(a[1], b[1], c)
+	u0, c := bits.Sub64(a[0], b[0], c)
+	return Uint512{u0, u1, u2, u3, u4, u5, u6, u7}, uint(c)
+}
+
 // Mul returns the product a*b.
 //
 // This function's execution time does not depend on the inputs.
@@ -267,6 +398,29 @@ func (a Uint512) Mul(b Uint512) Uint512 {
 	return Uint512{u0, u1, u2, u3, u4, u5, u6, u7}
 }
 
+// MulOverflow returns the product a*b and reports whether the multiplication overflowed.
+func (a Uint512) MulOverflow(b Uint512) (Uint512, bool) {
+	c := a.Mul1024(b)
+	return Uint512{c[8], c[9], c[10], c[11], c[12], c[13], c[14], c[15]}, c[0]|c[1]|c[2]|c[3]|c[4]|c[5]|c[6]|c[7] != 0
+}
+
+// SaturatingMul returns the product a*b.
+// If the result overflows, it is clamped to the maximum value.
+func (a Uint512) SaturatingMul(b Uint512) Uint512 {
+	c, overflow := a.MulOverflow(b)
+	if overflow {
+		return Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	}
+	return c
+}
+
+// MulFull returns the 1024-bit product of a and b: (hi, lo) = a * b
+// with the product bits' upper half returned in hi and the lower half returned in lo.
+func (a Uint512) MulFull(b Uint512) (hi, lo Uint512) {
+	c := a.Mul1024(b)
+	return Uint512{c[0], c[1], c[2], c[3], c[4], c[5], c[6], c[7]}, Uint512{c[8], c[9], c[10], c[11], c[12], c[13], c[14], c[15]}
+}
+
 // Mul1024 returns the product a*b, the result is a 1024-bit integer.
 //
 // This function's execution time does not depend on the inputs.
@@ -673,10 +827,10 @@ func (a Uint512) DivMod(b Uint512) (Uint512, Uint512) {
 // https://github.com/golang/go/blob/c893e1cf821b06aa0602f7944ce52f0eb28fd7b5/src/math/bits/bits.go#L514-L568
 func div256(hi, lo, y Uint256) (quo, rem Uint256) {
 	if y.IsZero() {
-		panic("division by zero")
+		panicDivideByZero()
 	}
 	if y.Cmp(hi) <= 0 {
-		panic("division overflow")
+		panicDivideOverflow()
 	}
 
 	// If high part is zero, we can directly return the results.
@@ -750,6 +904,283 @@ func (a Uint512) QuoRem(b Uint512) (Uint512, Uint512) {
 	return a.DivMod(b)
 }
 
+// Div1024 returns the quotient and remainder of (hi, lo) divided by a:
+// quo = (hi, lo)/a, rem = (hi, lo)%a with the dividend bits' upper half
+// in parameter hi and the lower half in parameter lo.
+// Div1024 panics for a == 0 (division by zero) or a <= hi (quotient overflow).
+// Like [math/bits.Div64], it panics with a [runtime.Error].
+func (a Uint512) Div1024(hi, lo Uint512) (quo, rem Uint512) {
+	return div512(hi, lo, a)
+}
+
+// AddMod returns (a+b) mod m.
+// It panics if m == 0.
+func (a Uint512) AddMod(b, m Uint512) Uint512 {
+	a, b = a.Mod(m), b.Mod(m)
+	s, carry := a.AddCarry(b, 0)
+	if carry != 0 || s.Cmp(m) >= 0 {
+		s = s.Sub(m)
+	}
+	return s
+}
+
+// SubMod returns (a-b) mod m.
+// The result is always in the range [0, m).
+// It panics if m == 0.
+func (a Uint512) SubMod(b, m Uint512) Uint512 {
+	a, b = a.Mod(m), b.Mod(m)
+	d, borrow := a.SubBorrow(b, 0)
+	if borrow != 0 {
+		d = d.Add(m)
+	}
+	return d
+}
+
+// MulMod returns (a*b) mod m, computed without overflow.
+// It panics if m == 0.
+func (a Uint512) MulMod(b, m Uint512) Uint512 {
+	hi, lo := a.MulFull(b)
+	_, r := m.Div1024(hi.Mod(m), lo)
+	return r
+}
+
+// ExpMod returns a**e mod m.
+// It panics if m == 0.
+func (a Uint512) ExpMod(e, m Uint512) Uint512 {
+	r := Uint512{0, 0, 0, 0, 0, 0, 0, 1}.Mod(m)
+	a = a.Mod(m)
+	for i := e.BitLen() - 1; i >= 0; i-- {
+		r = r.MulMod(r, m)
+		if e.Bit(i) != 0 {
+			r = r.MulMod(a, m)
+		}
+	}
+	return r
+}
+
+// GCD returns the greatest common divisor of a and b.
+// GCD(a, 0) is a, and GCD(0, 0) is 0.
+// It uses the binary GCD algorithm.
+func (a Uint512) GCD(b Uint512) Uint512 {
+	if a.IsZero() {
+		return b
+	}
+	if b.IsZero() {
+		return a
+	}
+	i, j := a.TrailingZeros(), b.TrailingZeros()
+	k := min(i, j)
+	a, b = a.Rsh(uint(i)), b.Rsh(uint(j))
+	for {
+		// a and b are odd here.
+		if a.Cmp(b) > 0 {
+			a, b = b, a
+		}
+		b = b.Sub(a)
+		if b.IsZero() {
+			return a.Lsh(uint(k))
+		}
+		b = b.Rsh(uint(b.TrailingZeros()))
+	}
+}
+
+// LCM returns the least common multiple of a and b.
+// LCM(a, 0) is 0. The result wraps around on overflow.
+func (a Uint512) LCM(b Uint512) Uint512 {
+	if a.IsZero() || b.IsZero() {
+		return Uint512{}
+	}
+	return a.Div(a.GCD(b)).Mul(b)
+}
+
+// ExtendedGCD returns the greatest common divisor g of a and b,
+// and the Bézout coefficients x and y that satisfy a*x + b*y = g.
+// The coefficients satisfy |x| <= b/(2g) + 1 and |y| <= a/(2g) + 1,
+// so they always fit in [Int512].
+// It uses the binary GCD algorithm and doesn't perform any division.
+func (a Uint512) ExtendedGCD(b Uint512) (g Uint512, x, y Int512) {
+	if a.IsZero() {
+		return b, Int512{}, Int512{0, 0, 0, 0, 0, 0, 0, 1}
+	}
+	if b.IsZero() {
+		return a, Int512{0, 0, 0, 0, 0, 0, 0, 1}, Int512{}
+	}
+
+	// Remove the common factors of 2, so that at least one of a and b is odd.
+	// The coefficients for a/2**k and b/2**k also work for a and b.
+	k := uint(min(a.TrailingZeros(), b.TrailingZeros()))
+	a, b = a.Rsh(k), b.Rsh(k)
+	if b[7]&1 == 0 {
+		g, y, x = b.ExtendedGCD(a)
+		return g.Lsh(k), x, y
+	}
+
+	// Divide a and b by their GCD h, which is odd because b is odd.
+	// The divisions are exact, so they are the multiplications by the inverse of h modulo 2**512.
+	h := a.GCD(b)
+	hinv := inverseOdd512(h)
+	a, b = a.Mul(hinv), b.Mul(hinv)
+
+	// Now a and b are coprime and b is odd, so x = a**-1 mod b exists.
+	// Choose x in the range (-b/2, b/2) to minimize |x| and |y|.
+	// y = (1 - a*x)/b is an exact division again.
+	u, _ := modInverseOdd512(a, b)
+	if u.Cmp(b.Rsh(1)) > 0 {
+		u = u.Sub(b)
+	}
+	v := Uint512{0, 0, 0, 0, 0, 0, 0, 1}.Sub(a.Mul(u)).Mul(inverseOdd512(b))
+	return h.Lsh(k), Int512(u), Int512(v)
+}
+
+// ModInverse returns the multiplicative inverse of a modulo m, in the range [0, m).
+// ok is false if a and m are not coprime, or m == 0.
+// It uses the binary GCD algorithm and doesn't perform any division.
+func (a Uint512) ModInverse(m Uint512) (x Uint512, ok bool) {
+	if m.IsZero() {
+		return Uint512{}, false
+	}
+	if m[7]&1 != 0 {
+		return modInverseOdd512(a, m)
+	}
+
+	g, s, _ := a.ExtendedGCD(m)
+	if g != (Uint512{0, 0, 0, 0, 0, 0, 0, 1}) {
+		return Uint512{}, false
+	}
+	if s.Sign() < 0 {
+		return Uint512(s).Add(m), true
+	}
+	return Uint512(s), true
+}
+
+// modInverseOdd512 returns the multiplicative inverse of a modulo odd m, in the range [0, m).
+// ok is false if a and m are not coprime.
+func modInverseOdd512(a, m Uint512) (x Uint512, ok bool) {
+	if m == (Uint512{0, 0, 0, 0, 0, 0, 0, 1}) {
+		return Uint512{}, true
+	}
+
+	// It keeps the invariants a*x1 = u and a*x2 = v (mod m).
+	half := func(x Uint512) Uint512 {
+		if x[7]&1 == 0 {
+			return x.Rsh(1)
+		}
+		s, carry := x.AddCarry(m, 0)
+		s = s.Rsh(1)
+		s[0] |= uint64(carry) << 63
+		return s
+	}
+	sub := func(x, y Uint512) Uint512 {
+		d, borrow := x.SubBorrow(y, 0)
+		if borrow != 0 {
+			d = d.Add(m)
+		}
+		return d
+	}
+	u, v := a, m
+	x1, x2 := Uint512{0, 0, 0, 0, 0, 0, 0, 1}, Uint512{}
+	for !u.IsZero() {
+		for u[7]&1 == 0 {
+			u, x1 = u.Rsh(1), half(x1)
+		}
+		for v[7]&1 == 0 {
+			v, x2 = v.Rsh(1), half(x2)
+		}
+		if u.Cmp(v) >= 0 {
+			u, x1 = u.Sub(v), sub(x1, x2)
+		} else {
+			v, x2 = v.Sub(u), sub(x2, x1)
+		}
+	}
+	if v != (Uint512{0, 0, 0, 0, 0, 0, 0, 1}) {
+		return Uint512{}, false
+	}
+	return x2, true
+}
+
+// inverseOdd512 returns the multiplicative inverse of odd a modulo 2**512.
+func inverseOdd512(a Uint512) Uint512 {
+	// Newton's method: each iteration doubles the number of correct low bits,
+	// and x = a is correct to 3 bits because a*a = 1 mod 8 for any odd a.
+	x := a
+	for i := 3; i < 512; i *= 2 {
+		x = x.Mul(Uint512{0, 0, 0, 0, 0, 0, 0, 2}.Sub(a.Mul(x)))
+	}
+	return x
+}
+
+// Sqrt returns the floor square root of a, ⌊√a⌋.
+// It uses Newton's method seeded from [Uint512.BitLen].
+func (a Uint512) Sqrt() Uint512 {
+	if a.Cmp(Uint512{0, 0, 0, 0, 0, 0, 0, 1}) <= 0 {
+		return a
+	}
+	x := Uint512{0, 0, 0, 0, 0, 0, 0, 1}.Lsh(uint(a.BitLen()+1) / 2)
+	for {
+		y := x.Add(a.Div(x)).Rsh(1)
+		if y.Cmp(x) >= 0 {
+			return x
+		}
+		x = y
+	}
+}
+
+// Cbrt returns the floor cube root of a, ⌊∛a⌋.
+func (a Uint512) Cbrt() Uint512 {
+	return a.Root(3)
+}
+
+// Root returns the floor n-th root of a, ⌊a**(1/n)⌋.
+// It uses Newton's method seeded from [Uint512.BitLen].
+// It panics if n == 0.
+func (a Uint512) Root(n uint) Uint512 {
+	switch n {
+	case 0:
+		panic("ints: zeroth root")
+	case 1:
+		return a
+	case 2:
+		return a.Sqrt()
+	}
+	bitLen := uint(a.BitLen())
+	if bitLen <= n {
+		// a < 2**n, so the root is 0 or 1.
+		if a.IsZero() {
+			return a
+		}
+		return Uint512{0, 0, 0, 0, 0, 0, 0, 1}
+	}
+
+	x := Uint512{0, 0, 0, 0, 0, 0, 0, 1}.Lsh((bitLen + n - 1) / n)
+	n1, d := Uint512FromUint64(uint64(n-1)), Uint512FromUint64(uint64(n))
+	for {
+		// y = ((n-1)*x + a/x**(n-1)) / n
+		p, overflow := x, false
+		for i := uint(2); i < n && !overflow; i++ {
+			p, overflow = p.MulOverflow(x)
+		}
+		var q Uint512
+		if !overflow {
+			q = a.Div(p)
+		}
+		y := x.Mul(n1).Add(q).Div(d)
+		if y.Cmp(x) >= 0 {
+			return x
+		}
+		x = y
+	}
+}
+
+// IsPerfectSquare reports whether a is the square of an integer.
+func (a Uint512) IsPerfectSquare() bool {
+	// Squares modulo 16 are 0, 1, 4 or 9.
+	if (0x0213>>(a[7]&15))&1 == 0 {
+		return false
+	}
+	r := a.Sqrt()
+	return r.Mul(r) == a
+}
+
 // And returns the bitwise AND of a and b.
 func (a Uint512) And(b Uint512) Uint512 {
 	return Uint512{
@@ -854,6 +1285,13 @@ func (a Uint512) Lsh(i uint) Uint512 {
 	}
 }
 
+// LshOverflow returns the left shift a<<i and reports whether the shift overflowed,
+// i.e. whether a<<i differs from a multiplied by 2**i.
+func (a Uint512) LshOverflow(i uint) (Uint512, bool) {
+	c := a.Lsh(i)
+	return c, c.Rsh(i) != a
+}
+
 // Rsh returns the logical right shift a>>i.
 //
 // This function's execution time does not depend on the inputs.
@@ -888,6 +1326,126 @@ func (a Uint512) Rsh(i uint) Uint512 {
 	}
 }
 
+// URsh returns the logical right shift a>>i.
+// It is the same as [Uint512.Rsh] because Uint512 is unsigned.
+func (a Uint512) URsh(i uint) Uint512 {
+	return a.Rsh(i)
+}
+
+// RshRound returns a/2**i rounded to the nearest integer, with ties rounded to even.
+func (a Uint512) RshRound(i uint) Uint512 {
+	if i == 0 {
+		return a
+	}
+	q := a.Rsh(i)
+	half := a.Bit(int(min(i-1, 512)))
+	sticky := !a.Extract(0, i-1).IsZero()
+	if half == 1 && (sticky || q.Bit(0) == 1) {
+		q = q.Add(Uint512{0, 0, 0, 0, 0, 0, 0, 1})
+	}
+	return q
+}
+
+// RshCeil returns a/2**i rounded toward positive infinity.
+func (a Uint512) RshCeil(i uint) Uint512 {
+	q := a.Rsh(i)
+	if !a.Extract(0, i).IsZero() {
+		q = q.Add(Uint512{0, 0, 0, 0, 0, 0, 0, 1})
+	}
+	return q
+}
+
+// RotateLeft returns the value of a rotated left by (k mod 512) bits.
+// To rotate a right by k bits, call a.RotateLeft(-k).
+//
+// This function's execution time does not depend on the inputs.
+func (a Uint512) RotateLeft(k int) Uint512 {
+	s := uint(k) & 511
+	return a.Lsh(s).Or(a.Rsh(512 - s))
+}
+
+// Reverse returns the value of a with its bits in reversed order.
+func (a Uint512) Reverse() Uint512 {
+	return Uint512{
+		bits.Reverse64(a[7]),
+		bits.Reverse64(a[6]),
+		bits.Reverse64(a[5]),
+		bits.Reverse64(a[4]),
+		bits.Reverse64(a[3]),
+		bits.Reverse64(a[2]),
+		bits.Reverse64(a[1]),
+		bits.Reverse64(a[0]),
+	}
+}
+
+// ReverseBytes returns the value of a with its bytes in reversed order.
+func (a Uint512) ReverseBytes() Uint512 {
+	return Uint512{
+		bits.ReverseBytes64(a[7]),
+		bits.ReverseBytes64(a[6]),
+		bits.ReverseBytes64(a[5]),
+		bits.ReverseBytes64(a[4]),
+		bits.ReverseBytes64(a[3]),
+		bits.ReverseBytes64(a[2]),
+		bits.ReverseBytes64(a[1]),
+		bits.ReverseBytes64(a[0]),
+	}
+}
+
+// Bit returns the value of the i'th bit of a. That is, it returns (a>>i)&1.
+// The bit index i must be >= 0.
+func (a Uint512) Bit(i int) uint {
+	if i < 0 {
+		panic("ints: negative bit index")
+	}
+	if i >= 512 {
+		return 0
+	}
+	return uint(a[7-i/64]>>(uint(i)%64)) & 1
+}
+
+// SetBit returns a with its i'th bit set to b (0 or 1).
+// It panics if i is out of the range [0, 512) or b is not 0 or 1.
+func (a Uint512) SetBit(i int, b uint) Uint512 {
+	checkBitIndex(i, 512)
+	if b > 1 {
+		panic("ints: set bit is not 0 or 1")
+	}
+	j, s := 7-i/64, uint(i)%64
+	a[j] = a[j]&^(1<<s) | uint64(b)<<s
+	return a
+}
+
+// ClearBit returns a with its i'th bit set to 0.
+// It panics if i is out of the range [0, 512).
+func (a Uint512) ClearBit(i int) Uint512 {
+	checkBitIndex(i, 512)
+	a[7-i/64] &^= 1 << (uint(i) % 64)
+	return a
+}
+
+// FlipBit returns a with its i'th bit inverted.
+// It panics if i is out of the range [0, 512).
+func (a Uint512) FlipBit(i int) Uint512 {
+	checkBitIndex(i, 512)
+	a[7-i/64] ^= 1 << (uint(i) % 64)
+	return a
+}
+
+// Extract returns the width bits of a starting at bit lo, shifted down to bit 0.
+// That is, it returns (a>>lo) & (1<<width - 1).
+func (a Uint512) Extract(lo, width uint) Uint512 {
+	width = min(width, 512)
+	return a.Rsh(lo).And(Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}.Rsh(512 - width))
+}
+
+// Insert returns a with the width bits starting at bit lo replaced by the low width bits of v.
+func (a Uint512) Insert(v Uint512, lo, width uint) Uint512 {
+	width = min(width, 512)
+	mask := Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}.Rsh(512 - width).Lsh(lo)
+	return a.AndNot(mask).Or(v.Lsh(lo).And(mask))
+}
+
 // LeadingZeros returns the number of leading zero bits in a; the result is 512 for a == 0.
 func (a Uint512) LeadingZeros() int {
 	if a[0] != 0 {
@@ -966,6 +1524,18 @@ func (a Uint512) BitLen() int {
 	return bits.Len64(a[7])
 }
 
+// OnesCount returns the number of one bits ("population count") in a.
+func (a Uint512) OnesCount() int {
+	return bits.OnesCount64(a[0]) +
+		bits.OnesCount64(a[1]) +
+		bits.OnesCount64(a[2]) +
+		bits.OnesCount64(a[3]) +
+		bits.OnesCount64(a[4]) +
+		bits.OnesCount64(a[5]) +
+		bits.OnesCount64(a[6]) +
+		bits.OnesCount64(a[7])
+}
+
 // Sign returns the sign of a.
 // It returns 1 if a > 0, and 0 if a == 0.
 // It does not return -1 because Uint512 is unsigned.
@@ -992,6 +1562,14 @@ func (a Uint512) Neg() Uint512 {
 	return Uint512{u0, u1, u2, u3, u4, u5, u6, u7}
 }
 
+// NegOverflow returns the negation of a and reports whether the negation overflowed,
+// which happens if a is not zero.
+//
+// This function's execution time does not depend on the inputs.
+func (a Uint512) NegOverflow() (Uint512, bool) {
+	return a.Neg(), !a.IsZero()
+}
+
 // Cmp returns the comparison result of a and b.
 // It returns -1 if a < b, 0 if a == b, and 1 if a > b.
 func (a Uint512) Cmp(b Uint512) int {
@@ -1050,3 +1628,190 @@ func (a Uint512) String() string {
 func (a Uint512) Format(s fmt.State, verb rune) {
 	format(s, verb, a.Sign(), a)
 }
+
+// ParseUint512 is like [ParseInt512] but for unsigned numbers.
+// A sign prefix is not permitted.
+func ParseUint512(s string, base int) (Uint512, error) {
+	var z Uint512
+	err := parseUint("ParseUint512", s, base, 512, z[:])
+	return z, err
+}
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+// The result is the decimal representation of a.
+func (a Uint512) MarshalText() ([]byte, error) {
+	return a.AppendText(nil)
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+// It accepts the same syntax as Go integer literals, such as "0x" prefixes and underscores.
+// See [ParseUint512] for more details.
+func (a *Uint512) UnmarshalText(text []byte) error {
+	v, err := ParseUint512(string(text), 0)
+	if err != nil {
+		return err
+	}
+	*a = v
+	return nil
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+// The result is a JSON number. Use [JSONString] to encode a as a JSON string.
+func (a Uint512) MarshalJSON() ([]byte, error) {
+	return a.AppendText(nil)
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+// It accepts both a JSON number such as 123 and a JSON string such as "123" or "0x7b".
+// JSON strings are parsed in the same way as [Uint512.UnmarshalText].
+func (a *Uint512) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(a, data, ParseUint512)
+}
+
+// BytesBE returns the big-endian representation of a.
+func (a Uint512) BytesBE() [64]byte {
+	var b [64]byte
+	a.PutBytesBE(b[:])
+	return b
+}
+
+// BytesLE returns the little-endian representation of a.
+func (a Uint512) BytesLE() [64]byte {
+	var b [64]byte
+	a.PutBytesLE(b[:])
+	return b
+}
+
+// PutBytesBE stores the big-endian representation of a into b.
+// It panics if len(b) < 64.
+func (a Uint512) PutBytesBE(b []byte) {
+	_ = b[63] // bounds check hint to compiler; see golang.org/issue/14808
+	binary.BigEndian.PutUint64(b[0:], a[0])
+	binary.BigEndian.PutUint64(b[8:], a[1])
+	binary.BigEndian.PutUint64(b[16:], a[2])
+	binary.BigEndian.PutUint64(b[24:], a[3])
+	binary.BigEndian.PutUint64(b[32:], a[4])
+	binary.BigEndian.PutUint64(b[40:], a[5])
+	binary.BigEndian.PutUint64(b[48:], a[6])
+	binary.BigEndian.PutUint64(b[56:], a[7])
+}
+
+// PutBytesLE stores the little-endian representation of a into b.
+// It panics if len(b) < 64.
+func (a Uint512) PutBytesLE(b []byte) {
+	_ = b[63] // bounds check hint to compiler; see golang.org/issue/14808
+	binary.LittleEndian.PutUint64(b[0:], a[7])
+	binary.LittleEndian.PutUint64(b[8:], a[6])
+	binary.LittleEndian.PutUint64(b[16:], a[5])
+	binary.LittleEndian.PutUint64(b[24:], a[4])
+	binary.LittleEndian.PutUint64(b[32:], a[3])
+	binary.LittleEndian.PutUint64(b[40:], a[2])
+	binary.LittleEndian.PutUint64(b[48:], a[1])
+	binary.LittleEndian.PutUint64(b[56:], a[0])
+}
+
+// Uint512FromBytesBE returns the Uint512 represented by the big-endian bytes b[:64].
+// It panics if len(b) < 64.
+func Uint512FromBytesBE(b []byte) Uint512 {
+	_ = b[63] // bounds check hint to compiler; see golang.org/issue/14808
+	return Uint512{
+		binary.BigEndian.Uint64(b[0:]),
+		binary.BigEndian.Uint64(b[8:]),
+		binary.BigEndian.Uint64(b[16:]),
+		binary.BigEndian.Uint64(b[24:]),
+		binary.BigEndian.Uint64(b[32:]),
+		binary.BigEndian.Uint64(b[40:]),
+		binary.BigEndian.Uint64(b[48:]),
+		binary.BigEndian.Uint64(b[56:]),
+	}
+}
+
+// Uint512FromBytesLE returns the Uint512 represented by the little-endian bytes b[:64].
+// It panics if len(b) < 64.
+func Uint512FromBytesLE(b []byte) Uint512 {
+	_ = b[63] // bounds check hint to compiler; see golang.org/issue/14808
+	return Uint512{
+		binary.LittleEndian.Uint64(b[56:]),
+		binary.LittleEndian.Uint64(b[48:]),
+		binary.LittleEndian.Uint64(b[40:]),
+		binary.LittleEndian.Uint64(b[32:]),
+		binary.LittleEndian.Uint64(b[24:]),
+		binary.LittleEndian.Uint64(b[16:]),
+		binary.LittleEndian.Uint64(b[8:]),
+		binary.LittleEndian.Uint64(b[0:]),
+	}
+}
+
+// AppendBinary implements the [encoding.BinaryAppender] interface.
+// It appends the big-endian representation of a to dst.
+func (a Uint512) AppendBinary(dst []byte) ([]byte, error) {
+	b := a.BytesBE()
+	return append(dst, b[:]...), nil
+}
+
+// MarshalBinary implements the [encoding.BinaryMarshaler] interface.
+// The result is the 64-byte big-endian representation of a.
+func (a Uint512) MarshalBinary() ([]byte, error) {
+	return a.AppendBinary(make([]byte, 0, 64))
+}
+
+// UnmarshalBinary implements the [encoding.BinaryUnmarshaler] interface.
+// data must be exactly 64 bytes of the big-endian representation, as generated by [Uint512.MarshalBinary].
+func (a *Uint512) UnmarshalBinary(data []byte) error {
+	if len(data) != 64 {
+		return fmt.Errorf("ints: Uint512.UnmarshalBinary: invalid length %d, want 64", len(data))
+	}
+	*a = Uint512FromBytesBE(data)
+	return nil
+}
+
+// BigInt returns a as a new [*big.Int].
+func (a Uint512) BigInt() *big.Int {
+	var w [512 / bits.UintSize]big.Word
+	return new(big.Int).SetBits(wordsFromLimbs(w[:], a[:]))
+}
+
+// Uint512FromBigInt returns x as an Uint512.
+// If x is not representable as an Uint512, the result is the lower 512 bits of x in two's complement,
+// and ok is false.
+func Uint512FromBigInt(x *big.Int) (Uint512, bool) {
+	var z Uint512
+	ok := uintFromBigInt(z[:], 512, x)
+	return z, ok
+}
+
+// Float64 returns the float64 value nearest to a, rounding ties to even, and an indication of any rounding error.
+// If a is too large to be represented by a float64, the result is ±Inf.
+func (a Uint512) Float64() (float64, big.Accuracy) {
+	return float64FromLimbs(a[:])
+}
+
+// Uint512FromFloat64 returns the integer value of f rounded according to mode,
+// and the accuracy of the result: [big.Below] if the result is less than f, [big.Above] if it is greater than f.
+// Use [big.ToZero] to truncate f like the Go conversion, and [big.ToNearestEven] to round f to the nearest integer.
+//
+// If f is NaN, the result is 0 and the error is [ErrNaN].
+// If the rounded value of f is not representable as an Uint512, including ±Inf,
+// the result is clamped to 0 or the maximum value of Uint512, and the error is [ErrOverflow].
+func Uint512FromFloat64(f float64, mode big.RoundingMode) (Uint512, big.Accuracy, error) {
+	var z Uint512
+	acc, err := uintFromFloat64(z[:], 512, f, mode)
+	return z, acc, err
+}
+
+// BigFloat returns a as a new [*big.Float].
+// The precision of the result is 512 bits, so the conversion is always exact.
+func (a Uint512) BigFloat() *big.Float {
+	return new(big.Float).SetPrec(512).SetInt(a.BigInt())
+}
+
+// Uint512FromBigFloat returns the integer value of x rounded according to mode,
+// and the accuracy of the result: [big.Below] if the result is less than x, [big.Above] if it is greater than x.
+//
+// If the rounded value of x is not representable as an Uint512, including ±Inf,
+// the result is clamped to 0 or the maximum value of Uint512, and the error is [ErrOverflow].
+func Uint512FromBigFloat(x *big.Float, mode big.RoundingMode) (Uint512, big.Accuracy, error) {
+	var z Uint512
+	acc, err := uintFromBigFloat(z[:], 512, x, mode)
+	return z, acc, err
+}
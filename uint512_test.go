@@ -1,10 +1,15 @@
 package ints
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
 	"math/big"
 	"runtime"
+	"slices"
+	"strconv"
 	"testing"
 )
 
@@ -965,3 +970,1274 @@ func TestUint512_Format(t *testing.T) {
 		}
 	}
 }
+
+func TestParseUint512(t *testing.T) {
+	testCases := []struct {
+		s    string
+		base int
+		want Uint512
+		err  error
+	}{
+		{"0", 10, Uint512{0, 0, 0, 0, 0, 0, 0, 0}, nil},
+		{"1", 10, Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}, nil},
+		{"13407807929942597099574024998205846127479365820592393377723561443721764030073546976801874298166903427690031858186486050853753882811946569946433649006084095", 10, Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, nil},
+		{"13407807929942597099574024998205846127479365820592393377723561443721764030073546976801874298166903427690031858186486050853753882811946569946433649006084096", 10, Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, strconv.ErrRange},
+		{"0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", 0, Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, nil},
+		{"0b101", 0, Uint512{0, 0, 0, 0, 0, 0, 0, 0x5}, nil},
+		{"0o17", 0, Uint512{0, 0, 0, 0, 0, 0, 0, 0xf}, nil},
+		{"017", 0, Uint512{0, 0, 0, 0, 0, 0, 0, 0xf}, nil},
+		{"1_000", 0, Uint512{0, 0, 0, 0, 0, 0, 0, 0x3e8}, nil},
+		{"1_000", 10, Uint512{0, 0, 0, 0, 0, 0, 0, 0}, strconv.ErrSyntax},
+		{"_1", 0, Uint512{0, 0, 0, 0, 0, 0, 0, 0}, strconv.ErrSyntax},
+		{"Z", 62, Uint512{0, 0, 0, 0, 0, 0, 0, 0x3d}, nil},
+		{"z", 36, Uint512{0, 0, 0, 0, 0, 0, 0, 0x23}, nil},
+		{"Z", 36, Uint512{0, 0, 0, 0, 0, 0, 0, 0x23}, nil},
+		{"", 10, Uint512{0, 0, 0, 0, 0, 0, 0, 0}, strconv.ErrSyntax},
+		{"12a", 10, Uint512{0, 0, 0, 0, 0, 0, 0, 0}, strconv.ErrSyntax},
+		{"-1", 10, Uint512{0, 0, 0, 0, 0, 0, 0, 0}, strconv.ErrSyntax},
+		{"+1", 10, Uint512{0, 0, 0, 0, 0, 0, 0, 0}, strconv.ErrSyntax},
+	}
+
+	for _, tc := range testCases {
+		got, err := ParseUint512(tc.s, tc.base)
+		if got != tc.want {
+			t.Errorf("ParseUint512(%q, %d) = %d, want %d", tc.s, tc.base, got, tc.want)
+		}
+		if !errors.Is(err, tc.err) {
+			t.Errorf("ParseUint512(%q, %d) error = %v, want %v", tc.s, tc.base, err, tc.err)
+		}
+		if err != nil {
+			var numErr *strconv.NumError
+			if !errors.As(err, &numErr) || numErr.Func != "ParseUint512" || numErr.Num != tc.s {
+				t.Errorf("ParseUint512(%q, %d) error = %#v, want *strconv.NumError", tc.s, tc.base, err)
+			}
+		}
+	}
+
+	if _, err := ParseUint512("1", 63); err == nil {
+		t.Errorf("ParseUint512(%q, %d) should fail", "1", 63)
+	}
+}
+
+func FuzzParseUint512(f *testing.F) {
+	f.Add(uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), 10)
+	f.Add(uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), 16)
+	f.Add(uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), 62)
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, u4, u5, u6, u7 uint64, base int) {
+		if base < 2 || base > 62 {
+			return
+		}
+		a := Uint512{u0, u1, u2, u3, u4, u5, u6, u7}
+		s := uint512ToBigInt(a).Text(base)
+		got, err := ParseUint512(s, base)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != a {
+			t.Errorf("ParseUint512(%q, %d) = %d, want %d", s, base, got, a)
+		}
+	})
+}
+
+func TestUint512_MarshalText(t *testing.T) {
+	testCases := []struct {
+		x    Uint512
+		want string
+	}{
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0}, "0"},
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}, "1"},
+		{Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, "13407807929942597099574024998205846127479365820592393377723561443721764030073546976801874298166903427690031858186486050853753882811946569946433649006084095"},
+	}
+
+	for _, tc := range testCases {
+		got, err := tc.x.MarshalText()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != tc.want {
+			t.Errorf("Uint512(%d).MarshalText() = %q, want %q", tc.x, got, tc.want)
+		}
+	}
+}
+
+func TestUint512_UnmarshalText(t *testing.T) {
+	testCases := []struct {
+		s    string
+		want Uint512
+		err  error
+	}{
+		{"0", Uint512{0, 0, 0, 0, 0, 0, 0, 0}, nil},
+		{"0x10", Uint512{0, 0, 0, 0, 0, 0, 0, 0x10}, nil},
+		{"0b1_0", Uint512{0, 0, 0, 0, 0, 0, 0, 0x2}, nil},
+		{"010", Uint512{0, 0, 0, 0, 0, 0, 0, 0x8}, nil},
+		{"13407807929942597099574024998205846127479365820592393377723561443721764030073546976801874298166903427690031858186486050853753882811946569946433649006084095", Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, nil},
+		{"13407807929942597099574024998205846127479365820592393377723561443721764030073546976801874298166903427690031858186486050853753882811946569946433649006084096", Uint512{0, 0, 0, 0, 0, 0, 0, 0}, strconv.ErrRange},
+		{"1.0", Uint512{0, 0, 0, 0, 0, 0, 0, 0}, strconv.ErrSyntax},
+	}
+
+	for _, tc := range testCases {
+		var got Uint512
+		err := got.UnmarshalText([]byte(tc.s))
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Uint512.UnmarshalText(%q) error = %v, want %v", tc.s, err, tc.err)
+		}
+		if got != tc.want {
+			t.Errorf("Uint512.UnmarshalText(%q) = %d, want %d", tc.s, got, tc.want)
+		}
+	}
+}
+
+func TestUint512_MarshalJSON(t *testing.T) {
+	testCases := []struct {
+		x    Uint512
+		want string
+	}{
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0}, "0"},
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}, "1"},
+		{Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, "13407807929942597099574024998205846127479365820592393377723561443721764030073546976801874298166903427690031858186486050853753882811946569946433649006084095"},
+	}
+
+	for _, tc := range testCases {
+		got, err := json.Marshal(tc.x)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != tc.want {
+			t.Errorf("json.Marshal(Uint512(%d)) = %s, want %s", tc.x, got, tc.want)
+		}
+	}
+}
+
+func TestUint512_UnmarshalJSON(t *testing.T) {
+	testCases := []struct {
+		s       string
+		want    Uint512
+		wantErr bool
+	}{
+		{"0", Uint512{0, 0, 0, 0, 0, 0, 0, 0}, false},
+		{"123", Uint512{0, 0, 0, 0, 0, 0, 0, 0x7b}, false},
+		{"\"123\"", Uint512{0, 0, 0, 0, 0, 0, 0, 0x7b}, false},
+		{"\"0x7f\"", Uint512{0, 0, 0, 0, 0, 0, 0, 0x7f}, false},
+		{"13407807929942597099574024998205846127479365820592393377723561443721764030073546976801874298166903427690031858186486050853753882811946569946433649006084095", Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false},
+		{"\"13407807929942597099574024998205846127479365820592393377723561443721764030073546976801874298166903427690031858186486050853753882811946569946433649006084095\"", Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false},
+		{"13407807929942597099574024998205846127479365820592393377723561443721764030073546976801874298166903427690031858186486050853753882811946569946433649006084096", Uint512{0, 0, 0, 0, 0, 0, 0, 0}, true},
+		{"0x7f", Uint512{0, 0, 0, 0, 0, 0, 0, 0}, true},
+		{"\"abc\"", Uint512{0, 0, 0, 0, 0, 0, 0, 0}, true},
+		{"1.5", Uint512{0, 0, 0, 0, 0, 0, 0, 0}, true},
+		{"null", Uint512{0, 0, 0, 0, 0, 0, 0, 0}, false},
+	}
+
+	for _, tc := range testCases {
+		var got Uint512
+		err := json.Unmarshal([]byte(tc.s), &got)
+		if (err != nil) != tc.wantErr {
+			t.Errorf("json.Unmarshal(%s) error = %v, wantErr %t", tc.s, err, tc.wantErr)
+		}
+		if got != tc.want {
+			t.Errorf("json.Unmarshal(%s) = %d, want %d", tc.s, got, tc.want)
+		}
+	}
+}
+
+func TestUint512_Bytes(t *testing.T) {
+	testCases := []struct {
+		x  Uint512
+		be [64]byte
+	}{
+		{
+			Uint512{0x102030405060708, 0x90a0b0c0d0e0f10, 0x1112131415161718, 0x191a1b1c1d1e1f20, 0x2122232425262728, 0x292a2b2c2d2e2f30, 0x3132333435363738, 0x393a3b3c3d3e3f40},
+			[64]byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10, 0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18, 0x19, 0x1a, 0x1b, 0x1c, 0x1d, 0x1e, 0x1f, 0x20, 0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28, 0x29, 0x2a, 0x2b, 0x2c, 0x2d, 0x2e, 0x2f, 0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3d, 0x3e, 0x3f, 0x40},
+		},
+		{
+			Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xfffffffffffffffe},
+			[64]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xfe},
+		},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.x.BytesBE(); got != tc.be {
+			t.Errorf("Uint512(%d).BytesBE() = %x, want %x", tc.x, got, tc.be)
+		}
+		if got := Uint512FromBytesBE(tc.be[:]); got != tc.x {
+			t.Errorf("Uint512FromBytesBE(%x) = %d, want %d", tc.be, got, tc.x)
+		}
+
+		le := tc.be
+		slices.Reverse(le[:])
+		if got := tc.x.BytesLE(); got != le {
+			t.Errorf("Uint512(%d).BytesLE() = %x, want %x", tc.x, got, le)
+		}
+		if got := Uint512FromBytesLE(le[:]); got != tc.x {
+			t.Errorf("Uint512FromBytesLE(%x) = %d, want %d", le, got, tc.x)
+		}
+
+		data, err := tc.x.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(data, tc.be[:]) {
+			t.Errorf("Uint512(%d).MarshalBinary() = %x, want %x", tc.x, data, tc.be)
+		}
+		var got Uint512
+		if err := got.UnmarshalBinary(data); err != nil {
+			t.Fatal(err)
+		}
+		if got != tc.x {
+			t.Errorf("Uint512.UnmarshalBinary(%x) = %d, want %d", data, got, tc.x)
+		}
+	}
+}
+
+func TestUint512_UnmarshalBinary_InvalidLength(t *testing.T) {
+	var a Uint512
+	if err := a.UnmarshalBinary(make([]byte, 63)); err == nil {
+		t.Error("want error, got nil")
+	}
+	if err := a.UnmarshalBinary(make([]byte, 65)); err == nil {
+		t.Error("want error, got nil")
+	}
+}
+
+func TestUint512FromBigInt(t *testing.T) {
+	testCases := []struct {
+		x    *big.Int
+		want Uint512
+		ok   bool
+	}{
+		{big.NewInt(0), Uint512{0, 0, 0, 0, 0, 0, 0, 0}, true},
+		{big.NewInt(1), Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}, true},
+		{bigIntFromString("13407807929942597099574024998205846127479365820592393377723561443721764030073546976801874298166903427690031858186486050853753882811946569946433649006084095"), Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, true},
+		{bigIntFromString("13407807929942597099574024998205846127479365820592393377723561443721764030073546976801874298166903427690031858186486050853753882811946569946433649006084096"), Uint512{0, 0, 0, 0, 0, 0, 0, 0}, false},
+		{bigIntFromString("13407807929942597099574024998205846127479365820592393377723561443721764030073546976801874298166903427690031858186486050853753882811946569946433649006084096"), Uint512{0, 0, 0, 0, 0, 0, 0, 0}, false},
+		{big.NewInt(-1), Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false},
+	}
+
+	for _, tc := range testCases {
+		got, ok := Uint512FromBigInt(tc.x)
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint512FromBigInt(%s) = %d, %t, want %d, %t", tc.x, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func FuzzUint512_BigInt(f *testing.F) {
+	f.Add(uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0))
+	f.Add(uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64))
+	f.Add(uint64(1), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0))
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, u4, u5, u6, u7 uint64) {
+		a := Uint512{u0, u1, u2, u3, u4, u5, u6, u7}
+		got := a.BigInt()
+		want := uint512ToBigInt(a)
+		if got.Cmp(want) != 0 {
+			t.Errorf("Uint512(%d).BigInt() = %s, want %s", a, got, want)
+		}
+		if b, ok := Uint512FromBigInt(got); b != a || !ok {
+			t.Errorf("Uint512FromBigInt(%s) = %d, %t, want %d, true", got, b, ok, a)
+		}
+	})
+}
+
+func TestUint512FromFloat64(t *testing.T) {
+	testCases := []struct {
+		f    float64
+		mode big.RoundingMode
+		want Uint512
+		acc  big.Accuracy
+		err  error
+	}{
+		{0, big.ToNearestEven, Uint512{0, 0, 0, 0, 0, 0, 0, 0}, big.Exact, nil},
+		{2.5, big.ToNearestEven, Uint512{0, 0, 0, 0, 0, 0, 0, 0x2}, big.Below, nil},
+		{3.5, big.ToNearestEven, Uint512{0, 0, 0, 0, 0, 0, 0, 0x4}, big.Above, nil},
+		{2.5, big.ToNearestAway, Uint512{0, 0, 0, 0, 0, 0, 0, 0x3}, big.Above, nil},
+		{2.5, big.ToZero, Uint512{0, 0, 0, 0, 0, 0, 0, 0x2}, big.Below, nil},
+		{2.5, big.AwayFromZero, Uint512{0, 0, 0, 0, 0, 0, 0, 0x3}, big.Above, nil},
+		{2.5, big.ToNegativeInf, Uint512{0, 0, 0, 0, 0, 0, 0, 0x2}, big.Below, nil},
+		{2.5, big.ToPositiveInf, Uint512{0, 0, 0, 0, 0, 0, 0, 0x3}, big.Above, nil},
+		{math.NaN(), big.ToNearestEven, Uint512{0, 0, 0, 0, 0, 0, 0, 0}, big.Exact, ErrNaN},
+		{math.Inf(1), big.ToNearestEven, Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, big.Below, ErrOverflow},
+		{math.Inf(-1), big.ToNearestEven, Uint512{0, 0, 0, 0, 0, 0, 0, 0}, big.Above, ErrOverflow},
+		{0x1p512, big.ToNearestEven, Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, big.Below, ErrOverflow},
+		{0x1p511, big.ToNearestEven, Uint512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, big.Exact, nil},
+		{-0.5, big.ToZero, Uint512{0, 0, 0, 0, 0, 0, 0, 0}, big.Above, nil},
+		{-1, big.ToNearestEven, Uint512{0, 0, 0, 0, 0, 0, 0, 0}, big.Above, ErrOverflow},
+	}
+
+	for _, tc := range testCases {
+		got, acc, err := Uint512FromFloat64(tc.f, tc.mode)
+		if got != tc.want || acc != tc.acc || err != tc.err {
+			t.Errorf("Uint512FromFloat64(%v, %s) = %d, %s, %v, want %d, %s, %v", tc.f, tc.mode, got, acc, err, tc.want, tc.acc, tc.err)
+		}
+
+		if math.IsNaN(tc.f) {
+			continue
+		}
+		got, acc, err = Uint512FromBigFloat(big.NewFloat(tc.f), tc.mode)
+		if got != tc.want || acc != tc.acc || err != tc.err {
+			t.Errorf("Uint512FromBigFloat(%v, %s) = %d, %s, %v, want %d, %s, %v", tc.f, tc.mode, got, acc, err, tc.want, tc.acc, tc.err)
+		}
+	}
+}
+
+func FuzzUint512_Float64(f *testing.F) {
+	f.Add(uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0))
+	f.Add(uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64))
+	f.Add(uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(1<<53+1))
+	f.Add(uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(1), uint64(1<<11|1<<10))
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, u4, u5, u6, u7 uint64) {
+		a := Uint512{u0, u1, u2, u3, u4, u5, u6, u7}
+		b := uint512ToBigInt(a)
+		got, acc := a.Float64()
+		want, wantAcc := new(big.Float).SetInt(b).Float64()
+		if got != want || acc != wantAcc {
+			t.Errorf("Uint512(%d).Float64() = %v, %s, want %v, %s", a, got, acc, want, wantAcc)
+		}
+
+		bf := a.BigFloat()
+		if c, _ := bf.Int(nil); c.Cmp(b) != 0 {
+			t.Errorf("Uint512(%d).BigFloat() = %v, want %v", a, bf, b)
+		}
+		if c, acc, err := Uint512FromBigFloat(bf, big.ToNearestEven); c != a || acc != big.Exact || err != nil {
+			t.Errorf("Uint512FromBigFloat(%v) = %d, %s, %v, want %d, %s, nil", bf, c, acc, err, a, big.Exact)
+		}
+	})
+}
+
+func FuzzUint512FromFloat64(f *testing.F) {
+	f.Add(0.0, uint8(big.ToNearestEven))
+	f.Add(-2.5, uint8(big.ToNearestEven))
+	f.Add(0x1p511, uint8(big.ToZero))
+	f.Add(0x1.fffffffffffffp511, uint8(big.ToPositiveInf))
+
+	f.Fuzz(func(t *testing.T, x float64, mode uint8) {
+		if math.IsNaN(x) || mode > uint8(big.ToPositiveInf) {
+			return
+		}
+		m := big.RoundingMode(mode)
+		got, acc, err := Uint512FromFloat64(x, m)
+		want, wantAcc, wantErr := Uint512FromBigFloat(big.NewFloat(x), m)
+		if got != want || acc != wantAcc || err != wantErr {
+			t.Errorf("Uint512FromFloat64(%v, %s) = %d, %s, %v, want %d, %s, %v", x, m, got, acc, err, want, wantAcc, wantErr)
+		}
+	})
+}
+
+func FuzzUint512_AddOverflow(f *testing.F) {
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(1),
+	)
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(1),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(1), uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(0), uint64(0), uint64(0), uint64(1), uint64(0), uint64(0), uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, u4, u5, u6, u7, v0, v1, v2, v3, v4, v5, v6, v7 uint64) {
+		a := Uint512{u0, u1, u2, u3, u4, u5, u6, u7}
+		b := Uint512{v0, v1, v2, v3, v4, v5, v6, v7}
+		got, overflow := a.AddOverflow(b)
+
+		want, ok := Uint512FromBigInt(new(big.Int).Add(uint512ToBigInt(a), uint512ToBigInt(b)))
+		if got != want || overflow == ok {
+			t.Errorf("Uint512(%d).AddOverflow(%d) = %d, %t, want %d, %t", a, b, got, overflow, want, !ok)
+		}
+	})
+}
+
+func FuzzUint512_SubOverflow(f *testing.F) {
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(1),
+	)
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(1),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(1), uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(0), uint64(0), uint64(0), uint64(1), uint64(0), uint64(0), uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, u4, u5, u6, u7, v0, v1, v2, v3, v4, v5, v6, v7 uint64) {
+		a := Uint512{u0, u1, u2, u3, u4, u5, u6, u7}
+		b := Uint512{v0, v1, v2, v3, v4, v5, v6, v7}
+		got, overflow := a.SubOverflow(b)
+
+		want, ok := Uint512FromBigInt(new(big.Int).Sub(uint512ToBigInt(a), uint512ToBigInt(b)))
+		if got != want || overflow == ok {
+			t.Errorf("Uint512(%d).SubOverflow(%d) = %d, %t, want %d, %t", a, b, got, overflow, want, !ok)
+		}
+	})
+}
+
+func FuzzUint512_MulOverflow(f *testing.F) {
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(1),
+	)
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(1),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(1), uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(0), uint64(0), uint64(0), uint64(1), uint64(0), uint64(0), uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, u4, u5, u6, u7, v0, v1, v2, v3, v4, v5, v6, v7 uint64) {
+		a := Uint512{u0, u1, u2, u3, u4, u5, u6, u7}
+		b := Uint512{v0, v1, v2, v3, v4, v5, v6, v7}
+		got, overflow := a.MulOverflow(b)
+
+		want, ok := Uint512FromBigInt(new(big.Int).Mul(uint512ToBigInt(a), uint512ToBigInt(b)))
+		if got != want || overflow == ok {
+			t.Errorf("Uint512(%d).MulOverflow(%d) = %d, %t, want %d, %t", a, b, got, overflow, want, !ok)
+		}
+	})
+}
+
+func TestUint512_NegOverflow(t *testing.T) {
+	testCases := []struct {
+		x        Uint512
+		want     Uint512
+		overflow bool
+	}{
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0}, Uint512{0, 0, 0, 0, 0, 0, 0, 0}, false},
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}, Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, true},
+		{Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}, true},
+	}
+
+	for _, tc := range testCases {
+		got, overflow := tc.x.NegOverflow()
+		if got != tc.want || overflow != tc.overflow {
+			t.Errorf("Uint512(%d).NegOverflow() = %d, %t, want %d, %t", tc.x, got, overflow, tc.want, tc.overflow)
+		}
+	}
+}
+
+func TestUint512_LshOverflow(t *testing.T) {
+	testCases := []struct {
+		x        Uint512
+		i        uint
+		want     Uint512
+		overflow bool
+	}{
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}, 0, Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}, false},
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}, 510, Uint512{0x4000000000000000, 0, 0, 0, 0, 0, 0, 0}, false},
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}, 511, Uint512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, false},
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}, 512, Uint512{0, 0, 0, 0, 0, 0, 0, 0}, true},
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0x3}, 510, Uint512{0xc000000000000000, 0, 0, 0, 0, 0, 0, 0}, false},
+		{Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 1, Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0xfffffffffffffffe}, true},
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0}, 522, Uint512{0, 0, 0, 0, 0, 0, 0, 0}, false},
+	}
+
+	for _, tc := range testCases {
+		got, overflow := tc.x.LshOverflow(tc.i)
+		if got != tc.want || overflow != tc.overflow {
+			t.Errorf("Uint512(%d).LshOverflow(%d) = %d, %t, want %d, %t", tc.x, tc.i, got, overflow, tc.want, tc.overflow)
+		}
+	}
+}
+
+func FuzzUint512_AddCarry(f *testing.F) {
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint(1),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(1), uint(1),
+	)
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(1),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint(1),
+	)
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(1), uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(0), uint64(0), uint64(0), uint64(1), uint64(0), uint64(0), uint64(0), uint64(0), uint(1),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint(1),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, u4, u5, u6, u7, v0, v1, v2, v3, v4, v5, v6, v7 uint64, carry uint) {
+		carry &= 1
+		a := Uint512{u0, u1, u2, u3, u4, u5, u6, u7}
+		b := Uint512{v0, v1, v2, v3, v4, v5, v6, v7}
+		sum, carryOut := a.AddCarry(b, carry)
+
+		want := new(big.Int).Add(uint512ToBigInt(a), uint512ToBigInt(b))
+		want.Add(want, new(big.Int).SetUint64(uint64(carry)))
+		wantSum, _ := Uint512FromBigInt(want)
+		wantCarry := want.Bit(512)
+		if sum != wantSum || carryOut != wantCarry {
+			t.Errorf("Uint512(%d).AddCarry(%d, %d) = %d, %d, want %d, %d", a, b, carry, sum, carryOut, wantSum, wantCarry)
+		}
+	})
+}
+
+func FuzzUint512_SubBorrow(f *testing.F) {
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint(1),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(1), uint(1),
+	)
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(1),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint(1),
+	)
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(1), uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(0), uint64(0), uint64(0), uint64(1), uint64(0), uint64(0), uint64(0), uint64(0), uint(1),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint(1),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, u4, u5, u6, u7, v0, v1, v2, v3, v4, v5, v6, v7 uint64, borrow uint) {
+		borrow &= 1
+		a := Uint512{u0, u1, u2, u3, u4, u5, u6, u7}
+		b := Uint512{v0, v1, v2, v3, v4, v5, v6, v7}
+		diff, borrowOut := a.SubBorrow(b, borrow)
+
+		want := new(big.Int).Sub(uint512ToBigInt(a), uint512ToBigInt(b))
+		want.Sub(want, new(big.Int).SetUint64(uint64(borrow)))
+		wantDiff, _ := Uint512FromBigInt(want)
+		var wantBorrow uint
+		if want.Sign() < 0 {
+			wantBorrow = 1
+		}
+		if diff != wantDiff || borrowOut != wantBorrow {
+			t.Errorf("Uint512(%d).SubBorrow(%d, %d) = %d, %d, want %d, %d", a, b, borrow, diff, borrowOut, wantDiff, wantBorrow)
+		}
+	})
+}
+
+func FuzzUint512_MulFull(f *testing.F) {
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(1),
+	)
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(1),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(1), uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(0), uint64(0), uint64(0), uint64(1), uint64(0), uint64(0), uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, u4, u5, u6, u7, v0, v1, v2, v3, v4, v5, v6, v7 uint64) {
+		a := Uint512{u0, u1, u2, u3, u4, u5, u6, u7}
+		b := Uint512{v0, v1, v2, v3, v4, v5, v6, v7}
+		hi, lo := a.MulFull(b)
+
+		want := new(big.Int).Mul(uint512ToBigInt(a), uint512ToBigInt(b))
+		wantLo, _ := Uint512FromBigInt(want)
+		wantHi, _ := Uint512FromBigInt(want.Rsh(want, 512))
+		if hi != wantHi || lo != wantLo {
+			t.Errorf("Uint512(%d).MulFull(%d) = %d, %d, want %d, %d", a, b, hi, lo, wantHi, wantLo)
+		}
+	})
+}
+
+func FuzzUint512_SaturatingAdd(f *testing.F) {
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(1),
+	)
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(1),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(1), uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(0), uint64(0), uint64(0), uint64(1), uint64(0), uint64(0), uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(1<<63), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, u4, u5, u6, u7, v0, v1, v2, v3, v4, v5, v6, v7 uint64) {
+		a := Uint512{u0, u1, u2, u3, u4, u5, u6, u7}
+		b := Uint512{v0, v1, v2, v3, v4, v5, v6, v7}
+		got := a.SaturatingAdd(b)
+
+		want := saturateBigInt(new(big.Int).Add(uint512ToBigInt(a), uint512ToBigInt(b)), 512, false)
+		if uint512ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("Uint512(%d).SaturatingAdd(%d) = %d, want %d", a, b, got, want)
+		}
+	})
+}
+
+func FuzzUint512_SaturatingSub(f *testing.F) {
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(1),
+	)
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(1),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(1), uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(0), uint64(0), uint64(0), uint64(1), uint64(0), uint64(0), uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(1<<63), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, u4, u5, u6, u7, v0, v1, v2, v3, v4, v5, v6, v7 uint64) {
+		a := Uint512{u0, u1, u2, u3, u4, u5, u6, u7}
+		b := Uint512{v0, v1, v2, v3, v4, v5, v6, v7}
+		got := a.SaturatingSub(b)
+
+		want := saturateBigInt(new(big.Int).Sub(uint512ToBigInt(a), uint512ToBigInt(b)), 512, false)
+		if uint512ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("Uint512(%d).SaturatingSub(%d) = %d, want %d", a, b, got, want)
+		}
+	})
+}
+
+func FuzzUint512_SaturatingMul(f *testing.F) {
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(1),
+	)
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(1),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(0), uint64(0), uint64(0), uint64(1), uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(0), uint64(0), uint64(0), uint64(1), uint64(0), uint64(0), uint64(0), uint64(0),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64),
+	)
+	f.Add(
+		uint64(1<<63), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+		uint64(1<<63), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, u4, u5, u6, u7, v0, v1, v2, v3, v4, v5, v6, v7 uint64) {
+		a := Uint512{u0, u1, u2, u3, u4, u5, u6, u7}
+		b := Uint512{v0, v1, v2, v3, v4, v5, v6, v7}
+		got := a.SaturatingMul(b)
+
+		want := saturateBigInt(new(big.Int).Mul(uint512ToBigInt(a), uint512ToBigInt(b)), 512, false)
+		if uint512ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("Uint512(%d).SaturatingMul(%d) = %d, want %d", a, b, got, want)
+		}
+	})
+}
+
+func TestMaxUint512(t *testing.T) {
+	max := new(big.Int).Lsh(big.NewInt(1), 512)
+	max.Sub(max, big.NewInt(1))
+	if got := uint512ToBigInt(MaxUint512); got.Cmp(max) != 0 {
+		t.Errorf("MaxUint512 = %d, want %d", got, max)
+	}
+}
+
+func TestUint512FromUint64(t *testing.T) {
+	testCases := []struct {
+		v    uint64
+		want Uint512
+	}{
+		{0, Uint512{0, 0, 0, 0, 0, 0, 0, 0}},
+		{1, Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}},
+		{math.MaxUint64, Uint512{0, 0, 0, 0, 0, 0, 0, math.MaxUint64}},
+	}
+
+	for _, tc := range testCases {
+		got := Uint512FromUint64(tc.v)
+		if got != tc.want {
+			t.Errorf("Uint512FromUint64(%d) = %d, want %d", tc.v, got, tc.want)
+		}
+	}
+}
+
+func TestUint512Of(t *testing.T) {
+	testCases := []struct {
+		v    string
+		got  Uint512
+		want Uint512
+	}{
+		{"int8(-1)", Uint512Of(int8(-1)), Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}},
+		{"int(42)", Uint512Of(int(42)), Uint512{0, 0, 0, 0, 0, 0, 0, 0x2a}},
+		{"uint64(math.MaxUint64)", Uint512Of(uint64(math.MaxUint64)), Uint512{0, 0, 0, 0, 0, 0, 0, math.MaxUint64}},
+		{"int64(math.MinInt64)", Uint512Of(int64(math.MinInt64)), Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0x8000000000000000}},
+		{"uintptr(7)", Uint512Of(uintptr(7)), Uint512{0, 0, 0, 0, 0, 0, 0, 0x7}},
+	}
+
+	for _, tc := range testCases {
+		if tc.got != tc.want {
+			t.Errorf("Uint512Of(%s) = %d, want %d", tc.v, tc.got, tc.want)
+		}
+	}
+}
+
+func TestUint512_IsInt64(t *testing.T) {
+	testCases := []struct {
+		a        Uint512
+		isInt64  bool
+		isUint64 bool
+		i64      int64
+		u64      uint64
+	}{
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0}, true, true, 0, 0},
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}, true, true, 1, 1},
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0x7fffffffffffffff}, true, true, 9223372036854775807, 9223372036854775807},
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0x8000000000000000}, false, true, math.MinInt64, 9223372036854775808},
+		{Uint512{0, 0, 0, 0, 0, 0, 0, math.MaxUint64}, false, true, -1, math.MaxUint64},
+		{Uint512{0, 0, 0, 0, 0, 0, 0x1, 0}, false, false, 0, 0},
+		{Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, false, false, -1, math.MaxUint64},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.a.IsInt64(); got != tc.isInt64 {
+			t.Errorf("Uint512(%d).IsInt64() = %t, want %t", tc.a, got, tc.isInt64)
+		}
+		if got := tc.a.IsUint64(); got != tc.isUint64 {
+			t.Errorf("Uint512(%d).IsUint64() = %t, want %t", tc.a, got, tc.isUint64)
+		}
+
+		got, ok := tc.a.Int()
+		want, wantOK := int(tc.i64), tc.isInt64 && int64(int(tc.i64)) == tc.i64
+		if got != want || ok != wantOK {
+			t.Errorf("Uint512(%d).Int() = %d, %t, want %d, %t", tc.a, got, ok, want, wantOK)
+		}
+
+		gotU, ok := tc.a.Uint()
+		wantU, wantOK := uint(tc.u64), tc.isUint64 && uint64(uint(tc.u64)) == tc.u64
+		if gotU != wantU || ok != wantOK {
+			t.Errorf("Uint512(%d).Uint() = %d, %t, want %d, %t", tc.a, gotU, ok, wantU, wantOK)
+		}
+	}
+}
+
+func TestUint512_OnesCount(t *testing.T) {
+	testCases := []struct {
+		x    Uint512
+		want int
+	}{
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0}, 0},
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}, 1},
+		{Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, 512},
+		{Uint512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0}, 1},
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0x5555555555555555}, 32},
+		{Uint512{0xf0000000000000, 0, 0, 0, 0, 0, 0, 0}, 4},
+	}
+
+	for _, tc := range testCases {
+		got := tc.x.OnesCount()
+		if got != tc.want {
+			t.Errorf("Uint512(%d).OnesCount() = %d, want %d", tc.x, got, tc.want)
+		}
+	}
+}
+
+func FuzzUint512_RotateLeft(f *testing.F) {
+	f.Add(uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), 0)
+	f.Add(uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(1), 0)
+	f.Add(uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), 0)
+	f.Add(uint64(1<<63), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), 0)
+	f.Add(uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), 1)
+	f.Add(uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(1), 1)
+	f.Add(uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), 1)
+	f.Add(uint64(1<<63), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), 1)
+	f.Add(uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), -1)
+	f.Add(uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(1), -1)
+	f.Add(uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), -1)
+	f.Add(uint64(1<<63), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), -1)
+	f.Add(uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), 577)
+	f.Add(uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(1), 577)
+	f.Add(uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), 577)
+	f.Add(uint64(1<<63), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), 577)
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, u4, u5, u6, u7 uint64, k int) {
+		a := Uint512{u0, u1, u2, u3, u4, u5, u6, u7}
+		got := a.RotateLeft(k)
+
+		s := uint(k) % 512
+		x := uint512ToBigInt(a)
+		want := new(big.Int).Lsh(x, s)
+		want.Or(want, new(big.Int).Rsh(x, 512-s))
+		wantV, _ := Uint512FromBigInt(want)
+		if got != wantV {
+			t.Errorf("Uint512(%d).RotateLeft(%d) = %d, want %d", a, k, got, wantV)
+		}
+	})
+}
+
+func FuzzUint512_Reverse(f *testing.F) {
+	f.Add(uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0))
+	f.Add(uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(1))
+	f.Add(uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64))
+	f.Add(uint64(1<<63), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0))
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, u4, u5, u6, u7 uint64) {
+		a := Uint512{u0, u1, u2, u3, u4, u5, u6, u7}
+
+		x := uint512ToBigInt(a)
+		want := new(big.Int)
+		for i := range 512 {
+			want.SetBit(want, 511-i, x.Bit(i))
+		}
+		if got := a.Reverse(); uint512ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("Uint512(%d).Reverse() = %d, want %d", a, got, want)
+		}
+
+		le := a.BytesLE()
+		if got := a.ReverseBytes(); got != Uint512FromBytesBE(le[:]) {
+			t.Errorf("Uint512(%d).ReverseBytes() = %d, want %d", a, got, Uint512FromBytesBE(le[:]))
+		}
+	})
+}
+
+func FuzzUint512_Bit(f *testing.F) {
+	f.Add(uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0))
+	f.Add(uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(1))
+	f.Add(uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64))
+	f.Add(uint64(1<<63), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0))
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, u4, u5, u6, u7 uint64) {
+		a := Uint512{u0, u1, u2, u3, u4, u5, u6, u7}
+		x := uint512ToBigInt(a)
+		for i := range 522 {
+			if got, want := a.Bit(i), x.Bit(i); got != want {
+				t.Errorf("Uint512(%d).Bit(%d) = %d, want %d", a, i, got, want)
+			}
+		}
+		for _, i := range []int{0, 1, 63, 64, 65, 256, 511} {
+			if got, want := uint512ToBigInt(a.SetBit(i, 1)), new(big.Int).SetBit(x, i, 1); got.Cmp(want) != 0 {
+				t.Errorf("Uint512(%d).SetBit(%d, 1) = %d, want %d", a, i, got, want)
+			}
+			if got, want := uint512ToBigInt(a.SetBit(i, 0)), new(big.Int).SetBit(x, i, 0); got.Cmp(want) != 0 {
+				t.Errorf("Uint512(%d).SetBit(%d, 0) = %d, want %d", a, i, got, want)
+			}
+			if got, want := uint512ToBigInt(a.ClearBit(i)), new(big.Int).SetBit(x, i, 0); got.Cmp(want) != 0 {
+				t.Errorf("Uint512(%d).ClearBit(%d) = %d, want %d", a, i, got, want)
+			}
+			if got, want := uint512ToBigInt(a.FlipBit(i)), new(big.Int).SetBit(x, i, x.Bit(i)^1); got.Cmp(want) != 0 {
+				t.Errorf("Uint512(%d).FlipBit(%d) = %d, want %d", a, i, got, want)
+			}
+		}
+	})
+}
+
+func TestUint512_SetBit_Panic(t *testing.T) {
+	testCases := []struct {
+		i int
+		b uint
+	}{
+		{-1, 0},
+		{512, 0},
+		{0, 2},
+	}
+
+	for _, tc := range testCases {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Uint512{}.SetBit(%d, %d) did not panic", tc.i, tc.b)
+				}
+			}()
+			Uint512{}.SetBit(tc.i, tc.b)
+		}()
+	}
+}
+
+func FuzzUint512_ExtractInsert(f *testing.F) {
+	f.Add(uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint(0), uint(512))
+	f.Add(uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint(3), uint(64))
+	f.Add(uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(1), uint64(1<<63), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint(63), uint(2))
+	f.Add(uint64(1<<63), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(1), uint(511), uint(5))
+	f.Add(uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(math.MaxUint64), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(0), uint64(1), uint(0), uint(513))
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, u4, u5, u6, u7, v0, v1, v2, v3, v4, v5, v6, v7 uint64, lo, width uint) {
+		a := Uint512{u0, u1, u2, u3, u4, u5, u6, u7}
+		v := Uint512{v0, v1, v2, v3, v4, v5, v6, v7}
+		x := uint512ToBigInt(a)
+		y := uint512ToBigInt(v)
+
+		// mask = 1<<min(width, 512) - 1
+		mask := new(big.Int).Lsh(big.NewInt(1), min(width, 512))
+		mask.Sub(mask, big.NewInt(1))
+
+		want := new(big.Int).Rsh(x, lo)
+		want.And(want, mask)
+		if got := uint512ToBigInt(a.Extract(lo, width)); got.Cmp(want) != 0 {
+			t.Errorf("Uint512(%d).Extract(%d, %d) = %d, want %d", a, lo, width, got, want)
+		}
+
+		// (x &^ (mask << lo)) | ((y & mask) << lo), truncated to 512 bits
+		mask.Lsh(mask, lo)
+		want = new(big.Int).AndNot(x, mask)
+		want.Or(want, new(big.Int).And(new(big.Int).Lsh(y, lo), mask))
+		wantV, _ := Uint512FromBigInt(want)
+		if got := a.Insert(v, lo, width); got != wantV {
+			t.Errorf("Uint512(%d).Insert(%d, %d, %d) = %d, want %d", a, v, lo, width, got, wantV)
+		}
+	})
+}
+
+func TestUint512_RshRound(t *testing.T) {
+	values := []Uint512{
+		Uint512{0, 0, 0, 0, 0, 0, 0, 0},
+		Uint512{0, 0, 0, 0, 0, 0, 0, 0x1},
+		Uint512{0, 0, 0, 0, 0, 0, 0, 0x2},
+		Uint512{0, 0, 0, 0, 0, 0, 0, 0x3},
+		Uint512{0, 0, 0, 0, 0, 0, 0, 0x5},
+		Uint512{0, 0, 0, 0, 0, 0, 0, 0x6},
+		Uint512{0, 0, 0, 0, 0, 0, 0, math.MaxUint64},
+		Uint512{0, 0, 0, 0, 0, 0, 0x1, 0x8000000000000000},
+		Uint512{0, 0, 0, 0, 0, 0, 0x1, 0x8000000000000001},
+		Uint512{0x7fffffffffffffff, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64},
+		Uint512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0},
+		Uint512{0x8000000000000000, 0, 0, 0, 0, 0, 0, 0x1},
+		Uint512{0xb000000000000000, 0, 0, 0, 0, 0, 0, 0},
+		Uint512{0xc000000000000000, 0, 0, 0, 0, 0, 0x1, 0},
+		Uint512{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64},
+	}
+	shifts := []uint{0, 1, 2, 3, 63, 64, 65, 127, 128, 129, 191, 192, 193, 255, 256, 257, 319, 320, 321, 383, 384, 385, 447, 448, 449, 511, 512, 513, 576}
+
+	for _, a := range values {
+		x := uint512ToBigInt(a)
+		for _, i := range shifts {
+			if got, want := a.RshRound(i), rshRoundBigInt(x, i); uint512ToBigInt(got).Cmp(want) != 0 {
+				t.Errorf("Uint512(%d).RshRound(%d) = %d, want %d", a, i, got, want)
+			}
+			if got, want := a.RshCeil(i), rshCeilBigInt(x, i); uint512ToBigInt(got).Cmp(want) != 0 {
+				t.Errorf("Uint512(%d).RshCeil(%d) = %d, want %d", a, i, got, want)
+			}
+			if got, want := a.URsh(i), new(big.Int).Rsh(uint512ToBigInt(a), i); uint512ToBigInt(got).Cmp(want) != 0 {
+				t.Errorf("Uint512(%d).URsh(%d) = %d, want %d", a, i, got, want)
+			}
+		}
+	}
+}
+
+func FuzzUint512_Div1024(f *testing.F) {
+	f.Add(
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x1),
+	)
+	f.Add(
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0),
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x7),
+	)
+	f.Add(
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x1),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x2),
+	)
+	f.Add(
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xfffffffffffffffe),
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+	)
+	f.Add(
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x5),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x3039),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x6),
+	)
+	f.Add(
+		uint64(0x4000000000000000), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x3),
+		uint64(0x8000000000000000), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0),
+	)
+
+	f.Fuzz(func(t *testing.T, h0, h1, h2, h3, h4, h5, h6, h7, l0, l1, l2, l3, l4, l5, l6, l7, y0, y1, y2, y3, y4, y5, y6, y7 uint64) {
+		hi := Uint512{h0, h1, h2, h3, h4, h5, h6, h7}
+		lo := Uint512{l0, l1, l2, l3, l4, l5, l6, l7}
+		y := Uint512{y0, y1, y2, y3, y4, y5, y6, y7}
+		if y.Cmp(hi) <= 0 {
+			return
+		}
+		quo, rem := y.Div1024(hi, lo)
+
+		x := new(big.Int).Lsh(uint512ToBigInt(hi), 512)
+		x.Or(x, uint512ToBigInt(lo))
+		wantQuo, wantRem := new(big.Int).QuoRem(x, uint512ToBigInt(y), new(big.Int))
+		if uint512ToBigInt(quo).Cmp(wantQuo) != 0 || uint512ToBigInt(rem).Cmp(wantRem) != 0 {
+			t.Errorf("Uint512(%d).Div1024(%d, %d) = %d, %d, want %d, %d", y, hi, lo, quo, rem, wantQuo, wantRem)
+		}
+	})
+}
+
+func TestUint512_Div1024_Panic(t *testing.T) {
+	testCases := []struct {
+		hi, lo, y Uint512
+		want      string
+	}{
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0}, Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}, Uint512{0, 0, 0, 0, 0, 0, 0, 0}, "runtime error: integer divide by zero"},
+		{Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}, Uint512{0, 0, 0, 0, 0, 0, 0, 0}, Uint512{0, 0, 0, 0, 0, 0, 0, 0x1}, "runtime error: integer overflow"},
+		{MaxUint512, Uint512{0, 0, 0, 0, 0, 0, 0, 0}, Uint512{0, 0, 0, 0, 0, 0, 0, 0x2}, "runtime error: integer overflow"},
+	}
+
+	for _, tc := range testCases {
+		func() {
+			defer func() {
+				err, ok := recover().(runtime.Error)
+				if !ok {
+					t.Errorf("Uint512(%d).Div1024(%d, %d) did not panic with a runtime.Error", tc.y, tc.hi, tc.lo)
+					return
+				}
+				if err.Error() != tc.want {
+					t.Errorf("Uint512(%d).Div1024(%d, %d) panicked with %q, want %q", tc.y, tc.hi, tc.lo, err.Error(), tc.want)
+				}
+			}()
+			tc.y.Div1024(tc.hi, tc.lo)
+		}()
+	}
+}
+
+func FuzzUint512_ModArith(f *testing.F) {
+	f.Add(
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x1),
+	)
+	f.Add(
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+	)
+	f.Add(
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xfffffffffffffffe),
+	)
+	f.Add(
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x3),
+		uint64(0x8000000000000000), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0),
+		uint64(0x8000000000000000), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x1),
+	)
+	f.Add(
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x10001),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x1), uint64(0xd),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, u4, u5, u6, u7, v0, v1, v2, v3, v4, v5, v6, v7, m0, m1, m2, m3, m4, m5, m6, m7 uint64) {
+		a := Uint512{u0, u1, u2, u3, u4, u5, u6, u7}
+		b := Uint512{v0, v1, v2, v3, v4, v5, v6, v7}
+		m := Uint512{m0, m1, m2, m3, m4, m5, m6, m7}
+		if m.IsZero() {
+			return
+		}
+		x, y, z := uint512ToBigInt(a), uint512ToBigInt(b), uint512ToBigInt(m)
+
+		if got, want := a.AddMod(b, m), new(big.Int).Mod(new(big.Int).Add(x, y), z); uint512ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("Uint512(%d).AddMod(%d, %d) = %d, want %d", a, b, m, got, want)
+		}
+		if got, want := a.SubMod(b, m), new(big.Int).Mod(new(big.Int).Sub(x, y), z); uint512ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("Uint512(%d).SubMod(%d, %d) = %d, want %d", a, b, m, got, want)
+		}
+		if got, want := a.MulMod(b, m), new(big.Int).Mod(new(big.Int).Mul(x, y), z); uint512ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("Uint512(%d).MulMod(%d, %d) = %d, want %d", a, b, m, got, want)
+		}
+		if got, want := a.ExpMod(b, m), new(big.Int).Exp(x, y, z); uint512ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("Uint512(%d).ExpMod(%d, %d) = %d, want %d", a, b, m, got, want)
+		}
+	})
+}
+
+func FuzzUint512_GCD(f *testing.F) {
+	f.Add(
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0),
+	)
+	f.Add(
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0xc),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x12),
+	)
+	f.Add(
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff),
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xfffffffffffffffe),
+	)
+	f.Add(
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xfffffffffffffffe),
+		uint64(0x8000000000000000), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0),
+	)
+	f.Add(
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x3),
+		uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffc5),
+	)
+	f.Add(
+		uint64(0x8000000000000000), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x10),
+	)
+	f.Add(
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x7),
+		uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x1),
+	)
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, u4, u5, u6, u7, v0, v1, v2, v3, v4, v5, v6, v7 uint64) {
+		a := Uint512{u0, u1, u2, u3, u4, u5, u6, u7}
+		b := Uint512{v0, v1, v2, v3, v4, v5, v6, v7}
+		x, y := uint512ToBigInt(a), uint512ToBigInt(b)
+		want := new(big.Int).GCD(nil, nil, x, y)
+
+		if got := a.GCD(b); uint512ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("Uint512(%d).GCD(%d) = %d, want %d", a, b, got, want)
+		}
+		if !a.IsZero() && !b.IsZero() {
+			wantLCM := new(big.Int).Mul(new(big.Int).Quo(x, want), y)
+			wantLCM.Mod(wantLCM, new(big.Int).Lsh(big.NewInt(1), 512))
+			if got := a.LCM(b); uint512ToBigInt(got).Cmp(wantLCM) != 0 {
+				t.Errorf("Uint512(%d).LCM(%d) = %d, want %d", a, b, got, wantLCM)
+			}
+		}
+		g, s, r := a.ExtendedGCD(b)
+		sum := new(big.Int).Add(new(big.Int).Mul(x, int512ToBigInt(s)), new(big.Int).Mul(y, int512ToBigInt(r)))
+		if uint512ToBigInt(g).Cmp(want) != 0 || sum.Cmp(want) != 0 {
+			t.Errorf("Uint512(%d).ExtendedGCD(%d) = (%d, %d, %d), want g = %d", a, b, g, s, r, want)
+		}
+		if want.Sign() != 0 {
+			// |s| <= b/(2g) + 1 and |r| <= a/(2g) + 1
+			sMax := new(big.Int).Add(new(big.Int).Quo(y, new(big.Int).Lsh(want, 1)), big.NewInt(1))
+			rMax := new(big.Int).Add(new(big.Int).Quo(x, new(big.Int).Lsh(want, 1)), big.NewInt(1))
+			if new(big.Int).Abs(int512ToBigInt(s)).Cmp(sMax) > 0 || new(big.Int).Abs(int512ToBigInt(r)).Cmp(rMax) > 0 {
+				t.Errorf("Uint512(%d).ExtendedGCD(%d) = (%d, %d, %d), coefficients are too large", a, b, g, s, r)
+			}
+		}
+
+		if b.IsZero() {
+			return
+		}
+		inv, ok := a.ModInverse(b)
+		wantInv := new(big.Int).ModInverse(x, y)
+		if b == (Uint512{0, 0, 0, 0, 0, 0, 0, 1}) {
+			wantInv = big.NewInt(0)
+		}
+		if wantInv == nil {
+			if ok {
+				t.Errorf("Uint512(%d).ModInverse(%d) = (%d, true), want (0, false)", a, b, inv)
+			}
+		} else if !ok || uint512ToBigInt(inv).Cmp(wantInv) != 0 {
+			t.Errorf("Uint512(%d).ModInverse(%d) = (%d, %t), want (%d, true)", a, b, inv, ok, wantInv)
+		}
+	})
+}
+
+func FuzzUint512_Sqrt(f *testing.F) {
+	f.Add(uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint8(2))
+	f.Add(uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x1), uint8(3))
+	f.Add(uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint8(2))
+	f.Add(uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint8(3))
+	f.Add(uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint8(17))
+	f.Add(uint64(0x7fffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint64(0xffffffffffffffff), uint8(7))
+	f.Add(uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0xffffffffffffffff), uint8(4))
+	f.Add(uint64(0x1), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint64(0x0), uint8(63))
+
+	f.Fuzz(func(t *testing.T, u0, u1, u2, u3, u4, u5, u6, u7 uint64, k uint8) {
+		a := Uint512{u0, u1, u2, u3, u4, u5, u6, u7}
+		x := uint512ToBigInt(a)
+
+		want := new(big.Int).Sqrt(x)
+		if got := a.Sqrt(); uint512ToBigInt(got).Cmp(want) != 0 {
+			t.Errorf("Uint512(%d).Sqrt() = %d, want %d", a, got, want)
+		}
+		isSquare := new(big.Int).Mul(want, want).Cmp(x) == 0
+		if got := a.IsPerfectSquare(); got != isSquare {
+			t.Errorf("Uint512(%d).IsPerfectSquare() = %t, want %t", a, got, isSquare)
+		}
+
+		// r is the floor n-th root of a if r**n <= a < (r+1)**n.
+		for _, n := range []uint{3, uint(k)%512 + 1} {
+			got := a.Root(n)
+			r := uint512ToBigInt(got)
+			lo := new(big.Int).Exp(r, big.NewInt(int64(n)), nil)
+			hi := new(big.Int).Exp(r.Add(r, big.NewInt(1)), big.NewInt(int64(n)), nil)
+			if lo.Cmp(x) > 0 || hi.Cmp(x) <= 0 {
+				t.Errorf("Uint512(%d).Root(%d) = %d", a, n, got)
+			}
+		}
+		if got, want := a.Cbrt(), a.Root(3); got != want {
+			t.Errorf("Uint512(%d).Cbrt() = %d, want %d", a, got, want)
+		}
+	})
+}
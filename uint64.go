@@ -2,7 +2,10 @@ package ints
 
 import (
 	"cmp"
+	"encoding/binary"
 	"fmt"
+	"math"
+	"math/big"
 	"math/bits"
 )
 
@@ -10,11 +13,46 @@ import (
 // It is an alias for the built-in uint64 type.
 type Uint64 uint64
 
+// MaxUint64 is the maximum value of Uint64.
+const MaxUint64 Uint64 = math.MaxUint64
+
+// Uint64Of returns v as a Uint64.
+// v is converted in the same way as Go's integer conversions.
+func Uint64Of[T builtinInteger](v T) Uint64 {
+	return Uint64(v)
+}
+
 // IsZero returns true if a is zero.
 func (a Uint64) IsZero() bool {
 	return a == 0
 }
 
+// IsInt64 reports whether a can be represented as an int64.
+func (a Uint64) IsInt64() bool {
+	_, ok := a.TryInt64()
+	return ok
+}
+
+// IsUint64 reports whether a can be represented as a uint64.
+func (a Uint64) IsUint64() bool {
+	_, ok := a.TryUint64()
+	return ok
+}
+
+// Int returns a as an int and reports whether a can be represented as an int.
+// If it can't, the result is a truncated to the size of int.
+func (a Uint64) Int() (int, bool) {
+	v, ok := a.TryInt64()
+	return int(v), ok && int64(int(v)) == int64(v)
+}
+
+// Uint returns a as a uint and reports whether a can be represented as a uint.
+// If it can't, the result is a truncated to the size of uint.
+func (a Uint64) Uint() (uint, bool) {
+	v, ok := a.TryUint64()
+	return uint(v), ok && uint64(uint(v)) == uint64(v)
+}
+
 // Add returns the sum a+b.
 //
 // This function's execution time does not depend on the inputs.
@@ -22,6 +60,34 @@ func (a Uint64) Add(b Uint64) Uint64 {
 	return a + b
 }
 
+// AddOverflow returns the sum a+b and reports whether the addition overflowed.
+//
+// This function's execution time does not depend on the inputs.
+func (a Uint64) AddOverflow(b Uint64) (Uint64, bool) {
+	c := a + b
+	return c, c < a
+}
+
+// SaturatingAdd returns the sum a+b.
+// If the result overflows, it is clamped to the maximum value.
+func (a Uint64) SaturatingAdd(b Uint64) Uint64 {
+	c, overflow := a.AddOverflow(b)
+	if overflow {
+		return math.MaxUint64
+	}
+	return c
+}
+
+// AddCarry returns the sum with carry of a, b and carry: sum = a + b + carry.
+// The carry input must be 0 or 1; otherwise the behavior is undefined.
+// The carryOut output is guaranteed to be 0 or 1.
+//
+// This function's execution time does not depend on the inputs.
+func (a Uint64) AddCarry(b Uint64, carry uint) (sum Uint64, carryOut uint) {
+	s, c := bits.Add64(uint64(a), uint64(b), uint64(carry))
+	return Uint64(s), uint(c)
+}
+
 // Sub returns the difference a-b.
 //
 // This function's execution time does not depend on the inputs.
@@ -29,11 +95,61 @@ func (a Uint64) Sub(b Uint64) Uint64 {
 	return a - b
 }
 
+// SubOverflow returns the difference a-b and reports whether the subtraction overflowed.
+//
+// This function's execution time does not depend on the inputs.
+func (a Uint64) SubOverflow(b Uint64) (Uint64, bool) {
+	return a - b, a < b
+}
+
+// SaturatingSub returns the difference a-b.
+// If the result overflows, it is clamped to zero.
+func (a Uint64) SaturatingSub(b Uint64) Uint64 {
+	c, overflow := a.SubOverflow(b)
+	if overflow {
+		return 0
+	}
+	return c
+}
+
+// SubBorrow returns the difference of a, b and borrow: diff = a - b - borrow.
+// The borrow input must be 0 or 1; otherwise the behavior is undefined.
+// The borrowOut output is guaranteed to be 0 or 1.
+//
+// This function's execution time does not depend on the inputs.
+func (a Uint64) SubBorrow(b Uint64, borrow uint) (diff Uint64, borrowOut uint) {
+	d, c := bits.Sub64(uint64(a), uint64(b), uint64(borrow))
+	return Uint64(d), uint(c)
+}
+
 // Mul returns the product a*b.
 func (a Uint64) Mul(b Uint64) Uint64 {
 	return a * b
 }
 
+// MulOverflow returns the product a*b and reports whether the multiplication overflowed.
+func (a Uint64) MulOverflow(b Uint64) (Uint64, bool) {
+	hi, lo := bits.Mul64(uint64(a), uint64(b))
+	return Uint64(lo), hi != 0
+}
+
+// SaturatingMul returns the product a*b.
+// If the result overflows, it is clamped to the maximum value.
+func (a Uint64) SaturatingMul(b Uint64) Uint64 {
+	c, overflow := a.MulOverflow(b)
+	if overflow {
+		return math.MaxUint64
+	}
+	return c
+}
+
+// MulFull returns the 128-bit product of a and b: (hi, lo) = a * b
+// with the product bits' upper half returned in hi and the lower half returned in lo.
+func (a Uint64) MulFull(b Uint64) (hi, lo Uint64) {
+	h, l := bits.Mul64(uint64(a), uint64(b))
+	return Uint64(h), Uint64(l)
+}
+
 // Mul128 returns the product a*b, the result is a 128-bit integer.
 func (a Uint64) Mul128(b Uint64) Uint128 {
 	h, l := bits.Mul64(uint64(a), uint64(b))
@@ -102,6 +218,204 @@ func (a Uint64) QuoRem(b Uint64) (Uint64, Uint64) {
 	return Uint64(q), Uint64(r)
 }
 
+// Div128 returns the quotient and remainder of (hi, lo) divided by a:
+// quo = (hi, lo)/a, rem = (hi, lo)%a with the dividend bits' upper half
+// in parameter hi and the lower half in parameter lo.
+// Div128 panics for a == 0 (division by zero) or a <= hi (quotient overflow).
+// Like [math/bits.Div64], it panics with a [runtime.Error].
+func (a Uint64) Div128(hi, lo Uint64) (quo, rem Uint64) {
+	q, r := bits.Div64(uint64(hi), uint64(lo), uint64(a))
+	return Uint64(q), Uint64(r)
+}
+
+// AddMod returns (a+b) mod m.
+// It panics if m == 0.
+func (a Uint64) AddMod(b, m Uint64) Uint64 {
+	a, b = a.Mod(m), b.Mod(m)
+	s, carry := a.AddCarry(b, 0)
+	if carry != 0 || s.Cmp(m) >= 0 {
+		s = s.Sub(m)
+	}
+	return s
+}
+
+// SubMod returns (a-b) mod m.
+// The result is always in the range [0, m).
+// It panics if m == 0.
+func (a Uint64) SubMod(b, m Uint64) Uint64 {
+	a, b = a.Mod(m), b.Mod(m)
+	d, borrow := a.SubBorrow(b, 0)
+	if borrow != 0 {
+		d = d.Add(m)
+	}
+	return d
+}
+
+// MulMod returns (a*b) mod m, computed without overflow.
+// It panics if m == 0.
+func (a Uint64) MulMod(b, m Uint64) Uint64 {
+	hi, lo := a.MulFull(b)
+	_, r := m.Div128(hi.Mod(m), lo)
+	return r
+}
+
+// ExpMod returns a**e mod m.
+// It panics if m == 0.
+func (a Uint64) ExpMod(e, m Uint64) Uint64 {
+	r := Uint64(1).Mod(m)
+	a = a.Mod(m)
+	for i := e.BitLen() - 1; i >= 0; i-- {
+		r = r.MulMod(r, m)
+		if e.Bit(i) != 0 {
+			r = r.MulMod(a, m)
+		}
+	}
+	return r
+}
+
+// GCD returns the greatest common divisor of a and b.
+// GCD(a, 0) is a, and GCD(0, 0) is 0.
+// It uses the binary GCD algorithm.
+func (a Uint64) GCD(b Uint64) Uint64 {
+	if a == 0 {
+		return b
+	}
+	if b == 0 {
+		return a
+	}
+	i, j := bits.TrailingZeros64(uint64(a)), bits.TrailingZeros64(uint64(b))
+	k := min(i, j)
+	a, b = a>>i, b>>j
+	for {
+		// a and b are odd here.
+		if a > b {
+			a, b = b, a
+		}
+		b -= a
+		if b == 0 {
+			return a << k
+		}
+		b >>= bits.TrailingZeros64(uint64(b))
+	}
+}
+
+// LCM returns the least common multiple of a and b.
+// LCM(a, 0) is 0. The result wraps around on overflow.
+func (a Uint64) LCM(b Uint64) Uint64 {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return a / a.GCD(b) * b
+}
+
+// ExtendedGCD returns the greatest common divisor g of a and b,
+// and the Bézout coefficients x and y that satisfy a*x + b*y = g.
+// The coefficients are the ones given by the extended Euclidean algorithm,
+// which are small enough to always fit in [Int64].
+func (a Uint64) ExtendedGCD(b Uint64) (g Uint64, x, y Int64) {
+	r0, r1 := a, b
+	s0, s1 := Uint64(1), Uint64(0)
+	t0, t1 := Uint64(0), Uint64(1)
+	// The coefficients alternate in sign, so only their magnitudes are tracked.
+	neg := false
+	for r1 != 0 {
+		q := r0 / r1
+		r0, r1 = r1, r0-q*r1
+		s0, s1 = s1, s0+q*s1
+		t0, t1 = t1, t0+q*t1
+		neg = !neg
+	}
+	x, y = Int64(s0), -Int64(t0)
+	if neg {
+		x, y = -x, -y
+	}
+	return r0, x, y
+}
+
+// ModInverse returns the multiplicative inverse of a modulo m, in the range [0, m).
+// ok is false if a and m are not coprime, or m == 0.
+func (a Uint64) ModInverse(m Uint64) (x Uint64, ok bool) {
+	if m == 0 {
+		return 0, false
+	}
+	g, s, _ := (a % m).ExtendedGCD(m)
+	if g != 1 {
+		return 0, false
+	}
+	if s < 0 {
+		return Uint64(s) + m, true
+	}
+	return Uint64(s), true
+}
+
+// Sqrt returns the floor square root of a, ⌊√a⌋.
+// It uses Newton's method seeded from [Uint64.BitLen].
+func (a Uint64) Sqrt() Uint64 {
+	if a <= 1 {
+		return a
+	}
+	x := Uint64(1) << ((a.BitLen() + 1) / 2)
+	for {
+		y := (x + a/x) >> 1
+		if y >= x {
+			return x
+		}
+		x = y
+	}
+}
+
+// Cbrt returns the floor cube root of a, ⌊∛a⌋.
+func (a Uint64) Cbrt() Uint64 {
+	return a.Root(3)
+}
+
+// Root returns the floor n-th root of a, ⌊a**(1/n)⌋.
+// It uses Newton's method seeded from [Uint64.BitLen].
+// It panics if n == 0.
+func (a Uint64) Root(n uint) Uint64 {
+	switch n {
+	case 0:
+		panic("ints: zeroth root")
+	case 1:
+		return a
+	case 2:
+		return a.Sqrt()
+	}
+	bitLen := uint(a.BitLen())
+	if bitLen <= n {
+		// a < 2**n, so the root is 0 or 1.
+		return min(a, 1)
+	}
+
+	x := Uint64(1) << ((bitLen + n - 1) / n)
+	for {
+		// y = ((n-1)*x + a/x**(n-1)) / n
+		p, overflow := x, false
+		for i := uint(2); i < n && !overflow; i++ {
+			p, overflow = p.MulOverflow(x)
+		}
+		var q Uint64
+		if !overflow {
+			q = a / p
+		}
+		y := (Uint64(n-1)*x + q) / Uint64(n)
+		if y >= x {
+			return x
+		}
+		x = y
+	}
+}
+
+// IsPerfectSquare reports whether a is the square of an integer.
+func (a Uint64) IsPerfectSquare() bool {
+	// Squares modulo 16 are 0, 1, 4 or 9.
+	if (0x0213>>(a&15))&1 == 0 {
+		return false
+	}
+	r := a.Sqrt()
+	return r.Mul(r) == a
+}
+
 // And returns the bitwise AND of a and b.
 func (a Uint64) And(b Uint64) Uint64 {
 	return a & b
@@ -134,6 +448,13 @@ func (a Uint64) Lsh(i uint) Uint64 {
 	return a << i
 }
 
+// LshOverflow returns the left shift a<<i and reports whether the shift overflowed,
+// i.e. whether a<<i differs from a multiplied by 2**i.
+func (a Uint64) LshOverflow(i uint) (Uint64, bool) {
+	c := a << i
+	return c, c>>i != a
+}
+
 // Rsh returns the logical right shift a>>i.
 //
 // This function's execution time does not depend on the inputs.
@@ -141,6 +462,100 @@ func (a Uint64) Rsh(i uint) Uint64 {
 	return a >> i
 }
 
+// URsh returns the logical right shift a>>i.
+// It is the same as [Uint64.Rsh] because Uint64 is unsigned.
+func (a Uint64) URsh(i uint) Uint64 {
+	return a.Rsh(i)
+}
+
+// RshRound returns a/2**i rounded to the nearest integer, with ties rounded to even.
+func (a Uint64) RshRound(i uint) Uint64 {
+	if i == 0 {
+		return a
+	}
+	q := a.Rsh(i)
+	half := a.Bit(int(min(i-1, 64)))
+	sticky := a.Extract(0, i-1) != 0
+	if half == 1 && (sticky || q.Bit(0) == 1) {
+		q++
+	}
+	return q
+}
+
+// RshCeil returns a/2**i rounded toward positive infinity.
+func (a Uint64) RshCeil(i uint) Uint64 {
+	q := a.Rsh(i)
+	if a.Extract(0, i) != 0 {
+		q++
+	}
+	return q
+}
+
+// RotateLeft returns the value of a rotated left by (k mod 64) bits.
+// To rotate a right by k bits, call a.RotateLeft(-k).
+//
+// This function's execution time does not depend on the inputs.
+func (a Uint64) RotateLeft(k int) Uint64 {
+	return Uint64(bits.RotateLeft64(uint64(a), k))
+}
+
+// Reverse returns the value of a with its bits in reversed order.
+func (a Uint64) Reverse() Uint64 {
+	return Uint64(bits.Reverse64(uint64(a)))
+}
+
+// ReverseBytes returns the value of a with its bytes in reversed order.
+func (a Uint64) ReverseBytes() Uint64 {
+	return Uint64(bits.ReverseBytes64(uint64(a)))
+}
+
+// Bit returns the value of the i'th bit of a. That is, it returns (a>>i)&1.
+// The bit index i must be >= 0.
+func (a Uint64) Bit(i int) uint {
+	if i < 0 {
+		panic("ints: negative bit index")
+	}
+	return uint(a>>uint(i)) & 1
+}
+
+// SetBit returns a with its i'th bit set to b (0 or 1).
+// It panics if i is out of the range [0, 64) or b is not 0 or 1.
+func (a Uint64) SetBit(i int, b uint) Uint64 {
+	checkBitIndex(i, 64)
+	if b > 1 {
+		panic("ints: set bit is not 0 or 1")
+	}
+	return a&^(1<<i) | Uint64(b)<<i
+}
+
+// ClearBit returns a with its i'th bit set to 0.
+// It panics if i is out of the range [0, 64).
+func (a Uint64) ClearBit(i int) Uint64 {
+	checkBitIndex(i, 64)
+	return a &^ (1 << i)
+}
+
+// FlipBit returns a with its i'th bit inverted.
+// It panics if i is out of the range [0, 64).
+func (a Uint64) FlipBit(i int) Uint64 {
+	checkBitIndex(i, 64)
+	return a ^ (1 << i)
+}
+
+// Extract returns the width bits of a starting at bit lo, shifted down to bit 0.
+// That is, it returns (a>>lo) & (1<<width - 1).
+func (a Uint64) Extract(lo, width uint) Uint64 {
+	width = min(width, 64)
+	return a >> lo & (MaxUint64 >> (64 - width))
+}
+
+// Insert returns a with the width bits starting at bit lo replaced by the low width bits of v.
+func (a Uint64) Insert(v Uint64, lo, width uint) Uint64 {
+	width = min(width, 64)
+	mask := MaxUint64 >> (64 - width) << lo
+	return a&^mask | v<<lo&mask
+}
+
 // LeadingZeros returns the number of leading zero bits in a; the result is 64 for a == 0.
 func (a Uint64) LeadingZeros() int {
 	return bits.LeadingZeros64(uint64(a))
@@ -156,6 +571,11 @@ func (a Uint64) BitLen() int {
 	return bits.Len64(uint64(a))
 }
 
+// OnesCount returns the number of one bits ("population count") in a.
+func (a Uint64) OnesCount() int {
+	return bits.OnesCount64(uint64(a))
+}
+
 // Sign returns the sign of a.
 // It returns 1 if a > 0, and 0 if a == 0.
 // It does not return -1 because Uint64 is unsigned.
@@ -173,6 +593,14 @@ func (a Uint64) Neg() Uint64 {
 	return -a
 }
 
+// NegOverflow returns the negation of a and reports whether the negation overflowed,
+// which happens if a is not zero.
+//
+// This function's execution time does not depend on the inputs.
+func (a Uint64) NegOverflow() (Uint64, bool) {
+	return -a, a != 0
+}
+
 // Cmp returns the comparison result of a and b.
 // It returns -1 if a < b, 0 if a == b, and 1 if a > b.
 func (a Uint64) Cmp(b Uint64) int {
@@ -206,3 +634,154 @@ func (a Uint64) String() string {
 func (a Uint64) Format(s fmt.State, verb rune) {
 	format(s, verb, a.Sign(), a)
 }
+
+// ParseUint64 is like [ParseInt64] but for unsigned numbers.
+// A sign prefix is not permitted.
+func ParseUint64(s string, base int) (Uint64, error) {
+	var z [1]uint64
+	err := parseUint("ParseUint64", s, base, 64, z[:])
+	return Uint64(z[0]), err
+}
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+// The result is the decimal representation of a.
+func (a Uint64) MarshalText() ([]byte, error) {
+	return a.AppendText(nil)
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+// It accepts the same syntax as Go integer literals, such as "0x" prefixes and underscores.
+// See [ParseUint64] for more details.
+func (a *Uint64) UnmarshalText(text []byte) error {
+	v, err := ParseUint64(string(text), 0)
+	if err != nil {
+		return err
+	}
+	*a = v
+	return nil
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+// The result is a JSON number. Use [JSONString] to encode a as a JSON string.
+func (a Uint64) MarshalJSON() ([]byte, error) {
+	return a.AppendText(nil)
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+// It accepts both a JSON number such as 123 and a JSON string such as "123" or "0x7b".
+// JSON strings are parsed in the same way as [Uint64.UnmarshalText].
+func (a *Uint64) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(a, data, ParseUint64)
+}
+
+// BytesBE returns the big-endian representation of a.
+func (a Uint64) BytesBE() [8]byte {
+	var b [8]byte
+	a.PutBytesBE(b[:])
+	return b
+}
+
+// BytesLE returns the little-endian representation of a.
+func (a Uint64) BytesLE() [8]byte {
+	var b [8]byte
+	a.PutBytesLE(b[:])
+	return b
+}
+
+// PutBytesBE stores the big-endian representation of a into b.
+// It panics if len(b) < 8.
+func (a Uint64) PutBytesBE(b []byte) {
+	binary.BigEndian.PutUint64(b, uint64(a))
+}
+
+// PutBytesLE stores the little-endian representation of a into b.
+// It panics if len(b) < 8.
+func (a Uint64) PutBytesLE(b []byte) {
+	binary.LittleEndian.PutUint64(b, uint64(a))
+}
+
+// Uint64FromBytesBE returns the Uint64 represented by the big-endian bytes b[:8].
+// It panics if len(b) < 8.
+func Uint64FromBytesBE(b []byte) Uint64 {
+	return Uint64(binary.BigEndian.Uint64(b))
+}
+
+// Uint64FromBytesLE returns the Uint64 represented by the little-endian bytes b[:8].
+// It panics if len(b) < 8.
+func Uint64FromBytesLE(b []byte) Uint64 {
+	return Uint64(binary.LittleEndian.Uint64(b))
+}
+
+// AppendBinary implements the [encoding.BinaryAppender] interface.
+// It appends the big-endian representation of a to dst.
+func (a Uint64) AppendBinary(dst []byte) ([]byte, error) {
+	b := a.BytesBE()
+	return append(dst, b[:]...), nil
+}
+
+// MarshalBinary implements the [encoding.BinaryMarshaler] interface.
+// The result is the 8-byte big-endian representation of a.
+func (a Uint64) MarshalBinary() ([]byte, error) {
+	return a.AppendBinary(make([]byte, 0, 8))
+}
+
+// UnmarshalBinary implements the [encoding.BinaryUnmarshaler] interface.
+// data must be exactly 8 bytes of the big-endian representation, as generated by [Uint64.MarshalBinary].
+func (a *Uint64) UnmarshalBinary(data []byte) error {
+	if len(data) != 8 {
+		return fmt.Errorf("ints: Uint64.UnmarshalBinary: invalid length %d, want 8", len(data))
+	}
+	*a = Uint64FromBytesBE(data)
+	return nil
+}
+
+// BigInt returns a as a new [*big.Int].
+func (a Uint64) BigInt() *big.Int {
+	return new(big.Int).SetUint64(uint64(a))
+}
+
+// Uint64FromBigInt returns x as an Uint64.
+// If x is not representable as an Uint64, the result is the lower 64 bits of x in two's complement,
+// and ok is false.
+func Uint64FromBigInt(x *big.Int) (Uint64, bool) {
+	var z [1]uint64
+	ok := uintFromBigInt(z[:], 64, x)
+	return Uint64(z[0]), ok
+}
+
+// Float64 returns the float64 value nearest to a, rounding ties to even, and an indication of any rounding error.
+// If a is too large to be represented by a float64, the result is ±Inf.
+func (a Uint64) Float64() (float64, big.Accuracy) {
+	z := [1]uint64{uint64(a)}
+	return float64FromLimbs(z[:])
+}
+
+// Uint64FromFloat64 returns the integer value of f rounded according to mode,
+// and the accuracy of the result: [big.Below] if the result is less than f, [big.Above] if it is greater than f.
+// Use [big.ToZero] to truncate f like the Go conversion, and [big.ToNearestEven] to round f to the nearest integer.
+//
+// If f is NaN, the result is 0 and the error is [ErrNaN].
+// If the rounded value of f is not representable as an Uint64, including ±Inf,
+// the result is clamped to 0 or the maximum value of Uint64, and the error is [ErrOverflow].
+func Uint64FromFloat64(f float64, mode big.RoundingMode) (Uint64, big.Accuracy, error) {
+	var z [1]uint64
+	acc, err := uintFromFloat64(z[:], 64, f, mode)
+	return Uint64(z[0]), acc, err
+}
+
+// BigFloat returns a as a new [*big.Float].
+// The precision of the result is 64 bits, so the conversion is always exact.
+func (a Uint64) BigFloat() *big.Float {
+	return new(big.Float).SetPrec(64).SetUint64(uint64(a))
+}
+
+// Uint64FromBigFloat returns the integer value of x rounded according to mode,
+// and the accuracy of the result: [big.Below] if the result is less than x, [big.Above] if it is greater than x.
+//
+// If the rounded value of x is not representable as an Uint64, including ±Inf,
+// the result is clamped to 0 or the maximum value of Uint64, and the error is [ErrOverflow].
+func Uint64FromBigFloat(x *big.Float, mode big.RoundingMode) (Uint64, big.Accuracy, error) {
+	var z [1]uint64
+	acc, err := uintFromBigFloat(z[:], 64, x, mode)
+	return Uint64(z[0]), acc, err
+}
@@ -1,9 +1,15 @@
 package ints
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
 	"math/big"
+	"runtime"
+	"slices"
+	"strconv"
 	"testing"
 )
 
@@ -482,3 +488,977 @@ func TestUint64_Format(t *testing.T) {
 		}
 	}
 }
+
+func TestParseUint64(t *testing.T) {
+	testCases := []struct {
+		s    string
+		base int
+		want Uint64
+		err  error
+	}{
+		{"0", 10, 0, nil},
+		{"1", 10, 1, nil},
+		{"18446744073709551615", 10, 18446744073709551615, nil},
+		{"18446744073709551616", 10, 18446744073709551615, strconv.ErrRange},
+		{"0xffffffffffffffff", 0, 18446744073709551615, nil},
+		{"0b101", 0, 5, nil},
+		{"0o17", 0, 15, nil},
+		{"017", 0, 15, nil},
+		{"1_000", 0, 1000, nil},
+		{"1_000", 10, 0, strconv.ErrSyntax},
+		{"_1", 0, 0, strconv.ErrSyntax},
+		{"Z", 62, 61, nil},
+		{"z", 36, 35, nil},
+		{"Z", 36, 35, nil},
+		{"", 10, 0, strconv.ErrSyntax},
+		{"12a", 10, 0, strconv.ErrSyntax},
+		{"-1", 10, 0, strconv.ErrSyntax},
+		{"+1", 10, 0, strconv.ErrSyntax},
+	}
+
+	for _, tc := range testCases {
+		got, err := ParseUint64(tc.s, tc.base)
+		if got != tc.want {
+			t.Errorf("ParseUint64(%q, %d) = %d, want %d", tc.s, tc.base, got, tc.want)
+		}
+		if !errors.Is(err, tc.err) {
+			t.Errorf("ParseUint64(%q, %d) error = %v, want %v", tc.s, tc.base, err, tc.err)
+		}
+		if err != nil {
+			var numErr *strconv.NumError
+			if !errors.As(err, &numErr) || numErr.Func != "ParseUint64" || numErr.Num != tc.s {
+				t.Errorf("ParseUint64(%q, %d) error = %#v, want *strconv.NumError", tc.s, tc.base, err)
+			}
+		}
+	}
+
+	if _, err := ParseUint64("1", 63); err == nil {
+		t.Errorf("ParseUint64(%q, %d) should fail", "1", 63)
+	}
+}
+
+func TestUint64_MarshalText(t *testing.T) {
+	testCases := []struct {
+		x    Uint64
+		want string
+	}{
+		{0, "0"},
+		{1, "1"},
+		{18446744073709551615, "18446744073709551615"},
+	}
+
+	for _, tc := range testCases {
+		got, err := tc.x.MarshalText()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != tc.want {
+			t.Errorf("Uint64(%d).MarshalText() = %q, want %q", tc.x, got, tc.want)
+		}
+	}
+}
+
+func TestUint64_UnmarshalText(t *testing.T) {
+	testCases := []struct {
+		s    string
+		want Uint64
+		err  error
+	}{
+		{"0", 0, nil},
+		{"0x10", 16, nil},
+		{"0b1_0", 2, nil},
+		{"010", 8, nil},
+		{"18446744073709551615", 18446744073709551615, nil},
+		{"18446744073709551616", 0, strconv.ErrRange},
+		{"1.0", 0, strconv.ErrSyntax},
+	}
+
+	for _, tc := range testCases {
+		var got Uint64
+		err := got.UnmarshalText([]byte(tc.s))
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Uint64.UnmarshalText(%q) error = %v, want %v", tc.s, err, tc.err)
+		}
+		if got != tc.want {
+			t.Errorf("Uint64.UnmarshalText(%q) = %d, want %d", tc.s, got, tc.want)
+		}
+	}
+}
+
+func TestUint64_MarshalJSON(t *testing.T) {
+	testCases := []struct {
+		x    Uint64
+		want string
+	}{
+		{0, "0"},
+		{1, "1"},
+		{18446744073709551615, "18446744073709551615"},
+	}
+
+	for _, tc := range testCases {
+		got, err := json.Marshal(tc.x)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != tc.want {
+			t.Errorf("json.Marshal(Uint64(%d)) = %s, want %s", tc.x, got, tc.want)
+		}
+	}
+}
+
+func TestUint64_UnmarshalJSON(t *testing.T) {
+	testCases := []struct {
+		s       string
+		want    Uint64
+		wantErr bool
+	}{
+		{"0", 0, false},
+		{"123", 123, false},
+		{"\"123\"", 123, false},
+		{"\"0x7f\"", 127, false},
+		{"18446744073709551615", 18446744073709551615, false},
+		{"\"18446744073709551615\"", 18446744073709551615, false},
+		{"18446744073709551616", 0, true},
+		{"0x7f", 0, true},
+		{"\"abc\"", 0, true},
+		{"1.5", 0, true},
+		{"null", 0, false},
+	}
+
+	for _, tc := range testCases {
+		var got Uint64
+		err := json.Unmarshal([]byte(tc.s), &got)
+		if (err != nil) != tc.wantErr {
+			t.Errorf("json.Unmarshal(%s) error = %v, wantErr %t", tc.s, err, tc.wantErr)
+		}
+		if got != tc.want {
+			t.Errorf("json.Unmarshal(%s) = %d, want %d", tc.s, got, tc.want)
+		}
+	}
+}
+
+func TestUint64_Bytes(t *testing.T) {
+	testCases := []struct {
+		x  Uint64
+		be [8]byte
+	}{
+		{
+			72623859790382856,
+			[8]byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08},
+		},
+		{
+			18446744073709551614,
+			[8]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xfe},
+		},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.x.BytesBE(); got != tc.be {
+			t.Errorf("Uint64(%d).BytesBE() = %x, want %x", tc.x, got, tc.be)
+		}
+		if got := Uint64FromBytesBE(tc.be[:]); got != tc.x {
+			t.Errorf("Uint64FromBytesBE(%x) = %d, want %d", tc.be, got, tc.x)
+		}
+
+		le := tc.be
+		slices.Reverse(le[:])
+		if got := tc.x.BytesLE(); got != le {
+			t.Errorf("Uint64(%d).BytesLE() = %x, want %x", tc.x, got, le)
+		}
+		if got := Uint64FromBytesLE(le[:]); got != tc.x {
+			t.Errorf("Uint64FromBytesLE(%x) = %d, want %d", le, got, tc.x)
+		}
+
+		data, err := tc.x.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(data, tc.be[:]) {
+			t.Errorf("Uint64(%d).MarshalBinary() = %x, want %x", tc.x, data, tc.be)
+		}
+		var got Uint64
+		if err := got.UnmarshalBinary(data); err != nil {
+			t.Fatal(err)
+		}
+		if got != tc.x {
+			t.Errorf("Uint64.UnmarshalBinary(%x) = %d, want %d", data, got, tc.x)
+		}
+	}
+}
+
+func TestUint64_UnmarshalBinary_InvalidLength(t *testing.T) {
+	var a Uint64
+	if err := a.UnmarshalBinary(make([]byte, 7)); err == nil {
+		t.Error("want error, got nil")
+	}
+	if err := a.UnmarshalBinary(make([]byte, 9)); err == nil {
+		t.Error("want error, got nil")
+	}
+}
+
+func TestUint64FromBigInt(t *testing.T) {
+	testCases := []struct {
+		x    *big.Int
+		want Uint64
+		ok   bool
+	}{
+		{big.NewInt(0), 0, true},
+		{big.NewInt(1), 1, true},
+		{bigIntFromString("18446744073709551615"), 18446744073709551615, true},
+		{bigIntFromString("18446744073709551616"), 0, false},
+		{bigIntFromString("18446744073709551616"), 0, false},
+		{big.NewInt(-1), 18446744073709551615, false},
+	}
+
+	for _, tc := range testCases {
+		got, ok := Uint64FromBigInt(tc.x)
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint64FromBigInt(%s) = %d, %t, want %d, %t", tc.x, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint64_BigInt(t *testing.T) {
+	testCases := []Uint64{0, 1, 18446744073709551615}
+
+	for _, a := range testCases {
+		got := a.BigInt()
+		if got.String() != a.String() {
+			t.Errorf("Uint64(%d).BigInt() = %s, want %s", a, got, a)
+		}
+		if b, ok := Uint64FromBigInt(got); b != a || !ok {
+			t.Errorf("Uint64FromBigInt(%s) = %d, %t, want %d, true", got, b, ok, a)
+		}
+	}
+}
+
+func TestUint64FromFloat64(t *testing.T) {
+	testCases := []struct {
+		f    float64
+		mode big.RoundingMode
+		want Uint64
+		acc  big.Accuracy
+		err  error
+	}{
+		{0, big.ToNearestEven, 0, big.Exact, nil},
+		{2.5, big.ToNearestEven, 2, big.Below, nil},
+		{3.5, big.ToNearestEven, 4, big.Above, nil},
+		{2.5, big.ToNearestAway, 3, big.Above, nil},
+		{2.5, big.ToZero, 2, big.Below, nil},
+		{2.5, big.AwayFromZero, 3, big.Above, nil},
+		{2.5, big.ToNegativeInf, 2, big.Below, nil},
+		{2.5, big.ToPositiveInf, 3, big.Above, nil},
+		{math.NaN(), big.ToNearestEven, 0, big.Exact, ErrNaN},
+		{math.Inf(1), big.ToNearestEven, 18446744073709551615, big.Below, ErrOverflow},
+		{math.Inf(-1), big.ToNearestEven, 0, big.Above, ErrOverflow},
+		{0x1p64, big.ToNearestEven, 18446744073709551615, big.Below, ErrOverflow},
+		{0x1p63, big.ToNearestEven, 9223372036854775808, big.Exact, nil},
+		{-0.5, big.ToZero, 0, big.Above, nil},
+		{-1, big.ToNearestEven, 0, big.Above, ErrOverflow},
+	}
+
+	for _, tc := range testCases {
+		got, acc, err := Uint64FromFloat64(tc.f, tc.mode)
+		if got != tc.want || acc != tc.acc || err != tc.err {
+			t.Errorf("Uint64FromFloat64(%v, %s) = %d, %s, %v, want %d, %s, %v", tc.f, tc.mode, got, acc, err, tc.want, tc.acc, tc.err)
+		}
+
+		if math.IsNaN(tc.f) {
+			continue
+		}
+		got, acc, err = Uint64FromBigFloat(big.NewFloat(tc.f), tc.mode)
+		if got != tc.want || acc != tc.acc || err != tc.err {
+			t.Errorf("Uint64FromBigFloat(%v, %s) = %d, %s, %v, want %d, %s, %v", tc.f, tc.mode, got, acc, err, tc.want, tc.acc, tc.err)
+		}
+	}
+}
+
+func TestUint64_Float64(t *testing.T) {
+	testCases := []Uint64{0, 1, 18446744073709551615}
+
+	for _, a := range testCases {
+		b := a.BigInt()
+		got, acc := a.Float64()
+		want, wantAcc := new(big.Float).SetInt(b).Float64()
+		if got != want || acc != wantAcc {
+			t.Errorf("Uint64(%d).Float64() = %v, %s, want %v, %s", a, got, acc, want, wantAcc)
+		}
+		if c, acc, err := Uint64FromBigFloat(a.BigFloat(), big.ToNearestEven); c != a || acc != big.Exact || err != nil {
+			t.Errorf("Uint64FromBigFloat(%v) = %d, %s, %v, want %d, %s, nil", a.BigFloat(), c, acc, err, a, big.Exact)
+		}
+	}
+}
+
+func TestUint64_AddOverflow(t *testing.T) {
+	testCases := []struct {
+		x, y     Uint64
+		want     Uint64
+		overflow bool
+	}{
+		{0, 0, 0, false},
+		{1, 1, 2, false},
+		{18446744073709551615, 1, 0, true},
+		{18446744073709551615, 18446744073709551615, 18446744073709551614, true},
+		{0, 1, 1, false},
+		{2, 9223372036854775807, 9223372036854775809, false},
+		{2, 9223372036854775808, 9223372036854775810, false},
+	}
+
+	for _, tc := range testCases {
+		got, overflow := tc.x.AddOverflow(tc.y)
+		if got != tc.want || overflow != tc.overflow {
+			t.Errorf("Uint64(%d).AddOverflow(%d) = %d, %t, want %d, %t", tc.x, tc.y, got, overflow, tc.want, tc.overflow)
+		}
+	}
+}
+
+func TestUint64_SubOverflow(t *testing.T) {
+	testCases := []struct {
+		x, y     Uint64
+		want     Uint64
+		overflow bool
+	}{
+		{0, 0, 0, false},
+		{1, 1, 0, false},
+		{18446744073709551615, 1, 18446744073709551614, false},
+		{18446744073709551615, 18446744073709551615, 0, false},
+		{0, 1, 18446744073709551615, true},
+		{2, 9223372036854775807, 9223372036854775811, true},
+		{2, 9223372036854775808, 9223372036854775810, true},
+	}
+
+	for _, tc := range testCases {
+		got, overflow := tc.x.SubOverflow(tc.y)
+		if got != tc.want || overflow != tc.overflow {
+			t.Errorf("Uint64(%d).SubOverflow(%d) = %d, %t, want %d, %t", tc.x, tc.y, got, overflow, tc.want, tc.overflow)
+		}
+	}
+}
+
+func TestUint64_MulOverflow(t *testing.T) {
+	testCases := []struct {
+		x, y     Uint64
+		want     Uint64
+		overflow bool
+	}{
+		{0, 0, 0, false},
+		{1, 1, 1, false},
+		{18446744073709551615, 1, 18446744073709551615, false},
+		{18446744073709551615, 18446744073709551615, 1, true},
+		{0, 1, 0, false},
+		{2, 9223372036854775807, 18446744073709551614, false},
+		{2, 9223372036854775808, 0, true},
+	}
+
+	for _, tc := range testCases {
+		got, overflow := tc.x.MulOverflow(tc.y)
+		if got != tc.want || overflow != tc.overflow {
+			t.Errorf("Uint64(%d).MulOverflow(%d) = %d, %t, want %d, %t", tc.x, tc.y, got, overflow, tc.want, tc.overflow)
+		}
+	}
+}
+
+func TestUint64_NegOverflow(t *testing.T) {
+	testCases := []struct {
+		x        Uint64
+		want     Uint64
+		overflow bool
+	}{
+		{0, 0, false},
+		{1, 18446744073709551615, true},
+		{18446744073709551615, 1, true},
+	}
+
+	for _, tc := range testCases {
+		got, overflow := tc.x.NegOverflow()
+		if got != tc.want || overflow != tc.overflow {
+			t.Errorf("Uint64(%d).NegOverflow() = %d, %t, want %d, %t", tc.x, got, overflow, tc.want, tc.overflow)
+		}
+	}
+}
+
+func TestUint64_LshOverflow(t *testing.T) {
+	testCases := []struct {
+		x        Uint64
+		i        uint
+		want     Uint64
+		overflow bool
+	}{
+		{1, 0, 1, false},
+		{1, 62, 4611686018427387904, false},
+		{1, 63, 9223372036854775808, false},
+		{1, 64, 0, true},
+		{3, 62, 13835058055282163712, false},
+		{18446744073709551615, 1, 18446744073709551614, true},
+		{0, 74, 0, false},
+	}
+
+	for _, tc := range testCases {
+		got, overflow := tc.x.LshOverflow(tc.i)
+		if got != tc.want || overflow != tc.overflow {
+			t.Errorf("Uint64(%d).LshOverflow(%d) = %d, %t, want %d, %t", tc.x, tc.i, got, overflow, tc.want, tc.overflow)
+		}
+	}
+}
+
+func TestUint64_AddCarry(t *testing.T) {
+	testCases := []struct {
+		x, y     Uint64
+		carry    uint
+		want     Uint64
+		carryOut uint
+	}{
+		{0, 0, 0, 0, 0},
+		{0, 0, 1, 1, 0},
+		{1, 2, 0, 3, 0},
+		{18446744073709551615, 0, 1, 0, 1},
+		{18446744073709551615, 1, 0, 0, 1},
+		{18446744073709551615, 18446744073709551615, 0, 18446744073709551614, 1},
+		{18446744073709551615, 18446744073709551615, 1, 18446744073709551615, 1},
+	}
+
+	for _, tc := range testCases {
+		got, carry := tc.x.AddCarry(tc.y, tc.carry)
+		if got != tc.want || carry != tc.carryOut {
+			t.Errorf("Uint64(%d).AddCarry(%d, %d) = %d, %d, want %d, %d", tc.x, tc.y, tc.carry, got, carry, tc.want, tc.carryOut)
+		}
+	}
+}
+
+func TestUint64_SubBorrow(t *testing.T) {
+	testCases := []struct {
+		x, y      Uint64
+		borrow    uint
+		want      Uint64
+		borrowOut uint
+	}{
+		{0, 0, 0, 0, 0},
+		{0, 0, 1, 18446744073709551615, 1},
+		{3, 2, 0, 1, 0},
+		{3, 2, 1, 0, 0},
+		{0, 1, 0, 18446744073709551615, 1},
+		{18446744073709551615, 18446744073709551615, 1, 18446744073709551615, 1},
+		{0, 18446744073709551615, 1, 0, 1},
+	}
+
+	for _, tc := range testCases {
+		got, borrow := tc.x.SubBorrow(tc.y, tc.borrow)
+		if got != tc.want || borrow != tc.borrowOut {
+			t.Errorf("Uint64(%d).SubBorrow(%d, %d) = %d, %d, want %d, %d", tc.x, tc.y, tc.borrow, got, borrow, tc.want, tc.borrowOut)
+		}
+	}
+}
+
+func TestUint64_MulFull(t *testing.T) {
+	testCases := []struct {
+		x, y   Uint64
+		hi, lo Uint64
+	}{
+		{0, 0, 0, 0},
+		{1, 1, 0, 1},
+		{18446744073709551615, 2, 1, 18446744073709551614},
+		{18446744073709551615, 18446744073709551615, 18446744073709551614, 1},
+		{9223372036854775808, 2, 1, 0},
+		{6148914691236517205, 6, 1, 18446744073709551614},
+	}
+
+	for _, tc := range testCases {
+		hi, lo := tc.x.MulFull(tc.y)
+		if hi != tc.hi || lo != tc.lo {
+			t.Errorf("Uint64(%d).MulFull(%d) = %d, %d, want %d, %d", tc.x, tc.y, hi, lo, tc.hi, tc.lo)
+		}
+	}
+}
+
+func TestUint64_SaturatingAdd(t *testing.T) {
+	testCases := []struct {
+		x, y Uint64
+		want Uint64
+	}{
+		{0, 0, 0},
+		{1, 2, 3},
+		{18446744073709551615, 1, 18446744073709551615},
+		{18446744073709551615, 18446744073709551615, 18446744073709551615},
+		{0, 1, 1},
+		{2, 9223372036854775808, 9223372036854775810},
+		{3, 2, 5},
+	}
+
+	for _, tc := range testCases {
+		got := tc.x.SaturatingAdd(tc.y)
+		if got != tc.want {
+			t.Errorf("Uint64(%d).SaturatingAdd(%d) = %d, want %d", tc.x, tc.y, got, tc.want)
+		}
+	}
+}
+
+func TestUint64_SaturatingSub(t *testing.T) {
+	testCases := []struct {
+		x, y Uint64
+		want Uint64
+	}{
+		{0, 0, 0},
+		{1, 2, 0},
+		{18446744073709551615, 1, 18446744073709551614},
+		{18446744073709551615, 18446744073709551615, 0},
+		{0, 1, 0},
+		{2, 9223372036854775808, 0},
+		{3, 2, 1},
+	}
+
+	for _, tc := range testCases {
+		got := tc.x.SaturatingSub(tc.y)
+		if got != tc.want {
+			t.Errorf("Uint64(%d).SaturatingSub(%d) = %d, want %d", tc.x, tc.y, got, tc.want)
+		}
+	}
+}
+
+func TestUint64_SaturatingMul(t *testing.T) {
+	testCases := []struct {
+		x, y Uint64
+		want Uint64
+	}{
+		{0, 0, 0},
+		{1, 2, 2},
+		{18446744073709551615, 1, 18446744073709551615},
+		{18446744073709551615, 18446744073709551615, 18446744073709551615},
+		{0, 1, 0},
+		{2, 9223372036854775808, 18446744073709551615},
+		{3, 2, 6},
+	}
+
+	for _, tc := range testCases {
+		got := tc.x.SaturatingMul(tc.y)
+		if got != tc.want {
+			t.Errorf("Uint64(%d).SaturatingMul(%d) = %d, want %d", tc.x, tc.y, got, tc.want)
+		}
+	}
+}
+
+func TestUint64Of(t *testing.T) {
+	testCases := []struct {
+		v    string
+		got  Uint64
+		want Uint64
+	}{
+		{"int8(-1)", Uint64Of(int8(-1)), 18446744073709551615},
+		{"int(42)", Uint64Of(int(42)), 42},
+		{"uint64(math.MaxUint64)", Uint64Of(uint64(math.MaxUint64)), 18446744073709551615},
+		{"int64(math.MinInt64)", Uint64Of(int64(math.MinInt64)), 9223372036854775808},
+		{"uintptr(7)", Uint64Of(uintptr(7)), 7},
+	}
+
+	for _, tc := range testCases {
+		if tc.got != tc.want {
+			t.Errorf("Uint64Of(%s) = %d, want %d", tc.v, tc.got, tc.want)
+		}
+	}
+}
+
+func TestUint64_IsInt64(t *testing.T) {
+	testCases := []struct {
+		a        Uint64
+		isInt64  bool
+		isUint64 bool
+		i64      int64
+		u64      uint64
+	}{
+		{0, true, true, 0, 0},
+		{1, true, true, 1, 1},
+		{9223372036854775807, true, true, 9223372036854775807, 9223372036854775807},
+		{9223372036854775808, false, true, math.MinInt64, 9223372036854775808},
+		{18446744073709551615, false, true, -1, math.MaxUint64},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.a.IsInt64(); got != tc.isInt64 {
+			t.Errorf("Uint64(%d).IsInt64() = %t, want %t", tc.a, got, tc.isInt64)
+		}
+		if got := tc.a.IsUint64(); got != tc.isUint64 {
+			t.Errorf("Uint64(%d).IsUint64() = %t, want %t", tc.a, got, tc.isUint64)
+		}
+
+		got, ok := tc.a.Int()
+		want, wantOK := int(tc.i64), tc.isInt64 && int64(int(tc.i64)) == tc.i64
+		if got != want || ok != wantOK {
+			t.Errorf("Uint64(%d).Int() = %d, %t, want %d, %t", tc.a, got, ok, want, wantOK)
+		}
+
+		gotU, ok := tc.a.Uint()
+		wantU, wantOK := uint(tc.u64), tc.isUint64 && uint64(uint(tc.u64)) == tc.u64
+		if gotU != wantU || ok != wantOK {
+			t.Errorf("Uint64(%d).Uint() = %d, %t, want %d, %t", tc.a, gotU, ok, wantU, wantOK)
+		}
+	}
+}
+
+func TestUint64_OnesCount(t *testing.T) {
+	testCases := []struct {
+		x    Uint64
+		want int
+	}{
+		{0, 0},
+		{1, 1},
+		{18446744073709551615, 64},
+		{9223372036854775808, 1},
+		{6148914691236517205, 32},
+		{67553994410557440, 4},
+	}
+
+	for _, tc := range testCases {
+		got := tc.x.OnesCount()
+		if got != tc.want {
+			t.Errorf("Uint64(%d).OnesCount() = %d, want %d", tc.x, got, tc.want)
+		}
+	}
+}
+
+func TestUint64_RotateLeft(t *testing.T) {
+	testCases := []struct {
+		x    Uint64
+		k    int
+		want Uint64
+	}{
+		{1, 0, 1},
+		{1, 1, 2},
+		{1, -1, 9223372036854775808},
+		{1, 64, 1},
+		{1, 67, 8},
+		{129, 1, 258},
+		{18446744073709551614, -3, 16140901064495857663},
+		{18, -65, 9},
+	}
+
+	for _, tc := range testCases {
+		got := tc.x.RotateLeft(tc.k)
+		if got != tc.want {
+			t.Errorf("Uint64(%#x).RotateLeft(%d) = %#x, want %#x", tc.x, tc.k, got, tc.want)
+		}
+	}
+}
+
+func TestUint64_Reverse(t *testing.T) {
+	testCases := []struct {
+		x            Uint64
+		reverse      Uint64
+		reverseBytes Uint64
+	}{
+		{0, 0, 0},
+		{1, 9223372036854775808, 72057594037927936},
+		{18446744073709551615, 18446744073709551615, 18446744073709551615},
+		{9223372036854775808, 1, 128},
+		{72623859790382856, 1216078140250538112, 578437695752307201},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.x.Reverse(); got != tc.reverse {
+			t.Errorf("Uint64(%#x).Reverse() = %#x, want %#x", tc.x, got, tc.reverse)
+		}
+		if got := tc.x.ReverseBytes(); got != tc.reverseBytes {
+			t.Errorf("Uint64(%#x).ReverseBytes() = %#x, want %#x", tc.x, got, tc.reverseBytes)
+		}
+	}
+}
+
+func TestUint64_Bit(t *testing.T) {
+	testCases := []Uint64{
+		0,
+		1,
+		18446744073709551615,
+		165,
+		9223372036854775808,
+	}
+
+	for _, a := range testCases {
+		for i := range 64 {
+			want := uint(a>>i) & 1
+			if got := a.Bit(i); got != want {
+				t.Errorf("Uint64(%d).Bit(%d) = %d, want %d", a, i, got, want)
+			}
+			if got, want := a.SetBit(i, 1), a|1<<i; got != want {
+				t.Errorf("Uint64(%d).SetBit(%d, 1) = %d, want %d", a, i, got, want)
+			}
+			if got, want := a.SetBit(i, 0), a&^(1<<i); got != want {
+				t.Errorf("Uint64(%d).SetBit(%d, 0) = %d, want %d", a, i, got, want)
+			}
+			if got, want := a.ClearBit(i), a&^(1<<i); got != want {
+				t.Errorf("Uint64(%d).ClearBit(%d) = %d, want %d", a, i, got, want)
+			}
+			if got, want := a.FlipBit(i), a^(1<<i); got != want {
+				t.Errorf("Uint64(%d).FlipBit(%d) = %d, want %d", a, i, got, want)
+			}
+		}
+		if got := a.Bit(64); got != 0 {
+			t.Errorf("Uint64(%d).Bit(64) = %d, want 0", a, got)
+		}
+	}
+}
+
+func TestUint64_SetBit_Panic(t *testing.T) {
+	testCases := []struct {
+		i int
+		b uint
+	}{
+		{-1, 0},
+		{64, 0},
+		{0, 2},
+	}
+
+	for _, tc := range testCases {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Uint64(0).SetBit(%d, %d) did not panic", tc.i, tc.b)
+				}
+			}()
+			Uint64(0).SetBit(tc.i, tc.b)
+		}()
+	}
+}
+
+func TestUint64_Extract(t *testing.T) {
+	testCases := []struct {
+		x         Uint64
+		lo, width uint
+		want      Uint64
+	}{
+		{18446744073709551615, 0, 64, 18446744073709551615},
+		{18446744073709551615, 0, 0, 0},
+		{165, 4, 4, 10},
+		{165, 1, 3, 2},
+		{18446744073709551615, 63, 8, 1},
+		{90, 0, 74, 90},
+		{18446744073709551615, 64, 4, 0},
+	}
+
+	for _, tc := range testCases {
+		got := tc.x.Extract(tc.lo, tc.width)
+		if got != tc.want {
+			t.Errorf("Uint64(%#x).Extract(%d, %d) = %#x, want %#x", tc.x, tc.lo, tc.width, got, tc.want)
+		}
+	}
+}
+
+func TestUint64_Insert(t *testing.T) {
+	testCases := []struct {
+		x, v      Uint64
+		lo, width uint
+		want      Uint64
+	}{
+		{0, 18446744073709551615, 0, 64, 18446744073709551615},
+		{18446744073709551615, 0, 4, 4, 18446744073709551375},
+		{165, 15, 1, 3, 175},
+		{0, 255, 62, 8, 13835058055282163712},
+		{18446744073709551615, 0, 0, 0, 18446744073709551615},
+		{90, 3, 0, 74, 3},
+	}
+
+	for _, tc := range testCases {
+		got := tc.x.Insert(tc.v, tc.lo, tc.width)
+		if got != tc.want {
+			t.Errorf("Uint64(%#x).Insert(%#x, %d, %d) = %#x, want %#x", tc.x, tc.v, tc.lo, tc.width, got, tc.want)
+		}
+	}
+}
+
+func TestUint64_RshRound(t *testing.T) {
+	values := []Uint64{
+		0,
+		1,
+		2,
+		3,
+		5,
+		6,
+		2147483648,
+		3221225472,
+		9223372036854775807,
+		9223372036854775808,
+		9223372036854775809,
+		12682136550675316736,
+		18446744073709551615,
+	}
+	shifts := []uint{0, 1, 2, 3, 31, 32, 33, 63, 64, 65, 128}
+
+	for _, a := range values {
+		x := new(big.Int).SetUint64(uint64(a))
+		for _, i := range shifts {
+			if got, want := a.RshRound(i), rshRoundBigInt(x, i); got.BigInt().Cmp(want) != 0 {
+				t.Errorf("Uint64(%d).RshRound(%d) = %d, want %d", a, i, got, want)
+			}
+			if got, want := a.RshCeil(i), rshCeilBigInt(x, i); got.BigInt().Cmp(want) != 0 {
+				t.Errorf("Uint64(%d).RshCeil(%d) = %d, want %d", a, i, got, want)
+			}
+			if got, want := a.URsh(i), new(big.Int).Rsh(new(big.Int).SetUint64(uint64(a)), i); got.BigInt().Cmp(want) != 0 {
+				t.Errorf("Uint64(%d).URsh(%d) = %d, want %d", a, i, got, want)
+			}
+		}
+	}
+}
+
+func TestUint64_Div128(t *testing.T) {
+	testCases := []struct {
+		hi, lo, y Uint64
+		quo, rem  Uint64
+	}{
+		{0, 0, 1, 0, 0},
+		{0, 18446744073709551615, 7, 2635249153387078802, 1},
+		{1, 0, 2, 9223372036854775808, 0},
+		{18446744073709551614, 18446744073709551615, 18446744073709551615, 18446744073709551615, 18446744073709551614},
+		{5, 12345, 6, 15372286728091295070, 5},
+		{4611686018427387904, 3, 9223372036854775808, 9223372036854775808, 3},
+	}
+
+	for _, tc := range testCases {
+		quo, rem := tc.y.Div128(tc.hi, tc.lo)
+		if quo != tc.quo || rem != tc.rem {
+			t.Errorf("Uint64(%d).Div128(%d, %d) = %d, %d, want %d, %d", tc.y, tc.hi, tc.lo, quo, rem, tc.quo, tc.rem)
+		}
+	}
+}
+
+func TestUint64_Div128_Panic(t *testing.T) {
+	testCases := []struct {
+		hi, lo, y Uint64
+		want      string
+	}{
+		{0, 1, 0, "runtime error: integer divide by zero"},
+		{1, 0, 1, "runtime error: integer overflow"},
+		{18446744073709551615, 0, 2, "runtime error: integer overflow"},
+	}
+
+	for _, tc := range testCases {
+		func() {
+			defer func() {
+				err, ok := recover().(runtime.Error)
+				if !ok {
+					t.Errorf("Uint64(%d).Div128(%d, %d) did not panic with a runtime.Error", tc.y, tc.hi, tc.lo)
+					return
+				}
+				if err.Error() != tc.want {
+					t.Errorf("Uint64(%d).Div128(%d, %d) panicked with %q, want %q", tc.y, tc.hi, tc.lo, err.Error(), tc.want)
+				}
+			}()
+			tc.y.Div128(tc.hi, tc.lo)
+		}()
+	}
+}
+
+func FuzzUint64_ModArith(f *testing.F) {
+	f.Add(uint64(0), uint64(0), uint64(1))
+	f.Add(uint64(18446744073709551615), uint64(18446744073709551615), uint64(18446744073709551615))
+	f.Add(uint64(18446744073709551615), uint64(18446744073709551615), uint64(18446744073709551614))
+	f.Add(uint64(3), uint64(9223372036854775808), uint64(9223372036854775809))
+
+	f.Fuzz(func(t *testing.T, u, v, w uint64) {
+		a, b, m := Uint64(u), Uint64(v), Uint64(w)
+		if m.IsZero() {
+			return
+		}
+		x, y, z := new(big.Int).SetUint64(uint64(a)), new(big.Int).SetUint64(uint64(b)), new(big.Int).SetUint64(uint64(m))
+
+		if got, want := a.AddMod(b, m), new(big.Int).Mod(new(big.Int).Add(x, y), z); new(big.Int).SetUint64(uint64(got)).Cmp(want) != 0 {
+			t.Errorf("Uint64(%d).AddMod(%d, %d) = %d, want %d", a, b, m, got, want)
+		}
+		if got, want := a.SubMod(b, m), new(big.Int).Mod(new(big.Int).Sub(x, y), z); new(big.Int).SetUint64(uint64(got)).Cmp(want) != 0 {
+			t.Errorf("Uint64(%d).SubMod(%d, %d) = %d, want %d", a, b, m, got, want)
+		}
+		if got, want := a.MulMod(b, m), new(big.Int).Mod(new(big.Int).Mul(x, y), z); new(big.Int).SetUint64(uint64(got)).Cmp(want) != 0 {
+			t.Errorf("Uint64(%d).MulMod(%d, %d) = %d, want %d", a, b, m, got, want)
+		}
+		if got, want := a.ExpMod(b, m), new(big.Int).Exp(x, y, z); new(big.Int).SetUint64(uint64(got)).Cmp(want) != 0 {
+			t.Errorf("Uint64(%d).ExpMod(%d, %d) = %d, want %d", a, b, m, got, want)
+		}
+	})
+}
+
+func FuzzUint64_GCD(f *testing.F) {
+	f.Add(uint64(0), uint64(0))
+	f.Add(uint64(12), uint64(18))
+	f.Add(uint64(18446744073709551615), uint64(18446744073709551614))
+	f.Add(uint64(18446744073709551614), uint64(9223372036854775808))
+	f.Add(uint64(3), uint64(18446744073709551557))
+	f.Add(uint64(9223372036854775808), uint64(16))
+	f.Add(uint64(7), uint64(1))
+
+	f.Fuzz(func(t *testing.T, u, v uint64) {
+		a, b := Uint64(u), Uint64(v)
+		x, y := new(big.Int).SetUint64(uint64(a)), new(big.Int).SetUint64(uint64(b))
+		want := new(big.Int).GCD(nil, nil, x, y)
+
+		if got := a.GCD(b); new(big.Int).SetUint64(uint64(got)).Cmp(want) != 0 {
+			t.Errorf("Uint64(%d).GCD(%d) = %d, want %d", a, b, got, want)
+		}
+		if !a.IsZero() && !b.IsZero() {
+			wantLCM := new(big.Int).Mul(new(big.Int).Quo(x, want), y)
+			wantLCM.Mod(wantLCM, new(big.Int).Lsh(big.NewInt(1), 64))
+			if got := a.LCM(b); new(big.Int).SetUint64(uint64(got)).Cmp(wantLCM) != 0 {
+				t.Errorf("Uint64(%d).LCM(%d) = %d, want %d", a, b, got, wantLCM)
+			}
+		}
+		g, s, r := a.ExtendedGCD(b)
+		sum := new(big.Int).Add(new(big.Int).Mul(x, big.NewInt(int64(s))), new(big.Int).Mul(y, big.NewInt(int64(r))))
+		if new(big.Int).SetUint64(uint64(g)).Cmp(want) != 0 || sum.Cmp(want) != 0 {
+			t.Errorf("Uint64(%d).ExtendedGCD(%d) = (%d, %d, %d), want g = %d", a, b, g, s, r, want)
+		}
+
+		if b == 0 {
+			return
+		}
+		inv, ok := a.ModInverse(b)
+		wantInv := new(big.Int).ModInverse(x, y)
+		if b == 1 {
+			wantInv = big.NewInt(0)
+		}
+		if wantInv == nil {
+			if ok {
+				t.Errorf("Uint64(%d).ModInverse(%d) = (%d, true), want (0, false)", a, b, inv)
+			}
+		} else if !ok || new(big.Int).SetUint64(uint64(inv)).Cmp(wantInv) != 0 {
+			t.Errorf("Uint64(%d).ModInverse(%d) = (%d, %t), want (%d, true)", a, b, inv, ok, wantInv)
+		}
+	})
+}
+
+func TestUint64_Sqrt(t *testing.T) {
+	testCases := []struct {
+		a        Uint64
+		sqrt     Uint64
+		cbrt     Uint64
+		root5    Uint64
+		isSquare bool
+	}{
+		{0, 0, 0, 0, true},
+		{1, 1, 1, 1, true},
+		{2, 1, 1, 1, false},
+		{3, 1, 1, 1, false},
+		{4, 2, 1, 1, true},
+		{15, 3, 2, 1, false},
+		{16, 4, 2, 1, true},
+		{17, 4, 2, 1, false},
+		{26, 5, 2, 1, false},
+		{27, 5, 3, 1, false},
+		{28, 5, 3, 1, false},
+		{1000, 31, 10, 3, false},
+		{1024, 32, 10, 4, true},
+		{12345678, 3513, 231, 26, false},
+		{10460353203, 102275, 2187, 100, false},
+		{18446744073709551614, 4294967295, 2642245, 7131, false},
+		{18446744073709551615, 4294967295, 2642245, 7131, false},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.a.Sqrt(); got != tc.sqrt {
+			t.Errorf("Uint64(%d).Sqrt() = %d, want %d", tc.a, got, tc.sqrt)
+		}
+		if got := tc.a.Cbrt(); got != tc.cbrt {
+			t.Errorf("Uint64(%d).Cbrt() = %d, want %d", tc.a, got, tc.cbrt)
+		}
+		if got := tc.a.Root(5); got != tc.root5 {
+			t.Errorf("Uint64(%d).Root(5) = %d, want %d", tc.a, got, tc.root5)
+		}
+		if got := tc.a.IsPerfectSquare(); got != tc.isSquare {
+			t.Errorf("Uint64(%d).IsPerfectSquare() = %t, want %t", tc.a, got, tc.isSquare)
+		}
+	}
+}
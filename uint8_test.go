@@ -1,9 +1,14 @@
 package ints
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
 	"math/big"
+	"runtime"
+	"slices"
 	"strconv"
 	"testing"
 )
@@ -471,3 +476,796 @@ func TestUint8_Format(t *testing.T) {
 		}
 	}
 }
+
+func TestParseUint8(t *testing.T) {
+	testCases := []struct {
+		s    string
+		base int
+		want Uint8
+		err  error
+	}{
+		{"0", 10, 0, nil},
+		{"1", 10, 1, nil},
+		{"255", 10, 255, nil},
+		{"256", 10, 255, strconv.ErrRange},
+		{"0xff", 0, 255, nil},
+		{"0b101", 0, 5, nil},
+		{"0o17", 0, 15, nil},
+		{"017", 0, 15, nil},
+		{"1_000", 0, 255, strconv.ErrRange},
+		{"1_000", 10, 0, strconv.ErrSyntax},
+		{"_1", 0, 0, strconv.ErrSyntax},
+		{"Z", 62, 61, nil},
+		{"z", 36, 35, nil},
+		{"Z", 36, 35, nil},
+		{"", 10, 0, strconv.ErrSyntax},
+		{"12a", 10, 0, strconv.ErrSyntax},
+		{"-1", 10, 0, strconv.ErrSyntax},
+		{"+1", 10, 0, strconv.ErrSyntax},
+	}
+
+	for _, tc := range testCases {
+		got, err := ParseUint8(tc.s, tc.base)
+		if got != tc.want {
+			t.Errorf("ParseUint8(%q, %d) = %d, want %d", tc.s, tc.base, got, tc.want)
+		}
+		if !errors.Is(err, tc.err) {
+			t.Errorf("ParseUint8(%q, %d) error = %v, want %v", tc.s, tc.base, err, tc.err)
+		}
+		if err != nil {
+			var numErr *strconv.NumError
+			if !errors.As(err, &numErr) || numErr.Func != "ParseUint8" || numErr.Num != tc.s {
+				t.Errorf("ParseUint8(%q, %d) error = %#v, want *strconv.NumError", tc.s, tc.base, err)
+			}
+		}
+	}
+
+	if _, err := ParseUint8("1", 63); err == nil {
+		t.Errorf("ParseUint8(%q, %d) should fail", "1", 63)
+	}
+}
+
+func TestParseUint8_RoundTrip(t *testing.T) {
+	for i := 0; i <= math.MaxUint8; i++ {
+		a := Uint8(i)
+		for base := 2; base <= 62; base++ {
+			got, err := ParseUint8(a.Text(base), base)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != a {
+				t.Errorf("ParseUint8(%q, %d) = %d, want %d", a.Text(base), base, got, a)
+			}
+		}
+	}
+}
+
+func TestUint8_MarshalText(t *testing.T) {
+	testCases := []struct {
+		x    Uint8
+		want string
+	}{
+		{0, "0"},
+		{1, "1"},
+		{255, "255"},
+	}
+
+	for _, tc := range testCases {
+		got, err := tc.x.MarshalText()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != tc.want {
+			t.Errorf("Uint8(%d).MarshalText() = %q, want %q", tc.x, got, tc.want)
+		}
+	}
+}
+
+func TestUint8_UnmarshalText(t *testing.T) {
+	testCases := []struct {
+		s    string
+		want Uint8
+		err  error
+	}{
+		{"0", 0, nil},
+		{"0x10", 16, nil},
+		{"0b1_0", 2, nil},
+		{"010", 8, nil},
+		{"255", 255, nil},
+		{"256", 0, strconv.ErrRange},
+		{"1.0", 0, strconv.ErrSyntax},
+	}
+
+	for _, tc := range testCases {
+		var got Uint8
+		err := got.UnmarshalText([]byte(tc.s))
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Uint8.UnmarshalText(%q) error = %v, want %v", tc.s, err, tc.err)
+		}
+		if got != tc.want {
+			t.Errorf("Uint8.UnmarshalText(%q) = %d, want %d", tc.s, got, tc.want)
+		}
+	}
+}
+
+func TestUint8_MarshalJSON(t *testing.T) {
+	testCases := []struct {
+		x    Uint8
+		want string
+	}{
+		{0, "0"},
+		{1, "1"},
+		{255, "255"},
+	}
+
+	for _, tc := range testCases {
+		got, err := json.Marshal(tc.x)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != tc.want {
+			t.Errorf("json.Marshal(Uint8(%d)) = %s, want %s", tc.x, got, tc.want)
+		}
+	}
+}
+
+func TestUint8_UnmarshalJSON(t *testing.T) {
+	testCases := []struct {
+		s       string
+		want    Uint8
+		wantErr bool
+	}{
+		{"0", 0, false},
+		{"123", 123, false},
+		{"\"123\"", 123, false},
+		{"\"0x7f\"", 127, false},
+		{"255", 255, false},
+		{"\"255\"", 255, false},
+		{"256", 0, true},
+		{"0x7f", 0, true},
+		{"\"abc\"", 0, true},
+		{"1.5", 0, true},
+		{"null", 0, false},
+	}
+
+	for _, tc := range testCases {
+		var got Uint8
+		err := json.Unmarshal([]byte(tc.s), &got)
+		if (err != nil) != tc.wantErr {
+			t.Errorf("json.Unmarshal(%s) error = %v, wantErr %t", tc.s, err, tc.wantErr)
+		}
+		if got != tc.want {
+			t.Errorf("json.Unmarshal(%s) = %d, want %d", tc.s, got, tc.want)
+		}
+	}
+}
+
+func TestUint8_Bytes(t *testing.T) {
+	testCases := []struct {
+		x  Uint8
+		be [1]byte
+	}{
+		{
+			1,
+			[1]byte{0x01},
+		},
+		{
+			254,
+			[1]byte{0xfe},
+		},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.x.BytesBE(); got != tc.be {
+			t.Errorf("Uint8(%d).BytesBE() = %x, want %x", tc.x, got, tc.be)
+		}
+		if got := Uint8FromBytesBE(tc.be[:]); got != tc.x {
+			t.Errorf("Uint8FromBytesBE(%x) = %d, want %d", tc.be, got, tc.x)
+		}
+
+		le := tc.be
+		slices.Reverse(le[:])
+		if got := tc.x.BytesLE(); got != le {
+			t.Errorf("Uint8(%d).BytesLE() = %x, want %x", tc.x, got, le)
+		}
+		if got := Uint8FromBytesLE(le[:]); got != tc.x {
+			t.Errorf("Uint8FromBytesLE(%x) = %d, want %d", le, got, tc.x)
+		}
+
+		data, err := tc.x.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(data, tc.be[:]) {
+			t.Errorf("Uint8(%d).MarshalBinary() = %x, want %x", tc.x, data, tc.be)
+		}
+		var got Uint8
+		if err := got.UnmarshalBinary(data); err != nil {
+			t.Fatal(err)
+		}
+		if got != tc.x {
+			t.Errorf("Uint8.UnmarshalBinary(%x) = %d, want %d", data, got, tc.x)
+		}
+	}
+}
+
+func TestUint8_UnmarshalBinary_InvalidLength(t *testing.T) {
+	var a Uint8
+	if err := a.UnmarshalBinary(make([]byte, 0)); err == nil {
+		t.Error("want error, got nil")
+	}
+	if err := a.UnmarshalBinary(make([]byte, 2)); err == nil {
+		t.Error("want error, got nil")
+	}
+}
+
+func TestUint8FromBigInt(t *testing.T) {
+	testCases := []struct {
+		x    *big.Int
+		want Uint8
+		ok   bool
+	}{
+		{big.NewInt(0), 0, true},
+		{big.NewInt(1), 1, true},
+		{big.NewInt(255), 255, true},
+		{big.NewInt(256), 0, false},
+		{big.NewInt(256), 0, false},
+		{big.NewInt(-1), 255, false},
+	}
+
+	for _, tc := range testCases {
+		got, ok := Uint8FromBigInt(tc.x)
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Uint8FromBigInt(%s) = %d, %t, want %d, %t", tc.x, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestUint8_BigInt(t *testing.T) {
+	for i := 0; i <= math.MaxUint8; i++ {
+		a := Uint8(i)
+		got := a.BigInt()
+		if got.Cmp(big.NewInt(int64(i))) != 0 {
+			t.Errorf("Uint8(%d).BigInt() = %s, want %d", a, got, i)
+		}
+		if b, ok := Uint8FromBigInt(got); b != a || !ok {
+			t.Errorf("Uint8FromBigInt(%s) = %d, %t, want %d, true", got, b, ok, a)
+		}
+	}
+}
+
+func TestUint8FromFloat64(t *testing.T) {
+	testCases := []struct {
+		f    float64
+		mode big.RoundingMode
+		want Uint8
+		acc  big.Accuracy
+		err  error
+	}{
+		{0, big.ToNearestEven, 0, big.Exact, nil},
+		{2.5, big.ToNearestEven, 2, big.Below, nil},
+		{3.5, big.ToNearestEven, 4, big.Above, nil},
+		{2.5, big.ToNearestAway, 3, big.Above, nil},
+		{2.5, big.ToZero, 2, big.Below, nil},
+		{2.5, big.AwayFromZero, 3, big.Above, nil},
+		{2.5, big.ToNegativeInf, 2, big.Below, nil},
+		{2.5, big.ToPositiveInf, 3, big.Above, nil},
+		{math.NaN(), big.ToNearestEven, 0, big.Exact, ErrNaN},
+		{math.Inf(1), big.ToNearestEven, 255, big.Below, ErrOverflow},
+		{math.Inf(-1), big.ToNearestEven, 0, big.Above, ErrOverflow},
+		{0x1p8, big.ToNearestEven, 255, big.Below, ErrOverflow},
+		{0x1p7, big.ToNearestEven, 128, big.Exact, nil},
+		{-0.5, big.ToZero, 0, big.Above, nil},
+		{-1, big.ToNearestEven, 0, big.Above, ErrOverflow},
+	}
+
+	for _, tc := range testCases {
+		got, acc, err := Uint8FromFloat64(tc.f, tc.mode)
+		if got != tc.want || acc != tc.acc || err != tc.err {
+			t.Errorf("Uint8FromFloat64(%v, %s) = %d, %s, %v, want %d, %s, %v", tc.f, tc.mode, got, acc, err, tc.want, tc.acc, tc.err)
+		}
+
+		if math.IsNaN(tc.f) {
+			continue
+		}
+		got, acc, err = Uint8FromBigFloat(big.NewFloat(tc.f), tc.mode)
+		if got != tc.want || acc != tc.acc || err != tc.err {
+			t.Errorf("Uint8FromBigFloat(%v, %s) = %d, %s, %v, want %d, %s, %v", tc.f, tc.mode, got, acc, err, tc.want, tc.acc, tc.err)
+		}
+	}
+}
+
+func TestUint8_Float64(t *testing.T) {
+	for i := 0; i <= math.MaxUint8; i++ {
+		a := Uint8(i)
+		f, acc := a.Float64()
+		if f != float64(i) || acc != big.Exact {
+			t.Errorf("Uint8(%d).Float64() = %v, %s, want %v, %s", a, f, acc, float64(i), big.Exact)
+		}
+		if got := a.BigFloat(); got.Cmp(big.NewFloat(float64(i))) != 0 {
+			t.Errorf("Uint8(%d).BigFloat() = %v, want %v", a, got, i)
+		}
+	}
+}
+
+func TestUint8_Overflow(t *testing.T) {
+	lo, hi := 0, math.MaxUint8
+	for i := lo; i <= hi; i++ {
+		a := Uint8(i)
+		for j := lo; j <= hi; j++ {
+			b := Uint8(j)
+
+			got, overflow := a.AddOverflow(b)
+			if want := i + j; got != Uint8(want) || overflow != (want < lo || want > hi) {
+				t.Errorf("Uint8(%d).AddOverflow(%d) = %d, %t, want %d", a, b, got, overflow, want)
+			}
+
+			got, overflow = a.SubOverflow(b)
+			if want := i - j; got != Uint8(want) || overflow != (want < lo || want > hi) {
+				t.Errorf("Uint8(%d).SubOverflow(%d) = %d, %t, want %d", a, b, got, overflow, want)
+			}
+
+			got, overflow = a.MulOverflow(b)
+			if want := i * j; got != Uint8(want) || overflow != (want < lo || want > hi) {
+				t.Errorf("Uint8(%d).MulOverflow(%d) = %d, %t, want %d", a, b, got, overflow, want)
+			}
+		}
+
+		got, overflow := a.NegOverflow()
+		if want := -i; got != Uint8(want) || overflow != (want < lo || want > hi) {
+			t.Errorf("Uint8(%d).NegOverflow() = %d, %t, want %d", a, got, overflow, want)
+		}
+
+		for s := range uint(10) {
+			got, overflow := a.LshOverflow(s)
+			if want := i << s; got != Uint8(want) || overflow != (want < lo || want > hi) {
+				t.Errorf("Uint8(%d).LshOverflow(%d) = %d, %t, want %d", a, s, got, overflow, want)
+			}
+		}
+	}
+}
+
+func TestUint8_Carry(t *testing.T) {
+	for i := range 256 {
+		a := Uint8(i)
+		for j := range 256 {
+			b := Uint8(j)
+			for c := range uint(2) {
+				sum, carry := a.AddCarry(b, c)
+				if want := i + j + int(c); sum != Uint8(want) || carry != uint(want>>8) {
+					t.Errorf("Uint8(%d).AddCarry(%d, %d) = %d, %d, want %d", a, b, c, sum, carry, want)
+				}
+
+				diff, borrow := a.SubBorrow(b, c)
+				if want := i - j - int(c); diff != Uint8(want) || borrow != uint(want>>8&1) {
+					t.Errorf("Uint8(%d).SubBorrow(%d, %d) = %d, %d, want %d", a, b, c, diff, borrow, want)
+				}
+			}
+
+			hi, lo := a.MulFull(b)
+			if want := i * j; hi != Uint8(want>>8) || lo != Uint8(want) {
+				t.Errorf("Uint8(%d).MulFull(%d) = %d, %d, want %d", a, b, hi, lo, want)
+			}
+		}
+	}
+}
+
+func TestUint8_Saturating(t *testing.T) {
+	lo, hi := 0, math.MaxUint8
+	for i := lo; i <= hi; i++ {
+		a := Uint8(i)
+		for j := lo; j <= hi; j++ {
+			b := Uint8(j)
+			if want := min(max(i+j, lo), hi); a.SaturatingAdd(b) != Uint8(want) {
+				t.Errorf("Uint8(%d).SaturatingAdd(%d) = %d, want %d", a, b, a.SaturatingAdd(b), want)
+			}
+			if want := min(max(i-j, lo), hi); a.SaturatingSub(b) != Uint8(want) {
+				t.Errorf("Uint8(%d).SaturatingSub(%d) = %d, want %d", a, b, a.SaturatingSub(b), want)
+			}
+			if want := min(max(i*j, lo), hi); a.SaturatingMul(b) != Uint8(want) {
+				t.Errorf("Uint8(%d).SaturatingMul(%d) = %d, want %d", a, b, a.SaturatingMul(b), want)
+			}
+		}
+	}
+}
+
+func TestUint8Of(t *testing.T) {
+	testCases := []struct {
+		v    string
+		got  Uint8
+		want Uint8
+	}{
+		{"int8(-1)", Uint8Of(int8(-1)), 255},
+		{"int(42)", Uint8Of(int(42)), 42},
+		{"uint64(math.MaxUint64)", Uint8Of(uint64(math.MaxUint64)), 255},
+		{"int64(math.MinInt64)", Uint8Of(int64(math.MinInt64)), 0},
+		{"uintptr(7)", Uint8Of(uintptr(7)), 7},
+	}
+
+	for _, tc := range testCases {
+		if tc.got != tc.want {
+			t.Errorf("Uint8Of(%s) = %d, want %d", tc.v, tc.got, tc.want)
+		}
+	}
+}
+
+func TestUint8_IsInt64(t *testing.T) {
+	testCases := []struct {
+		a        Uint8
+		isInt64  bool
+		isUint64 bool
+		i64      int64
+		u64      uint64
+	}{
+		{0, true, true, 0, 0},
+		{1, true, true, 1, 1},
+		{255, true, true, 255, 255},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.a.IsInt64(); got != tc.isInt64 {
+			t.Errorf("Uint8(%d).IsInt64() = %t, want %t", tc.a, got, tc.isInt64)
+		}
+		if got := tc.a.IsUint64(); got != tc.isUint64 {
+			t.Errorf("Uint8(%d).IsUint64() = %t, want %t", tc.a, got, tc.isUint64)
+		}
+
+		got, ok := tc.a.Int()
+		want, wantOK := int(tc.i64), tc.isInt64 && int64(int(tc.i64)) == tc.i64
+		if got != want || ok != wantOK {
+			t.Errorf("Uint8(%d).Int() = %d, %t, want %d, %t", tc.a, got, ok, want, wantOK)
+		}
+
+		gotU, ok := tc.a.Uint()
+		wantU, wantOK := uint(tc.u64), tc.isUint64 && uint64(uint(tc.u64)) == tc.u64
+		if gotU != wantU || ok != wantOK {
+			t.Errorf("Uint8(%d).Uint() = %d, %t, want %d, %t", tc.a, gotU, ok, wantU, wantOK)
+		}
+	}
+}
+
+func TestUint8_OnesCount(t *testing.T) {
+	for i := range 256 {
+		a := Uint8(i)
+		want := 0
+		for j := range 8 {
+			want += (i >> j) & 1
+		}
+		if got := a.OnesCount(); got != want {
+			t.Errorf("Uint8(%d).OnesCount() = %d, want %d", a, got, want)
+		}
+	}
+}
+
+func TestUint8_RotateLeft(t *testing.T) {
+	testCases := []struct {
+		x    Uint8
+		k    int
+		want Uint8
+	}{
+		{1, 0, 1},
+		{1, 1, 2},
+		{1, -1, 128},
+		{1, 8, 1},
+		{1, 11, 8},
+		{129, 1, 3},
+		{254, -3, 223},
+		{18, -9, 9},
+	}
+
+	for _, tc := range testCases {
+		got := tc.x.RotateLeft(tc.k)
+		if got != tc.want {
+			t.Errorf("Uint8(%#x).RotateLeft(%d) = %#x, want %#x", tc.x, tc.k, got, tc.want)
+		}
+	}
+}
+
+func TestUint8_Reverse(t *testing.T) {
+	testCases := []struct {
+		x            Uint8
+		reverse      Uint8
+		reverseBytes Uint8
+	}{
+		{0, 0, 0},
+		{1, 128, 1},
+		{255, 255, 255},
+		{128, 1, 128},
+		{1, 128, 1},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.x.Reverse(); got != tc.reverse {
+			t.Errorf("Uint8(%#x).Reverse() = %#x, want %#x", tc.x, got, tc.reverse)
+		}
+		if got := tc.x.ReverseBytes(); got != tc.reverseBytes {
+			t.Errorf("Uint8(%#x).ReverseBytes() = %#x, want %#x", tc.x, got, tc.reverseBytes)
+		}
+	}
+}
+
+func TestUint8_Bit(t *testing.T) {
+	testCases := []Uint8{
+		0,
+		1,
+		255,
+		165,
+		128,
+	}
+
+	for _, a := range testCases {
+		for i := range 8 {
+			want := uint(a>>i) & 1
+			if got := a.Bit(i); got != want {
+				t.Errorf("Uint8(%d).Bit(%d) = %d, want %d", a, i, got, want)
+			}
+			if got, want := a.SetBit(i, 1), a|1<<i; got != want {
+				t.Errorf("Uint8(%d).SetBit(%d, 1) = %d, want %d", a, i, got, want)
+			}
+			if got, want := a.SetBit(i, 0), a&^(1<<i); got != want {
+				t.Errorf("Uint8(%d).SetBit(%d, 0) = %d, want %d", a, i, got, want)
+			}
+			if got, want := a.ClearBit(i), a&^(1<<i); got != want {
+				t.Errorf("Uint8(%d).ClearBit(%d) = %d, want %d", a, i, got, want)
+			}
+			if got, want := a.FlipBit(i), a^(1<<i); got != want {
+				t.Errorf("Uint8(%d).FlipBit(%d) = %d, want %d", a, i, got, want)
+			}
+		}
+		if got := a.Bit(8); got != 0 {
+			t.Errorf("Uint8(%d).Bit(8) = %d, want 0", a, got)
+		}
+	}
+}
+
+func TestUint8_SetBit_Panic(t *testing.T) {
+	testCases := []struct {
+		i int
+		b uint
+	}{
+		{-1, 0},
+		{8, 0},
+		{0, 2},
+	}
+
+	for _, tc := range testCases {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Uint8(0).SetBit(%d, %d) did not panic", tc.i, tc.b)
+				}
+			}()
+			Uint8(0).SetBit(tc.i, tc.b)
+		}()
+	}
+}
+
+func TestUint8_Extract(t *testing.T) {
+	testCases := []struct {
+		x         Uint8
+		lo, width uint
+		want      Uint8
+	}{
+		{255, 0, 8, 255},
+		{255, 0, 0, 0},
+		{165, 4, 4, 10},
+		{165, 1, 3, 2},
+		{255, 7, 8, 1},
+		{90, 0, 18, 90},
+		{255, 8, 4, 0},
+	}
+
+	for _, tc := range testCases {
+		got := tc.x.Extract(tc.lo, tc.width)
+		if got != tc.want {
+			t.Errorf("Uint8(%#x).Extract(%d, %d) = %#x, want %#x", tc.x, tc.lo, tc.width, got, tc.want)
+		}
+	}
+}
+
+func TestUint8_Insert(t *testing.T) {
+	testCases := []struct {
+		x, v      Uint8
+		lo, width uint
+		want      Uint8
+	}{
+		{0, 255, 0, 8, 255},
+		{255, 0, 4, 4, 15},
+		{165, 15, 1, 3, 175},
+		{0, 255, 6, 8, 192},
+		{255, 0, 0, 0, 255},
+		{90, 3, 0, 18, 3},
+	}
+
+	for _, tc := range testCases {
+		got := tc.x.Insert(tc.v, tc.lo, tc.width)
+		if got != tc.want {
+			t.Errorf("Uint8(%#x).Insert(%#x, %d, %d) = %#x, want %#x", tc.x, tc.v, tc.lo, tc.width, got, tc.want)
+		}
+	}
+}
+
+func TestUint8_RshRound(t *testing.T) {
+	values := []Uint8{
+		0,
+		1,
+		2,
+		3,
+		5,
+		6,
+		8,
+		12,
+		127,
+		128,
+		129,
+		176,
+		255,
+	}
+	shifts := []uint{0, 1, 2, 3, 4, 5, 7, 8, 9, 72}
+
+	for _, a := range values {
+		x := new(big.Int).SetUint64(uint64(a))
+		for _, i := range shifts {
+			if got, want := a.RshRound(i), rshRoundBigInt(x, i); got.BigInt().Cmp(want) != 0 {
+				t.Errorf("Uint8(%d).RshRound(%d) = %d, want %d", a, i, got, want)
+			}
+			if got, want := a.RshCeil(i), rshCeilBigInt(x, i); got.BigInt().Cmp(want) != 0 {
+				t.Errorf("Uint8(%d).RshCeil(%d) = %d, want %d", a, i, got, want)
+			}
+			if got, want := a.URsh(i), new(big.Int).Rsh(new(big.Int).SetUint64(uint64(a)), i); got.BigInt().Cmp(want) != 0 {
+				t.Errorf("Uint8(%d).URsh(%d) = %d, want %d", a, i, got, want)
+			}
+		}
+	}
+}
+
+func TestUint8_Div16(t *testing.T) {
+	testCases := []struct {
+		hi, lo, y Uint8
+		quo, rem  Uint8
+	}{
+		{0, 0, 1, 0, 0},
+		{0, 255, 7, 36, 3},
+		{1, 0, 2, 128, 0},
+		{254, 255, 255, 255, 254},
+		{5, 57, 6, 222, 5},
+		{64, 3, 128, 128, 3},
+	}
+
+	for _, tc := range testCases {
+		quo, rem := tc.y.Div16(tc.hi, tc.lo)
+		if quo != tc.quo || rem != tc.rem {
+			t.Errorf("Uint8(%d).Div16(%d, %d) = %d, %d, want %d, %d", tc.y, tc.hi, tc.lo, quo, rem, tc.quo, tc.rem)
+		}
+	}
+}
+
+func TestUint8_Div16_Panic(t *testing.T) {
+	testCases := []struct {
+		hi, lo, y Uint8
+		want      string
+	}{
+		{0, 1, 0, "runtime error: integer divide by zero"},
+		{1, 0, 1, "runtime error: integer overflow"},
+		{255, 0, 2, "runtime error: integer overflow"},
+	}
+
+	for _, tc := range testCases {
+		func() {
+			defer func() {
+				err, ok := recover().(runtime.Error)
+				if !ok {
+					t.Errorf("Uint8(%d).Div16(%d, %d) did not panic with a runtime.Error", tc.y, tc.hi, tc.lo)
+					return
+				}
+				if err.Error() != tc.want {
+					t.Errorf("Uint8(%d).Div16(%d, %d) panicked with %q, want %q", tc.y, tc.hi, tc.lo, err.Error(), tc.want)
+				}
+			}()
+			tc.y.Div16(tc.hi, tc.lo)
+		}()
+	}
+}
+
+func TestUint8_ModArith(t *testing.T) {
+	for _, m := range []int{1, 2, 3, 7, 128, 200, 255} {
+		for i := range 256 {
+			for j := range 256 {
+				a, b, m := Uint8(i), Uint8(j), Uint8(m)
+				if got, want := a.AddMod(b, m), (i+j)%int(m); got != Uint8(want) {
+					t.Errorf("Uint8(%d).AddMod(%d, %d) = %d, want %d", a, b, m, got, want)
+				}
+				if got, want := a.SubMod(b, m), ((i-j)%int(m)+int(m))%int(m); got != Uint8(want) {
+					t.Errorf("Uint8(%d).SubMod(%d, %d) = %d, want %d", a, b, m, got, want)
+				}
+				if got, want := a.MulMod(b, m), (i*j)%int(m); got != Uint8(want) {
+					t.Errorf("Uint8(%d).MulMod(%d, %d) = %d, want %d", a, b, m, got, want)
+				}
+				want := new(big.Int).Exp(big.NewInt(int64(i)), big.NewInt(int64(j)), big.NewInt(int64(m)))
+				if got := a.ExpMod(b, m); uint64(got) != want.Uint64() {
+					t.Errorf("Uint8(%d).ExpMod(%d, %d) = %d, want %d", a, b, m, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestUint8_GCD(t *testing.T) {
+	for i := range 256 {
+		for j := range 256 {
+			a, b := Uint8(i), Uint8(j)
+			x, y := big.NewInt(int64(i)), big.NewInt(int64(j))
+			want := new(big.Int).GCD(nil, nil, x, y)
+			if got := a.GCD(b); uint64(got) != want.Uint64() {
+				t.Errorf("Uint8(%d).GCD(%d) = %d, want %d", a, b, got, want)
+			}
+			if got := a.LCM(b); i != 0 && j != 0 && int(got) != i/int(want.Int64())*j%256 {
+				t.Errorf("Uint8(%d).LCM(%d) = %d, want %d", a, b, got, i/int(want.Int64())*j%256)
+			}
+			g, s, r := a.ExtendedGCD(b)
+			if uint64(g) != want.Uint64() || i*int(s)+j*int(r) != int(g) {
+				t.Errorf("Uint8(%d).ExtendedGCD(%d) = (%d, %d, %d), want g = %d", a, b, g, s, r, want)
+			}
+			if j == 0 {
+				continue
+			}
+			inv, ok := a.ModInverse(b)
+			wantInv := new(big.Int).ModInverse(x, y)
+			if j == 1 {
+				wantInv = big.NewInt(0)
+			}
+			if wantInv == nil {
+				if ok {
+					t.Errorf("Uint8(%d).ModInverse(%d) = (%d, true), want (0, false)", a, b, inv)
+				}
+			} else if !ok || uint64(inv) != wantInv.Uint64() {
+				t.Errorf("Uint8(%d).ModInverse(%d) = (%d, %t), want (%d, true)", a, b, inv, ok, wantInv)
+			}
+		}
+	}
+}
+
+func TestUint8_Sqrt(t *testing.T) {
+	for i := range 256 {
+		a := Uint8(i)
+		for n := uint(1); n <= 9; n++ {
+			// want is the largest r such that r**n <= i.
+			want := 0
+			for r := 1; r <= i; r++ {
+				p := 1
+				for range n {
+					p *= r
+				}
+				if p > i {
+					break
+				}
+				want = r
+			}
+			if got := a.Root(n); got != Uint8(want) {
+				t.Errorf("Uint8(%d).Root(%d) = %d, want %d", a, n, got, want)
+			}
+			if n == 2 {
+				if got := a.Sqrt(); got != Uint8(want) {
+					t.Errorf("Uint8(%d).Sqrt() = %d, want %d", a, got, want)
+				}
+				if got := a.IsPerfectSquare(); got != (want*want == i) {
+					t.Errorf("Uint8(%d).IsPerfectSquare() = %t, want %t", a, got, want*want == i)
+				}
+			}
+			if n == 3 {
+				if got := a.Cbrt(); got != Uint8(want) {
+					t.Errorf("Uint8(%d).Cbrt() = %d, want %d", a, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestUint8_Root_Panic(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Uint8(2).Root(0) did not panic")
+		}
+	}()
+	Uint8(2).Root(0)
+}